@@ -2,18 +2,33 @@ package main
 
 import (
 	"github.com/hajimehoshi/ebiten"
-	"github.com/hajimehoshi/ebiten/ebitenutil"
-	"image/color"
 )
 
 // Hitbox is used to determine collisions
 type Hitbox struct {
-	x float64
-	y float64
-	w float64
-	h float64
+	x     float64
+	y     float64
+	w     float64
+	h     float64
+	shape ShapeKind // box unless set, see hitShapes
+	mask  *Mask     // solid pixels for shapePixels, lined up with the sprite, only those inside the box count
+
+	// the mask is turned by angle around its centre moved by pivotX, pivotY, like
+	// Transform. The box does not turn, it stays the part of the screen that can be hit.
+	angle  float64
+	pivotX float64
+	pivotY float64
 }
 
+// groups of actors, each is drawn on its own layer
+const (
+	groupEnemy       = "enemy"
+	groupEnemyBullet = "enemyBullet"
+)
+
+// actorBullet is the actorType of anything an enemy fires
+const actorBullet = "bullet"
+
 type Actor struct {
 	group       string
 	imageWidth  int
@@ -24,16 +39,27 @@ type Actor struct {
 	vy          float64
 	angle       int
 	actorType   string
-	sprite      string
+	sprite      SpriteName
 	toDelete    bool
 	t           int
 	hitbox      Hitbox
+	anim        *Animation
+	animStart   int
+	animEnd     func(a *Actor)
+	animDone    bool
+	transform   Transform // rotation, scale and flip, on top of angle
+	faceTravel  bool      // turn to point along vx, vy each update
+	queryMark   int       // last SpatialHash query that returned this actor
+	collision   CollisionLayer
+	id          int // unique per actor, in the order they were created
 }
 
 // Actors is an array of Actor and num, to count
 type Actors struct {
 	actors []*Actor
 	num    int
+	grid   SpatialHash // rebuilt by Update and added to by Create, answers Query
+	nextID int
 }
 
 // Create an actor
@@ -52,25 +78,55 @@ func (a *Actors) Create(newActor Actor) {
 		toDelete:    false,
 		t:           newActor.t,
 		hitbox: Hitbox{
-			x: newActor.hitbox.x,
-			y: newActor.hitbox.y,
-			w: newActor.hitbox.w,
-			h: newActor.hitbox.h,
+			x:      newActor.hitbox.x,
+			y:      newActor.hitbox.y,
+			w:      newActor.hitbox.w,
+			h:      newActor.hitbox.h,
+			shape:  newActor.hitbox.shape,
+			mask:   newActor.hitbox.mask,
+			angle:  newActor.hitbox.angle,
+			pivotX: newActor.hitbox.pivotX,
+			pivotY: newActor.hitbox.pivotY,
 		},
+		anim:       newActor.anim,
+		animStart:  newActor.animStart,
+		animEnd:    newActor.animEnd,
+		transform:  newActor.transform,
+		faceTravel: newActor.faceTravel,
+		collision:  newActor.collision,
+		id:         a.nextID,
 	})
+	a.nextID++
+	created := a.actors[len(a.actors)-1]
+	created.Animate() // show the first frame straight away
 	a.num = len(a.actors)
+	if a.grid.cells != nil {
+		a.grid.Insert(created) // collides this tick, not only after the next Update
+	}
 }
 
-// Update runs against every actor
+// Update runs against every actor, then rebuilds the spatial hash at their new positions
 func (a *Actors) Update() {
 	for i := 0; i < len(a.actors); i++ {
 		a.actors[i].Update()
 	}
+	if a.grid.cells == nil {
+		a.grid = newSpatialHash(screenWidth, screenHeight)
+	}
+	a.grid.Build(a.actors)
+}
+
+// Query returns the actors that might overlap a box, from the spatial hash built by the last Update
+func (a *Actors) Query(x float64, y float64, w float64, h float64) []*Actor {
+	if a.grid.cells == nil {
+		return nil
+	}
+	return a.grid.Query(x, y, w, h)
 }
 
 //Clean up actors that are to be deleted
 func (a *Actors) Clean() bool {
-	var tempActors = make([]*Actor, 0)
+	var tempActors = a.actors[:0] // filter in place, no new slice every frame
 	var atLeastOne bool = false
 	for _, actor := range a.actors {
 		if !actor.toDelete {
@@ -79,7 +135,11 @@ func (a *Actors) Clean() bool {
 			atLeastOne = true
 		}
 	}
+	for i := len(tempActors); i < len(a.actors); i++ {
+		a.actors[i] = nil // let the removed actors be collected
+	}
 	a.actors = tempActors
+	a.num = len(tempActors)
 	return atLeastOne
 }
 
@@ -100,67 +160,69 @@ func (a *Actor) SetVectors(vx float64, vy float64) {
 	a.vy = vy
 }
 
-// CollidesHitbox does this hitbox collide with anything in this group?
-func (a *Actors) CollidesHitbox(x float64, y float64, hitbox Hitbox, group string) bool {
-	var hasCollided bool = false
-	for j := len(a.actors) - 1; j >= 0; j-- {
-		var b = a.actors[j]
-
-		if b.group == group {
-			if collide(
-				x+hitbox.x,
-				y+hitbox.y,
-				hitbox.w,
-				hitbox.h,
-				b.x+b.hitbox.x,
-				b.y+b.hitbox.y,
-				b.hitbox.w,
-				b.hitbox.h,
-			) {
-				hasCollided = true
-			}
-		}
-	}
-	return hasCollided
-}
-
 // Update an Actor
 func (a *Actor) Update() {
 	var newX = a.x + a.vx
 	var newY = a.y + a.vy
 	a.SetPosition(newX, newY)
 
+	if a.faceTravel && (a.vx != 0 || a.vy != 0) {
+		a.transform.angle = faceAngle(a.vx, a.vy)
+	}
+	// a pixel mask turns with the sprite, as DrawGroup draws it
+	a.hitbox.angle = a.transform.angle + stepsToRadians(a.angle)
+	a.hitbox.pivotX, a.hitbox.pivotY = a.transform.pivotX, a.transform.pivotY
+
 	a.t++ // tick the timer for this actor
+	a.Animate()
+}
+
+// Play starts an animation on this actor, onEnd is called each time it completes and may be nil
+func (a *Actor) Play(anim *Animation, onEnd func(a *Actor)) {
+	a.anim = anim
+	a.animStart = a.t
+	a.animEnd = onEnd
+	a.animDone = false
+	a.Animate()
+}
+
+// Animate picks the sprite for the current frame, driven by the actor timer
+func (a *Actor) Animate() {
+	if a.anim == nil || len(a.anim.frames) == 0 || a.animDone {
+		return
+	}
+	ticks := a.t - a.animStart
+	frame, done := a.anim.FrameAt(ticks)
+	a.sprite = frame.sprite
+	if a.hitbox.shape == shapePixels && frame.mask != nil {
+		a.hitbox.mask = frame.mask
+	}
+	if done {
+		a.animDone = true
+		if a.animEnd != nil {
+			a.animEnd(a)
+		}
+		return
+	}
+	// looping animations report every time they wrap around
+	if a.anim.mode == animLoop && ticks > 0 && ticks%a.anim.length == 0 && a.animEnd != nil {
+		a.animEnd(a)
+	}
 }
 
 //Draw this group of actors
-func (a *Actors) DrawGroup(g *Game, screen *ebiten.Image, group string) {
+func (a *Actors) DrawGroup(g *Game, group string, layer int) {
+	var noColor ebiten.ColorM
 	for i := 0; i < len(a.actors); i++ {
 		if !a.actors[i].toDelete {
 			if group == a.actors[i].group {
 				s := a.actors[i]
 
-				var w, h int
-				w = g.sprites[s.sprite].width
-				h = g.sprites[s.sprite].height
-
-				g.op.GeoM.Reset()
-				g.op.GeoM.Translate(-float64(w)/2, -float64(h)/2)
-				//g.op.GeoM.Rotate(2 * math.Pi * float64(s.angle) / maxAngle)
-				g.op.GeoM.Translate(float64(w)/2, float64(h)/2)
-				g.op.GeoM.Translate(float64(s.x), float64(s.y))
-				//screen.DrawImage(thisImg, &g.op)
-				spriteDraw(screen, g, s.sprite)
-				if g.debug {
-					ebitenutil.DrawRect(
-						screen,
-						float64(s.x+s.hitbox.x),
-						float64(s.y+s.hitbox.y),
-						float64(s.hitbox.w),
-						float64(s.hitbox.h),
-						color.NRGBA{0xff, 0x00, 0x00, 0x77},
-					)
-				}
+				id := g.spriteTable.ID(s.sprite)
+				w, h := g.spriteTable.Size(id)
+
+				geoM := s.transform.Rotated(stepsToRadians(s.angle)).GeoM(w, h, s.x, s.y)
+				g.render.SubmitID(id, geoM, noColor, layer)
 			}
 		}
 	}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultFrameTicks = 8   // how long a frame is shown when no duration is configured
+	frameSep          = "#" // between the animation name and frame number in atlas names, eg. enemy1#0
+)
+
+// AnimMode decides what an animation does when it runs out of frames
+type AnimMode int
+
+const (
+	animLoop AnimMode = iota // start again from the first frame
+	animOnce                 // hold the last frame
+)
+
+// Frame is a single sprite from the atlas and how many ticks it is shown for
+type Frame struct {
+	sprite   SpriteName
+	duration int
+	mask     *Mask // collision mask of the sprite, so pixel hitboxes follow the frame on screen
+}
+
+// Animation is an ordered list of atlas sprites, named after the sprites it is made from
+type Animation struct {
+	name   string
+	frames []Frame
+	mode   AnimMode
+	length int // total ticks of all frames
+}
+
+// AnimationConfig overrides the defaults for an animation found in the atlas, read from assets/data/animations.json
+type AnimationConfig struct {
+	Durations []int  `json:"durations"` // ticks per frame, the last value is repeated for any remaining frames
+	Mode      string `json:"mode"`      // "loop" or "once", loop if not set
+
+	mode AnimMode // resolved Mode
+}
+
+// animModes are the mode names animations.json can use
+var animModes = map[string]AnimMode{
+	"loop": animLoop,
+	"once": animOnce,
+}
+
+// loadAnimationConfigs reads the per animation timings, anything not listed
+// loops at defaultFrameTicks per frame
+func loadAnimationConfigs(data []byte) (map[string]AnimationConfig, error) {
+	var configs map[string]AnimationConfig
+	if err := json.Unmarshal(data, &configs); err != nil {
+		return nil, fmt.Errorf("animations: %v", err)
+	}
+	for name, c := range configs {
+		c.mode = animLoop
+		if c.Mode != "" {
+			mode, ok := animModes[c.Mode]
+			if !ok {
+				return nil, fmt.Errorf("animation %s: no mode named %q", name, c.Mode)
+			}
+			c.mode = mode
+		}
+		configs[name] = c
+	}
+	return configs, nil
+}
+
+// splitFrameName splits an atlas name like "enemy1#2" into the animation name and frame number
+func splitFrameName(name string) (string, int, bool) {
+	i := strings.LastIndex(name, frameSep)
+	if i <= 0 || i == len(name)-1 {
+		return "", 0, false
+	}
+	frame, err := strconv.Atoi(name[i+1:])
+	if err != nil || frame < 0 {
+		return "", 0, false
+	}
+	return name[:i], frame, true
+}
+
+// buildAnimations collects every frame sequence in the sprite map into
+// animations. Like the atlas tool, a sequence without a frame 0 is not an
+// animation. Every configured animation must be in the atlas.
+func buildAnimations(sprites map[string]Sprite, configs map[string]AnimationConfig) (map[string]*Animation, error) {
+	grouped := make(map[string][]Sprite)
+	for _, s := range sprites {
+		if s.anim != "" {
+			grouped[s.anim] = append(grouped[s.anim], s)
+		}
+	}
+
+	animations := make(map[string]*Animation)
+	for name, frames := range grouped {
+		sort.Slice(frames, func(i, j int) bool {
+			return frames[i].frame < frames[j].frame
+		})
+		if frames[0].frame != 0 {
+			continue
+		}
+
+		config, ok := configs[name]
+		if !ok {
+			config = AnimationConfig{mode: animLoop}
+		}
+
+		anim := &Animation{
+			name: name,
+			mode: config.mode,
+		}
+		for i, s := range frames {
+			duration := defaultFrameTicks
+			if len(config.Durations) > 0 {
+				if i < len(config.Durations) {
+					duration = config.Durations[i]
+				} else {
+					duration = config.Durations[len(config.Durations)-1]
+				}
+			}
+			if duration < 1 {
+				duration = 1
+			}
+			anim.frames = append(anim.frames, Frame{sprite: SpriteName(s.name), duration: duration})
+			anim.length += duration
+		}
+		animations[name] = anim
+	}
+
+	for name := range configs {
+		if _, ok := animations[name]; !ok {
+			return nil, fmt.Errorf("animation %s: no frames named %s%s0 in the atlas", name, name, frameSep)
+		}
+	}
+	return animations, nil
+}
+
+// FrameAt returns the frame to show after the given number of ticks, and
+// whether a one shot animation has finished
+func (anim *Animation) FrameAt(ticks int) (*Frame, bool) {
+	last := &anim.frames[len(anim.frames)-1]
+	if ticks < 0 {
+		ticks = 0
+	}
+	if ticks >= anim.length {
+		if anim.mode == animOnce {
+			return last, true
+		}
+		ticks = ticks % anim.length
+	}
+	for i := range anim.frames {
+		if ticks < anim.frames[i].duration {
+			return &anim.frames[i], false
+		}
+		ticks -= anim.frames[i].duration
+	}
+	return last, false
+}
@@ -0,0 +1,87 @@
+// Code generated by go run ./tools. DO NOT EDIT.
+
+package main
+
+// SpriteName is the name of a sprite in the atlas
+type SpriteName string
+
+// sprites in the atlas
+const (
+	spriteBullet           SpriteName = "bullet"
+	spriteCircleWhite      SpriteName = "circleWhite"
+	spriteEnemy1           SpriteName = "enemy1"
+	spriteEnemy1_0         SpriteName = "enemy1#0"
+	spriteEnemy1_1         SpriteName = "enemy1#1"
+	spriteEnemy1_2         SpriteName = "enemy1#2"
+	spriteEnemy1_3         SpriteName = "enemy1#3"
+	spriteEnemy2           SpriteName = "enemy2"
+	spriteEnemy3           SpriteName = "enemy3"
+	spriteEnemyBullet      SpriteName = "enemyBullet"
+	spriteFont0            SpriteName = "font_0"
+	spriteFont1            SpriteName = "font_1"
+	spriteFont2            SpriteName = "font_2"
+	spriteFont3            SpriteName = "font_3"
+	spriteFont4            SpriteName = "font_4"
+	spriteFont5            SpriteName = "font_5"
+	spriteFont6            SpriteName = "font_6"
+	spriteFont7            SpriteName = "font_7"
+	spriteFont8            SpriteName = "font_8"
+	spriteFont9            SpriteName = "font_9"
+	spriteFontA            SpriteName = "font_a"
+	spriteFontB            SpriteName = "font_b"
+	spriteFontC            SpriteName = "font_c"
+	spriteFontComma        SpriteName = "font_comma"
+	spriteFontD            SpriteName = "font_d"
+	spriteFontDot          SpriteName = "font_dot"
+	spriteFontE            SpriteName = "font_e"
+	spriteFontExclaim      SpriteName = "font_exclaim"
+	spriteFontF            SpriteName = "font_f"
+	spriteFontG            SpriteName = "font_g"
+	spriteFontH            SpriteName = "font_h"
+	spriteFontI            SpriteName = "font_i"
+	spriteFontJ            SpriteName = "font_j"
+	spriteFontK            SpriteName = "font_k"
+	spriteFontL            SpriteName = "font_l"
+	spriteFontM            SpriteName = "font_m"
+	spriteFontMinus        SpriteName = "font_minus"
+	spriteFontN            SpriteName = "font_n"
+	spriteFontO            SpriteName = "font_o"
+	spriteFontP            SpriteName = "font_p"
+	spriteFontPlus         SpriteName = "font_plus"
+	spriteFontQ            SpriteName = "font_q"
+	spriteFontQuestionmark SpriteName = "font_questionmark"
+	spriteFontR            SpriteName = "font_r"
+	spriteFontS            SpriteName = "font_s"
+	spriteFontSpace        SpriteName = "font_space"
+	spriteFontT            SpriteName = "font_t"
+	spriteFontU            SpriteName = "font_u"
+	spriteFontV            SpriteName = "font_v"
+	spriteFontW            SpriteName = "font_w"
+	spriteFontX            SpriteName = "font_x"
+	spriteFontY            SpriteName = "font_y"
+	spriteFontZ            SpriteName = "font_z"
+	spriteFontZeroPlain    SpriteName = "font_zero_plain"
+	spriteLives            SpriteName = "lives"
+	spritePlayer           SpriteName = "player"
+	spriteStarFast         SpriteName = "starFast"
+	spriteStarSlow         SpriteName = "starSlow"
+	spriteStarSmall        SpriteName = "starSmall"
+	spriteStarTiny         SpriteName = "starTiny"
+)
+
+// SoundName is the name of a sound in the audio manager
+type SoundName string
+
+// sounds in the audio manager, from data/sfx.json and data/synth.json
+const (
+	soundDeath     SoundName = "death"
+	soundExplode   SoundName = "explode"
+	soundExplosion SoundName = "explosion"
+	soundHit       SoundName = "hit"
+	soundLaser     SoundName = "laser"
+	soundLaser12   SoundName = "laser12"
+	soundPickup    SoundName = "pickup"
+	soundPowerup   SoundName = "powerup"
+	soundShoot     SoundName = "shoot"
+	soundShotgun   SoundName = "shotgun"
+)
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const assetPoll = 500 * time.Millisecond // how often the directory source looks for changed files
+
+// the files the game loads, by their path under assets/
+const (
+	assetManifest    = "manifest.json" // written by go run ./tools, lists the atlas files
+	assetEmitters    = "data/emitters.json"
+	assetAnimations  = "data/animations.json"
+	assetSFX         = "data/sfx.json"
+	assetSynth       = "data/synth.json"
+	assetSoundEvents = "data/sounds.json"
+)
+
+// AssetSource is where the game reads its files from, named by their path
+// under assets/ with forward slashes
+type AssetSource interface {
+	// ReadFile returns the contents of a file, missing files give an error os.IsNotExist accepts
+	ReadFile(name string) ([]byte, error)
+	// Changed returns the files read or looked for so far that have changed, or
+	// appeared, since it was last called
+	Changed() []string
+}
+
+// Manifest is the part of assets/manifest.json the game needs
+type Manifest struct {
+	Atlases []string `json:"atlases"` // atlas files, one per page, in page order
+}
+
+// embeddedSource serves the files built into the executable, for release builds. They never change.
+type embeddedSource struct {
+	files map[string][]byte
+}
+
+func newEmbeddedSource() *embeddedSource {
+	return &embeddedSource{files: embeddedAssets}
+}
+
+// ReadFile returns an embedded file
+func (s *embeddedSource) ReadFile(name string) ([]byte, error) {
+	data, ok := s.files[name]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	return data, nil
+}
+
+// Changed is always empty, embedded files are fixed at build time
+func (s *embeddedSource) Changed() []string {
+	return nil
+}
+
+// dirSource reads files from a directory while developing, and notices when they are saved again
+type dirSource struct {
+	root     string
+	modified map[string]time.Time // every file read so far and when it was last changed, zero if it was missing
+	polled   time.Time
+}
+
+func newDirSource(root string) *dirSource {
+	return &dirSource{root: root, modified: make(map[string]time.Time)}
+}
+
+// ReadFile reads a file from the directory and starts watching it. A missing
+// file is watched too, so it is reported as changed once it is saved.
+func (s *dirSource) ReadFile(name string) ([]byte, error) {
+	path := filepath.Join(s.root, filepath.FromSlash(name))
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			s.modified[name] = time.Time{}
+		}
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s.modified[name] = info.ModTime()
+	return data, nil
+}
+
+// Changed polls the watched files and returns those saved since the last poll,
+// including missing ones that have appeared, sorted by name
+func (s *dirSource) Changed() []string {
+	now := time.Now()
+	if now.Sub(s.polled) < assetPoll {
+		return nil
+	}
+	s.polled = now
+
+	var changed []string
+	for name, modified := range s.modified {
+		info, err := os.Stat(filepath.Join(s.root, filepath.FromSlash(name)))
+		if err != nil {
+			continue // still missing, or probably mid save, try again next poll
+		}
+		if !info.ModTime().Equal(modified) {
+			s.modified[name] = info.ModTime()
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
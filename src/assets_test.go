@@ -0,0 +1,43 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDirSourceWatchesMissingFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "assets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := newDirSource(dir)
+	if _, err := s.ReadFile("audio/late.wav"); !os.IsNotExist(err) {
+		t.Fatalf("error = %v, want not exist", err)
+	}
+	changed := func() []string {
+		s.polled = time.Time{} // poll now rather than waiting for assetPoll
+		return s.Changed()
+	}
+	if got := changed(); len(got) != 0 {
+		t.Errorf("changed = %v while still missing, want none", got)
+	}
+
+	if err := os.Mkdir(filepath.Join(dir, "audio"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "audio", "late.wav"), []byte("RIFF"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got := changed(); !reflect.DeepEqual(got, []string{"audio/late.wav"}) {
+		t.Errorf("changed = %v once saved, want audio/late.wav", got)
+	}
+	if got := changed(); len(got) != 0 {
+		t.Errorf("changed = %v on the next poll, want none", got)
+	}
+}
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package main
-
-var packagexml = []byte("<TextureAtlas imagePath=\"atlas-1.png\">\n    <SubTexture name=\"circleWhite\" x=\"0\" y=\"0\" width=\"64\" height=\"64\"/>\n    <SubTexture name=\"enemy1\" x=\"64\" y=\"0\" width=\"32\" height=\"32\"/>\n    <SubTexture name=\"enemy3\" x=\"96\" y=\"0\" width=\"32\" height=\"32\"/>\n    <SubTexture name=\"enemy2\" x=\"128\" y=\"0\" width=\"32\" height=\"32\"/>\n    <SubTexture name=\"player\" x=\"160\" y=\"0\" width=\"32\" height=\"32\"/>\n    <SubTexture name=\"lives\" x=\"192\" y=\"0\" width=\"16\" height=\"16\"/>\n    <SubTexture name=\"font\\m\" x=\"208\" y=\"0\" width=\"13\" height=\"14\"/>\n    <SubTexture name=\"font\\y\" x=\"221\" y=\"0\" width=\"13\" height=\"14\"/>\n    <SubTexture name=\"font\\n\" x=\"234\" y=\"0\" width=\"13\" height=\"14\"/>\n    <SubTexture name=\"font\\w\" x=\"247\" y=\"0\" width=\"13\" height=\"14\"/>\n    <SubTexture name=\"font\\x\" x=\"260\" y=\"0\" width=\"12\" height=\"14\"/>\n    <SubTexture name=\"font\\u\" x=\"272\" y=\"0\" width=\"12\" height=\"14\"/>\n    <SubTexture name=\"font\\v\" x=\"284\" y=\"0\" width=\"12\" height=\"14\"/>\n    <SubTexture name=\"font\\k\" x=\"296\" y=\"0\" width=\"12\" height=\"14\"/>\n    <SubTexture name=\"font\\h\" x=\"308\" y=\"0\" width=\"12\" height=\"14\"/>\n    <SubTexture name=\"font\\a\" x=\"320\" y=\"0\" width=\"12\" height=\"14\"/>\n    <SubTexture name=\"font\\r\" x=\"332\" y=\"0\" width=\"12\" height=\"14\"/>\n    <SubTexture name=\"font\\l\" x=\"344\" y=\"0\" width=\"11\" height=\"14\"/>\n    <SubTexture name=\"font\\c\" x=\"355\" y=\"0\" width=\"11\" height=\"14\"/>\n    <SubTexture name=\"font\\0\" x=\"366\" y=\"0\" width=\"11\" height=\"14\"/>\n    <SubTexture name=\"font\\d\" x=\"377\" y=\"0\" width=\"11\" height=\"14\"/>\n    <SubTexture name=\"font\\4\" x=\"388\" y=\"0\" width=\"11\" height=\"14\"/>\n    <SubTexture name=\"font\\t\" x=\"399\" y=\"0\" width=\"11\" height=\"14\"/>\n    <SubTexture name=\"font\\e\" x=\"410\" y=\"0\" width=\"11\" height=\"14\"/>\n    <SubTexture name=\"font\\f\" x=\"421\" y=\"0\" width=\"11\" height=\"14\"/>\n    <SubTexture name=\"font\\q\" x=\"432\" y=\"0\" width=\"11\" height=\"14\"/>\n    <SubTexture name=\"font\\g\" x=\"443\" y=\"0\" width=\"11\" height=\"14\"/>\n    <SubTexture name=\"font\\p\" x=\"454\" y=\"0\" width=\"11\" height=\"14\"/>\n    <SubTexture name=\"font\\o\" x=\"465\" y=\"0\" width=\"11\" height=\"14\"/>\n    <SubTexture name=\"font\\b\" x=\"476\" y=\"0\" width=\"11\" height=\"14\"/>\n    <SubTexture name=\"font\\9\" x=\"487\" y=\"0\" width=\"10\" height=\"14\"/>\n    <SubTexture name=\"font\\7\" x=\"497\" y=\"0\" width=\"10\" height=\"14\"/>\n    <SubTexture name=\"font\\5\" x=\"192\" y=\"16\" width=\"10\" height=\"14\"/>\n    <SubTexture name=\"font\\6\" x=\"202\" y=\"16\" width=\"10\" height=\"14\"/>\n    <SubTexture name=\"font\\j\" x=\"212\" y=\"16\" width=\"10\" height=\"14\"/>\n    <SubTexture name=\"font\\s\" x=\"222\" y=\"16\" width=\"10\" height=\"14\"/>\n    <SubTexture name=\"font\\z\" x=\"232\" y=\"16\" width=\"10\" height=\"14\"/>\n    <SubTexture name=\"font\\8\" x=\"242\" y=\"16\" width=\"10\" height=\"14\"/>\n    <SubTexture name=\"font\\3\" x=\"252\" y=\"16\" width=\"10\" height=\"14\"/>\n    <SubTexture name=\"font\\font_59\" x=\"262\" y=\"16\" width=\"10\" height=\"14\"/>\n    <SubTexture name=\"font\\questionmark\" x=\"272\" y=\"16\" width=\"10\" height=\"14\"/>\n    <SubTexture name=\"font\\2\" x=\"282\" y=\"16\" width=\"10\" height=\"14\"/>\n    <SubTexture name=\"starSmall\" x=\"292\" y=\"16\" width=\"11\" height=\"11\"/>\n    <SubTexture name=\"enemyBullet\" x=\"64\" y=\"32\" width=\"6\" height=\"14\"/>\n    <SubTexture name=\"font\\minus\" x=\"70\" y=\"32\" width=\"6\" height=\"14\"/>\n    <SubTexture name=\"font\\plus\" x=\"76\" y=\"32\" width=\"6\" height=\"14\"/>\n    <SubTexture name=\"font\\1\" x=\"82\" y=\"32\" width=\"6\" height=\"14\"/>\n    <SubTexture name=\"font\\i\" x=\"507\" y=\"0\" width=\"5\" height=\"14\"/>\n    <SubTexture name=\"font\\exclaim\" x=\"88\" y=\"32\" width=\"5\" height=\"14\"/>\n    <SubTexture name=\"bullet\" x=\"303\" y=\"16\" width=\"8\" height=\"8\"/>\n    <SubTexture name=\"font\\comma\" x=\"93\" y=\"32\" width=\"4\" height=\"14\"/>\n    <SubTexture name=\"starTiny\" x=\"311\" y=\"16\" width=\"7\" height=\"7\"/>\n    <SubTexture name=\"font\\dot\" x=\"97\" y=\"32\" width=\"3\" height=\"14\"/>\n    <SubTexture name=\"starFast\" x=\"0\" y=\"64\" width=\"1\" height=\"32\"/>\n    <SubTexture name=\"starSlow\" x=\"1\" y=\"64\" width=\"1\" height=\"32\"/>\n    <SubTexture name=\"font\\font_123\" x=\"208\" y=\"14\" width=\"4\" height=\"1\"/>\n</TextureAtlas>\n")
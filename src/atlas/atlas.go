@@ -0,0 +1,137 @@
+// Package atlas reads texture atlas descriptions: Starling/Sparrow XML and
+// TexturePacker JSON in its hash and array flavours. Every format comes out
+// as the same Atlas, and anything unexpected in a file is reported as an
+// error naming the sprite and attribute rather than a panic.
+package atlas
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Page is one image of an atlas
+type Page struct {
+	Image  string // path of the image, as written in the atlas file
+	Width  int    // zero if the format does not say
+	Height int
+}
+
+// Frame is one sprite of an atlas. Trimmed sprites only store their opaque
+// part, which sits at OffsetX, OffsetY within the full SourceW by SourceH
+// sprite. Rotated sprites are stored turned 90 degrees clockwise, so they
+// take up H by W pixels of the page.
+type Frame struct {
+	Name    string
+	Page    int // index into Atlas.Pages
+	X       int // top left of the stored pixels in the page
+	Y       int
+	W       int // size of the stored pixels, upright
+	H       int
+	Rotated bool
+	OffsetX int
+	OffsetY int
+	SourceW int
+	SourceH int
+}
+
+// Trimmed reports whether the stored pixels are smaller than the sprite
+func (f Frame) Trimmed() bool {
+	return f.OffsetX != 0 || f.OffsetY != 0 || f.W != f.SourceW || f.H != f.SourceH
+}
+
+// PageRect is the rectangle the frame takes up in its page, as x, y, width, height
+func (f Frame) PageRect() (int, int, int, int) {
+	if f.Rotated {
+		return f.X, f.Y, f.H, f.W
+	}
+	return f.X, f.Y, f.W, f.H
+}
+
+// Atlas is every page and frame read from one or more atlas files
+type Atlas struct {
+	Pages  []Page
+	Frames []Frame
+}
+
+// Parse reads an atlas in whichever supported format data is in. name is
+// used for errors and to pick the format from its extension if the
+// contents do not make it obvious.
+func Parse(name string, data []byte) (*Atlas, error) {
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("<")):
+		return ParseStarling(name, data)
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		return ParseTexturePacker(name, data)
+	}
+	switch strings.ToLower(path.Ext(name)) {
+	case ".xml":
+		return ParseStarling(name, data)
+	case ".json":
+		return ParseTexturePacker(name, data)
+	}
+	return nil, fmt.Errorf("atlas %s: unknown format", name)
+}
+
+// Merge puts the pages of several atlases into one, for atlases packed
+// across more than one file. Sprite names must be unique across them all.
+func Merge(atlases ...*Atlas) (*Atlas, error) {
+	merged := &Atlas{}
+	seen := make(map[string]bool)
+	for _, a := range atlases {
+		base := len(merged.Pages)
+		merged.Pages = append(merged.Pages, a.Pages...)
+		for _, f := range a.Frames {
+			if seen[f.Name] {
+				return nil, fmt.Errorf("atlas: sprite %q is in more than one page", f.Name)
+			}
+			seen[f.Name] = true
+			f.Page += base
+			merged.Frames = append(merged.Frames, f)
+		}
+	}
+	return merged, nil
+}
+
+// check makes sure a frame makes sense on its own and fits its page, if the page size is known
+func (a *Atlas) check(name string, f Frame) error {
+	fail := func(format string, args ...interface{}) error {
+		return fmt.Errorf("atlas %s: sprite %q: %s", name, f.Name, fmt.Sprintf(format, args...))
+	}
+	if f.Name == "" {
+		return fmt.Errorf("atlas %s: sprite with no name", name)
+	}
+	if f.X < 0 || f.Y < 0 {
+		return fail("position %d,%d is negative", f.X, f.Y)
+	}
+	if f.W <= 0 || f.H <= 0 {
+		return fail("size %dx%d is empty", f.W, f.H)
+	}
+	if f.OffsetX < 0 || f.OffsetY < 0 || f.OffsetX+f.W > f.SourceW || f.OffsetY+f.H > f.SourceH {
+		return fail("trimmed %dx%d at %d,%d does not fit its %dx%d source", f.W, f.H, f.OffsetX, f.OffsetY, f.SourceW, f.SourceH)
+	}
+	if f.Page < 0 || f.Page >= len(a.Pages) {
+		return fail("no page %d", f.Page)
+	}
+	p := a.Pages[f.Page]
+	x, y, w, h := f.PageRect()
+	if p.Width > 0 && p.Height > 0 && (x+w > p.Width || y+h > p.Height) {
+		return fail("%dx%d at %d,%d is outside the %dx%d page", w, h, x, y, p.Width, p.Height)
+	}
+	return nil
+}
+
+// add checks a frame and adds it, refusing a second sprite of the same name
+func (a *Atlas) add(name string, f Frame, seen map[string]bool) error {
+	if err := a.check(name, f); err != nil {
+		return err
+	}
+	if seen[f.Name] {
+		return fmt.Errorf("atlas %s: sprite %q appears twice", name, f.Name)
+	}
+	seen[f.Name] = true
+	a.Frames = append(a.Frames, f)
+	return nil
+}
@@ -0,0 +1,198 @@
+package atlas
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseStarling(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+<TextureAtlas imagePath="atlas.png" width="32" height="16">
+	<SubTexture name="plain" x="0" y="0" width="8" height="8"/>
+	<SubTexture name="trimmed" x="8" y="0" width="6" height="4" frameX="-2" frameY="-1" frameWidth="10" frameHeight="8"/>
+	<SubTexture name="rotated" x="16" y="0" width="4" height="6" rotated="true"/>
+	<SubTexture name="both" x="24" y="0" width="3" height="5" frameX="-1" frameY="-2" frameWidth="8" frameHeight="6" rotated="true"/>
+</TextureAtlas>`
+	a, err := Parse("atlas.xml", []byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantPages := []Page{{Image: "atlas.png", Width: 32, Height: 16}}
+	if !reflect.DeepEqual(a.Pages, wantPages) {
+		t.Errorf("pages = %+v, want %+v", a.Pages, wantPages)
+	}
+	want := []Frame{
+		{Name: "plain", X: 0, Y: 0, W: 8, H: 8, SourceW: 8, SourceH: 8},
+		{Name: "trimmed", X: 8, Y: 0, W: 6, H: 4, OffsetX: 2, OffsetY: 1, SourceW: 10, SourceH: 8},
+		{Name: "rotated", X: 16, Y: 0, W: 6, H: 4, Rotated: true, SourceW: 6, SourceH: 4},
+		{Name: "both", X: 24, Y: 0, W: 5, H: 3, Rotated: true, OffsetX: 1, OffsetY: 2, SourceW: 8, SourceH: 6},
+	}
+	if !reflect.DeepEqual(a.Frames, want) {
+		t.Errorf("frames =\n%+v\nwant\n%+v", a.Frames, want)
+	}
+
+	if x, y, w, h := a.Frames[2].PageRect(); x != 16 || y != 0 || w != 4 || h != 6 {
+		t.Errorf("rotated page rect = %d,%d %dx%d, want 16,0 4x6 as stored", x, y, w, h)
+	}
+	if a.Frames[0].Trimmed() || !a.Frames[1].Trimmed() || a.Frames[2].Trimmed() || !a.Frames[3].Trimmed() {
+		t.Errorf("only trimmed and both should report Trimmed")
+	}
+}
+
+func TestParseTexturePacker(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		wantPages  []Page
+		wantFrames []Frame
+	}{
+		{
+			name: "hash",
+			data: `{
+				"frames": {
+					"b": {"frame": {"x": 8, "y": 0, "w": 6, "h": 4}, "rotated": false, "trimmed": true,
+						"spriteSourceSize": {"x": 2, "y": 1, "w": 6, "h": 4}, "sourceSize": {"w": 10, "h": 8}},
+					"a": {"frame": {"x": 0, "y": 0, "w": 8, "h": 8}}
+				},
+				"meta": {"image": "hash.png", "size": {"w": 32, "h": 16}}
+			}`,
+			wantPages: []Page{{Image: "hash.png", Width: 32, Height: 16}},
+			wantFrames: []Frame{
+				{Name: "a", X: 0, Y: 0, W: 8, H: 8, SourceW: 8, SourceH: 8},
+				{Name: "b", X: 8, Y: 0, W: 6, H: 4, OffsetX: 2, OffsetY: 1, SourceW: 10, SourceH: 8},
+			},
+		},
+		{
+			name: "array",
+			data: `{
+				"frames": [
+					{"filename": "z", "frame": {"x": 0, "y": 0, "w": 8, "h": 8}},
+					{"filename": "r", "frame": {"x": 16, "y": 0, "w": 6, "h": 4}, "rotated": true}
+				],
+				"meta": {"image": "array.png", "size": {"w": 32, "h": 16}}
+			}`,
+			wantPages: []Page{{Image: "array.png", Width: 32, Height: 16}},
+			wantFrames: []Frame{
+				{Name: "z", X: 0, Y: 0, W: 8, H: 8, SourceW: 8, SourceH: 8},
+				{Name: "r", X: 16, Y: 0, W: 6, H: 4, Rotated: true, SourceW: 6, SourceH: 4},
+			},
+		},
+		{
+			name: "multipack",
+			data: `{
+				"textures": [
+					{"image": "pack-0.png", "size": {"w": 16, "h": 16},
+						"frames": [{"filename": "one", "frame": {"x": 0, "y": 0, "w": 8, "h": 8}}]},
+					{"image": "pack-1.png", "size": {"w": 16, "h": 16},
+						"frames": {"two": {"frame": {"x": 8, "y": 8, "w": 8, "h": 8}}}}
+				]
+			}`,
+			wantPages: []Page{
+				{Image: "pack-0.png", Width: 16, Height: 16},
+				{Image: "pack-1.png", Width: 16, Height: 16},
+			},
+			wantFrames: []Frame{
+				{Name: "one", Page: 0, X: 0, Y: 0, W: 8, H: 8, SourceW: 8, SourceH: 8},
+				{Name: "two", Page: 1, X: 8, Y: 8, W: 8, H: 8, SourceW: 8, SourceH: 8},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := Parse(tt.name+".json", []byte(tt.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(a.Pages, tt.wantPages) {
+				t.Errorf("pages = %+v, want %+v", a.Pages, tt.wantPages)
+			}
+			if !reflect.DeepEqual(a.Frames, tt.wantFrames) {
+				t.Errorf("frames =\n%+v\nwant\n%+v", a.Frames, tt.wantFrames)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	starling := func(sub string) string {
+		return `<TextureAtlas imagePath="a.png" width="16" height="16">` + sub + `</TextureAtlas>`
+	}
+	tests := []struct {
+		name string
+		file string
+		data string
+		want string // part of the error
+	}{
+		{"unknown format", "a.txt", "hello", "unknown format"},
+
+		{"bad xml", "a.xml", "<TextureAtlas", "atlas a.xml:"},
+		{"no image path", "a.xml", `<TextureAtlas></TextureAtlas>`, "no imagePath"},
+		{"bad page width", "a.xml", `<TextureAtlas imagePath="a.png" width="wide"/>`, "TextureAtlas width"},
+		{"bad page height", "a.xml", `<TextureAtlas imagePath="a.png" height="tall"/>`, "TextureAtlas height"},
+		{"no name", "a.xml", starling(`<SubTexture x="0" y="0" width="1" height="1"/>`), "SubTexture 0 has no name"},
+		{"missing x", "a.xml", starling(`<SubTexture name="s" y="0" width="1" height="1"/>`), `"s": x: missing`},
+		{"bad y", "a.xml", starling(`<SubTexture name="s" x="0" y="1.5" width="1" height="1"/>`), `"s": y:`},
+		{"bad width", "a.xml", starling(`<SubTexture name="s" x="0" y="0" width="" height="1"/>`), `"s": width:`},
+		{"missing height", "a.xml", starling(`<SubTexture name="s" x="0" y="0" width="1"/>`), `"s": height: missing`},
+		{"bad rotated", "a.xml", starling(`<SubTexture name="s" x="0" y="0" width="1" height="1" rotated="maybe"/>`), `"s": rotated:`},
+		{"bad frameX", "a.xml", starling(`<SubTexture name="s" x="0" y="0" width="1" height="1" frameX="a"/>`), `"s": frameX:`},
+		{"bad frameY", "a.xml", starling(`<SubTexture name="s" x="0" y="0" width="1" height="1" frameY="a"/>`), `"s": frameY:`},
+		{"bad frameWidth", "a.xml", starling(`<SubTexture name="s" x="0" y="0" width="1" height="1" frameWidth="a"/>`), `"s": frameWidth:`},
+		{"bad frameHeight", "a.xml", starling(`<SubTexture name="s" x="0" y="0" width="1" height="1" frameHeight="a"/>`), `"s": frameHeight:`},
+
+		{"empty name", "a.xml", starling(`<SubTexture name="" x="0" y="0" width="1" height="1"/>`), "sprite with no name"},
+		{"negative position", "a.xml", starling(`<SubTexture name="s" x="-1" y="0" width="1" height="1"/>`), "is negative"},
+		{"empty size", "a.xml", starling(`<SubTexture name="s" x="0" y="0" width="0" height="1"/>`), "is empty"},
+		{"trim outside source", "a.xml", starling(`<SubTexture name="s" x="0" y="0" width="4" height="4" frameX="-1" frameWidth="4" frameHeight="4"/>`), "does not fit"},
+		{"outside page", "a.xml", starling(`<SubTexture name="s" x="12" y="0" width="8" height="8"/>`), "outside the 16x16 page"},
+		{"rotated outside page", "a.xml", starling(`<SubTexture name="s" x="0" y="12" width="2" height="8" rotated="true"/>`), "outside the 16x16 page"},
+		{"twice", "a.xml", starling(`<SubTexture name="s" x="0" y="0" width="1" height="1"/><SubTexture name="s" x="1" y="0" width="1" height="1"/>`), `"s" appears twice`},
+
+		{"bad json", "a.json", `{"frames": `, "atlas a.json:"},
+		{"no page image", "a.json", `{"frames": []}`, "page 0 has no image"},
+		{"no frames", "a.json", `{"meta": {"image": "a.png"}}`, "page 0 frames: missing"},
+		{"frames not a list or hash", "a.json", `{"frames": 5, "meta": {"image": "a.png"}}`, "neither a hash nor an array"},
+		{"no frame rectangle", "a.json", `{"frames": {"s": {}}, "meta": {"image": "a.png"}}`, `"s": no frame rectangle`},
+		{"trimmed without sizes", "a.json", `{"frames": {"s": {"frame": {"w": 1, "h": 1}, "trimmed": true}}, "meta": {"image": "a.png"}}`, "trimmed without spriteSourceSize"},
+		{"twice across pages", "a.json", `{"textures": [
+			{"image": "0.png", "frames": {"s": {"frame": {"w": 1, "h": 1}}}},
+			{"image": "1.png", "frames": {"s": {"frame": {"w": 1, "h": 1}}}}
+		]}`, `"s" appears twice`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := Parse(tt.file, []byte(tt.data))
+			if err == nil {
+				t.Fatalf("no error, got %+v", a)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error %q does not mention %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckNoPage(t *testing.T) {
+	a := &Atlas{Pages: []Page{{Image: "a.png"}}}
+	err := a.check("a.xml", Frame{Name: "s", Page: 1, W: 1, H: 1, SourceW: 1, SourceH: 1})
+	if err == nil || !strings.Contains(err.Error(), "no page 1") {
+		t.Errorf("error = %v, want no page 1", err)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	one := &Atlas{Pages: []Page{{Image: "0.png"}}, Frames: []Frame{{Name: "a"}}}
+	two := &Atlas{Pages: []Page{{Image: "1.png"}, {Image: "2.png"}}, Frames: []Frame{{Name: "b", Page: 1}}}
+	m, err := Merge(one, two)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Pages) != 3 || m.Frames[1].Name != "b" || m.Frames[1].Page != 2 {
+		t.Errorf("merged = %+v, want b on page 2 of 3", m)
+	}
+
+	if _, err := Merge(one, &Atlas{Pages: []Page{{Image: "1.png"}}, Frames: []Frame{{Name: "a"}}}); err == nil || !strings.Contains(err.Error(), "more than one page") {
+		t.Errorf("error = %v, want a sprite in more than one page", err)
+	}
+}
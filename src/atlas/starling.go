@@ -0,0 +1,125 @@
+package atlas
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+)
+
+// starlingAtlas is a Starling or Sparrow TextureAtlas document. Numbers are
+// read as strings so a bad one can be reported by name.
+type starlingAtlas struct {
+	XMLName     xml.Name `xml:"TextureAtlas"`
+	ImagePath   string   `xml:"imagePath,attr"`
+	Width       *string  `xml:"width,attr"`
+	Height      *string  `xml:"height,attr"`
+	SubTextures []struct {
+		Name        *string `xml:"name,attr"`
+		X           *string `xml:"x,attr"`
+		Y           *string `xml:"y,attr"`
+		Width       *string `xml:"width,attr"`
+		Height      *string `xml:"height,attr"`
+		FrameX      *string `xml:"frameX,attr"`
+		FrameY      *string `xml:"frameY,attr"`
+		FrameWidth  *string `xml:"frameWidth,attr"`
+		FrameHeight *string `xml:"frameHeight,attr"`
+		Rotated     *string `xml:"rotated,attr"`
+	} `xml:"SubTexture"`
+}
+
+// ParseStarling reads a Starling/Sparrow XML atlas, one page per file. x, y,
+// width and height are the stored rectangle in the page. frameX and frameY
+// are where the sprite starts relative to the stored pixels, so trimmed
+// sprites have them zero or negative. Rotated sprites have rotated="true"
+// and their width and height are as stored, turned clockwise.
+func ParseStarling(name string, data []byte) (*Atlas, error) {
+	var doc starlingAtlas
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("atlas %s: %v", name, err)
+	}
+	if doc.ImagePath == "" {
+		return nil, fmt.Errorf("atlas %s: TextureAtlas has no imagePath", name)
+	}
+
+	page := Page{Image: doc.ImagePath}
+	var err error
+	if page.Width, err = optionalInt(doc.Width, 0); err != nil {
+		return nil, fmt.Errorf("atlas %s: TextureAtlas width: %v", name, err)
+	}
+	if page.Height, err = optionalInt(doc.Height, 0); err != nil {
+		return nil, fmt.Errorf("atlas %s: TextureAtlas height: %v", name, err)
+	}
+
+	a := &Atlas{Pages: []Page{page}}
+	seen := make(map[string]bool)
+	for i, st := range doc.SubTextures {
+		if st.Name == nil {
+			return nil, fmt.Errorf("atlas %s: SubTexture %d has no name", name, i)
+		}
+		f := Frame{Name: *st.Name}
+		fail := func(attr string, err error) error {
+			return fmt.Errorf("atlas %s: SubTexture %q: %s: %v", name, f.Name, attr, err)
+		}
+
+		var w, h, frameX, frameY int
+		for _, field := range []struct {
+			attr  string
+			value *string
+			into  *int
+		}{
+			{"x", st.X, &f.X},
+			{"y", st.Y, &f.Y},
+			{"width", st.Width, &w},
+			{"height", st.Height, &h},
+		} {
+			if field.value == nil {
+				return nil, fail(field.attr, fmt.Errorf("missing"))
+			}
+			if *field.into, err = strconv.Atoi(*field.value); err != nil {
+				return nil, fail(field.attr, err)
+			}
+		}
+		if f.Rotated, err = optionalBool(st.Rotated); err != nil {
+			return nil, fail("rotated", err)
+		}
+		f.W, f.H = w, h
+		if f.Rotated {
+			f.W, f.H = h, w
+		}
+
+		if frameX, err = optionalInt(st.FrameX, 0); err != nil {
+			return nil, fail("frameX", err)
+		}
+		if frameY, err = optionalInt(st.FrameY, 0); err != nil {
+			return nil, fail("frameY", err)
+		}
+		if f.SourceW, err = optionalInt(st.FrameWidth, f.W); err != nil {
+			return nil, fail("frameWidth", err)
+		}
+		if f.SourceH, err = optionalInt(st.FrameHeight, f.H); err != nil {
+			return nil, fail("frameHeight", err)
+		}
+		f.OffsetX, f.OffsetY = -frameX, -frameY
+
+		if err := a.add(name, f, seen); err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
+// optionalInt parses an attribute that may be left out
+func optionalInt(s *string, missing int) (int, error) {
+	if s == nil {
+		return missing, nil
+	}
+	return strconv.Atoi(*s)
+}
+
+// optionalBool parses an attribute that is false if left out
+func optionalBool(s *string) (bool, error) {
+	if s == nil {
+		return false, nil
+	}
+	return strconv.ParseBool(*s)
+}
@@ -0,0 +1,136 @@
+package atlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+type tpRect struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+type tpFrame struct {
+	Filename         string  `json:"filename"` // array format only
+	Frame            *tpRect `json:"frame"`
+	Rotated          bool    `json:"rotated"`
+	Trimmed          bool    `json:"trimmed"`
+	SpriteSourceSize *tpRect `json:"spriteSourceSize"`
+	SourceSize       *tpRect `json:"sourceSize"`
+}
+
+type tpMeta struct {
+	Image string `json:"image"`
+	Size  tpRect `json:"size"`
+}
+
+// tpSheet is one page, frames is either an object keyed by name (hash) or a list (array)
+type tpSheet struct {
+	Image  string          `json:"image"` // multipack pages name their image here
+	Size   tpRect          `json:"size"`
+	Frames json.RawMessage `json:"frames"`
+	Meta   tpMeta          `json:"meta"`
+}
+
+// tpFile is a TexturePacker JSON file, a single sheet or a multipack list of them
+type tpFile struct {
+	tpSheet
+	Textures []tpSheet `json:"textures"`
+}
+
+// ParseTexturePacker reads TexturePacker JSON in the hash or array format,
+// or a multipack file with a "textures" list of pages. Frames of a hash are
+// sorted by name, as JSON objects have no order.
+func ParseTexturePacker(name string, data []byte) (*Atlas, error) {
+	var file tpFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("atlas %s: %v", name, err)
+	}
+	sheets := file.Textures
+	if len(sheets) == 0 {
+		sheets = []tpSheet{file.tpSheet}
+	}
+
+	a := &Atlas{}
+	seen := make(map[string]bool)
+	for i, sheet := range sheets {
+		page := Page{Image: sheet.Image, Width: sheet.Size.W, Height: sheet.Size.H}
+		if page.Image == "" {
+			page = Page{Image: sheet.Meta.Image, Width: sheet.Meta.Size.W, Height: sheet.Meta.Size.H}
+		}
+		if page.Image == "" {
+			return nil, fmt.Errorf("atlas %s: page %d has no image", name, i)
+		}
+		a.Pages = append(a.Pages, page)
+
+		frames, err := tpFrames(sheet.Frames)
+		if err != nil {
+			return nil, fmt.Errorf("atlas %s: page %d frames: %v", name, i, err)
+		}
+		for _, tf := range frames {
+			f, err := tf.frame(i)
+			if err != nil {
+				return nil, fmt.Errorf("atlas %s: sprite %q: %v", name, tf.Filename, err)
+			}
+			if err := a.add(name, f, seen); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return a, nil
+}
+
+// tpFrames reads the frames of a sheet in either format
+func tpFrames(raw json.RawMessage) ([]tpFrame, error) {
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("missing")
+	}
+	var list []tpFrame
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return list, nil
+	}
+	var hash map[string]tpFrame
+	if err := json.Unmarshal(raw, &hash); err != nil {
+		return nil, fmt.Errorf("neither a hash nor an array: %v", err)
+	}
+	names := make([]string, 0, len(hash))
+	for n := range hash {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		f := hash[n]
+		f.Filename = n
+		list = append(list, f)
+	}
+	return list, nil
+}
+
+// frame converts a TexturePacker frame, whose frame size is already upright
+func (tf tpFrame) frame(page int) (Frame, error) {
+	if tf.Frame == nil {
+		return Frame{}, fmt.Errorf("no frame rectangle")
+	}
+	f := Frame{
+		Name:    tf.Filename,
+		Page:    page,
+		X:       tf.Frame.X,
+		Y:       tf.Frame.Y,
+		W:       tf.Frame.W,
+		H:       tf.Frame.H,
+		Rotated: tf.Rotated,
+		SourceW: tf.Frame.W,
+		SourceH: tf.Frame.H,
+	}
+	if tf.Trimmed || tf.SpriteSourceSize != nil {
+		if tf.SpriteSourceSize == nil || tf.SourceSize == nil {
+			return Frame{}, fmt.Errorf("trimmed without spriteSourceSize and sourceSize")
+		}
+		f.OffsetX, f.OffsetY = tf.SpriteSourceSize.X, tf.SpriteSourceSize.Y
+		f.SourceW, f.SourceH = tf.SourceSize.W, tf.SourceSize.H
+	}
+	return f, nil
+}
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package main
-
-var deathSample = []byte("RIFF\xf6\x00\x02\x00WAVEfmt \x10\x00\x00\x00\x01\x00\x01\x00D\xac\x00\x00\x88X\x01\x00\x02\x00\x10\x00data\x8a\x00\x02\x00\x00\x00\x00\x00P\x04\x1f\xff\x1e\xf9\xfc\xf7\xfa\x00H\x06\xeb\x16\"2.9\x986\xd5)\xb2 \xb7\x13\xdb\x04\xe8\x02}\x04\x17\x14\x9d!\xec\x0f\xe3\xf8~\x03<\x14\x1d\x1bU\"m\x1b4#\x813\xa91|7\xe2>J?\xd8?\x8c>\xaf<\xd6+\xd5\x11\xca\x00k\xe3;\xe4_\xe9!\xf3\xa5\x05\xb8\xfa\xf2\xde\r\xdc\x1d\xef\x1d\xef\x1d\xef\x1d\xef\x1b\xef\x1d\xef\x1b\xefC\xef\xba\xef\xba\xf0\b\xf4\xb0\xfa\xcb\x12\xaa\x10\x1e\b\xa7\am\b\\\x14J\f\xbf\fY\x0f\xad\x0f\xc0\x11\x81\x0eL\xf6\xa2\xf7k\x03i\x03\x1f\x03\xe8\x00\xe5\x11\xfe\x11\xcb\x13&\x14\xdb\x1d\xdc\x1e\x155\xed4]\xfc\v\xfc\xcc\xea\xf7\xe8\xdb\xe7\xcf\xe7?\xf1\xcd\xf1l\xe6\x89\xe5\x8d\x12\xf1\x16\xef\x16\xef\x16\xaf\x05H\x03H\x03H\x03\x8c\xcck\u009cՊ\xd8M\xdc<\xdd\xca\xe8\xcc\xeb\xaf\xe8\xca\xe7N\xe2\x90\xe0g\xf8\xa9\x00]\x1c\xd8&)$\x10#\xe1\xfc\xd6\xeb\xa4\xefy\xf1u\xeff\xee\x8e\xf8C\xfe\x12\x00-\x01\xfc \xae5Q%\xe1\x19\xda\x18\x17\x18\x84\x17\r\x17L\b\x93\xfb\x19\xf5\x1b\xef\x13\xdc2\xc9X\xd7c\xe6\xcf\xe5'\xe5\xe6\xe5\xcf\xe6\xce\xe8m\xeb\xb4\xf5.\x04\xf7\x02 \x01\x86\xfd\xa2\xf7\xa2\xf7\xa1\xf7\x83\xf1\xe2\xe5{\xe4\x96\xe1\xdb\xd8e\xc5&\xc4!\xc1\xdc\xc0'\xc0'\xc0'\xc0\xa7\xc0:ª\xcd\xf4\xf5\xf4\xf5\xf4\xf5\xc2\xf7\xab\xff`\xff\xe0\xfd\xcc\xf8\xf2\xdb\xce\xdf0\xf9.\x00\x9f6\xaf5\xe7,o*j\r\xf9\n\xcd\xe4\xcd\xe4\xcd\xe4\xea\xe4{\xe9\xb2\xe95\rQ\r* \x92 l8f9<\x11\xc9\x0e\v\f_\f\xfb\xed\xd2\xea\x8e\xe9e\xe9\x16\xed\xa4\xedn\v\xa3\x10\x0e\"\x8d%\x90\x12\xbf\v\xc5\x05f\x04R\xcf'\xc0'\xc0'\xc0'\xc0%\xc0Q\xe5\x98\xf3\x96\xf3%\xf2\x04\xea\x93\xe6\xbe\xe4\xd2\xe3\x15\xec\x8f\xf0\xa4\xecV\xea\xca\xda\xe3Н\xc8\xf7\xd0\x06\xdb\x06\xdbE\x01\xf4\x1f\xf4\x1f\xf1\x1f\x82\x17\xa4\x0f\xf0\xe9\x05ğ\xc5bś\xdb5\xf8~\xf7\x97\xf6e\xe9j\xd7xѳ\xc8\x01\xc8\xe4\xc6m\xc4_˗ߙߋ\xe2\x8e\xe8\x90\xe8\x94\xe5c\xe0\xcb\xe4\x10\xe9\x8a\xf4\x8a\xf4\n\xf4\x9c\xe7\xa5Æ\xc3\x0f\xc3\x10\xc3T\xc8T\xdf\u007f\xde\xfdޢ\xe1\r\xe7\xf4\x04F\xe7D\xe7\xfe\xe7\xa1\xee\xa1\xee\xa0\xee=\xec4\xc6\xf8\xc5'\xc0'\xc0'\xc01\xc0h\xe9\xc7\xe9\xc5\xe9\xc5\xe9\xcb\xe9\xcc\xe9\xcc\xe9\xcb\xe9f\xec\xa6\xec\x00\xee\xd5\x10h\x15g\x15\xea\xec\xfd\xe5\x01\xe6\xb4\xdd\xc0\x01^\n[\nZ\n\x89\x01\xaf\xe8e\xc8'\xc0(\xc0(\xc0\xc5\xdc&\xe8&\xe8%\xe8rь\xc6cƙ\xdf\v\xf1\x17\xf3\xfd\x06i\x13h\x13h\x13\x18\x02Z\xf5[\xf5\\\xf5O\xf9\xbb\xfcW\t4\x151\x150\x15\xef\x18\x0f\x1d\x0e\x1d\r\x1d\x19\x1b\x91\x18\x13\r\v\xedz\xe0\xee\xf0\x83\xeeu\xeaw\xea\xe3\xf7\\\x15\v\x1dv%\xf47\xf47\xeb,\x98\x11v\x13v\x13t\x13J\x14G\x17d\x18\xd9\x19\xd7\x19\xd7\x19\xf7\x19p\x1a\xaa\x19\xa7\x19\xa7\x19\xa6\x19s\x18^\x12\xe52\x18\x17\x8d\x16\xe8\x0e\xe8\x0e\xe7\x0e-\x0e\xe9\xf1\xdf\xf11\xe8 \xe8#\xe8\xa9\xc2\xdb\xed\x19\xf0\x19\xf0\x19\xf0\x88\xeeb\xeeA\xc6C\xc1I\xc1I\xc1\xc9֨کک\xdaL\xd9\xf6،α˲˲\xcbc\xc4\xd8\xc1\xa0\xc0'\xc0'\xc0'\xc0\xe9\xd7$\xe3$\xe3\xde\xfb\xff\x05\x17\x04\xf3\xf1\x9a\xe6\x9d\xe6-Ж\xdaP\xee\xfe\xee\x88\xef\x88\xef\xc2\xf7\xa7\xeb\x98\xd8v\xe4\xf3\xf0&\x03\t\x1b\xa1\x1e\xa1\x1e\xbb\x1f=!;!\x9d!8\"7\"4\"1\"0\"\x1f \xd6\x1b\xd6\x1b\xd2\x11\xb7\xf9\xb7\xf9)\xf9\xb5\xf6\xfd\xf3\xa5\xf6\x9f\xffH\x00D\a\xbb\x1d\x9f0\xa01\xcc6\xc86\xb66\xb26\xa86-46\x1d\xe6\x1d\xbb%\xe1\x1c\xdf\x1c\xd0\x1d\xb2:\xb0:\xaf:\x95=\x9a=\x99=\xb7\x14\xc4\x12\xc6\x12Z\x12\xc6\x15\x1c\x16\xba\x18\x0e\x19\f\x19\f\x19\x11\xfaU\xf4\xf3\xfb\x9a\xfd\x9a\xfdo\xff\xa2\xedc\xe8\\\xe7\x05\xe7\x1c\xf0[\xf3S\b\xe3\x10\x1c\x16|\x18\x1d\xfe\xda\xf0\xdb\xf0\xdd\xf0\xdd\xf0\xdd\xf0\xb3\x04\b\x12\a\x12\a\x12Z\xfew\xee,\xed\a\xec\xdc\xed\xa3\xef\x0f\xe1\x9bќџѢѣх\xe1\xad\xf7\xac\xf7\xad\xf7\x93\xf6\xb4\xf4{\xf4\v\xf4\v\xf4\x0e\xf4\xac\xf9\x8b\x06\xde\x01\xe7\xf5\xe7\xf5\xe6\xf5\x12\xf7\xde\xfa\x00\x037!6!3!1!1!\x92 \xe6\x1c\xaf\x1dm#k#j#\xab\"a\x19\x03\x194\x12(\x11\x03\xed\x02\xedZ\xebW\xebU\x16\xa7\x17\xa4\x17\xa4\x17\xd3\x17\xd7\x17x\xef\x02\xeb\x04\xeb\x04\xeb\xed\xea\xeb\xea\v\xf4\xe7\xf5\xe9\xf5\xea\xf5\xdb\xf5\xd5\xf5j\xf9\x8b\xfa?\xf1\xee\xed\xa6\xf4X\xf7\xd9\xf4\xbb\xf3\xbb\xf3\xba\xf3\xf9\xdep\xd3-\xcb\x1a\xc6\x1d\xc6\x1dƺ\xd1i\xdal\xda\xe5\xdb*\xdd-\xdde\xe2\x95\xe7\x97\xe7\x99\xe7\x9b\xe7\x9c\xe7\x9c\xe7\x9d\xe7\x9f\xe7\xa0\xe7\x9c\xeb\f\xf2l\xe7a\xd4b\xd4\x16\xd6\xd8\xe27\xf7p\xff\xe6\x13\xe4\x13\xe3\x13\xd1\x13\x9b\x13\xd4\f\v\xf4\n\xf3H\xee\x90\xea\xb8\xe2,\xe2\xdcޯ\xdb\xf5\xc3\xf8\xc3\xf9\xc3\xfa\xc3\xfeÀ\xc5\xe1\xe4\xe0\xe4\xe0\xe4\xe0\xe4\xe1\xe4\xe0\xe48\xc3\xda\xc1\xde\xc1\xde\xc1Mƺ\xc6_\xe9\r\xef:\xef9\xef7\xf2\xcb\xf2\xcb\xefx\x06;\f;\f\xe5\x11\xa3\x13\xa2\x13\xde\fW\nW\n!܌ǐ\xc7\xf9\xc3\x16\xc2\x16\u0080\xdc\xd4\xec\xd3\xec\xcf\x02\xad\x12\xac\x12\xb4\r\x8b\t\x8a\t|\b\v\x10\xd5\x18\xbe'\x998\x968y*\xef\x17\xed\x17\xec\x17\xeb\x17\xe9\x17\xc1#\x0f7E3\xd5'\x88\x0f\x88\x0f\xe5\x05j\xedl\xed:\xed\xa0\xec\xa2\xec\xe2\xf3\x93\x0f\x95\x0f\x95\x0f\x96\x0f\x96\x0f\xfc\n\xaf\xec\xb1\xec\xb2\xec\xb5\xec\xb6\xec\xb8\xec\xa3\xec\xd6\xea\xd2\xea\xd9\xe9\xd9\xe9\xd7\xe9J\x03\x8a\xffR\xff7\xe9\x89\xe7\x8b\xe7F\xd8\xd1\xe3\xa9\xe5\xac\xee9\xf09\xf0\xaf\x02\x16\n\xe0\n\xc9\fV\rU\r\xd8\xf5\x83\xed\x82\xed\x82\xed\x82\xed\x81\xed\xdd\x03M\x0fM\x0f\xba\xf4\xa5\xe4\xa8\xe4:\xd2\"\xc5\"\xc5cܻ\xef\xe4\xf4\x8b\v\xee\x1c\xec\x1c\x00\ti\xf2k\xf2m\xf2m\xf2p\xf2\xd7\xf9\\\x05\\\x05.\x03+\xff-\xffF\xfb\xb0\xf2n\xf2{\xf3\xf9\xf7\xfc\xf7\xb3\xf6\x80\xf2\x82\xf2\x84\xf2\x84\xf2\x86\xf2\xfc\xf3\xd9\xfb\xda\xfb\x1b\xfbj\xf5j\xf5\xb1\xf4\x1f\xec \xect\xeb'\xda#\xd3#\xd3\xf6\xf9\x87\xfa\x85\xfa\xed\xf0`\xf0_\xf0_\xf0_\xf0^\xf0\x1e\x0e\xb1\x12\xb1\x12$\xf5\xf5\xee\xf8\xee~\xeb\x8e\xea\x8e\xea\x1b\x00e\ac\a\xed\xf1\f\xe9\n\xe9\x97\xd1\xe8\xc5\xebŚ\xd9J\xe5K\xe5.\xebO\xefR\xef\xd2\xde/\xd1/ѩ\xda\xdd\xe3\xdc\xe3%\xf5\xdb\b\xdb\b\xc8\v\xb6\x0f\xb6\x0f\x91\x04\xe2\xf2\xe1\xf2F\xe5\x8cˌ\xcb\x0f\xcb\xe9\xc9\xec\xc9\\ʏː\xcb\xcc\xcc\t\xd1\r\xd1p\xcfB\xc8y\xc8\xfe\xc8A\xc5C\xc5\xe7ŏ\xcb5\xce\xd6\xf0\xda\r\xda\r\xbd\rt\bt\b\xab\n\x95\xfc\x13\xfc\x11\xfc\x16\xf11\xf0/\xf0{\xed\xe2\xf7|\xf9\xdd\x12\x9c\x17\xac\xf8\xd2\xf3^\xf4^\xf4\xa0\xf4\xb5\xf43\r;\x16b\x19\xb1\x1ah/E9B9_9p9m9\xc9'\xc0\x1b\xbd\x1b\x01\x19\xd5\x00\x11\xee\xd7\xf8\x1f\x03\x1f\x03\xaa\x04\xdf\x14)&\xa7\x1f\xf4\x16\xf4\x16\x90\x16\xac\x13\xd8\x0f\x18\x10\x97\x10\x97\x10\xbc\x10\x13\x11\x13\x11\xa7\x13\xff\x1a\xfe\x1a\xfb\x1a\xfb\x1a\xf7\x1a-\x1a\x84\x16\x82\x16\x80\x16\x80\x16}\x16\xde\x19>;\xc9:\x1d4\x1c4\x1b4\x1b42444<\x14\x13\x13\x16\x13\x16\x13\x16\x13\x16\x13\u007f\x15\xd4\x15\x1f\x19\xb2\x19r\xfda\xf7a\xf7d\xf7\x05\x11\xd3\x18\xfb\x16U\x16Q\x16Q\x16\x1e\xfb_\xee\\\x06%\x13(\x13&\x13r\x12\xf7\x11\xe8\x03*\xf9-\xf9-\xf9.\xf9.\xf9b\xe9T\xd8V\xd8X\xd8[\xd8\\\xd8\x0f\xdb0\xdf\x1c\xe7\xd8\xf4\xd8\xf4\xd7\xf4\xa9\xef\a\xe4\xb8ߙԜԟԽ\xdcI\xf9\xdf\x00\xc6 \xc5 \xc2 \xd5\x1b\x1f\xfc\xf9\xf7\xb5ԶԹ\xd4\xd9\xd4W\xd7X\xd7\\\xd7\\\xd7_\xd7a\xd7J\xf6\x15\xf8\x1a\xf8\x1a\xf8\xfa(9/\xed\x19d\x16^\x16`\x16\x1d\xf7=\xef\x04\vV\x13Y\x13Y\x13Z\x13Z\x13\xe1\xfd\xd5\xf3\xd8\xf3\xd1\xf4b\xf5b\xf5\xaa\b\xe8\x15\xe8\x15\xe8\x15\xe9\x15\xe9\x15\xa8\"./-/w-u+s+\xce\x14\xf9\xf4\xab\xf2@\xef\xae\xef\xae\xef\\\xfbo\x13\x93\x14F\x17F\x17G\x17G\x17H\x17\xda\x1bT-\xc8-\xeb/\xd1/8/6/\x05.\x8e$\xc3-],\x9b\x15\x9b\x15\xa2\x15\xf6\x18\xf4\x18\xf4\x18\x986\x0f8\r8\xd78\xe98\xe78\xb39\xd49\xd09\xce9\xca9\xca9\xc59\xc39\xc19\xa5-\x11)\x10)W\x1cc\x16\xff\x06\xb7\xffA\x00A\x00\xb4\x00\x02\x01\xe4\t\xa1\x11\xd9\x13D\x15\x82\x15\xc3\x15\xc2\x15\xdd#\x966\xc18\xbe8\xbd8\xbd8\x844\x9d+\xdc(\xe5\x19w\xf9w\xf9L\x01\x88\x16\x88\x16\x18\x1e[8\x968\x929\x919\x909\x909\x8e9\v914\x1c1\x1c1\x1215060\xe10\xc4\x11\xd9\x14\xff\x14\xff\x14\xff\x14\xff\x14\x1f b\x05#\x01\xf5\xfc%\xfc#\xfcc\xf3\x15\xf1\x14\xf1\x99\v\xae\x14\xad\x14)*r3r3o3o39\x1d\x93\xfek\xf3k\xf3j\xf3k\xf3k\xf3\x98\x007\r7\r7\r5\r4\r4\r\"\x05\x1a\xf9\xc6\xf5\x1b\xf0B\xf2u\xf6u\xf6u\xf6\xca\xfe\xaa\x14\xd4\x14R\x15#\x15z\x14z\x14z\x14\xa0\x0f\x03\xf4\x03\xf3~\xebj\xeb\x9c\xea\x9d\xea\x9d\xea\b\xea\x17\xd1\x17\xd1\x18\xd1\x17ю\xedA\xefA\xefC\xefM\x10\xcd\x14\xcd\x14\xce\x14\x11\xfbH\xf5\xd0\u05f9\xcfu\xe7@\xef@\xef@\xef@\xef=\xef>\xef>\xef\xe9\u0600ˀˁ\xcb\xd2ߺ\xef\xd7\xf7\x14\xff\x14\xff\x13\xff\x13\xff\x14\xff\xcc\xe8\x89ˈ̓\xd0\xe8\xe38\x058\x056\x05\xc0\x10@+@+@+\xbb\"\x98\a\x98\a\x96\a+\a/\x05/\x05/\x05/\x052\x05t\x02\x91\xe1\xf6\xe2\xcc\x00\xcb\x00\xca\x00\xca\x00%\xe3\x01\xe2\xc6\x01'\x04\xcd\x06\x1a\a\x1a\a\x19\a\x16 \x1b%@\x15J\x11n\x0f\xdd\x0e\xdd\x0e\xdf\x0e\xa5\a\x95\x04]\xfaO\xf5!\xe3\xbbؼؼ\xd8\x1a؟\xd7\xd7\xd6+֒\xd3\x06\xd1\x06\xd1\aѷ\xd2\xdf\xd4\xe1\xd4\xe1\xd4eעۤۤ\xdb\xc8\xd8P\xd2P\xd2P\xd2R\xd2S\xd2\x1d\xd3!֖\xdc\x1e\xfb\x1e\xfb\x1e\xfb\x99\xf8\xca\xe3\xca\xe3\xca\xe3\x98\xe3:\xdf;\xdf;\xdf;\xdf\xccݻݻݻ\xdd\xd0\x1a=\";\";\":&\x12'\x12'\x12'\x8b\xf4g\xe4g\xe4g\xe4\xb5\xe8\xa0\xea\xa2\xea\xa2\xea\xdd\xe71\xe61\xe62\xe6\x86\xe8a\xea\x0e\xed\u007f\xef\xc7\xfc\xa4\n\xa2\n\xa2\n\x8b\v\xcd\f\xcd\f\xcd\f\xf8\x17[,[,[,\xf8\"\x96\v\x96\v\x96\v\xf8\x05\xee\xf1\xee\xf1\xee\xf1\xdd\xf6\xa7\x12\xa6\x12\xa6\x12\x93\x11\xbc\x05;\x06\x00\x10\x00\x10\x00\x10\x00\x10\xfe\x0f\xfe\x0f\x89\xf8\xc1\xf6\xc6\xf2P\xf2\f\xfaJ\xfbI\xfbI\xfb]\xfba\xfb2\x18`!\xb9\"=#;#;#61\x8e8\x8e8\x8e8\x8d8\x8b8\x8a8\x8a8~1\xf2*\x90\x18\xf0\x04T\xfb\x88\xef\x88\xef\x87\xef\x87\xef\x88\xef\x88\xef\x87\xef\xdf\xf2F\xfaF\xfaF\xfa\xaf\xf7\x8a\xef\x8a\xef\x88\xef\x16\xf6\x88\x15\x86\x15\x86\x15\x91\x18\x193\x183\x183\x9e1\xb9\x06\xb9\x06\xb9\x03\xaa\x03\xeb\x13\xd8\x14\xd8\x14\xd8\x14\xbe\x16\x05\x17\x1b3c8G\x1c\x89\x15\x88\x15\x88\x15\x88\x15\x89\x15\xe4\xfa\x8e\xef\xb7\xf1\xc9\xf2\xc8\xf2\xc9\xf2\x99\x05W\x12'\x17\xeb\x1a\b\x1a=\x19=\x19<\x19V\x18D\x17\xe8\x04\x99\xeb8\xde\xd9\xc8\xd9\xc8\xd9\xc8\xdb\xc8\xdc\xc8w\xd3\xda\xee\xda\xee\xdb\xee\xdc\xee\xdc\xee}\xf4n\x0f\xa5\x0e\xbb\t\xd4\t\xc6\n\xc6\n\xc6\n\xc6\n\xc6\n\xc6\nK\xec\x97\xeb\xf2\xca\xf1\xc8\xf1\xc8\xf1ȸ\xe0?\xe4?\xe4=\xe4\xd7\xe5?\xe6?\xe6@\xe6 \xea\x92\xeb\x94\xeb\x94\xeb\x84\xe7c\xe5d\xe5d\xe5d\xe5d\xe5c\xe5d\xe5\xab֭ȯȯ\xc8\xc2\xd7\xe1\xea\xe1\xea\xe2\xea\x97\xea\x18\xea\x18\xea\x1a\xea\xf2\xe6u\xdfu\xdfu\xdf\b\xe9\x16\nF\vg\x10f\x10d\x10d\x10d\x10\xa5\r\xf4\xed\x8a\xec\x8e͓͓͕͕͐͑͑Ͱ\xc8\x05\xc82\xe8&\xee\x80\x06N\f2\xf6\x9e\xef\x9e\xef\x9e\xef\x9e\xef\x9e\xef\x9e\xef\x9e\xefZ\x034\x0f4\x0f4\x0f\xc8\xfd\xa1\xef\xa0\xef\x9e\xef\xa0\xef\xa1\xef\xa0\xef\xa0\xef\x02\xff\x94\x15\x94\x15\x93\x15\x93\x15\x97\x15\x97\x15\x96\x15\x94\x15\x94\x15\xc8\r[\xf2[\xf2[\xf2[\xf2[\xf2$\xf6\x94\x14a\x14\xdc\x11\xcd\x11C\x10C\x10B\x10B\x10x\x06\xe4\x05\xe4\x05\xe4\x05\b\b[\b[\b[\b\xc8\ng\vf\vf\v/\xffy\xfaC\xf9\xb3\xf8$\xf52\xf32\xf30\xf3+\xf1\xa8\xef\xa8\xef\xa8\xef\xa8\xef\xa8\xef\xa8\xef\xa7\xef:\xf0\x02\xf1\x02\xf1\x00\xf1\xc2\xfd\x9a\x15\x9a\x15\x98\x15\x98\x15\x9a\x15\x9a\x15\x9a\x15\xb3\x18?%\xda!!\x10\x97\f|\xf3|\xf3|\xf3f\xf3\x96\xf1\x9d\xf1\x92\xf4\x99\xf4\xd8\xf5\xe7\xf5\xe6\xf5\xe6\xf5\xe6\xf5\xe7\xf5\xe7\xf5\xe6\xf5Y\x0f\x9c\x15\x9b\x15\x9b\x15F.\x897\x897\x897\x867\x847C \x97\x11\x94\x11\x93\x11\x93\x11\x93\x11\xaf\r\xc6\t\xc6\t\xc5\t\xcf\x13\x83!\x83!\x83!\x83!\x81!\x81!\x81!\xc2\x1eZ\x17&\x17y\x166\x17O\x1aM\x1aM\x1aG\x1a\x1e\x1a\xd0\x19S\x16?\x15\b\xfc\b\xfc\b\xfc\t\xfc\xf6\xfe!\xff\"\xff\"\xffU\x02\xd2\x02\x81\xe5|ߍ\xf2\x94\xf7\x94\xf7\x94\xf74\xe3\v\xdb\v\xdb\r\xdb\xc6\xdc\xc1ݿ\xdd\xc1\xdd4\xe94\xf26\xf27\xf2C\xe4Hո\xd8\a\xdd\b\xdd\b\xdd\n\xdd\vݝ\xdd\xc8\xde\xc8\xde\xc8\xde\x0e\xe4\xee\xf3\xee\xf3\xef\xf3r\xf4\xea\xf6\xea\xf6\xea\xf6\xe9\xf6\xe9\xf6\xc1\xf8{\x18a\x18\a\x12\x01\x12\x02\x12\x02\x12W\xff\xad\xfd\xae\xfd\xae\xfd\xf7\xde\x1d\xd9\x1d\xd9\x1d\xd9\r\xf1\x89\xf8\x89\xf8\x89\xf8\xabܼϽϽ\xcf\xd9̛̜͛\xcc4\xde\x11\xeeU\xfc_\v]\v\\\v\\\v]\v\\\v\\\v\x15\f\x8f\r~\rU\rV\rV\r9\aE\xf0G\xf0G\xf0\x93\xf0\x96\xf2\x98\xf2\x98\xf2\v\xf4\x87\x0e\x87\x0e\x87\x0e\x87\x0e\xf1\xf3\x9e\xf2n\xe5*\xe4z\xed\xd3\xee\xd1\xee\xd1\xee\xd3\xee\xd3\xee\xd1\xee\xd1\xee\xd4\xee\xd4\xee\xd3\xee\xd3\xee\xd3\xee\xd4\xee\xd4\xee\xd3\xee\x06\xef,\xef\xfe\xf0\xb3\xf2H\x02\x96\x13\x94\x13\x94\x13\x93\x13\x91\x13\x91\x13\x90\x13x\x11\xe3\f\xe3\f\xe3\f\x96\r\xe5\x0f\xe4\x0f\xe4\x0f\xe4\x0f\xe1\x0f\xbc\x13\x1f2 292929292\b\x12\x16\x11\x16\x11\x16\x11d\x13\xad\x13\xad\x13\xad\x13x.\xc44\xc44\xc44\x1c\b\x80\xf7\xf5\xdfJ\xd5L\xd5M\xd5M\xd5Oռוٖٖٽ\xef9\a9\a9\aB\x06\xd8\x04\xd8\x04\xda\x04\xfe\xfa=\xe6\b\xe6z\xe5j\xedr\x06t\x06t\x06b\x06\x05\x06\x05\x06\x05\x06E\x04b\xefi\xef\x0e\xf0\x0e\xf0[\x0f*\x10,\x10,\x10,\x10,\x10M\x0f&\x0f\x8d\x04\x14\x02\x14\x02\x15\x02\xc3\x00D\x00D\x00D\x00y\xf3v\xec\x14ޮԈϋˌˌ\xcb~Ϊ\xd1eәՕ۞\xe4\x9e\xe4\x9e\xe4\x9e\xe4\x9e\xe4\x9e\xe4\x9f\xe41\xea\x8a\xfc\x8a\xfc\x89\xfc\xdd\xfd^\x05^\x05\\\x05[\x05Z\x05Z\x05Z\x05Z\x05=\xf1u\xf0S\xf2{\xf2|\xef\x12\xef\x12\xef\x14\xef~\xeeW\xeeW\xeeW\xee\x19\x01\xae\bi\x06M\x05L\x05L\x05L\x05L\x05I\x05I\x05I\x05I\x05\x8b\n\xb0\x10=\x1az'\xd9(\x1e+\x1e+\x1c+\x1b+\x1a+\x1a+\x1a+\x1a+\x17+\x16+\x16+\x16+\x14+@*\x18!\x87 \x14\x13\x14\x13-\x10\x1d\x10\xff\xec\x8d\xea\x8d\xea\x8e\xeav\xe7\xe9\xe6\xe9\xe6\xeb\xe6\xeb\xe6\xe9\xe6\xc3\xe6\xb3\xe6\n\xd3\xd4\xc9\xd4\xc9\xd4\xc9|\xda\xc6\xe5\xc7\xe5\xc7\xe5\xd9\xe5\xe9\xe5\xdb\xe85\xec\xff\xf87\n7\n9\n\n\x00]\xec]\xec]\xec^\xec_\xec\xec\xeb>\xea\v\xee\x9c\x00\x9c\x00\x9e\x00P\xfeN\xe5O\xe5O\xe5O\xe5R\xe5R\xe5\x96\xdc\xf9\xdb\xfc\xf1\xa8\xf4\xa8\xf4\xa9\xf4\xfa݃\u0603\u0603\xd8y\xd8t\xd8P\xd6J\xd5^\xd1\x18\xcf\x19\xcf\x19\xcf`\xce\xc7\xcd\xc8\xcd\xc8\xcd\xf9\xcd0\xce.\xce0\xce!\xdc[\xf3[\xf3[\xf3^\xf2\xe9\xef\xea\xef\xea\xef\xea\xef\xee\xef\xee\xef\xed\xef\xdf\xefj\xefk\xefk\xefW\xf0\x85\x0f\x85\x0f\x85\x0f\x85\x0f\x9a\x03\x93\x02l\xe6s\xe2\x19ў͟͞\xcd\x1e\xe3q\xeas\xeas\xeas\xeaq\xea\x83\xea\x8e\xea\x8d\xea\x8c\xea\x8d\xea\x8e\xea\x13ېʐʐ\xcaW\xe2\x8d\x06\x8d\x06\x8e\x06|\b\xd3\f\xd4\f\xd4\fy\x06\x19\xf0\x1b\xf0\x1b\xf0\xe9\xef\xac\xee\xac\xee\xac\xee\xae\xee\xb0\xee\xb2\xee\xb2\xee\xb2\xeer\xf5\xeb\xf53\xf7\\\xf7]\xfb\x16\xfce\x12\xe1\x17\xc7\x17\xbe\x17\xbd\x17\xbd\x17\xda\x1ad\x1c\xb6\x04\x96\xf6=\x01\xe6\b\xe3\b\xe3\b\xe2\b\xe2\b\xe2\b\xe2\b\x15\ap\x04\xb6\t\x17\x13\x17\x13\x19\x13\x19\x13\x17\x13\x17\x13\x19\x13\x19\x13\x17\x13\v\x12x\vx\vx\v\x8b\n\x9f\xf8\x9f\xf8\x9f\xf8\x9f\xf8\xa1\xf8\xa1\xf8\xa1\xf8\x9f\xf8P\v\xab\x0e\xab\x0e\xa9\x0e\n!\xe4&\xe3&\xe3&\x80'\xce'\xcd'\xcc'\xeb'\x04(\x01(\x01(\xcc\x1b\x12\x0f\x12\x0f\x10\x0fI\x0e\x1e\r\x1d\r\x1d\rD\x03Y\xedY\xedW\xedk\xf2n\x04n\x04m\x04\x1c\x05\xb3\t\xe2\t\xe6\v]\rV1V1\x93+h+D\v\xb6\b\xb6\b\xb6\b\xb7\b\xb7\b\xb7\b\xb7\b\x12 \x0f(\x0e(\f(w\x160\r.\r.\r\xac\n\xbc\b\xbc\b\xbc\b\xbc\b\xbe\b\xbe\b\xbe\b\xd5\x13\xc9%\xc9%\xc9%\xb6\x1eu\rs\rs\r\xfb\x04\xfe\xe2\xfe\xe2\xfd\xe2a\xe6N\x02L\x02L\x02\xc5\x01N\xe3\x1d\xe3\x1d\xe3\x1d\xe3\x18\xe8\xa3\xe8e\xec\x04\xedX\x06E\fE\fC\fS\n\x91\t\x92\t\x92\t{\x06\xa5\x04\xf7\xff\x94\xfc0\xfd\xb8\xfd\xb8\xfd\xb8\xfd\xe9\xf1\x0e\xe3\x0e\xe3\x0e\xe3\x0f\xe3\x10\xe3\x93\xee\xd3\b\xe0\x10\xf8'\xf8'\xf8'>#\x90\v\x90\v\x90\v}\v{\n{\n{\n{\n\xab \xa5!\xbf'X(\\\x17\xb7\x14\xb7\x14\xb7\x14\xb7\x14\xb8\x14\xb7\x14\xb7\x14}\"\xff(\xfd(\xfd(\xcb\x16\xf8\t\xf8\t\xf6\t\xf6\t\xf8\t\xf8\t\xf6\t9\v\x1e\r\x0f\a\x00\xfc\t\xfb\xdc\xf8\xdc\xf8\xdb\xf8\xdc\xf8\xde\xf8\xde\xf8\xdb\xf8\xdb\xf8\xde\xf8\x16\xf9\xe7\xfb\x98\xfc~\x16~\x16~\x16}\x164\x15\x14\x15\x14\x15\x14\x15\xeb\rK\fJ\fI\f\xc3'd2/3\x943\x913\x913\x8f3\x8f3\x8e3\x8d3\x8b3\x8b3 '<\x17=\x17=\x17\xad\x16\x9b\x15\x9b\x15\x9b\x15d\x15\xc2\x14\xc0\x14\xc0\x14\x83\x19{3{3z3\xc01\xed\x16\xce\x16\xd0\r\xb7\r\xb4\r\xb4\r\xb4\r\xb4\r\xba\xf3\a\xef\x06\xef\x06\xef\t\xef\t\xef\t\xef\t\xefv\x02\x9d\f\xfd\v\x99\vC\xfcG\xf0\xdd\xea\xbe\xe5Z\xe75\xe95\xe95\xe9}\xf0\xd8\xfc]\x01\xdc\nG\f\b\x10\a\x10\a\x10Q\x0f\x18\f\x18\f\x18\f\x19\nM\xf4M\xf4O\xf4O\xf4\a\xd4\xd2\xd2\xd2\xd2\xd2\xd2\xfe\xceh\xceh\xceh\xcei\xcek\xcek\xcek\xceN\xd05\xd1n\xd7 \xdb\xc1\xe8\xa5\xf2\xa5\xf2\xa5\xf2\x98\xf2\x87\xf2\x87\xf2\x87\xf2\b\xf5\x00\xf9\x00\xf9\x01\xf9S\xfeX\v<\x02\xa2\xe5\xf4\xe5J\xe7K\xe7K\xe7\xae\xe7H\xebH\xebH\xebH\xebI\xe1\a\xe1\b\xe1\b\xe1D\xd7\xe8\xd5\xe8\xd5\xe8\xd5\x0fتبت\xd85\xe1)\xe5*\xe5*\xe5X\xe5y\xe5y\xe5y\xe5t\xd9\xe1\xcc\xe1\xcc\xe3\xcc\xe3\xcc\xe4\xcc\xe5\xcc\xe5\xcc\xe4\xcc\xe5\xcc\xe5\xcc\xe7\xcc{\xd2_\xe9a\xe9a\xe9\xb3\xe6\x17\xce\x17\xce\x17\xce\x18\xce\x1a\xce\x18\xce\x1a\xce\x1aΈ\xe7\x14\xebZ\xea3\xea\x1e\aQ\x0fQ\x0fR\x0fR\x0fR\x0fR\x0fR\x0f\x95\xffL\xf4L\xf4L\xf4\xaa\x01\xec\x0f\xec\x0f\xec\x0f\xec\x0f\xee\x0f\xee\x0f\xee\x0f6\a\x88\xf1\x88\xf1\x88\xf1D\xf6\xac\n\xac\n\xad\n\x9a\n\xdc\t\xdc\t\xdc\t\xdc\t\xdd\xfaR\xfaR\xfaT\xfa\xbb\xf2\x8f\xf1\x91\xf1\x91\xf1\xaa\xd4\xde\xcb\xe0\xcb\xe0\xcb\x1c\xcc;\xcc;\xcc;\xccM\xddH\xeaH\xeaH\xeaI\xeaI\xea\xb9\xeaS\xebS\xebS\xebT\xebT\xeb)\xe3\xf2\xcc\xf2\xcc\xf3̣\xcd\x02\xd1\xe2\xd0\xfaϰѧ\xe8\xa8\xe8\xa8\xe8\xa8\xe8\xd2\xe7\xc4\xe7\xc5\xe7\xc5\xe7\x16\xe1\xd9\xdf\xd9\xdf\xd9\xdf\xc9\xdba\xda.\xec\x0f\xf4\t\x06\x05\x10\a\x10\x05\x106\x01\xbc\xf4\xbc\xf4\xbe\xf4b\xf3\xab\xf1\xd7\xeeq\xea\xed\xea\xda\xeb\xdc\xeb\xdc\xeba\xe79\xd9:\xd9;\xd9\xf2\xd7\x11\xd0\x11\xd0\x12\xd0K\xd0\xf5\xd5\xf5Շ\xd4yԧ\xcf/\xcf1\xcf1\xcf3\xce\xf6\xcd\xf6\xcd\xf6\xcd\"\xddY\xe3Y\xe3Y\xe34\xd8\x02ь\xe1\xb4\xeeC\xee\xd3\xed\xd3\xed\xd1\xed\x18\xfa)\f)\f(\f\xe4\ba\x01a\x01a\x01\xc2\x058\x178\x176\x17\xc3\x16\xa6\x12\xa5\x12\xa5\x12\xa5\x12\xf9*\xb2+\xb1+\xb1+\xb1+\xb1+\xae0\xcb1\x86\x1ar\x13r\x13r\x13\x97'\xc71\xc71\xc71\xc61\xc61\xc41\xc41\x8d1K1K1K1\xfa)\xc3\x1c\xc3\x1c\xc3\x1c\xc3\x1c\xc1\x1c\xc1\x1c\xc1\x1c\xe7\x1f\xbc1\xbc1\xbc1\r0_\x0e_\x0e#\f\x11\f\x11\f\x0f\f\x0f\f\x0f\f\x8b\x04\xd7\x02\xd7\x02\xd7\x02E\x04\xda\x04\xda\x04\xdb\x04\xa7\x04\x86\x04\x84\x04\x84\x04\x01\xfe\x9a\xf7\x9a\xf7\x9a\xf7\xfd\xf4\x15\xf1\x15\xf1\x14\xf1\xd7\xf0E\xf0E\xf0E\xf0\xc6\xef\xb7\xed\xb7\xed\xb6\xed\xb6\xed\xb4\xed\xb4\xed\xb4\xed\xb4\xed\xb4\xed\xb3\xed\xb3\xed\xb3\xed\xb3\xed\xb3\xed\xb3\xed\xb3\xed\xd7\x05\xdc\r;\x0f\xd1\x0f\xcf\x0f\xcf\x0f\xce\x0f\xce\x0f\xcf\x00!\xf4!\xf4 \xf4\xbb\xf4\x84\xf5\x84\xf5\x83\xf5N\xfc\xee\t\xd8\n1\r\xbb\x0e\xf5\x13\xf3\x13\xf3\x13\xcd\x12\x8e\n\x8e\n\x8e\nI\n\x85\xfbd\xfbd\xfbc\xfb\x94\xf6\xf6\xf5I\xef\xf4\xed{\xfe\x1d\x03\x1d\x03\x1d\x033\x04\xba\x04\xa1\x04\x94\x04\x88\x04\x80\x04\xc8\x03\x1d\x03\xfa\x05F\tD\n\xad\v\xa7\x14\xb4$\xb5$\xb4$\xb7 \xf2\x14\xf2\x14\xf2\x14@\x17\xa5$\xa4$\xa4$L$V\x1cV\x1cT\x1cT\x1c\xe5\xfa\x85\xf7\xaf\xf5_\xf5M\x05=\t=\t;\t;\t;\t;\t;\t(\t\x1c\t\x1a\t\x1a\t\x88\xfd\x1d\xf1D\x01\xbc\x16\x1e\x15p\x12o\x12o\x12M\x12\xf2\x11\xf2\x11\xf2\x11{\x15\xbb'\xbb'\xbb'\x1d'\xa8\x1c\xa8\x1c\xa8\x1c\xa8\x1cJ\xf9H\xf6H\xf6H\xf6|\x04\xb9\a\xb6\a\xb6\a\xc3\xfaf\xf5f\xf5e\xf5e\xf5e\xf5e\xf5c\xf5g\xf4b\xf3n\xf4\xc6\xf5~\xfb\xa0\x04\xa0\x04\xa1\x04\xa0\x04\xa0\x04\xa0\x04\x9f\x04\xc8\x00\xfb\xed\xfb\xed\xfb\xed\xbe\xef\xa2\n\xa1\n\xa1\n\xa1\n\xaf\"\x99$\x16,.-\xa7\x17\xe0\x12\xdf\x12\xdf\x12\xf7\x1d\x80\"\u007f\"\u007f\"\u007f\"\u007f\"}\"}\"\x9f\x19\x83\x10\x83\x10\x81\x10\x1f\x1c\xb5.\xb5.\xb5.\xb4.\xb4.\xb4.\xb4.\x14*\x16\x13\x14\x13\x14\x13\xec\x12}\x10|\x10|\x10|\x10|\x10|\x10|\x10|\x10\xa4\xf2\xc7\xeb\xc5\xeb\xc5\xeb\x0f\xfc\xe5\x02\xe5\x02\xe5\x02\x05\x03\x1b\x03\x1b\x03\x19\x03\xc1\x03t\x04t\x04t\x04\xbf\xfbB\xed\x02\xee\x93\xef\xa8\xf4\xaf\x02\xaf\x02\xaf\x02\x9b\x02-\x02+\x02+\x02\x93\x03Y Y Y Y \x9f\x1a\r\x1a\r\x1a\f\x1a\xf5\x06*\x029\xfb\xd5\xf8\xe4\x05\xc9\v\xc9\v\xc9\vm\nq\ts\tq\t)\xfd6\xef5\xef5\xefo\xf9\xc3\v\xc3\v\xc3\v\x90\n\xef\x06\xaa\a\xbb\n\xfd\x06k\xefk\xefj\xefk\xefk\xefk\xefj\xefj\xef\xdc\x00\x12\x03\x12\x03\x12\x036\v\x8e\r\x8e\r\x8e\r\xbd\v\xd4\n\xd4\n\xd4\n\x96\t\x98\b\x98\b\x98\b\n\bZ\aZ\aZ\a\t\x00J\xf1J\xf1I\xf1\xde\xf0_\xef_\xef^\xef^\xef^\xef\xf4\xf0y\ry\r^$\x1b%\x1a%\x19%\xd8\x10v\ru\ru\rv\ru\ru\rs\r\x86 \x96+\x11/\x9f1\x9e1\x9e1\x9c1\x9c1\x9c1\x9b1\x9b1\x9b1\xad(y\x13w\x13v\x13\xc7\x18d0^0:0L0'1'1'1'1=1@1?1?1=1=1=1=1<1<1:1:1i\x1en\x11m\x11m\x11\xf6\x0f_\x0e]\x0e]\x0e]\x0e]\x0e\\\x0e[\x0e\xf5\r\xc3\f\xc3\f\xc2\f\xef\x10\xdc*B+\xb0/\xb0/\xb0/\xb0/\xae/\xae/\x18\x1c\x80\x19\x80\x19\x80\x19\x97(\x1c-\x1c-\x1c-\x1b-\x1a-\x01.\x9c.\x95-\xb6,\xb6,\xb6,y\"\xc4\x14\xc3\x14\xc3\x14\xc3\x14\xc3\x14\xc3\x14\xc3\x14\xf2\x14\xb3\x15\xb1\x15\xb1\x15\r\x18\x861\xb708\x142\x141\x141\x14/\x14/\x14l\x13D\x13C\x13C\x13~\xfe\x1c\xf6\x1a\xf6\x19\xf6D\xf7\f\xf8\f\xf8\v\xf8\x89\x02\xca\r\xca\r\xc9\r\xc8\r\xc8\r\xc8\r\xc8\rb\r7\f7\f6\fH\b\xb4\xef\xb4\xef\xb4\xef_\xf0\x8f\r\xc2\r`0\xbe2\xbe2\xbe2\xbd2\xbd2\xbc2\xbc2\xba2\xba2` ]\x16]\x16]\x16\"\x18\xb3\x19\xb2\x19\xb1\x19\x0f \x05),\x1d\xe3\am\x01T\xf2T\xf2T\xf2W\xf2j\xf2j\xf2i\xf2k\xf2\x87\xf2\x87\xf2\x86\xf2\x86\xf2\x19\n\x18\f\x16\f\x16\f\xc7\xfb\xd5\xf7z\xf8\xb1\xf8\xab\xf2\xf3\xef\xf3\xef\xf1\xef\xf1\xef\xf1\xef\xf1\xef\xf0\xef\xf0\xef\xee\xef\xee\xef\xee\xef\xee\xef\xed\xef\xed\xef\xec\xef\xbd\xfe\x9c2\x9b2\x9b2\x9b2\x9a2\x9a2\x982\x982\x972\x972\x952\x952\xb5.\xfb-\xfa-\xfa-1\x1d\xcc\x16\xcb\x16\xc9\x16\x05\x02\x91\xf4\x91\xf4\x91\xf4\x91\xf4\x91\xf4\x91\xf4\x8f\xf4\xb7\xff\x90\x12\x90\x12\x90\x12\x8f\x12\x8f\x12\x8f\x12\x8d\x12\x0f\x0f\xeb\xf8\xeb\xf8\xeb\xf8\xd0\xf9\xe0)q*a,\x88,\xaa-\xd6-\xd6-\xd5-\xc3\x1a}\x14{\x14{\x14t\x15\x05\x16\x05\x16\x05\x16)\x16L\x16L\x16L\x16\x0f!d1d1d1p0\xfa-\xf9-\xf9-\xf7-\xf6-\xf6-\xf6-%.p2p2o2o2\xa8\x17p\x14o\x14o\x14l\x14n\x14d\x10\xc8\x0e\x8e\r\xe9\f\xe9\f\xe7\f\xe7\f\xe6\f\xe6\f\xe6\f\xe5\f\xe3\f\xe5\f\xe5\f \fM\nM\nM\n\xc1\n\xe0\f\xdf\f\xdf\f\xdf\f\xdf\f\xdf\fX\x10u\x10\xa8\n\x12\n\x0f\n\x0f\n\x13\xf8:\xf3:\xf3:\xf3:\xf38\xf38\xf38\xf3\xb8\x01\xb3\r\xb3\r\xb2\r\xdc\x0em\x10\x9e\x10\xf5\x10\u007f\x11\xbc\x12\xbc\x12\xbc\x12[\x0f\xa9\x00\xa9\x00\xa7\x00\xda\x01\xb8\x12\xb8\x12\xb8\x12\xb7\x12\xb7\x12\xb7\x12\xb7\x12\xb4\x12\xe5\xfa\xc2\xf4\xc0\xf4\xbf\xf4\xbf\xf4\xbf\xf40\xf33\xf23\xf23\xf23\xf23\xf2&\xff!\x10!\x10!\x10\xcd\x06/\xf2/\xf2/\xf2W\xf2\xff\xf2\xff\xf2\xfd\xf2\xea\xf4\f\x0f\f\x0f\f\x0f\f\x0f\xc2\n^\n\x9e$\b)\x90*\xf6*\xf6*\xf4*\x81-\xbd.\xbd.\xbf.\x1d =\x14<\x14<\x14<\x14;\x14;\x149\x149\x149\x148\x146\x146\x146\x14\x81\x12\x94\x06\x94\x06\x92\x06\x92\x06\x92\x06\x92\x06\x91\x06\x91\x06\x91\x06\x91\x06'\x1e{$x$x$*!\x81\x1f\x80\x1f\x80\x1f\xef\x11\x8b\x06\x8a\x06\x8a\x06\x8a\x06\x8a\x06;\n\xd2\x10\x17\x12\x15\x15\x15\x15\x14\x15\x94\x17_#_#_#\xeb#\xef-\xef-\xef-\xef-\xb9\x16\x18\x14\x18\x14\x17\x14\x88\x11\xca\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\a\t\xa1\x03q\xfa8\xf28\xf28\xf28\xf26\xf26\xf24\xf24\xf24\xf24\xf23\xf23\xf22\xf22\xf22\xf2A\xf2\xd1\xf3\xd1\xf3\xd0\xf3\xce\xf3\x9e\f\x0f\x10\x0f\x10\x0f\x10\x14\x0f\xc3\x0e\a\x0f%\x0f\xa0\r\xbd\f\xbb\f\xba\f\xe8\x0e\f\x11\f\x11\f\x11G\r.\a,\a,\a\x9f\x03]\xf9\\\xf9\\\xf95\xfa\xcd\xff\xcd\xff\xca\xff\xb4\xff\xb3\xf4p\xf4\xa5\xf7\xf4\xf7;\x10w\x14w\x14w\x14\x02\x158\x156\x155\x15{\x14\x02\x14\x01\x14\x01\x14\x00\x14\xfe\x13\xfe\x13\xfe\x13\xb5\x14\t\x16\t\x16\t\x16P\x1c\xd61\xd61\xd61\xd41\xd31`1\xca%\xca%\xbb\xf4\xcd\xf1\xcd\xf1\xcd\xf1\xa9\t\xfa\x0e\xf9\x0e\xf9\x0e\xf9\x0e\xf9\x0e\xf9\x0e\xf9\x0e\xf7\x0e\xf7\x0e\xf7\x0e\xf7\x0e\xf7\x0e\xf7\x0e\xf7\x0e\xf6\x0e\xf6\x0e\xf6\x0e\xf6\x0e\xf6\x0e\xc2\x0e\xe0\r\xd9\x10\xad%:&\x8d.\x8d.\x8d.\x8d.\x8d.\x8d.\x8c.\x8c.\\\x17\xb7\x10\xb7\x10\xb7\x10\xb7\x10\xb7\x10\xb7\x10\xb5\x10\xb5\x10\xb7\x10\xb7\x10\xb7\x10\xb7\x10\xb5\x10\xb5\x10\xb5\x10\xa8\x18!.\x1f.\x1f.|*\xbb\x14\xb9\x14\xb9\x14a\x14i\xff\x12\xff\x12\xff\x13\xff\xb2\xfe\xa3\xfe\xa3\xfe\xa3\xfe5\xfd\xad\xfc\xad\xfc\xad\xfc\xc6\xf9\xd9\xf7\xd9\xf7\xd9\xf7\x8d\xfc\xc0\x01\xbf\x01\xbf\x01t\x05r\fq\fq\fu\x0e\xa5\x15\xe8\x14\xe8\x10\xe1\x10\x9e\x10\x9c\x10\x9c\x10\x9c\x10r\f$\f%\f$\f\n\xf8\x1b\xf3\x1b\xf3\x1b\xf3T\xf9R\xfcR\xfcQ\xfcL\x03\x19\t\x19\t\x19\t\\\f\xe4\x10\xe4\x10\xe3\x10\xe3\x10\xe4\x10\xe4\x10\xe4\x10U\x14?&''\x9b/\xa9.\x94\x12\x94\x12\x94\x12\x93\x12_(\x96+\x96+\x96+\xdc\x1d\x18\x19\x18\x19\x18\x19\x18\x15\x94\x12\x91\x12\x91\x12\x93\x12\x93\x12\x93\x12\x93\x12\x93\x12\x93\x12\x93\x12\x91\x12\xcf\x13\x15\x18\x15\x18\x15\x18I\x1a\x01/\x01/\x01/\x01/\x831\xb01\xb01\xb11\xb01\xb01\xb01\xae1\x19!\x1b\x19\x1a\x19\x1a\x19j%\xb4/\xb4/\xb4/\x850\xad1\xad1\xab1\x8a0\xb6-\xb6-\xb8-;-\x93*\x93*\x92*u*\xf9%\xef%L\x03\x8c\x00\n\xf1\x88\xee\x87\xee\x88\xeeY\xef\xa4\xef\xa4\xef\xa4\xef\x00\xf0<\xf0<\xf0:\xf0d\xfdB\fB\fB\fB\fC\fB\fB\f\xc8\f\xc6\x0eY\x12\xef'\xef'\xef'\xef'\xef'\xef'\x9d#4#4#3#1 Z\x1fY\x1fY\x1f\x9e\x1f\xc5\x1f\xc4\x1f\xc4\x1f\xc1\x1f\xbf\x1f\xbf\x1f\xbf\x1f\xe1 \xab\"\xab\"\xab\"*\x1d\x1f\r\x1e\r\x1e\ru\r\xd5\x0f\xd5\x0f\xd5\x0f\xd5\x0fv\x10\u007f\x10\u007f\x10\u007f\x10C&\xd5*\xd5*\xd5*\xa8%b#`#`#\xa9\x11\xdf\x03\xdf\x03\xdf\x03\xe3\xfbJ\xf1J\xf1I\xf1E\xf18\xf1:\xf1:\xf18\xf17\xf18\xf18\xf1#\xf1\x87\xee\x87\xee\x87\xee\x87\xee\xcb\xfeK\x01K\x01I\x01\x0e\x17\xf7\x1e\x17$\xa6&_\x1b\xe1\x13\xe0\x13\xe0\x13u\x18\xaf\x1d\xaf\x1d\xb1\x1d\xaf\x1d\xaf\x1d\xaf\x1d\xaf\x1d2\x1dB\x1bB\x1bB\x1b\x13\x1b\u007f\x18\x80\x18\x80\x18\x80\x18\x80\x18\u007f\x18`\x15\xc2\x144\x11!\x10!\x10!\x10\xf0\"\xcf-\xce-\xce-\xce-\xce-\xcd-\xcd-\xf0\"\x1f\x10\x1f\x10\x1f\x10\x1f\x10\x1f\x10\x1f\x10\x1f\x10\x1f\x10\x1f\x10\x1f\x10\x1f\x10\x1f\x10\xdf\xf0\x86\xee\x86\xee\x86\xee\x86\xee\x86\xee\x85\xee\x85\xee\x85\xee\x85\xee\x86\xee\x86\xee\xcd\xf8\x06\x02\x06\x02\x06\x02U\xfd\f\xf6\f\xf6\v\xf6/\xf6\x94\xf6\x94\xf6\x94\xf6y\xf8\x02\x06\x02\x06\x02\x06\x02\x06\xe0\v)\f)\f)\f)\f)\f)\f)\f\xb2\xfc\x89\xf5\x89\xf5\x89\xf5\xf0\x01(\f(\f(\f(\f(\f(\f(\f(\f(\f'\f'\f'\f'\f'\f'\f'\f'\f'\f'\f'\f\xd7\tc\tc\tc\t|\xfc\xf5\xf6\xf4\xf6\xf4\xf6\xfd\xfe-\x05+\x05+\x05(\b#\f$\f$\f{\x03\x85\xee\x85\xee\x83\xee\x85\xee\x85\xee\x85\xee\x85\xee\x85\xee\x85\xee\x83\xee\x83\xee\x83\xee\x85\xee\x85\xee\x83\xee\x83\xee\x88\xf1\xc2\xf2\xc2\xf2\xc2\xf2Y\x01 \f \f \f$\xff\x83\xee\x83\xee\x83\xee\x83\xee\x83\xee\x85\xee\x85\xee\x8a\xef\xbe\xf4\xbe\xf4\xbc\xf4S\xf5\xf1\t\x17\n\x17\n\x17\n\xa0\xfe\xb0\xfc\xb0\xfc\xb0\xfc\xd6\xf2\xf8\xee\xf7\xee\xf7\xee\xa9\xf0\xe2\xf1\xe2\xf1\xe2\xf1`\xfbJ\aJ\aJ\a\xc2\b\x1a\f\x1a\f\x19\f4\v\xc9\x06\xc8\x06\xc8\x06\x18\x06\xd0\xef\xb4\xef\xb4\xef\xb4\xef\xae\xee\x83\xee\x83\xee\x83\xee}\xff\x1b\x06\x1b\x06\x1b\x06c\xfa\xed\xf1\xec\xf1\xec\xf1j\xf0\x83\xee\x83\xee\x83\xee\x82\xf7\x15\f\x16\f\x15\f\xa8\af\xf1f\xf1e\xf1m\xf1\xa7\xf2\xaa\xf2\xaa\xf2\xaa\xf2\xe6\v;\x10:\x10:\x10&\x0f\xb8\x0e\xb8\x0e\xb8\x0e>\x0e\xe6\r\xe6\r\xe5\r\x88\x02\xe3\xf3\xe3\xf3\xe3\xf3\x86\xf3\xaa\xf2\xaa\xf2\xaa\xf2\xaa\xf2\xaa\xf2\xaa\xf2\xa8\xf2\x01\xf3\xed\x04:\x05Q\b\x9d\b\xe3\x12\xc9\x14\xc6\x14\xc6\x14\xd8\x13t\x13t\x13t\x13\x18!\x97+\x97+\x97+\x04\x1f\t\x0e\v\x0e\t\x0e\b\x0e\b\x0e\b\x0e\b\x0e\t\x0e\t\x0e\t\x0e\b\x0e\x10\x0e\x12\x14@\x14@\x14@\x14\xc1\x16F\x17o\x15\xd9\x14U\x15\x8d\x15\x8d\x15\x8d\x15\x8d\x15\x8d\x15\x8d\x15\x8d\x15\x8d\x15\x8d\x15\x8c\x15\x8c\x15\x8c\x15\x8c\x15\x8c\x15\x8c\x15\xd2\x11B\xf8B\xf8B\xf8B\xf8\n\xf8\x05\xf8\x05\xf8\x05\xf8\x05\xf8\x05\xf8\a\xf8\a\xf8\x1a\xf9\xa4\xf9\xa4\xf9\xa2\xf9-\x06j\x11\xf6\x11\x9e\x12\x85\x16\xba\x1c\xba\x1c\xba\x1c\xf8 \v.\f.\v.\v.\t.\t.\t.\v.\xb1\x12\x87\x10\x85\x10\x85\x10\b$a)a)a)a)a)`)`)\x1b+\xd3,\xd2,\xd2,U%\xf8\x17{\x18\xbf\x19\xf4\x19\xb6\x1a\xb6\x1a\xb6\x1a'\x1aD\x13D\x13D\x13D\x13=&l(l(l(l(l(l(l(z\x1bA\x13A\x13C\x13\xd6 50303030303030\xe4*A\x13A\x13A\x13\x93\x13\x1c\x1b\x1c\x1b\xd0\xf8Z\xf6Z\xf6Z\xf6Z\xf6Z\xf6\xdf\x05\xf8\v\xf8\v\xf8\v\x06\xfb\x81\xee\u007f\xee\u007f\xee\u007f\xee\u007f\xee\u007f\xee\u007f\xee\xfd\xf6\xf5\v\xf5\v\xf5\v\xed\v\xba\v\xb8\v\xb8\v\xb8\v\x84\ny\ny\ny\n\x82\xf3\u007f\xee\u007f\xee\u007f\xeeo\x02\xf2\v\xf4\v\xf4\vz\x16\xb4\x1f\xb4\x1f\xb4\x1f\xc4\x1c'\x18'\x18'\x18\xcb\x12>\x02>\x02=\x02=\x02>\x02>\x02>\x02>\x02\x98\x1c\xe5\x1e\xe5\x1e\xe3\x1e\x83\v\xe4\x05\xe4\x05\xe4\x05\"\x06F\x06F\x06F\x06\xee\a\xb1\t\xb0\t\xb0\t/\x11\xae\x1f\xae\x1f\xae\x1f\xae\x1f\xae\x1f>\x1d\xed\v\xed\v\xed\v\xed\v\xed\v\xed\v\xbe\tj\tj\tj\t\x9f\x06\x8e\x05\x8e\x05\x8d\x057\xf8~\xee~\xee~\xee\x00\xfa\x0e\t\f\t\f\t\x0f\t\x13\t\x13\t\x13\t\x15\t$\t#\t#\t#\t\xca\v\xe7\v\xe7\v\xe6\v\xe6\v\xe7\v\xe6\v\xe6\v\x8e\xf8\xe6\xee\x03\x05\x1f\x14\xe7\x13\xb5\x13\xb5\x13\xb5\x13+\x14\xf1\x14\xef\x14\xef\x14\xc1\x19\b*\b*\b*\x9d*\x1c1\x1c1\x1c1\x1c1\x1c1\x1c1\x1c1\x1c1[0\x1c0\x1c0\x1c0\xe9\x1e\xb2\x13\xb4\x13\xb2\x13\xb2\x13\xb2\x13\xb2\x13\xb2\x13\xb2\x13\xb2\x13\xb2\x13\xb2\x13\xfc\x13r\x15r\x15s\x15\x81\x15\x96\x18\xa7\x18\xa7\x18\xa7\x18\x8f(\xbd+\xbd+\xbf+\x8b\x1f\xf2\x19\xf2\x19\xf2\x19\x84\"\xe2)\xe2)\xe2)\xb0,\x121\x121\x121\x061\xe30\xe30\xe30\xc60\xa7/\xa6/\xa6/\xa6/\xfd-\xd2-\xd2-\xd2-J0\x101\x0e1\x0e1\x0e1\x0e1\r1\r180C/C/A/O'6\x166\x165\x16\xe7\x19\",\",#,<,\xf40\t1\t1\n1\xf40\xf00\xf00\xf00\xc2/5/5/5/^.\xa6-\xa6-\xa5-X&\xcf\x1a\xcf\x1a\xcf\x1a\x1b\x19\xac\x13\xac\x13\xac\x13\xad\x13\xac\x13\xaa\x13\xaa\x13\xaa\x13(.\x031\x031\x031\x1d\x1e\xec\x17\xec\x17\xec\x17Z\x15\xaa\x13\xf5\r\xde\b.\x166&6&6&6&4&4&4&4&3&3&3&\x15&\xac\f\xcc\v\xcc\v\xca\vf\t\xdc\b\xdc\b\xdc\b\xdc\b\xdc\b\xdc\b\xdc\b\xb7\t\x84\n\x84\n\x85\n\xab\fc\x10c\x10b\x10\xbb\x12L\x1bK\x1bK\x1b\xc5\x1b\xb3\"\xb3\"\xb3\"\xb3\"\x8f\r\x94\n\x94\n\x94\nT\t\xdb\b\x8f\x0e\xa1\x11 \"V.V.V.)%\xae\x18\xae\x18\xae\x18\xce\x17{\x15{\x15{\x15\xd0\x18\xf30\xf30\xf30\xf30\xe5/\xd2/\xd2/\xd2/F.\xd8-\xd8-\xd8-\xca/\xf00\xef0\xef0\x180+/+/+/\xdd.>.>.>.\xb4.\xec0\xec0\xed0\xc00\x1b)\xfc(\xfc(\xfa(z\x1eZ\x1cZ\x1cZ\x1c\u007f\x1c\x91\x1c\x91\x1c\x91\x1c\r\x1b\xb3\x19\xb2\x19\xb2\x19\xa0\x19\x83\x19\x83\x19\x85\x19\xe4\x173\x123\x123\x12\x05\x14?,?,?,@,\x93.\xe4.\xe4.\xe4.\xb1\x1c\xd5\x15\xd4\x15\xd4\x15X\x15\xfb\x14\xfb\x14\xfb\x14\xef\x14\xdf\x14\xdf\x14\xdf\x14&\x140\x120\x120\x12\x85\x15s/r/r/r/r/s/r/r/\xb8\x1b\xca\x15\xca\x15\xcb\x15\x91\x13/\x12/\x12/\x12/\x12/\x12n\x04w\xeew\xeex\xeex\xeex\xee\xf8\xf2\xb6\v\xb6\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\vi\ab\x06b\x06a\x06\xdb\t\xb3\v\xb3\v\xb3\v\xb4\v\xb3\v\xb3\v\xb3\v\xcf\x02\xed\xf1\xec\xf1\xed\xf1E\xf1w\xeew\xeew\xeev\xeev\xeev\xeew\xeew\xeew\xeew\xeew\xeew\xeew\xeew\xeew\xeew\xeex\xeex\xeew\xeew\xee~\xf9\xaf\v\xad\v\xad\v\x1d\t \x00 \x00 \x00\x1f\x00\x1f\x00\x1f\x00{\x1f\x1d!\xae\x11q\x0fr\x0fq\x0f\xab\x1dF#H#H#F#F#F#F#7\x1bq\x0fq\x0fq\x0fq\x0fo\x0fo\x0fo\x0fq\x0fq\x0fo\x0fo\x0fo\x0fo\x0fo\x0fo\x0fo\x0f|\x10\xd8\x10\xd8\x10\xd6\x10\xd6\x10\xd6\x10\xd6\x10\xd6\x10(\x12\xe7\x13\xe6\x13\xe6\x13\xb9\x12\xa2\x0f\xa1\x0f\xa1\x0f\xa1\x0f\xa1\x0f\xa1\x0f\xa0\x0f\xa0\x0fy\x13\xcd\x13\xcd\x13\xcd\x13\xc0 \xb7$\xb7$\xb7$\xb5$\xb7$\xb7$\xb7$\x02\x1a\x04\r\xc8\x01\xf1\xee\x8b\xf7\x1d\f\x1d\f\x1d\f\x1c\f\x1c\f\x1c\f\x1d\f\x1d\f\xa8\xf0\xf0\xee\x8e\xedV\xed2\x02\xf5\a\xf6\a\xf6\a\xf5\a\xf5\a\xf5\a\xf5\aK\xfa\xcb\xea\xcc\xea\xce\xea\xcb\xea\xcc\xea\xcc\xea\xcc\xea\xcc\xea\xcc\xea\xcb\xea\xcc\xea\xcc\xea\xc6\xef\x00\xf0\x00\xf0\x02\xf0\xda\xeb\xcc\xea\xcc\xea\xcb\xea\xcb\xea\xce\xea\xce\xea\xcc\xea\xd8\xf8\xf1\a\xf1\a\xf1\a\n\x00\x88\xef\x88\xef\x88\xef\x88\xef\x88\xef\x88\xef\x88\xef\x92\xef\\\xf6\x97\xf6\x97\xf6\x96\xf6~\xf7\xb4\xf7\xb3\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\x9a\x04\x01\x12\x01\x12\x01\x12;\x12\xb2\x12\xb2\x12\xb2\x12\x88\x12\xb8\x11\xb8\x11\xb8\x11\x9c\x11,\n\xf9\t\xf8\t\xf8\t\xa9!\x10'\x12'\x12'\x10'\x10'\x10'\x10'\xa0\x18\xf0\t\xf0\t\xee\t;\n\xd7\n\xd7\n\xd7\n\xed\nX\vX\vX\v\xb1\v\x97\x1f\x12 \x12 \x14 \xc5%\f'\x0e'\x0e'\f'\f'\f'\f'i\x1b\xa1\x0f\xa1\x0f\xa1\x0f\x81\x17\v'\v'\v'\v'\v'\n'\n'\x94&\xa2\n\xee\t\xee\t\xed\t\xbd\f_\r_\r]\rc\f\xe0\v\xe0\v\xdf\v\xe9\n\xed\t\xed\t\xed\t\xcf\x13\x9d'\x04(9)8)8)8)9)\xf9(\x17\x13l\x12k\x12k\x12T\r \f \f \f \f \f \f \f \f \f\x1e\f\x1e\fH\x15I(G(G(\xdc'\xbe%\xbe%\xbf%\xb0%\x19\r\x1e\f\x1e\f\x1e\f\x1e\f\x1e\f\x1e\f\x1e\f \f\x1e\f\x1e\f \fZ\x17\xb9#\xb7#\xb6#M\x1c\x1e\f\x1e\f\x1d\f\x1d\f\x1e\f\x1e\f\x1d\f\x1d\f\xe7&Y(Y(Y(\x00).).).)-)-)-),),),),),)\xdf%!\x1e!\x1e!\x1e\x9e\x1e\xbd!\xbd!\xbd!\xbd!\xa8(*)))))\xd4\x12\x1d\f\x1d\f\x1c\f\x17\xfa[\xee>\xecS\xea\x82\xed\x82\xf1\x82\xf1\x80\xf1\xb9\xef\x1c\xeb\x1c\xeb\x1d\xeb\xab\xeb\xe1\xef\xe1\xef\xe1\xef\xe1\xefE\x05[\aZ\a[\a\x9a\xf9\xf2\xf4\xf1\xf4\xf1\xf4F\xf4\xcd\xf3\xcd\xf3\xcd\xf3\x82\xf2\xb5\xf0\xb5\xf0\xb5\xf0A\xf1\xd6\xf2\xd6\xf2\xd6\xf2\xa0\xf3q\xfbq\xfbq\xfbo\xfb^\x04\x84\x05\x84\x05\x84\x05\xd4\xf3\x03\xed\x02\xed\x02\xed\x15\xee\xee\xee\xee\xee\xee\xee\xd9\xf6\x0e\x03\x0e\x03\x0e\x03\xf3\x02\x91\x02\x91\x02\x91\x02\xaf\x02m\x04m\x04m\x04m\x04\xea\x06T\aT\aV\a?\xf3T\xeaT\xeaS\xeaT\xeaT\xeaT\xeaT\xea\xd8\xf4T\aS\aS\a\xd4\x02\x8a\xf0\x8a\xf0\x87\xf0v\xf0a\xee[\xee[\xee[\xee\n\xebT\xeaT\xeaT\xeaT\xeaT\xeaT\xeaT\xeaT\xeaT\xeaT\xeaT\xeaC\xf2\x85\x02\x85\x02\x0e\nR(V,V,V,V,7-B-\x1b.;.\x89.\x9f.\x9f.\x9f.\x9f.\x9f.\xa0.\x9f.\x9f.\x9e.\x9e.\x9e.;&\xa3\x11\xa3\x11\xa3\x11\xa3\x11\xa3\x11\xa3\x11\xa3\x11\xa3\x11\xc4\x16?\x17?\x17?\x17\xb7(\x9b.\x9b.\x9b.\x9b.\x9b.\x9a.\x9a.`-\xa6+\xa6+\xa5+8%\xa2\x11\xa2\x11\xa1\x11\xef\x13\x98.\x98.\x98.\x97.\x97.\x97.\x95.\x95.\xbb+\x87*\x87*\x87*\xb6 4\x184\x184\x18\x88\x17a\x16a\x16a\x16\x9a\x15}\x12}\x12{\x12\xd6\x12d\x1d\u007f\x1d\u007f\x1d\u007f\x1dG\x18&\x17&\x17'\x17\x88\x13\x9f\x11\x9f\x11\x9f\x11W\x1c\xa8'\u007f\x1c\xf0\v\x8e\a.\xfe0\xfe0\xfe\xf2\xfc\xe7\xf5\xe7\xf5\xe7\xf5\xe7\xf5\xf6\xf99\xfa9\xfa8\xfa\xe1\xfb`\xfc`\xfc`\xfc\"\x06\x99\f\x99\f\x99\fK\x0f\xd2\x12\xd1\x12\xd1\x12\xd1\x12\xd1\x12\xd1\x12\xd1\x12\xcf\x12\xcf\x12\xd1\x12\xd1\x12\xcf\x12r\xf9 \xf6 \xf6 \xf6\xf6\xf5\xe6\xf5\xe7\xf5\xe7\xf5\xe6\xf5\xe6\xf5\xe6\xf5\x05\xf2\xa0\xec\xa0\xec\xa0\xec\xa0\xec\xa0\xec\xa0\xec\xa0\xec\x9f\xecG\xed\x05\xfe\x1b\xfe\x1b\xfe\x1b\xfe\x12\xf0\x18\xed\x18\xed\x18\xed\x1c\xf0\xb2\xf1\xb2\xf1\xb3\xf1C\xfd\x83\t\x83\t\x82\tf\x00\xa0\xec\x9f\xec\x9f\xec\xdb\xec9\xee9\xee9\xee9\xee$\x06\xe0\a\xe0\a\xe0\a\x82\xf7e\xf2e\xf2e\xf2\xc7\xf9\xe0\xfe\xe0\xfe\xe0\xfeV\x03\u007f\t\x80\t\x80\t\x80\t\u007f\t\u007f\t\x80\t\u007f\t\u007f\t\u007f\t\u007f\t\u007f\t\xcc\a\x89\a\x89\a\x8a\aY\x003\xfd3\xfd3\xfd>\xfdJ\xfdJ\xfdJ\xfd`\xfd\x88\xfd\x88\xfd\x88\xfd\x11\xfb\xf4\xef\xf5\xef\xf5\xef\x19\xf0!\xfd\x93\xfd\x93\xfd\x91\xfd\v\ay\t{\t{\tw\xf7\x9f\xec\x9f\xec\xa0\xec{\xed\x83\xee\x83\xee\x83\xee_\xf5\xf3\x06\xf3\x06\xf3\x06\xf7\x06/\a^\a<\x0fh\x0f\x8f\t1!x%x%\x06\x17r\x11r\x11r\x11I!K.K.K.&.\xe8-\xe7-\xe7-\x16(q\x11q\x11r\x11\xac\x11Z\x18n\x18n\x18n\x18\xdd\x15J\x15K\x15K\x15\xcf\x12q\x11q\x11q\x11\x00\x1fF.F.F.F.F.E.E.\xd3-\xba*\xba*\xba*\xba*\xf0-E.E.C.C.C.C.C.\xa3\x18\xba\a\xba\a\x95\xff\xf5\xfe\xf7\x0e\xf6\x0e\xf6\x0e\xf6\x0e\xf6\x0e\xf6\x0e\xf7\x0e\xf6\x0e\xf6\x0e\xf6\x0e\xc8\f\x90\f\xb6\xf9\xa9\xf5\x1a\xf3/\xf20\xf22\xf22\xf20\xf22\xf22\xf22\xf22\xf22\xf22\xf22\xf22\xf22\xf22\xf22\xf22\xf20\xf2\xfe\xf0\xde\xf0\xdd\xf0\xdd\xf0!\xf1:\xf18\xf1:\xf1\xc1\xef\x98\xee\x96\xee\x98\xee1\xe5)\xd6(\xd6)\xd6\xf1۩\xf1\xa9\xf1\xa9\xf1\x9e\xf1g\xf0\x13\xf38\xf38\xf3\xe2\xe7X\xe5X\xe5V\xe5,\xf0:\xf68\xf68\xf6:\xf6:\xf6:\xf6:\xf6-\xf6\v\xf6\f\xf6\x0e\xf6\x10\xf5q\xeds\xeds\xeds\xed\x98\xed\x9e\xed\x9e\xed\x9e\xed\x19\xeeH\xeeH\xeeG\xee\x9d\xf2;\xf6;\xf6;\xf6;\xf6;\xf6;\xf6;\xf6;\xf6;\xf6;\xf6;\xf66\xf6\xeb\xf4\xe2\xf4\xe3\xf4\xe3\xf4X\xf2\xb3\xf1\xb3\xf1\xb2\xf1\xaa\xe2{\xd9{\xd9{\xd9hڒےے\xdb\xea\xdc\x01\xe5M\xf4O\xf4\xa4\xf6\xe6\r\xe7\r\xe7\r\xe7\rj\xf9Z\xf6Z\xf6Z\xf6\xdb\xf8\xf4\xf9\xf4\xf9\xf6\xf9C\x04\xe7\r\xe7\r\xe7\r$\x04+\xf1+\xf1+\xf16\xf1q\xf1p\xf1p\xf1p\xf1\x03\x05=\x06=\x06>\x06\x18\f\xe7\r\xe7\r\xe9\r\xe7\r\xe7\r\xe7\r\xe7\r\xe9\r\xe9\r\xe9\r\xe7\r\xdc\r\xb8\r\xb7\r\xb7\r\xa6\rr\fr\fs\fr\f4\xf9J\xf5J\xf5J\xf5-\xffj\x04i\x04i\x04'\x04\xdd\x03\xdb\x03\xdd\x038\x01\xfa\xfa\xfa\xfa\x81\xfbi\x00\x1d\x11\x1b\x11\x1b\x11\x1b\x11\x1d\x11\x1d\x11\x1d\x11\x1d\x11\x86\xfci\xf4i\xf4g\xf4\xdb\x03\x1e\x11\x1d\x11\x1d\x11\xb2\x10\xf9\x0f\xf9\x0f\xf9\x0f\xfc\nj\xf4j\xf4j\xf4k\xf4k\xf4k\xf4k\xf4m\xf4m\xf4k\xf4k\xf4k\xf4\x9c\x05\x1e\x11\x1d\x11\x1d\x11\xeb\x10\xa7\x10\xa7\x10\xa7\x10m\x10\xb2\x0f\xb0\x0f\xb0\x0f\x05\x0en\xf4n\xf4n\xf4n\xf4m\f\x1d\x11\x1d\x11\x1e\x11\x1e\x11\x1e\x11\x1e\x11\x1e\x11x\x10\xc0\x0f\xc0\x0f\xc2\x0f(\x10\x1d\x11\x1d\x11\x1d\x11\x13\x11\xc2\x10\xc2\x10\xc2\x10\xc2\x10Z\xda\xcc\xd3\xcd\xd3\xcf\xd3\xcf\xd3\xcd\xd3\xcd\xd3\xcd\xd3\xcd\xd3\xcf\xd3\xcf\xd3\xcfӞ\xdc\xda\xec\xda\xec\xdb\xec1\xed\xcd\xee\xcd\xee\xcd\xee\xcd\xee\xcd\xee\xcf\xee\xcf\xee\xcf\xee\x16\xf0|\xf0|\xf0}\xf0}\xf0|\xf0}\xf0}\xf0}\xf0{\xf0|\xf0}\xf0 \xea\xd4\xd3\xd6\xd3\xd6\xd3\x02\xd5^\xf0}\xf0^\xf1u\xf1u\xf1u\xf1u\xf1u\xf1i\xef:\xee:\xee:\xee\xc0\xe2\xd0\xd4\xd0\xd4\xd0\xd4\xd0\xd4\xd2\xd4\xd2\xd4\xd2\xd4\xd0\xd4\xd2\xd4\xd2\xd4\xd2\xd4\xd2\xd4\xd3\xd4\xd3\xd4\xd4\xd4\xd4\xd4\xd4\xd4\xd4\xd4\xd4\xd4\xd4\xd4;\xe3\xc5\xf1\xc5\xf1\xe2\xf1\r\xe9r\xd5r\xd5r\xd5r\xd5r\xd5r\xd5r\xd5r\xd5\r\xed\x83\xef\x83\xef\x83\xef#\xf1\xc1\xf1\xc2\xf1\xc2\xf1\xc2\xf1\xc2\xf1\xc2\xf1\xc2\xf1\xb2\xf1\x93\xf1\x93\xf1\x95\xf1\xac\xedE\xdbE\xdbE\xdbE\xdbE\xdbE\xdbF\xdbF\xdb\xe8\xea\xbd\xef\xbb\xef\xbd\xef\xd4\xe0\x0e\xd6\x0e\xd6\x0e\xd6\x10\xd6\x10\xd6\x0e\xd6\x0e֯ڢ\xeb\xa0\xeb\xa0\xeb\xf2\xea\xd2\xd5|\xd5~\xd5~\xd5~\xd5~\xd5~\xd5~\xd5R\xe6\xb6\xf0\xb7\xf0\xb7\xf0\xae\xefS\xeeS\xeeS\xeeS\xeeR\xeeS\xeeT\xee\xe3\xec\xd8\xd8\xd8\xd8\xd8\xd8\xd8\xd8\x0eփՃՃՃ\xd5t\xd6\x11\xd7\x11\xd70\xd8x\xd9x\xd9y\xd9\x1c\xd90\xd82\xd82\xd82\xd80\xd80\xd80\xd82ؤ\xd9\xdb\xd9\xdb\xd9\xdb\xd9>\xec\xb4\xf4\xb4\xf4\xb4\xf4\xb5\xf4\xb5\xf4\xb4\xf4\xb4\xf4\x9c\xed0\xde0\xde1\xdeh\xdd$\xd8#\xd8#\xd8$\xd8$\xd8$\xd8%\xd8%؊\xec\xb5\xf4\xb5\xf4\xb7\xf4u\xf2p\xf0p\xf0p\xf0p\xf0p\xf0p\xf0q\xf0\x1d\xf1\xb7\xf4\xb7\xf4\xb8\xf4\xb8\xf4\x90\xdf\xdf\xdd\xdf\xdd\xdf\xdd\xdf\xdd\xdf\xdd\xe0\xdd\xe0݊\xea\xb8\xf4\xb8\xf4\xb8\xf4\xb8\xf4\xb8\xf4\xb8\xf4\xb8\xf4\xb8\xf4\xb8\xf4\xb8\xf4\xb8\xf4\xb8\xf4\x9a\xd9.\xd8.\xd8.؊\xdc\xe3\xdd\xe3\xdd\xe3\xddd\xde\xc2\xde\xc2\xde\xc2\xdeZ\xe7\xba\xf4\xba\xf4\t\xfc#\f\b\xf6\a\xf6\a\xf6\x04\xf6S\xf5M\xf5M\xf5M\xf5\x96\xf5\xa9\xf5\xa9\xf5\xa9\xf5\x1e\xfa\x1d\xfd\x1d\xfd\x1d\xfd\x14\xfev\xffv\xffv\xff1\xfdP\xf5P\xf5O\xf5T\xf6\xd4\x10\x1d\x11\x1d\x11\x1d\x11\xae\x11\xd2\x11\xd2\x11\xd2\x11\x03\x02\"\xf8\"\xf8$\xf81\xfbE\xffE\xffD\xff3\x01W\aW\aX\aX\a^\a^\a^\a^\aE\x0e\xce\x0f\xce\x0f\xce\x0f\x84\x10\xee\x10\xee\x10\xee\x10!\x0f\xdc\f\xdc\f\xdc\fF\b\xcb\xfa\x13\xfeK\x0fq\x0fq\x11q\x11q\x11q\x11p\x14\x0e\x15\x0e\x15\r\x15\xb9\x12o\x11o\x11o\x11o\x11o\x11o\x11o\x11\xfa\x18\xec-\xeb-\xeb-\xe9-\xe9-\xe9-\xeb-\xeb-\xe9-\xe9-\xe9-\xe9-]\x1bn\x11m\x11m\x11n\x13\xc6\x15\x0e\x16\x8c\x16\xb3\x17\xca\x1a\xca\x1a\xc8\x1a$\x1c\x0f+\x0f+\x0f+\x0f+\xc2\x1f\xb9\x1d\xb9\x1d\xb9\x1dH\x1a~\x18~\x18|\x18\xae\x1d\x96#\x95#\x95#\x93\x1f2\x151\x151\x15\xec\x141\x121\x121\x121\x12\x02*..-.-.}.\xa6.\xa5.\xa5.\xa5.\xa5.\xa5.\xa3.\xed'\xc3\x16\xc3\x16\xc3\x16\x02\x17{\x19{\x19z\x19z\x19-#\xdb$\xdb$\xdb$|(U*U*V*X*[*[*[*\\*c*a*a*\x99*\xc8,\xc8,\xc8,\xca,`-z-z-z-\x05.N.L.L.\xff%\x99\x1c\x98\x1c\x98\x1c\xf3\x1f\xa9(\xa9(\xa9(,)\x97.\x97.\x98.\x9a.\xc1\x1a+\x17+\x17*\x17W ,%,%,%\x89)\x95.\x95.\x95.b.\xde-\xde-\xde-\"-\xab$\xab$\xab$\xab$\x1f\x15*\x12)\x12)\x12\x8f c(c(d(\xc4!\xdb\x19\xdb\x19\xdb\x19\xd3\x17(\x12(\x12(\x12(\x12(\x12(\x12(\x12)\x12)\x12(\x12(\x12(\x12C$\x8d.\x8d.\x8d.\xea!&\x12&\x12&\x12\x9e\x12\x04\x14\x04\x14\x04\x14\x96\x15\x8a.\x8a.\x8a.\x8a.R\x17&\x12&\x12&\x12&\x12%\x12%\x12%\x12\xc4\x15\x87\x1a\x87\x1a\x87\x1a\x86\x19Q\x16Q\x16Q\x16H\x16\x9b\x15\x9b\x15\x9b\x15\x9b\x15\xd4\x12%\x12%\x12%\x12%\x12%\x12$\x12$\x12%\x12%\x12$\x12\"\x12$\x12$\x12$\x12$\x12\xe8\x12\xe5-\x81.\x81.\x81.\x81.\x81.\u007f.\u007f.\xfc\x1d\x88\x12\x88\x12\x88\x12\x19\x13\xf7\x13\xf7\x13\xf7\x13\xe7\x13\xa6\x13\xa6\x13\xa6\x13\xa3\x131\x12!\x12!\x12!\x12\xc7'z.z.{.{.{.z.z.z.z.z.x.d)\x1f\x12\xce\x111\x0e1\x0e\xf9\b\x9d\b\x9d\b\x9c\b\xa2\x1c\xa9#\xa9#\xa9#\x8f\"\xa5!\xa5!\xa5!\xa5!\xa4!\xa4!\xa5!&\"\xf1$\xf1$\xf1$\xf1$\xe3\x01i\xfei\xfe\xc6\xfb\xf3\xfa\xf3\xfa\xf3\xfa\xf3\xfa\x12\xff\xe3\x02\xe3\x02\xe3\x02\xd0\xf9\xb2\xe6\xb2\xe6\xb2\xe6\xb2\xe6\xb2\xe6\xb2\xe6\xb2\xe6\xb2\xe6\x89\xff\xe2\x02\xe2\x02\xe2\x02\x80\xef\x93\xe6\x94\xe6\x94\xe6\x93\xe6\x93\xe6\x93\xe6\x94\xe6\x94\xe6\x94\xe6\x94\xe6\x94\xe6\r\xe7\x95\xeb\x95\xeb\x95\xeb\x95\xeb\x95\xeb\x95\xeb\x95\xeb\x95\xeb\x89\xf6]\xfc]\xfc]\xfc6\xf5\xad\xec\xad\xec\xad\xec\xad\xec\xad\xec\xad\xec\xad\xec\xf5\xedR\x01R\x01R\x01R\x01\x95\x02\xdf\x02\xde\x02\xdf\x02\xde\x02\xde\x02\xde\x02\xde\x02D\x01\x10\xff\x10\xff\x10\xff\xfb\xf9R\xe8R\xe8Q\xe8N\xe8\xb9\xe7\xb6\xe7\xb6\xe7\xb6\xe7\xdc\xfc\xdd\x02\xdd\x02\xdd\x02\xf2\xf6V\xeeV\xeeV\xeel\xed\xf4\xeb\xf4\xeb\xf4\xeb\x18\xef\xe8\xfc\xe8\xfc\xe8\xfc\xe8\xfc\x9c\xe9Q\xe8Q\xe8Q\xe8\x86\xe7@\xe7@\xe7?\xe7\xe5\xe6\x98\xe6\x98\xe6\x98\xe6\x14\xe7\xff\xe7\xff\xe7\xff\xe7<\xe8\x9f\xe9\x9f\xe9\x9f\xe9\x9f\xe9\xe9\xe6\x9a\xe6\x9a\xe6\x9a\xe6`\xf7\xae\xfe\xae\xfe\xae\xfe\xc0\x00\xda\x02\xda\x02\xd8\x02@\x02\xda\x00\xda\x00\xda\x00@\xfe\x9b\xe6\x9b\xe6\x9b\xe6\x9b\xe6\x9b\xe6\x9b\xe6\x9b\xe6\x9a\xe6b\xe9\xde\xea\xde\xea\xde\xea\xf2\xe8\x9b\xe6\x9b\xe6\x9b\xe6\x9a\xe6\x9b\xe6\x9b\xe6\x9b\xe6\x9b\xe6\x9b\xe6\x9b\xe6\x9b\xe6\x9d\xe6T\xfc\xa2\x01\xa2\x01\xa3\x01[\x02\xd6\x02\xd6\x02\xd6\x02\xcb\x00\x00\b\xb5\x1a\xb6\x1a\xbd\x1b\xc2\x1f\xc4\x1f\xc4\x1f\xc4\x1f\xc4\x1f\xc4\x1f\xc2\x1f\xc2\x1f`\x0e\xac\b\xac\b\xac\by\x14\a\x1e\a\x1e\a\x1e\xa3\x1e\xc1\x1f\xc1\x1f\xc1\x1ff\x1c\xa8\t\xa8\t\xa6\t\xa6\t\x85\x17\f\x19\f\x19\n\x19\xbd\x1d\xbf\x1f\xbf\x1f\xbf\x1f\xbf\x1f\xbf\x1f\xbf\x1f\xbf\x1f\x95\x1e\xd7\x1b\xd7\x1b\xd7\x1bz\x19\x8a\x03\x8a\x03\x88\x03\x88\x03\x11\x06\x87\x06\x89\x06\x89\x06\x0e\x05?\x04?\x04?\x04\xed\x03\x88\x03\x88\x03\x88\x03\n\b2\x161\x161\x16Q\x16\x01\x19\t\x19\t\x19\t\x19\xf4\x1dH\x1fH\x1fH\x1f\x1c \xb2 \xc1\"\xef$\x13%M%L%L%\x84!\x8c\x10\x8c\x10\x8c\x10\x8c\x101\r\xf1\f\xf1\f\xf1\f\xaf\f\x96\f\x95\f\x95\f%\f\xc2\v\xc1\v\xc1\v\xd4\x14\xee'\xee'\xee'\xee'\xee'\xed'\xed'\xed'\xed'\xed'\xeb'\xeb'9\x15\xbf\v\xbf\v\xbf\v\xbf\v\xbf\v\xbf\v\xbf\v\x9c\x11\x81\"\x81\"\x81\"\xcd\"\xe9'\xe9'\xe9'\xe9'\xe7'\xe7'\xe7'\xe7'\xe6'\xe6'\xe6'\xe6'\x86\x1e\x17\x10\x17\x10\x17\x10\x17\x10\x17\x10\x17\x10\x17\x10\x17\x10\\&\xe4'\xe4'\xe4'e&\xd9%\xd9%\xd9%\xd8%\xd8%\xd8%\xd9%\xf1%$&$&$&Z&\xe0'\xe0'\xe0'\xe0'\xe0'\xdf'\xdf'\xe0'\xdf'\xdf'\xdf'\xdd'\xc3%E#C#C#C#C#B#B#T\"\xab\x0f\x99\x0f\x97\x0f\x97\x0f\x88\f\xbb\v\xbb\v\xbb\v\xbb\v\xbb\v\xba\v\xba\v\xbe\v\xc3\v\xc3\v\xc3\v\xbc\x10\xd9'\xd9'\xd8'\xd8'\xd8'\xd8'\xd8'\xd8'\xb9\x13\xb8\v\xb8\v\xb8\vr\x18\xa8$\xa8$\xa8$\x00%\xcd%\xcd%\xcc%\xff%\xd5'\xd5'\xd4'\xd4'\x94\x12\x97\x0e\x97\x0e\x99\x0ex\r\xd3\f\xd1\fE\f]\x123\x1bw\x1a\xf4\x18\xb1\x19:\x1c9\x1c9\x1c:\x1c\xb1\x1c\xb5\x1c\xb5\x1c\xb5\x1c\x96\"p$p$p$r >\x1d=\x1d=\x1d\xce\x18\x84\x10\x84\x10\x84\x10\x13\x13\x98\"\x98\"\x98\"\x98\"\xae\"\xb0\"\xb0\"\xb0\"\xb7\"\xbb\"\xbb\"\xbb\"S\x16V\bV\bV\bV\bV\bV\bV\bV\bV\bV\bV\bT\b\x8a\x1e0$0$0$?\x18\xf0\x0f\xf0\x0f\xf0\x0f\a\rV\bT\bT\b?\re$f$e$e$ \"\xec!\xea!\xea!&\x13\x11\r\x11\r\x11\r\xb1\nT\bT\bS\bS\bT\bS\bS\bb\b\xf8\b\xf8\b\xf8\b\xf8\b\x92\x1f`$_$_$\xe8 \xc1\x1e\xc2\x1e\xc2\x1e\x9a\x15S\bS\bS\b\xeb\r^$^$\\$\\$\xcf\"\xb6\"\xb7\"\xb7\"m\x0fQ\bQ\bP\bQ\bQ\b\xbc\xfe[\xf1\x14\xf0B\xedB\xedB\xed\xa0\xed\xcb\xf0\xcb\xf0\xcc\xf0\xcc\xf0\xd1\xedD\xedD\xedD\xed\xc2\xed\v\xee\v\xee\r\xee\x81\xf9\x04\t\x04\t\x04\t\xc8\aJ\x03J\x03J\x03J\x03v\x02k\x02k\x02k\x02\xf2\xf3\xf7\xee\xf7\xee\xf7\xee\t\xfdI\tI\tK\tK\tK\tK\tI\tI\tK\tK\tK\tL\t\x96\xf6X\xf3X\xf3X\xf3X\xf3Z\xf3Z\xf3Z\xf3\xbd\xf0L\xedL\xedL\xed\x83\xf3L\tL\tL\tK\tK\tK\tL\tL\tZ\xf4O\xedO\xedO\xedO\xedP\xedP\xedP\xedP\xedP\xedP\xedR\xed\x9f\xed\xdf\xef\xdf\xef\xdf\xef\xe1\xef\x8b\x05L\tL\tL\tL\tL\tL\tL\t\x1a\xfeE\xefE\xefE\xef\xf9\xf2-\x00-\x00-\x00.\x005\x01C\x01A\x01A\x01\xc7\x06\xaf\b\xaf\b\xaf\b#\xfaV\xedV\xedV\xedW\xedY\xedW\xedW\xedb\xf0M\tM\tM\tM\t0\t,\t.\t.\t\xdc\b\xaf\b\xb1\b\xaf\b\xf1\bM\tM\tO\t\xfd\a\xf8\x02\xf8\x02\xf7\x02\xf7\x02\x82\xf4\x9e\xf3\xa0\xf3\xa0\xf3\x8b\xef\t\xee\t\xee\t\xee\xb0\xed^\xed^\xed^\xed^\xed^\xed^\xed^\xed\xf1\xefO\tO\tO\tO\t\xc5\b\xa8\b\xa8\b\xa8\b\x9f\x05\xb5\x03\xb5\x03\xb5\x03\xf3\x05P\tP\tP\tP\tP\tP\tP\tP\tP\tP\tP\tP\tZ\b\xf4\a\xf4\a\xf4\a\xb1\x06f\x05f\x05e\x05\xad\x00\x92\xf4\x92\xf4\x93\xf4\xa1\xf4^\xf5^\xf5^\xf5\\\xf5Q\xfdP\xffP\xffP\xff\x03\x04Z\aZ\aZ\a\f\b=\t=\t=\t?\tQ\tP\tP\tQ\tQ\tQ\tQ\tQ\tB\xf7\x91\xee\x91\xee\x92\xee\xc8\xee\x04\xef\x04\xef\x04\xef\xfb\xef\xed\xf2\xed\xf2\xed\xf2\xa4\xf3\xd4\bQ\tQ\tS\tQ\tQ\tS\tS\t\xc7\xfa\xcf\xee\xcf\xee\xd0\xee[\xeep\xedp\xedp\xedp\xedp\xedq\xedq\xedq\xed\xa8\xf2\x95\xf3\x95\xf3\x95\xf3\x95\xf2\x00\xf2\x00\xf2\x00\xf2\x02\xf2\x02\xf2\x00\xf2\x02\xf2\u007f\xf1y\xefy\xefy\xefy\xef\xa0\aT\tT\tS\tS\tS\tS\tT\tR\xfbw\xedw\xedw\xedc\xf4\x8f\x05\x8f\x05\x92\x05\x91\x05\x91\x05\x91\x05\x91\x05\x91\x05\xdc\xf5\xf3\xf1\xf3\xf1\xf3\xf1\xec\xfc\xb6\x04\xb6\x04\xb6\x04\xb6\x04\xb6\x04\xb6\x04\xb6\x04o\x02\xc2\xf5\xc2\xf5\xc2\xf5\xc2\xf5\x98\xee\xa2\xed\xa2\xed\xa2\xed\x89\xed|\xed~\xed~\xed|\xed|\xed~\xed~\xed\xb2\xf1\xb0\xff\xb1\xff\xb0\xff\xbf\xffh\x03\x8f\x03\xc7\x06W\a\x05\xf7\x1d\xf4\\\xf5\\\xf5\x84\xf5\xa8\xf5\xa6\xf5\x1d\xf5\x8b\xf4`\xf5\x87\xf6\"\xfb4\xfd\xb7\x102\xf6\xb4\xf5\xb4\xf5\xcb\xeeD\xedE\xedE\xedE\xedE\xed\x92\xfa;\b\x1e\b\x15\vx\x11x\x11\x84\x11\xc2\x11\xc2\x11\xa5\x11'\xefE\xedG\xedG\xedG\xed,\xed\x98\x03G\x11G\x11\xa1\x0f\xaf\r\xaf\r\xf2\vl\x06\x18\x02\x18\x02\x18\x02\xb4\x01\xa8\xf0\xd0\xed\x98\xed\x98\xed\\\xdb=\xd5=\xd5\xcf\xd5)\xe2\xc1\xec\xa6\xed\xe1\xee\xfb\xe6\xe5\xd5\xff\xd6\xff\xd6\xe6\xd6\xf1\xd5\xf1\xd5\xf3\xd5\v\xd2\x00\xd2\x1d\xd2\x1d\xd2\x1dҽѬ\xd5(\xd9(\xd9\x14\xe5n\xf8l\xf8n\xf8\x89\xfc\xc3\x11J\x11\xd3\b\xcd\b\"\xf77\xf9\x13\xfa\x13\xfa\xe4\xe9\xdd\xe1\xdd\xe1\xb4\xdc\xe1Ԃѝ\xdd/\xf5\xc2\xf5L\xf2\xdb\xd8\xdb\xd8\xce\xd8\x14\xd4\xd8\xd3\xdaӟ\xed\xea\xf2Z\xf2\x1c\xf1^\xf0\xfb\xf1%\xf3\xbe\xf2\xbe\xf2\x98\xf4\x01\xf9\x00\xf9\x8b\xf4\x83\xd6m\xd3mӴшшѲ\xec\x00\xf6\x01\xf6f\xe9A\xe0A\xe0)\xdcX\xe0\"\xf2\xf0\xf0P\xedw\xe9\x8cэээээээ\xd1a\xe3P\xedm\xfd\x9a\v\xbc\b\xcf\x04\xcf\x04\xd0\x04\xc0\xfe\x86\xe6\x86\xe6\x98\xe6\x9f\xe8_\xea\x82\xea#\xed\xbd\xed\xb6\xdd;\xe7\x1b\xf0\x1b\xf0g\xe1\x94ѓ\xd1;\xd5\xd9ީ\xe3c\xe7\x89\xfc\x10\xfd\xc2\t\xcc\x04f\x04f\x04r\xf03\xea3\xeaD\xe8V\xe9]\xeb{\xeb\xa0\xeb\xb8\xebt\xefc\xf9c\xf9\xca\xfa\xe0\a\xe0\a\xe0\a\xe1\x0e`\xfdG\xf9}\xf9\x93\xf9z\xf7\x0e\xf6\x0e\xf6\x0e\xf6l\xea\x00\xd7\x00\u05ee\xd6\x18\xd9^\xedV\xed\x8c\xec\x87\xec*\xec\x19\xedP\xedP\xed\xe4\xf9\x97\x00\x97\x00q\x05[\f\xb3\x0e\x87\x0fN\x11\xde\r\x9f\x00\xa0\x00\xa0\x00\xa0\x00Jޝ۲\xe1\xb4\xf6\xd6\x17 # # #\x03\"\xdb\x1f\x91\x1e\x92\x1e\x9f\x11\x86\xec\x86\xec\x85\xec\x1c\xed\xc0\xfc\x17\xfd\xed\x17\x04\x1c{\x18\xae\x01k\xf8k\xf8\xdf\xee\x80\xe6\x80\xe6\xd0\xe8\xa7\xec5\xec\xbd\xef\x1d\xfe(\xfe\xf3\xfen\n\xc1\n\xc1\nK\x01\x06\xff\x06\xff\x06\xff\x06\xff\xc6\xf4\xa3\xfc\xf0\x0f\xef\x0f\xfc\x05H\xee\x1d\xec\xa7\xeb\xa8\xe8\xa8\xe8\xa8\xe8(\bL\vK\vL\vL\v\xf5\n\xc2\n\xc2\n\xc3\n^\xffs\xf7\x01\b\x01\b\x01\b\x01\b\xe6\a}\x06}\x06}\x06!\x1b\xf9\x1e\xc1\nL\x03L\x03N\x03\xad\xf8\xba\xfa\xf0\t\x9a\a\a\x03\a\x03\xcf\x02\xbb\x01\xb9\x01\xb9\x01@\x1b\xc8\x1c\xd0\x0e\xc9\xf9|\xf4|\xf4\xa7\xf1W\xeb\xad\xe7\xfa\xe7\\\xe8]\xe8#\xea\xfb\xee\xfb\xee\xf8\xee\xe6\xee\xe5\xeeG\xfe\xd7\x1a\xd6\x1e\xd6\x1e\xd6\x1e?\x11\xd8\t\x87\f\xdc\x0e\xdc\x0eV\x03N\xefO\xef\xd4\xee\xdb\xec\x19\xedL\xf0o\x03\v\x04\v\x04q\xfaC\xfa\b\xfb\x98\xf3<\xef<\xef\xcb\xefq\xf0q\xf0q\xf0q\xf0q\xf0*\xef\xe0\xe5\xe0\xe5\xe0\xe5\xfc\xec\xd3\xed\xd3\xedt\x00\x84\a\x82\a\xff\xf4'\xe6)\xe6)\xe6'\xe6\x14\xe6\"\xe6\x0e\xe7\x0e\xe7\x13\xe81\xfd-\x17\x11\x19\xb6 i\"i\"9\x1dh\x1ah\x1af\x1ae\x1a\xd2\x11\xb1\xfc\xc9\xee\xc9\xee\xdc\xef\xb1\xf6n\xf7n\xf7\xdb\x06e\bf\b\xd8\xf4\xf1\xed\xf3\xed\xf1\xed\xf1\xed\xd5\xf6\xfd\t\xe1\x16\xe1\x16\xc4\x18\x9b\x1d\xd4\x154\x16\x80\x1c\x80\x1c\u007f\x1cd\rE\nE\nD\nD\n^\x16G\x1b\xbc\x15\xbb\x15\xbb\x15\x1c\x17\r\x1b\x9c\x1c\xdb%\xdc%\xdc%\x92\fD\nD\nE\nD\n\xad\x1b\xd9%\xd9%\xd9%\xd9%\xdc#\x1b \xaf\x17\xbc\xfb\xbb\xfb\x14\xfcw\x01w\x01w\x01w\x01w\x01\xf2\xffS\x17\xb5#\xb5#\xb5#\xed\x18\xb3\n1\xffU\xe6U\xe6t\xea\xcd\x02\xcd\x02\xcd\x02\xa2\x18\xa3\x1a\xe9\x15\x11\x1d\xf0\x1f\xf0\x1f\xee\x1f\xc6\x14B\n\x84\b\xea\x05\xea\x05~\n\x01\x1a6\x18\v\t\xce\xf5\xd4\xf1,\xf1]\x04i\bi\bi\b\x16\xfc\xc0\xf3n\xe3\xe3\xd1\xe3\xd1\xe5\xd1\xe5\xd1\xe5\xd1\xe5\xd6D\xf6E\xf6E\xf6E\xf6E\xf6E\xf6E\xf6\x96\x04j\v_\xfb\xdf\xee\xdf\xee\xc0\xef\x1c\xf1\x1c\xf1/\xf2\x0e\xf63\xf6]\xf7\xd5\xf5\xd1\xf5\xd1\xf5\xb7\xf0F\xf1\xd4\xf1\xd0\x02T\fT\f\x8f\n\x8f\b\x91\b\x8d\xff\x04\xeai\xeb\xc2\xf1\xc1\xf1\xc2\xf1\xc2\xf1n\r\xfd\xf9p\xf5p\xf5p\xf5p\xf5\a\xe4\u007f\xd5\u007fչ\xd5\x19\xd6l\xd7_\xdb\xdf\xdb\xdf\xdb\xc3ۑ\xd7\xd0\xe5?\xe7?\xe7?\xe7@\xe7\v\xda\xf5\xd1\xf5\xd1\xf5\xd1\xf5ѥԨ\xda1\xdc1\xdc1\xdc\xdc\xdb6\xd4\x14\xd4\xcb\xf1Q\xf6Q\xf6:\xf3\xde\xf1\xde\xf1G\xe1\xfa\xd1\x18\xdd\xd9\xf91\x113\x11M\x11\xad\x0eT\xf6T\xf6S\xf6T\xf6T\xf6a\v\xd1\x11\xd2\x11P\xfc{\xed\x02\xed{\xecz\xec{\xec{\xec\xa6\xeb\xf6\xe7\xf7\xe7\xf7\xe7\xf7\xe7\xf6\xe7\x04\xec\xd0\xec\xd0\xec\xcf\xec\xd0\xec\xea\xec\xb8\xe3\xf9\xd9\xf8\xd9\xf8٤٬\xd8_\xd8w\xd6w\xd6w\xd6\xf8\xdaq\xdbq\xdb7\xea\xab\xef\xae\xf3\xe8\xf9\xe0\xfc\xe1\xfc\xe1\xfc\xd1\xff\xf7\x05\xf7\x05\xf7\x05\xf8\x05{\x05\xc9\xf6^\xf6^\xf6^\xf6\\\xf6\x11\t\xd4\x11\xd4\x11\xd4\x11\x90\x019\xec5\xea7\xea>\xea>\xea\x04\xeb$\xf2$\xf2%\xf2O\x06\x82\t\x04\v\x87\v\x85\v\x85\v\xc6\xf9\xc5\xe9\xdc\xea\xdc\xea\xdc\xea\xdc\xea\x1f\xefF\x05F\x05F\x05H\x05F\x05Q\b\\\xf4\x83\xed\x83\xed\x85\xed\x85\xed\x83\xed\x85\xed\x85\xed\x85\xed^\xe9\xf8\xda\xf8\xdaK\xdcg\xf6\xb1\xf6\x92\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8c\xf6T\x06\xd5\x11\xd5\x11\xd5\x11\xd5\x11|\n\x12\xf8\x12\xf8\x11\xf8\x12\xf8\x12\xf8\x89\x00&\x01&\x01&\x01\x90\xeb(\xde\xdf\xdb\xdf\xdb\xdf\xdb\xdf\xdbgہڃڃڃڏ\xdas\xdb$\xf5\x01\xf7\x01\xf7\x00\xf7\xa0\x05\xfd\v{\nb\tb\t[\xfe\xf9\xed\xfb\xedA\xeaq\xdd\r\xe0\xac\xf5\xac\xf5\xaa\xf5\xac\xf5\x03\xf9\x85\xe7\x8d\xe1\x8c\xe1\x8c\xe1\x8d\xe1\xaa\xe9;\xf3;\xf3;\xf3;\xf3\x9c\xf3\xd8\xf5\xb7\xf8\xb7\xf8\xb7\xf8\xb7\xf8\x86\t\x80\r\x80\r\x81\r\x81\r\xaa\x01r\xf6r\xf6B\xea'\xd2+֛\xe4\x9b\xe4\x9b\xe4\x9b\xe4\r\x06Q\tc\tc\tc\tc\t\x80\t\x98\t\x98\t\x99\t\x99\tm\x04\xd7\xf5w\xf3w\xf3w\xf3w\xf3\xaf\xf5\xe4\xf5\xa9\xf6\xdc\xf6\xdc\xf6D\x00\x18\x06\x18\x06\xfd\x06!\b\x04\t\xe4\n\xe6\n\xe6\n\xdb\a\x8d\xe6\x18\xe4\x00\xe4\x01\xe4\x01\xe4\x01\xe4\xa8\xdf}\xdd}\xdd}\xdd\u007f\xdd\xc3\xdc\xec\xe4\xc3\xf9\xc3\xf9\xc3\xf9\x00\xfb\xa0\n\xa0\nx\tV\tV\t\x81\x0e\x8f\x10\x8f\x10\xbd\x01\x01\xf57\xf4 \xf3 \xf3 \xf3\xcb\xf1:\xeb\xcc\xe7\xcc\xe7\xcc\xe7\xce\xe7\xce\xe76\xec\x95\xed\x94\xed\x95\xed\x95\xed\xe0\xe0&\xe0\x95\xf4\x96\xf4\x96\xf4X\xf5\"\xf9\x87\xf7:\xd7\xcb\xd6\xcb\xd6\x1f\xd3@\xd2@\xd2M\xd8\xe0\xdb\xe2\xdb\xe2\xdb\xe3\xdb\xe3ۗ\xd9h\xd3h\xd3i\xd3i\xd3i\xd3iӕ\xe9q\xedq\xeds\xeds\xed\xfa\xe4\x86\xeem\xffm\xffm\xff\x15\x04\xda\x11\xda\x11\xd9\x11\xd9\x11\xd9\x11|\xfb\xd1\xf8\xd1\xf8\xefގ\xd43\xd3G\xd2G\xd2H\xd2`\xd3gՀՀ\xd5\u007fՀՀ\xd5\xe6\t\x9b\r\x9a\r\x9b\r\x9d\r\xd7\f4\x0e\x8c\x0f\x8e\x0f\x8e\x0f\xd9\x06w\xf5\xb3\xf5\x8f\xf6\x8f\xf6\x97\xf6\\\xf7a\xf7a\xf7<\xe5z\xe0|\xec.\xea\xff\xe4\xff\xe4\xff\xe4\x12\xdeP\xd2hۓ\xf6\x93\xf6\x93\xf6\x93\xf6\x93\xf6\x92\xf6\x83\xd8S\xd2\xca\xe0\xba\xe6\xbc\xe6\xbc\xe6\xb1\xf7\xaa\x02\x94\xf6\x94\xf6\x94\xf6\x94\xf6\xbe\xf2V\xd2V\xd2V\xd2V\xd2V\xd2Zֺ\u05fa\u05fa\u05fa\xd7Z\xd5Y\xd2z\xdc\xe1\xf1\xe1\xf1\xd2\xf5\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\xd1\xfb\x9e\xdc[\xd2Z\xd2]\xd2]\xd2]\xd2}ӋՋ\xd5\xc2\xd5\xc7\xd6\xc7\xd6\xc7־\xd9\xf6\xd9\xf6ػػػ\xd8A\xd7\xe0\xe1R\xed2\xed\xfe\xec\xfe\xec\xee\xec\xae\xec\xae\xec\x9f\xecB\xea)\xea\x96\xdb\xce\xd8\xce\xd8\xcd\xd8\xdc\xdb\x0e\xddK\xdcK\xdcK\xdcMܲސ\xe6\x90\xe6\x90\xe6\x90\xe6\x90\xe6\xb6\xec\x03\xefV\xefW\xef\xa1\xf6\x00\xfb\x00\xfb\x00\xfb\x00\xfb\x01\xfb\xa8\xf1#\xd7#\xd7#\xd7#\xd7#\xd7#\xd7VӔҔҔҖ\xd4\xfa\xd5\x17\xd8y\xdax\xdaP\xe1N\xf2N\xf2\xd3\xf1\xa9\xed\xa9\xed\xa9\xed\xa9\xed\xa9\xed\xa9\xedl\xee\xa3\xed\xec\xecB\xed\x99\xed\x99\xed\n\xee\t\xef\t\xef\xe8\xef\xd1\xf5\xd1\xf5l\xf5e\xf5e\xf5e\xf5\xc4\xedO\xdb\xc0\xd2\xc0\xd2\xc0\xd2\xc0\xd2\xf3\xe6\x1e\x0f\x1f\x0f\x1f\x0f\x1f\x0f\x93\x0e\xdd\xfcm\xdb\n\xd8\n\xd8\n\xd8Z\xd4w\xd2\xd4\u058cڌ\xda(\xdd\xdd\xe1\xdd\xe1x\xe0\xc5\xd9\x12\xda\xcb\xdf\x1dګ٫٩\xd9R\xe7\xae\xed\xb8\xfe\x1e\f\x1e\f\xe5\x01\xee\xf0\xf0\xf0h\xeb\x14\xd4a\xd4i\xd8i\xd8j\xd8j\xd8\f\xe1\xc2\xf3\x97\xfax\x02:\b:\b:\b9\b9\b>\n\xe1\x11\xe1\x11\xe1\x11\xe1\x11\xe1\x11\xe1\x11\xe1\x11&\x01\x96\xfa\x96\xfa\x96\xfa\x97\xfa\xfa\x03u\x11u\x11u\x11u\x11\xa8\x0eL\xf6L\xf6J\xf6L\xf6\xa4\xf6\x0f\xf7-\xf7-\xf7-\xf7-\xf73\xf3\xd4\xed\xd4\xed\xd4\xed\xd4\xed\xc8\xea\x8a\xd5\xe8\xf0\x11\xf1\x11\xf1\xb7\xf43\xf7\xbf\xf7\xbf\xf7\xbf\xf7\xbf\xf7i\xeac\xd9c\xd9c\xd9c\xd9:\xd9\xc2\u05cfҏҏҐҐҐ\xd2:ӚӚӶ\xef\xe4\x11\xe3\x11\xe3\x11\xe3\x11\xe4\x11\xe3\x11\xe3\x11\xe3\x11\xe4\x11\xe4\x11\r\x11\xce\x10\xae\x10\x9e\x10\x9f\x10S\x05&\xf8&\xf8'\xf8'\xf8\xdf\xf7\xff\xf3\xf6\xdc\xf6\xdc\xf6\xdc\xf8\xdc\x06\xdbx\xda\f\xd8\xc7\xd6\xc7\u0590\xe1\xb7\xed\xb9\xed\xb9\xed\xb9\xed\x99\xe9\x82\xd7!\xf8!\xf8!\xf8!\xf8\x13\xf7\xc9\xf6\xc9\xf6\xc9\xf6\xc9\xf6\x99\x02\x8b\x0f\x8b\x0f\x8c\x0f\x8c\x0f\xef\x0f\xe7\x10e\be\be\bf\b\xe2\xf2K\xed\x0e\xef\xec\xef\xea\xef>\xf3\xcc\xf6\xcd\xf6\xcd\xf6\xcd\xf6\xcf\xfa.\r\x98\x05\x98\x05\x98\x05\x98\x05\xfa\xf2G\xeeH\xeeH\xeeG\xeew\xe1\xf8\xd3\xf8\xd3\xf8\xd3\xf8\xd3^\xd5\xdf\xdf\xc7\xfe\xc7\xfe\xc7\xfe\xc7\xfe\xfe\xfc\x8c\xfc\x8d\xfc\x8d\xfc\x8c\xfc\xd5\xf7\xe7\xf2\xe7\xf2\xe7\xf2\xe7\xf2u\xf6\x87\aT\fT\fT\fT\f~\x0e\b\x0f\x1f\x0f*\x0f)\x0f\xf6\x0f\xcd\x10\xcd\x10\xce\x10r\x12\xd6\x13+\x00\xa3\xf0\xa3\xf0\xa4\xf0\xa4\xf0\xba\x02/\a\xc2\v\xf1\r\xf1\r\xe6\x01[\xf5[\xf5\xba\xfa)\a\xb9\x06\x00\x06C\x10C\x10C\x10C\x10C\x10B\x10=\x02\x80\xfb\x80\xfb\xdd\xfef\x02e\x02\xaf\x05h\rJ\x10\x95\x1e\x01#\x01#\x01#\x01#\xd0%\x87&\xf5\x13\xdf\n\xdf\n\x92\b\x1c\x06\x1c\x06\xe8\x05n\x05\xea\x02`\xf6\xba\xf4\xba\xf4\xb8\xf4\xb8\xf4d\xef\xfc\xed5\xed\xcf\xec\xcf\xec\x83\xedG\xeeG\xeeG\xeeG\xee\xbb\xf1\x80\x03\x06\x04\x06\x04\x06\x04\x06\x04\xb5\xf1\xb4\xec\xc9\xedW\xeeW\xeeV\xeeW\xeeW\xeeW\xeeW\xeeW\xee\xd4\xf09\t9\t9\t9\t9\x1e>$\xd1(D+D+B!\x9a\x15\x9a\x15\x98\x15\x98\x15\xf1\x14\xdc\x10B\x0eB\x0eB\x0eB\x0e\x9c\x10U\x11U\x11U\x11U\x11\xd3\x06\x13\xfa\x11\xfa\x13\xfa\x11\xfa\x13\xfc\xe9\n\x9e%\x9f%\x9f%\x9f%s\x15;\x10;\x10;\x10;\x10\f\x18\x02\"\x02\"\x02\"\x02\"\x00\"\x00\"\x00\"\x00\"\x00\"T\f!\a!\a!\a!\a!\a\xfb\f\xdc\x14\xdc\x14\xf6\x19z*!(\xf8\x14\xf8\x14\xf7\x14\xf7\x14\xd4\x10\x90\x11>\x12>\x12>\x12>\x12\xf5\x0e?\n?\n\xe7\n<\r\x9d\x0f\x9a&\x9a&\x9a&\x9a&\x9a&\x15'H'\xb1'\xfd'\xfd''\x1f\x9a\x11\x9a\x11\xc6\x11w\x12/\x12\xd4\x0e$\x107\x107\x107\x10\xf1\",+\t**)*)\xeb$\xe5\x1d\xe5\x1d\xe5\x1d\xe5\x1d\xc9\x1d\x11\x1cQ&\x16'\x16'\xc2'\x0e\"3\x1f3\x1f3\x1f2\x1f\xeb\x19g\x10g\x10g\x10g\x10@\x11j&\x03'\x01'\x01'r\x10\b\t\xc8\t\xc8\t\xc8\t\xc8\t\n\x03\xb1\xf5\xb1\xf5\x99\xf9\xd6\x10\xc9\x10\xb0\r\x87\r\x87\r\x87\r\x87\ru\vA\n?\r3\x101\x10\x93\x17\xd4'\xd4'\xd5'\xd4'\xd4'!%\xb6!6!6!6!\x9a\x14y\fM\n\xf5\a\xf5\a\xcd\b\xe4\n\xe4\n\xe3\n\xe3\n\xe4\n\xc5\x1f\xa6)\x1c+\x1c+\x1e+\x1c+\x1c+\x1c+\x1c+\x1b+\xcd!\x94\a\x94\a\x92\a\x92\a\x92\aK\x1bW\v\x03\a\x03\a\x03\a\x03\a\x03\a\x03\a\x03\a\x01\a\x01\a\x03\a\x01\a\x01\a\x01\a\x03\a\x03\a\x01\a\x00\a\x00\a\x01\ab\x05\xef\x03\xef\x03\xef\x03\xef\x03\xb4\xff7\xef7\xef\x83\xefI\a\xa1\b\\\n\xbf\n\xc1\n\xbf\n\xf1\r\xab\x0f\xe0\x0e\xb1\x10\xd4\x13\xd4\x130\x13,\x10,\x10,\x10R\f\xf8\v$\x1f\xf5 \xab\x1f\xab\x1f\xab\x1fZ\x14M\a\xc2\xfe\xcb\xed\xcb\xedB\xf1H\x06F\x06F\x06F\x06F\x06V\xf7\xc1\xf1n\xf1p\xf1p\xf1n\xef\xc5\xec\xc5\xec\xc7\xec\xc7\xecV\xed\xf4\xf1\xf4\xf1\xf4\xf1\xf4\xf1\xf3\xf1\x11\xef\xf0\xed\xf0\xed\xf0\xed\x16\xed8\xf5\"\x03\"\x03\"\x03#\x03d\x03\xb3\x06\xb3\x06\xb3\x06\x98\a\xcd\a\xf9\a\x0f\b\x0f\b\x0f\b\x0f\bl\xfe6\xeda\xef\xa5\xf6\xa4\xf6=\xf7!\a\xa4\a\xa4\a\xa4\a\xa4\a\x89\t~\x16\x1a\x1f\x1a\x1f\x1a\x1f\xeb\x18\xd5\v\xd7\v\xd7\v\xd7\v\xd7\v\x8e\x10\xe7\x10\xe7\x10\xe5\x10\xe5\x10'\x176\x1b6\x1b6\x1b\x15\x19\x11\x19W#W#W#W#W#\xe5\x1b\xfb\x1a\xfb\x1a!\x1c\xe7\x1a,\x1c\x8c\x1b)\x19)\x19)\x191\x1aw\x1d\xaf\x1a\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\v\xf6\x9a\xf7\x9f\b\x9d\b\x9d\b\x9d\b\x9d\b\x9d\b\x9d\b\x9d\b\x9d\b\xf8\x1d$#$#\xa1!\xab \xc2 \xdc \xdc \xdb ;!\x92\"\x92\"\x96\"b#k#m#k#k#k#k#k#\x96 \xe3\x1c\xe2\x1c\xe2\x1c\xe2\x1c\xbc\x1a\x9d\b\x9d\b\x9d\b\x9d\b\x9d\bD\x05\xec\x03\xe9\x03\xea\x03\xec\x03\xb0\x03S\x03S\x03S\x03\xc7\x02h\xfd\xec\xe4\xb9\xe4\xb9\xe4\xb9\xe4\xb9\xe4\xbb\xe4\xbb\xe4\xb9\xe4k\xe52\xe67\xef\x89\b\xda\x1c\xda\x1c\xda\x1c\x9d\x1c\x96\x03\x11\x02\x11\x02\x11\x02\x11\x02\v\x02\t\x02\t\x02\a\x02\a\x02\t\x02\t\x02\t\x02\t\x02\x8e\x01\xb2\xee\xe5\xfe\xf3\x00\xa3\xfe\xe8\xfd\xe8\xfd\xde\x00.\x03.\x03.\x03.\x03w\xfd\xbf\xe9\x9b\xe4\x9b\xe4\x9b\xe4\x9b\xe4 \xe8\xcb\xe8\xcb\xe8\xcb\xe8\xca\xe8\xc5\xf8\x17\b\x17\b\x17\b\x17\bP\v\xe9\x19\xe9\x19\xe9\x19\xe9\x19\xe1\x1d_\x0f7\v7\v9\v9\vW\x13\r\x1d\r\x1d\xf4\x19\f\x113\x0f`\x02`\x02`\x02`\x02^\x02c\x05\x82\x06\xa8\x0e$\x14#\x14^\x18\xbd\x1e\xbb\x1e\xbb\x1e\xbd\x1e\xd1\x1e\xf3\x1f\xf3\x1f\xf1\x1f\xf1\x1f\xf3\x1f\xeb\x10\x99\t\x99\t\x99\t\x99\td\x12X#X#\xa7!\x85\x17F\x17\r\x02\xcb\x00\xcb\x00\xcc\x00\xcb\x00Y\xfe\xcd\xfcN\xf2\xfd\xe6\xfe\xe6\xec\xebs\xf8s\xf8s\xf8s\xf8s\xf8o\xffh\xfe\xfb\xfd\xfb\xfd\xfb\xfd\xf3\xf2\n\xea\n\xea\n\xea\n\xeaU\xf1\xff\n\xff\n\xff\n\xff\n\xff\n\xbf\v\xea\v\xea\v\xe9\v\x8f\v\xc5\x16\xb0\"\xb0\"\xb0\"\xb0\"\t \xf9\x11\xf9\x11\xf9\x11W\x0f\a\x0fq\a\x92\xee\xa2\xe4\xa2\xe4\xa2\xe4\x02\xe7/\xea.\xea/\xea/\xea\xf2\xea-\xf2-\xf2-\xf2-\xf2-\xf29\xfc\x9f\x00\x9f\x00\x9f\x00\xdf\r;\x17\x9f\b\x9d\b\x9d\b\x9d\bb\tJ\"L#L#L#J#\xbb\x13\x8c\n\x8c\n\x8e\n\x8e\n\x06\v$\f#\f#\f$\f$\f\a\x03\xf7\x01\xf7\x01\xf7\x01\xf7\x01i\x02\xc4\x02\xc4\x02\xc4\x02y\x05\xd7\f\xbd\x0f\xea\x0f\xa2\x11\xa2\x11\xa2\x11\xc2\x1f\xbd\"\xbd\"\xbd\"\xbb\"\xa0\"e\x16\xfb\x05\xfb\x05\xfb\x05\xbc\x05j\x04j\x04j\x04j\x04j\x04\x06\n\xd7\v\xd7\v\xd7\v\xd7\v\xca\x0e\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xb9\x13\xed\x1c\xed\x1c\xce\x1b\xa8\x1b\xa7\x1b\xc6\rX\aX\aX\aX\ab\b\x17\t\xe9\x05\xe9\x05\x96\x056\x02F\x18\x91\x19\x91\x19\x91\x19\x91\x19S\t\xf0\xfe\xf0\xfe\xe1\x02\x9c\b\x9d\b\x9d\b\x9d\b\x9d\b\x9d\b\x9d\bX\n\x9a\n\x9a\n\x9a\n\x9a\n\xd8\x01;\xfa;\xfa9\xfa9\xfau\xfbh\x00i\x00i\x00h\x00r\xff&\xea\xac\xe4\xac\xe4\xac\xe4\xaa\xe4\xda\xf0J\xffJ\xffH\xffH\xffH\xff2\x01\xb9\":#:#:#\xb1\x13\x8c\r\x8b\r\x8b\r\x8b\r\xac\v\x9d\b\x9d\b\xf8\a\xf8\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xe9\x15\x92\x1f\xba\x1a\xec\x15\xec\x15y\fZ\xf6Z\xf6Z\xf6[\xf6[\xf6)\xf9}\xf9}\xf9}\xf9\xdf\x01V\a\xa5\a\x18\x11\x1d\x1e\x1d\x1e\xc5\x1e\x1d!\x1f!\x1d!\x1d!\f%\xf1 \x99\"\x88#\xbb#\xdc#\xfa\x1a\x17\x11\x17\x11\x17\x11\x16\x11\x16\x11\x16\x11\x16\x11\x16\x11\x9f\x04\xa5\x02\xa5\x02\xa7\x02\xa7\x02\xa7\x02\xa5\x02\xd8\x05\xe0\n\xdf\n\xdf\n\xe0\n>\f\xc7%E&E&E&E&0\x16?\r?\r?\r?\rQ\r|\rd\x0e\xda\x12\xda\x12\xdc\x12C\x11\x13\x11\x13\x11\x13\x11\x13\x11\x13\x11\x13\x11\x13\x11\x13\x11\xdf\x06\xd0\xed6\xf08\xf08\xf06\xf06\xf0@\xfa\xa3\xfc\xa3\xfc\xa3\xfc\xa2\xfcf\xf5$\xf0\xa0\xf4\xa1\xf4\x03\xf9\xd4\v\r\x11\r\x11\r\x11\r\x11\r\x11\xe4\x17\x94\x1a\x95\x1a\xbe\r\x98\x01\xc0\x05\x9d\f\x9d\f\x9d\f\x9d\f\x9a\fk\fj\f\xd1\x14\x84\x16\x84\x16\xd9\x11\x13\x0f\x14\x0f\x13\x0f\x13\x0f\xa1\x14\x83\"\x83\"\x83\"\x19\"\xb2\x03\x8f\x05\x17\x06\x15\x06\x15\x06\x15\x06\x9f\a\xf6\b\xf5\b\xf4\b\xf4\b\xd8\x03\f\xf2c\vc\vc\vc\v! \xe6%\xe6%\xe6%\xe6%\xef\x1bB\x0f@\x0f\x02\x0e\xf9\t\xf9\t\xf9\t\xf9\t\xf9\t\xf8\t\xf8\t\xf8\t\xf8\t\xb1\b\xa4\a\xa4\a\x86\xfe'\xed'\xed&\xed&\xed.\xed\xa1\x03?\x05?\x05?\x05>\x05\xa8\x05\xf1\x05\xf1\x05\xf1\x05\xf1\x05B\x06>\a>\a>\a>\a>\a\x8f\a\x9f\a\x9f\a\xa0\a\x9f\a\x9f\a\x9f\a\x9f\a\x9f\a\x9f\a\xdc\aE\tE\tE\tb!\xe6$\x98\x15\x17\x10\x16\x10\x16\x10\x16\x10*\x1a\xd5)\xf5 \xe2\x06\xe2\x06\xea\x06\x98\a\x9a\a\x9a\a\x9a\a\x9a\a\x9a\a\x99\a\x99\a\x99\a\x99\a\x99\a\x99\a\x99\a\x99\a\x99\a\x99\a\x11\x00\x04\xff\x02\xff\x04\xff\x02\xff\"\xfe\xab\xfe-\x00-\x00-\x00\x1a\xffa\xfaa\xfaa\xfaa\xfa`\xfa\x13\xf5\x84\xf3\x83\xf3\xea\xf1\xb0\xf0\x19\xf8\x03\x02\x03\x02\x03\x02\x03\x02O\x00#\xed#\xed#\xed#\xed#\xed#\xed#\xed#\xed#\xed#\xed#\xed$\xed$\xed#\xed#\xed#\xed$\xed#\xed\xac\b\x00\x11\x00\x11Q\x14\xec\x16\xec\x16\xec\x16\xec\x16O\x1a\xc5&\xc5&\xc5&\xc5&\xf9\x1b\v\x1e\xfb\x1e\x87\x0f\x8e\a\x8d\a\x8d\a\x8d\a\x8d\a\x8d\a\x8b\a\x8b\a\x8b\a\x8b\a\x8a\a\x8a\a\x9e\tH\nH\nH\nH\nH\nF\nF\nF\nF\nF\nF\n\xdd\xf1H\xef.\xef.\xef.\xef.\xef.\xef.\xef.\xef,\xef.\xef.\xef,\xef!\xef<\xed!\xed\x93\x02\x86\a\x86\a\x84\a\x84\a\xea\xfc\xaf\xf0\xae\xf0\xaf\xf0\xaf\xf0\xdb\xf0(\xf2(\xf2(\xf2\xcc\xf7\xe2\xf8`\xf5\xdc\xf3\xdc\xf3\xdb\xf3\xdb\xf3\xdb\xf3\xdb\xf3\xdb\xf3\xdb\xf3\xdb\xf3\xe0\xf30\xfe\xf0\xfe\xf0\xfe\xef\xfe\xef\xfe\x00\xfaw\xf6y\xf6y\xf6w\xf6Q\xfb!\vV\n\xcb\x02\xcb\x02\xcb\x02\x9f\x06}\a}\a}\a}\a\xd8\x05\xf7\x03\xf7\x03\xf5\x03\xf5\x03I\x01\x1f\xed \xed \xed\x1f\xed \xed \xed\x1f\xed\x1f\xed\x1f\xed \xedL\xf6y\ay\ay\ay\ay\a7\xf1|\xef{\xef{\xef{\xef\xa9\xee\x0f\xee\x0f\xee\x0f\xee\x11\xee\xdb\xed \xed\x1f\xed\x1d\xed\x1d\xed\x8c\f\xf2\x10\xf2\x10\xf2\x10\xf2\x10\xf3\x10I\x1ae%e%e%e%\xd9$K K K K :\x05\x0f\xf8\a\xf6\a\xf6\a\xf6\a\xf6\x87\xf5\x83\xf4\x83\xf4\x83\xf4x\xf4\xd0\xf3\r\xedJ\xddu\xdat\xd63\xd8\xfe\xe8\x8d\xf4\x8e\xf4\x8e\xf4\x8e\xf4A\xf3S\xeeR\xeeR\xeeR\xeeS\xee?\xe2i\xe2\xc4\xe3\xc6\xe3\u007f\xef\"\xfa\x16\xf9\x16\xf9\x16\xf9\x16\xf9\xd8\xf80\xf60\xf60\xf62\xf62\xf6\xc5\x06\xee\xffI\xf5I\xf5{\xf2\"\xe6\xc8\xd4\xc9\xd4\xc9\xd4\xc9\xd4\xc9\xd4#ؑؑؑؑ\xd8i\xd8h\xdc7\xe18\xe1\x9f\xe6\x1e\xf6E\xf3E\xf3E\xf3E\xf3F\xf3F\xf3F\xf3F\xf3F\xf3F\xf3c\xf7\xb4\xfe/\x01.\x01`\x01J\x02\xd8\xf8\xa1\xf8\xa1\xf8\xa1\xf8\xa1\xf8\xf9\xf8-\xf9-\xf9-\xf9-\xf9\x01\xfb\xe3\xff\xe3\xff\xe3\xff\xe3\xff\xb0\xff\xc8\x0f\xae\x12\xae\x12\xb0\x12\xb0\x12\xae\x12\xae\x12\xb0\x12\xb0\x12\xb0\x12h\x12\x9a\x0e7\xf2%\xf2&\xf2&\xf26\xe8;\xe4;\xe4;\xe4;\xe4\x81\xe0\xdf\xd9\xdf\xd9#\xd9\xda\xd4\xda\xd4\xda\xd4\xdd\xd4\xdd\xd4\xdd\xd4\xdd\xd4\xdd\xd4\xdd\xd4\xdd\xd4\xde\xd4\xdeԽ\xda\t\xef\n\xefq\xef\xae\x10\xd2\x12_\x0f\x80\x0e\x80\x0e\x80\x0e\x80\x0e7\t\x91\x02\x91\x02\x93\x02\x93\x02\xab\x01I\xf8I\xf8I\xf8I\xf8I\xf8\xf6\xe8\x14\xe1\x16\xe1\x16\xe1\x16\xe1\x89\xe1\x90\xe2\x1e\xe1\xa1٢٢\xd9\"֥եեէ\xd5D\xd5\xe8\xd4\xe8\xd4\xe8\xd4\xe8\xd4\xe9\xd4\xe9\xd4\xe9\xd4\xe9\xd4\xe9\xd4\xe9\xd4@\xe8$\xef$\xef$\xef&\xefj\xec\xf8\xe7\xf8\xe7\xf8\xe7%\xe8\xec\xe9\x95\xf0\xec\xf0\xf9\t\xdf\x0f\xdf\x0f\xdf\x0f\xdf\x0f\xdf\x0f\xdf\x0f\xdf\x0ft\n'\xf9'\xf9'\xf9'\xf9\xd8\xfb\xb1\b\xa1\v\xa1\v\xa2\v\xa2\v\xec\x0e\xeb\x12\xeb\x12\xeb\x12\xec\x12\x81\x10R\xf9R\xf9R\xf9T\xf9T\xf9(\xfa\x93\xfa\x93\xfa\x93\xfa\x92\xfa\v\x02\xec\x12\xec\x12\xec\x12\xec\x12\xec\x12e\x12T\x12T\x12T\x125\xfdI\xe1\xf8\xd4\xfa\xd4\xfa\xd4\xf8\xd4.\xd9*\xef+\xef+\xef+\xef+\xef\xdc\xedn\xf7\xa3\xfc\xa3\xfc\xb7\x05\x80\t\x98\xfc\x98\xfc\x98\xfc\x98\xfc\xe0\xfc\x9a\x11\xec\x12\xec\x12\xee\x12\xee\x12\xb3\x03\x16\xf5\x8f\xf1\x8f\xf1\xed\xeb;\xe4\x97\xf8\x97\xf8\x97\xf8\x97\xf8\x97\xf8/\xf3\xc1\xf1\xc1\xf1\xc2\xf1\xc2\xf1m\xe9\xd7\xe7\x9e\xf8\x9d\xf8\xa4\xf8\x1e\xf9r\xfdr\xfdr\xfdr\xfdr\xfd/\v\xee\x12\xee\x12\xef\x12\xef\x12\xde\f;\xfd9\xfd;\xfd;\xfd;\xfd\xf7\xfb\xbc\xfb\xbc\xfb\xbc\xfb\xbc\xfb@\xfb\xbc\xfa\xbb\xfa\xbc\xfa\xbc\xfa\xca\xfdy\x12\x93\nm\nn\n\x88\xf7\x9dߖזזזר\xde\xdc\xec\xdc\xec\xdc\xec\xdc\xec\xdc\xec\x13\xe7k\xe6l\xe6l\xe6\x9a\xf8Y\x00\b\xfd\b\xfd\x06\xfd\b\xfd\xb0\xfbg\xf5g\xf5g\xf5g\xf5g\xf5i\xf5i\xf5i\xf5i\xf54\xf6\x06\xff\xd0\v\xd1\v\xd1\v\xd1\v\xa0\v \x02\x94\x01\x95\x01\x95\x01\x95\x01\x00\x00\xe0\xfe\xe1\xfe\xe3\xfe\xdb\xf4t\xe0<\xed<\xed:\xed:\xed<\xedE\xe2L\xdfL\xdfM\xdfM\xdf\x0e\xe5\xe1\xec\xe1\xec\xe1\xec\xe1\xec\x06\xed2\xef9\xef \fy\x11y\x11y\x11y\x11y\x11y\x11y\x11P\f\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\x83\xf8c\xf7\xb9\xe7j\xe0j\xe0\xff\xebc\xf9c\xf9c\xf9c\xf9(\xf7\x17\xe3\x17\xe3\x17\xe3\x17\xe3\x19\xe3;\xe2\xc9\xe1\xc9\xe1\xc9\xe1\xca\xe1%\xe8\xfc\xf6\xfa\xf6\xfc\xf6\xfc\xf6\xfc\xf6\xe7\tE\f\x1a\f\x1a\f\x1c\f\xd0\b\x82\x05\x82\x05\x83\x05\x83\x05\x80\x06\xeb\f\xeb\f\xeb\f\xc8\f\xc2\f\xc9\v]\v_\v_\v_\v\xa3\x05\x1c\xfa\x1c\xfa\x1c\xfa\x1c\xfa\x1c\xfa\xe7\xfa\xfe\xfa\x00\xfb\xe2\xeb\x9b\xe4\xa2\xe0q\xdbx\xd9x\xd9x\xd9x\xd9x\xd9y\xd9y\xd9y\xd9y\xd9fڿڿ\xda\xc1\xda\xc1ڀ\xe0T\xe9\xe0\xe5\xde\xe5\xe0\xe5\xe0\xe5\xc9\xedw\xeew\xeex\xeex\xee~\xe1\x16\xd7\x16\xd7\x16\xd7\x17\xd7\xcf\xd7\xdf\xda\xe0\xda\xe0\xda\xe0\xda`\xe6Z\xe6\x84\xe5\x91\xeb\x8b\xef\x8b\xefp\xee\xa9\xec\xa9\xec\xa9\xec\xa9\xec\x18\xed6\x05\xaf\x06\xae\x06\xae\x06\xaf\x06\xf3\x05g\x05\xe1\xfe6\xf66\xf6\x87\xf5\xf3\xf2\xf3\xf2\xf3\xf2\xf2\xf2\xf2\xf2\xf2\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf2\xf2\xf2\xf2\xf3\xf2\xf5\xf2\xf5\xf2\xf3\xf2\xf5\xf2\xf5\xf2\n\x04\a\n<\xfb\x18\xf1\x16\xf1\x16\xf1\x18\xf1\xbb\xf6\b\n\b\n\b\n\b\n\b\nE\xfc|\xf8|\xf8z\xf8|\xf8\xf8\xf4\xdf\xf1`\xf5_\xf5`\xf5\x8b\xf6\xe3\f\x81\r\x81\r\x81\r\x83\r\xaa\x04\xee\xfe\xee\xfe\xee\xfe\xee\xfe\xd3\x02_\x0f_\x0f_\x0f_\x0f\xef\x0e\xca\b;\a)\x06\x95\x05\x95\x05E\xff\xc3\xf6\xc3\xf6\xc5\xf6\xc5\xf6\x0f\xf8\xd8\f7\r7\r7\r7\r\xa4\fF\fF\fF\fF\f\xfb\xff\x11\xda\x11\xda\x11\xda\x11\xda\x12\xdaM\xe6S\xe9S\xe9S\xe9S\xe9o\xea\xe2\xeb\xe2\xeb\xe2\xeb\xe2\xebp\xea\xd2ԊԊԊԊ\xd4R\xe3\xe5\xef\xc6\xf2\xc6\xf2\xc8\xf2J\xf3\xd4\xf4\xd4\xf4\xd5\xf4\xd5\xf4\xd5\xf4\x18\xe4\x00\xe0\x00\xe0\x00\xe0\x00\xe0\xcd\xdd\xed\xda\xed\xda\xed\xda\xed\xda}\xdb\xdc\xe3\xf7\xe3\x9c\xfcN\x01O\x01\xff\x06\x87\n\x87\n\x87\n\x87\n\x06\x06<\xf8<\xf8<\xf8<\xf8<\xf8\b\xfew\xffw\xffv\xffv\xff\xe4\x04\x1c\f\x1c\f\x1c\f\x1d\fv\f\x12\x12,\x12,\x12,\x12-\x12N\x02@\xf8@\xf8@\xf8B\xf8\xa4\xf5W\xedY\xedY\xedY\xedY\xed\xbeݯٰٰٰٰٱٱٳٱٕ\xd9q\xd7b\xd7b\xd7b\xd7b\xd7%ۡݡݢݢ\xdd(\xe2M\xf1N\xf1M\xf1M\xf1M\xf1\v\xddf\xd7f\xd7\xc5\xe9I\xf8I\xf8J\xf8J\xf8J\xf8J\xf8;\xf9/\x11/\x12/\x12/\x12/\x12\x8f\x03V\xf9V\xf9X\xf9X\xf9\b\xfa\x8c\xfc\x8c\xfc\x8c\xfc\x8c\xfc\x8d\xfcK\xf9N\xf8N\xf8N\xf8N\xf8k\xf8\x96\xf8\x96\xf8\x96\xf8\x96\xf8\x10\xf9\xbf\x10\xfb\b\x98\a\x98\a\xe7\xf7>\xef>\xef>\xef>\xef>\xef\xdc\xeb\xe0\xdd\xe0\xdd\xe0\xdd\xe0\xdd\xe0݄\xda_\xd9b\xd9a\xd9\x18\xe8\xb7\xfaN\xfb\xbd\xfbE\xfdE\xfdE\xfd\xf1\t\x06\v\a\v\a\v\a\v\xce\x00V\xf8V\xf8V\xf8X\xf8\xc4\xfc1\x121\x121\x121\x121\x12G\x11\xec\x10\xec\x10\xeb\x10\xeb\x10\xec\x10\xec\x10\xee\x10\xee\x10\xee\x10\xee\x10\xcd\xf9\x16\xf7\x16\xf7\x16\xf7\x16\xf7\xc1\xf7\\\xf8[\xf8[\xf8\\\xf8\\\xf8\\\xf8\\\xf8]\xf8]\xf8\\\xf8\x91\xfeS\x01S\x01S\x01S\x01\xd5\x00\xc4\xff\xc4\xff\xc4\xff\xc4\xff\xc4\xffc\xfc\xe4\xf8\x19\xf8\x19\xf8\x19\xf8h\xf6\xa3\xf4\xa3\xf4\xa3\xf4\xa3\xf4|\xf7s\x0es\x0es\x0er\x0er\x0ex\xfd\xf1\xf6\x96\xf8\n\x01\x85\r\xef\x0f\xef\x0f\xef\x0f\xef\x0f\xef\x0f\xee\x0f\xa2\x121\x131\x131\x131\x13N\x13p\x13p\x13p\x13p\x13\xee\x14\xd0'\xdd'\xdd'\xdc'\xdc'F(\x87(\x85(\x85(\x85(\x85(\x85(\x84(\x84(\x84(\x84(\x82(\x82(\x84(\x82(\x82(\x82(\x81(\x81(\xba(\xb7)\x97)\v&\xe0%\xe0%\xe0%\xe0%\x80&\xf6&\xf6&\xf5&\xf5&\xf8%\xf5!\xf4!\xf4!\xf4!\xf4!\x89\x14\xea\x0f\xea\x0f\xea\x0f\xea\x0f\xea\x0f\xea\x0f\xe8\x0f\xe8\x0f\xea\x0f\xea\x0f& \xc4!\xc2!\xc2!\xc4!\x1b\"k\"j\"j\"j\"\x95\"\x8b#\x8b#\x8b#\x8b#\x8b#\x8c\fs\x02s\x02s\x02s\x02\x96\x03\x15\x06\x15\x06\x15\x06\x14\x06\x14\x06\x14\x06\x14\x06\x14\x06\x12\x06\x12\x06s\xfd\b\xf4\b\xf4\a\xf4\a\xf4\x83\xf3\xf4\xee;\xf8\xc6\xf8\xc5\xf8\xc5\xf8:\xf7[\xf6\\\xf6\\\xf6\\\xf6\x13\xfd\x18\x10\x17\x10\x17\x10\x17\x10\x18\x10l\xfb\\\xf6\\\xf6\\\xf6[\xf6[\xf6[\xf6@\xfd\xe3\x0f\xe3\x0f\xe3\x0f\xe3\x0f\xe3\x0f\xe1\x0f\xe1\x0f\xe3\x0f\xe3\x0f\xe3\x0f\xe3\x0f\xe3\x0f\xe1\x0f:\x10\x97\x11\x97\x11\x97\x11\x97\x11\x97\x11\x9c\x1fq$q$q$p$\x0f$g#f#f#g#g#\x9e&\xf6&\xf6&\xf5&\xf5&T(\x97)\x99)\x97)\x97)\x0f']\x17]\x17]\x17]\x17]\x17\xfc\x1e\x93\"\xd8\x0f\x05\xff\x05\xff\xa4\x00\x82\x04\x82\x04\x82\x04\x82\x04\x82\x04\x92\x05\xc8\x05\xc8\x05\xc7\x05\xc7\x05\xe2\x05\x03\x06\x02\x06\x02\x06\x02\x06-\x04v\xecP\xecP\xec6\xed|\xed\x98\xef\v\xf2\x04\xf3\x04\xf3\x04\xf3t\xf2\x87\xf0\x87\xf0\x87\xf0\x87\xf0\x87\xf07\xf5\xa4\xf6\xa4\xf6\xa4\xf6\xa4\xf6\xc8\xfdA\tB\tA\tA\tA\tA\t?\t\x10\"\x88)\x88)\x98\x1c\u007f\x11\u007f\x11\u007f\x11\u007f\x112\x15\x85)\x85)\x85)\x87)\x85)\x84)\x84)\x85)\x85)\x84)\x82)\x82)\x84)\x82)\x82)\x82)\x82)\xf2%\xe0$\xdf$\xdf$]\x1a\x12\x0e\x10\x0e\x12\x0e\x12\x0e\x81\x0f\t!\x0f!\x0e!\x0e!\x0e!A!`!`!`!`!\x87!\v\"\v\"\v\"\n\"\n\"\xc8\xff?\xf5?\xf5{\xf0O\xec\xa2\xed\xcd\xef\xcd\xef\xcc\xef\xcd\xef\xcd\xef\xee\xed\xc2\xed\xc2\xed\xc2\xed\xc2\xedA\xf0x\xf2v\xf2v\xf2v\xf23\xf5\x86\x05\x86\x05\x86\x05\x86\x05\x86\x05f\xf4O\xec\xf3\xfe\xd2\x0f\xd2\x0f\xfc\x116\x175\x175\x175\x175\x17S&r)r)q)q)\x89(d'd'd'd'\x1b&\\\x10\xd1\x0f\xd1\x0f\xd1\x0f\xd1\x0f\xef\x11i\x13i\x13h\x13\f\v\xc9\xf5\xca\xf6\xca\xf6\xca\xf6\xca\xf6\xca\xf6\x12\x06\x88\v\x87\v\x87\v\x87\vr\vM\vM\vM\vM\vM\v2\b\xc9\a\xcb\a\xcb\a\xc9\aE\t\xc8\n\xc8\nX\n\x1e\t3\a \xf7\xfa\xf4\xdc\xf4\xdc\xf4\xdc\xf4\xf4\xf6!\xf8!\xf8!\xf8 \xf8\x9f\xf9\v\xfe\v\xfe\v\xfe\v\xfe\v\xfe2\x01\x10\x02\x10\x02\x10\x02\x10\x02\xd2\xfa\xc6\xef\xc8\xef\xc8\xef\xc8\xef\xde\xef%\xfc\x16\xfd\x16\xfd\x8d\xfd\xc2\xfd\xa0\xff6\x015\x015\x015\x01\xfa\x00\xb7\xff\xb7\xff\xb7\xff\xb7\xff\xb7\xff\xf8\xf5q\xf1q\xf1q\xf1q\xf1\x9c\xf0\x8f\xeef\xeeV\xeei\xedf\xed\xe5\xec\xc8\xec\xc8\xec\xc8\xec\xc7\xecY\x01\x12\x1c\x12\x1c\x12\x1c\x12\x1c\n\x1cm\x1bh\x1bh\x1bh\x1bh\x1bz\x0e\xf7\x04\xf7\x04\xf7\x04\xf7\x04\xfb\x04\x10\x05\x10\x05\x10\x05\x0e\x05\x0e\x05H\x17\u007f\x1e\x81\x1e\u007f\x1e\u007f\x1e0\x16\xf7\x04\xf5\x04\xf5\x04\xf5\x04\xf5\x04H\xeex\xeax\xeax\xeaz\xea\xdc\xec\x9a\xef\x9a\xef\x9a\xef\x9a\xef\x16\xf1\xb9\x03\xd3\x03\xd4\x03\xd4\x03\xd3\x03\x88\xf4z\xeax\xeax\xeax\xeax\xeaz\xeaz\xeaz\xeax\xeax\xeaz\xeaz\xeaz\xeaz\xeax\xea\xc6\xffu's'u'u'u'\xca\x11\xcc\x0e\xcc\x0e\xcc\x0e\xca\x0e\xc5\x1as'r'r'r'\x12%\xf0\r\xf0\r\xf0\r\xf0\r\xf0\r\xf0\r\xf0\r\xf0\r\xf0\r\xf0\r~\x13:&8&8&8&8&S\x191\x151\x151\x151\x15J\x19r q q r r 7$\xac$\xac$\xac$\xac$\x05%_%_%_%_%\xdb$p p p p p \xbf\x14\xee\r\xee\r\xed\r\xed\r\xd2\x0f\xef\x15\xef\x15\xed\x15\xed\x15\xed\x15\x0e\x14y\x13y\x13y\x13y\x13=\x13\xda\x12\xd9\x12\xd9\x12\xd9\x12\xd9\x12m\x0e\xeb\r\xeb\r\xeb\r\xeb\r\xe0\x12\xce\x17\xcf\x17\xce\x17\xcc\x17\x15\x17\x0f\x11\x0f\x11:\xf2\x9c\xed\x9c\xed\x83\xef\x9d\xf0\x9c\xf0\x9c\xf0\x9c\xf0 \xf1\xc6\xf2\xc6\xf2\xc5\xf2\xc5\xf2\xc6\xf2\xda\xff\xe9\x03\xe9\x03\xe9\x03\xe9\x03\xe9\x03\xe8\x03\xe8\x03\xf6\a\"#\"#n\f\x1e\r\xe9\r\xea\r\xe9\r\x85\x10/\x13/\x13-\x13-\x13\x13\x15\f&\f&\f&\f&\f&\x87#\x03\"\x03\"\x03\"\x03\"\r\"/\"\xb6\x1f\x17\xff\xc0\xfe\xc0\xfeu\xf0\xc1\xeb\xc1\xeb\xc1\xeb\xc1\xeb+\xf2\xc6\xfd\xc6\xfd\xc6\xfd\xc6\xfd\xc6\xfd$\x03\xe2\x03\xe2\x03\xe1\x03\xe1\x03\xe8\xff\xa2\xfb\xa3\xfb\xa3\xfb\xa2\xfb\xe8\xfa\x19\xf3\x19\xf3\x17\xf3\x17\xf3\x19\xf3\xa1\xfaz\xffz\xffx\xffx\xff;\xfc\x18\xf0\x19\xf0\x19\xf0\x19\xf0\x18\xf0\xf8\xebx\xeaw\xeaw\xeax\xeax\xeax\xeax\xeax\xeax\xeax\xea\xef\xfc\x00\x00\x00\x00\xfe\xff\xfe\xff\x1c\xf6x\xeax\xeax\xeax\xea~\xea\xcb\xea\xce\xea\xce\xea\xce\xea\xce\xeag\xf9\xd7\x03\xd7\x03\xd8\x03\xd8\x03\xd7\x03\xd7\x03\xd7\x03\xd7\x03\xd7\x03\xd7\x03\t\xf9\x87\xf4\x86\xf4\x86\xf4\x86\xf4q\xf1x\xeax\xeax\xeax\xeax\xeax\xeax\xeaw\xeax\xeax\xea\xce\xea@\xeb@\xeb@\xeb>\xeb\xf1\xeb\x8d\x02\xd3\x03\xd2\x03\xd2\x03\xd3\x034\xf8\xa3\xee\xa3\xee\xa3\xee\xa1\xee\xc1\xeeu\xefu\xefu\xefu\xefu\xef\x92\xf5\x97\xf8\x97\xf8\x97\xf8\x97\xf8\x9b\xfb\xcf\x03\xcf\x03\xcf\x03\xcf\x03\xcf\x03\x89\x01\xed\x00\xed\x00\xed\x00\xed\x00\n\x02\xcd\x03\xcd\x03\x01\t4'4'4'4'4'4'4'3'3'3'3'3'3'2'2'\x9a&\x82&\x82&\x82&\x82&\x82&>&\xd0%\xd0%\xce%\xce%\xce%\xce%\xee&\xd4\x0eD\x06D\x06D\x06B\x06\xf5\x00\x99\xf6\x97\xf6\x97\xf6\x97\xf6\x97\xf6\x97\xf6\xd1\xfc\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\xda\xfa\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\n\xf3\x82\xf3\x82\xf3\x82\xf3~\xfek\x0e\xcd\f\xdc\b\xda\r\xda\r\xda\r\xda\r\xd8\rT\x0e\x8c\x0e\x8c\x0e\xf3\x0f\xbc\x11\xbc\x11\xa5\x16R*R*R*R*R*R*\x02\x1c\xcd\x13\xcb\x13\xca\x13\xca\x13\xcb\x13^\x13\x05\x11\x05\x11\x05\x11\x05\x11\x90\xf6\xa6\xedz\xfc\xec\x06\xec\x06\xec\x06\xec\x06\xec\x06\xa8\x06[\x04[\x04[\x04Z\x04Z\x04[\x04\x92\xf8e\xeed\xeed\xeed\xeed\xeeY\xee\xa9\xed\xa4\xed\xa6\xed\xa6\xed\xa4\xed\xa4\xed\xa4\xed\xa4\xed\xa4\xed\xa3\xedX\xf3\xbc\x10\xc0\x10\xf6'\xfe)\xfe)\xfd)\xfd)\xfe)\x06\x1f\xbb\x10\xbb\x10\xbb\x10\xb9\x10\xb9\x10\xb9\x10\xb9\x10\xb9\x10\xbb\x10\xb9\x10\xb9\x10\xb9\x10\xeb\x187&6&4&4&4&6&\xd9\x14\xe0\x10\xe0\x10\xe0\x10\xb5\xff\xcb\xed\xbd\xed\xa2\xed\xa2\xed\xa2\xed\xa2\xed\xa2\xed\xa2\xed\xa2\xed\xa3\xed\xa3\xed\xa2\xed\xa2\xed\xa2\xed\xa2\xed\xa2\xed\xa0\xed\xa0\xed\xa0\xed\xa2\xed\xa2\xed\xa2\xed\xa2\xed\xa0\xed\xa0\xed\xa2\xed\xa2\xed\xb9\xf2(\x05(\x05(\x05(\x05\xcc!\x82(!)w)w)w)v)v)\x94)1*1*0*0*0*.*\x8b(g'g'g'e'e'\xad'-*,*,*-*-*,*]\x1f\xd4\x15\xd4\x15\xd4\x15\xd2\x15\xd2\x15\xb0\x16_)\x86\x13m\x10m\x10n\x10k\x10\x87\x04;\xf7;\xf7;\xf7;\xf7:\xf7:\xf7\xeb\rj\x10j\x10j\x10k\x10j\x10\xd4\x0e\x8e\f\x8e\f\x8e\f\x8c\f\x8c\f\x8c\f\x11\xfb\xba\xf7\xba\xf7\xba\xf7\xbb\xf7\xbb\xf7\x1a\xf8\xd0\xf8\xd0\xf8\xd0\xf8\x97\xf8\xc5\xef3\xefk\xfdz\x01z\x01{\x01{\x01z\x01\xbd\xfc\xf4\xf0\xf4\xf0\xf4\xf0\xf4\xf0\xf4\xf0\xf4\xf0y\xf0G\xf0E\xf0G\xf0G\xf0G\xf0\xae\xef\x9c\xed\x9c\xed\x9c\xed\x9c\xed\x9c\xed\x9c\xed\x87\xf1\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa0\xf3Q\xf6\x96\x04\x96\x04\x96\x04\x95\x04\xdb\x14R\x1a\x80\x1bW\x1cW\x1cW\x1cW\x1cW\x1cZ\x1c{\x1c{\x1c{\x1cz\x1cz\x1cz\x1cq\x0fU\x03U\x03S\x03U\x03U\x03\xfb\x03\r\x1bw\x1cw\x1cw\x1cw\x1cw\x1cu\x1cu\x1ct\x1ct\x1cu\x1cu\x1cu\x1cp\x06\xa3\a\xc2\b\xc2\b8\r\xe8\x10\xe8\x10\xe8\x10\xe8\x10\xe8\x10\xe8\x10\xe8\x10\xe8\x10\xfe\x10\x02\x11\x02\x11\x02\x11\x02\x11\x01\x11w\x16k\"j\"j\"j\"j\"k\"\x81\x15\xe7\x10\xe5\x10\xe5\x10\xe5\x10\xe5\x10\xae\x16\xb3(\xb3(\xb3(\xb3(\xb3(\xb3(\xeb\x18\x02\x11\x02\x11\x02\x11\x02\x11\x02\x11Y\x11\xfe\x12\xfe\x12\xfe\x12\xfc\x12\xfc\x12\xfd\x12\x1d\x1cX\"X\"W\"W\"X\"\xc2 h\x12h\x12h\x12h\x12h\x12g\x12\x9b\x11\xe1\x10\xe1\x10\xe1\x10\xe0\x10\xe0\x10\x86\x11\x89(\xf7)\xf6)\xf6)\xf6)\xf6)\x15\x1f\xa3\x11\xa2\x11\xa2\x11\xa2\x11\xa2\x11\xa2\x11-&L\x13Q\rQ\rQ\rQ\r\x8f\r\xf9\r\xf9\r\xf9\r\xf9\r\xf9\r\xf8\r(\vm\nm\nl\nl\nl\nE\t\x8e\x06\x8e\x06\x8d\x06\x8d\x06\x8d\x06\x8b\x06\xcc\b\xaf\t\xaf\t\xaf\t\xaf\t\xaf\t \x05\xe7\xf4\xe7\xf4\xe7\xf4\xe7\xf4\xe7\xf4\xe6\xf4\xce\xf9\x94\xfc\x94\xfc\x94\xfc\xad\xfb\xa2\xf9\x99\xf8\f\xf2\xb4\xee\x9d\xee\x9d\xee\x9d\xee\x9d\xeeq\xeeN\xeeN\xeeL\xeeN\xeeN\xee\xda\xef\xcf\x06V\aV\aV\aT\aT\a\xc1\xfb:\xef:\xef:\xef:\xef:\xef:\xef\xe2\x04Q\aQ\aS\aS\aS\aQ\aQ\aQ\aQ\aQ\aQ\aP\an\x05\x03\x05\x02\x05\x02\x05F\x06\x9f\aZ\bk\x11\xd9)\xd9)\xd9)\xd9)\xd9)s\x17\xd4\x10\xd4\x10\xd4\x10\xd4\x10\xd4\x10\xb6\x16\xd7)\xd5)\xd5)\xd5)\xd4)\xd4)\xd5)\xd5)\xd4)\xd4)\xd4)\xd2)\xb5&R\x15R\x15R\x15S\x15R\x15R\x15\xad\x13l\x12l\x12k\x12k\x12k\x12P\x12\xd5\x10\xcf\x10\xcf\x10\xce\x10\xce\x10\xcf\x10\xcf\x10\xbe\f\x9c\x06\x9a\x06\xe3\x02\x8e\xed\x8e\xed\x8e\xed\x8e\xed\x8e\xed\x8e\xed\x8d\xed\x8e\xed7\xf4^\xfe^\xfe]\xfe]\xfe]\xfe]\xfeM\xf1@\xee>\xee@\xee@\xee@\xee\t\xee\x8d\xed\x8d\xed\x8d\xed\x8d\xed\x8d\xed\x8d\xedi\xf0\x82\xf1\x82\xf1\x80\xf1\x80\xf1\x80\xf1\x03\xf3\\\xf8\\\xf8\\\xf8\\\xf8[\xf8\\\xf8\x83\xf1\x8c\xed\x8c\xed\x8c\xed\x8c\xed\x8c\xed\xd0\xf0'\bo(\xbe)\xbe)\xbe)\xbe)s\x1cL\x11L\x11L\x11J\x11J\x11E\x11\xcd\x10\xc7\x10\xc7\x10\xc6\x10\xc6\x10\xc7\x10\x12\x1c\xb8)\xb8)\xb8)\xb7)\xb7)\xb7)\x03\x17\x18\x14\x18\x14\x19\x14\x19\x14\x19\x14\xe6\x12\xc6\x10\xc4\x10\xc4\x10\xc4\x10\xc4\x10\xc4\x10L\x11u\x11t\x11t\x11r\x11r\x11\x9f\x16\xca$\xc9$\xc9$\xc9$\xc9$\xc9$1\x17\xc2\x10\xc2\x10\xc2\x10\xb5\x04\x89\xed\x00\xef\xf8\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf5\xf5\xf5\xf5s\xfe{\x04{\x04{\x04|\x04|\x04\x84\x03>\xf8*\xf8*\xf8*\xf8,\xf8,\xf8m\xf3v\xeew\xeew\xeew\xeev\xeev\xee\x96\xee\x99\xee\x99\xee\x9a\xee\x9a\xee\x9a\xee\xf1\xf7l\x06j\x06j\x06l\x06l\x06j\x06\xd1\xf5\xaf\xf1\xaf\xf1\xaf\xf1\xaf\xf1\xaf\xf1\xf6\xf58\x008\x008\x008\x006\x006\x00\x16\xf3\x9b\xf5\x9a\xfa\x9a\xfal\x00\x96\n\x96\n\x97\n\x97\n\x97\n\x97\n\x96\n\x96\n\x89\x19^#^#\\#\\#^#\xd7!l\x13l\x13k\x13k\x13k\x13k\x13\xf9\x0e\x94\n\x94\n\x94\n\x94\n\x93\n\x93\n\xbb\x1e\xba \xb9 \xb9 \xb7 \xb7 \xd0!q#p#p#p#p#p#\xfb\x1d\xaf\x1c\xaf\x1c\xaf\x1c\xaf\x1c\xae\x1cA\x17\x92\n\x92\n\x92\n\x92\n\x92\n\x90\n\x19\x1ck#k#j#s\x1e\xd9\x15\xb7\x15+\x15+\x15+\x15+\x15+\x15+\x151\x155\x155\x154\x154\x154\x15A\x15\xd5\x15\xd5\x15\xd5\x15\xd4\x15\xd2\x15\xd2\x15A\x15\xae\x14\xae\x14\xae\x14\xae\x14\xae\x14\xae\x14\x9a\r\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xea\f\x13\r\x13\r\x13\r\x13\r\x13\r\x13\r\x02\x14\xcd\x15\xcd\x15\xcd\x15\xcd\x15\xce\x15\xce\x15\xcd\x15\xcb\x15\xcd\x15\xcd\x15\xcb\x15\xcb\x15\xbd\x04\xfd\xfc\xfb\xfc\xfb\xfc\xfd\xfc\xfd\xfc\x02\xfe\xc8\x02\xc8\x02\xc8\x02\xc7\x027\v\t\x0e\xef\x0e>\x10\xe8\x10\xe8\x10\xe8\x10\xe8\x10\x13\x12\t\"[\"\\\"Y\"Y\"Y\"\xa7\x18\xb3\r\xb3\r\xb2\r\xb0\r\xb2\r\xb2\rX\x19\x13\x1b\x13\x1b\x13\x1b\x13\x1b\x13\x1b\xc9\x14\x8d\t\x8d\t\x8d\t\x8b\t\x8b\t\x8b\t\x8b\t\x8b\t\x8b\t\x8b\t\x8b\t\x8a\t?\f\x82\x14\x82\x14\x82\x14\x84\x14\x82\x14\x82\x14\xd6\x0fM\rM\rM\rM\rK\r@\r\x00\r\x00\r\x00\r\x00\r\x00\r\x00\r%\v\x8a\t\x89\t\x89\t\x89\t\x89\t\x89\t\x89\t\x89\t\x89\t\x89\t\xbe\r\xe3\b\xfd\t\x13\x14x\x10x\x10x\x10x\x10x\x10\xec\x13\xae\x14\xae\x14\xae\x14\xae\x14\xb0\x14D\x14S\x13R\x13S\x13S\x13R\x13S\x13\xaa\x06[\x01[\x01[\x01]\x01[\x01\x05\x05\xae\x14\xb0\x14\xb0\x14\xb0\x14\xb0\x14\xb0\x14\xee\x13g\x13g\x13h\x13h\x13h\x13\xd9\x12\xd3\v\xb8\v\xb8\v\xb8\v\xb7\v\xb7\vO\x04\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\x97\n\xd3\f\xd3\f\xd3\f\xd3\f\xd3\fT\x0e\x1e\x11\x1e\x11\x1e\x11\x1f\x11\x1f\x11\x1f\x11\x02\x12N\x12N\x12P\x12P\x12N\x12!\r\xfb\xfb\xfb\xfb\xfb\xfb\xfd\xfb;\xf3\r\xf1\r\xf1\x8d\xe4\xfd\xd9\xfc\xd9\xfd\xd9\xfd\xd97\xdc\x1d\xee\x1d\xee\x1c\xee\x1d\xee\x1d\xee\x1d\xee\xeb\xe3\xff\xd9\x00\xda\x00\xda\x00\xda\x00\xda\x00\xda\xd0\xe9\x85\xeb\x85\xeb\x85\xeb\x86\xeb\x86\xeb\xcb\xe4\x03\xda\x03\xda\x04\xda\x04\xda\x04\xda\x03ڗ\xe9\xfe\xed\xff\xed\xff\xed\xff\xed\xff\xed\xfb\xed\xed\xed\xed\xed\xed\xed\xed\xed\xee\xed\xee\xed\xc9\xec/\xec/\xec/\xec/\xec1\xec\xf0\xea\x04\xe3\x04\xe3\x04\xe3\x04\xe3\x05\xe3\x05\xe3@\xde\v\xda\v\xda\r\xda\r\xda\r\xdaP\xda\xc5\xef\x8b\xf1\x8b\xf1\x8a\xf1\x8a\xf1\x8b\xf1\x06\xf2\xb9\xf2\xb9\xf2\xb9\xf2\xb9\xf2\xb9\xf2\xb9\xf2\f\xdf\x0f\xda\x12\xda\x12\xda\x11\xda\x11\xda\x00\xe0\x96\xf5\xa7\x0f\xa7\x0f\xa7\x0f\xa7\x0f\xa5\x0fS\x01K\xfaK\xfaK\xfaK\xfaK\xfa\xfb\xfd\xf1\x12\xf1\x12\xf1\x12\xf1\x12\xf1\x12\xef\x123\n\xd2\x02\xd2\x02\xd2\x02\xd2\x02\xd2\x02&\x03\xdc\x11\xef\x12\xf1\x12\xf1\x12\xf1\x12\xf1\x12\x88\n\x8c\xfe\x8c\xfe\x8c\xfe\x8d\xfe\x8d\xfe\x8d\xfe\xec\x0e\xf1\x12\xf1\x12\xf1\x12\xf1\x12\xf1\x12\xf1\x12\xf1\x12\xf2\x12\xf2\x12\xf2\x12\xf1\x12\xf1\x12\xd6\x12\xca\x12\xca\x12\xca\x12\xc9\x12\xc9\x12\x1f\x0fU\xfaU\xfaU\xfaU\xfaU\xfaV\xfaV\xfaV\xfaV\xfaU\xfaV\xfaV\xfa\xad\xfa\x81\f\xe0\r\xe2\r\xe2\r\xe0\r\xe0\r\xf0\x0f\xf1\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12m\x03w\xffw\xffw\xffw\xffw\xff\xc4\x04\xf1\x12\xf1\x12\xf1\x12\xf1\x12\xf2\x12\xf2\x12Z\b\xf0\x03\xaf\x04\xaf\x04\xaf\x04\xaf\x04\xe4\x06\xb4\x14\xb4\x14\xb4\x14\xb5\x14\xb7\x14\xb7\x14\xb4\x14\xb4\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb0\x14L\x02\x90\x00\x90\x00\x91\x00\x90\x00\x90\x00\n\x008\xff8\xff9\xff9\xff9\xff9\xff4\x0e\x88\x12\x88\x12\x89\x12\x89\x12\x89\x12\xeb\f&\xfc(\xfc(\xfc(\xfc(\xfc(\xfcS\b\xf2\xfb+\xec+\xec*\xec+\xec\"\xea\xf4\xd9\xf4\xd9\xf5\xd9\xf5\xd9\xf5\xd9\xf5\xd9~\xdb\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\n\xdd\xf8\xeeP\xf1P\xf1P\xf1P\xf1P\xf1\x8e\xeaq\xdeq\xdeq\xdeq\xdeq\xder\u07b8\xec\xa4\xf1\xa4\xf1\xa5\xf1\xa5\xf1\xa5\xf1\xa5\xf1\xa7\xf1\xa7\xf1\xa7\xf1\xa7\xf1\xa7\xf1\xa5\xf1\xb0\xf0\r\xf0\r\xf0\r\xf0\r\xf0\r\xf0[\xees\xd9 \xd9 \xd9!\xd9!\xd9!\xd9`\xe4\xa8\xf1\xa9\xf1\xa8\xf1\xa9\xf1\xa9\xf1\xa9\xf1\v\xe3N\xe0N\xe0N\xe0P\xe0P\xe0\xa3\xe4\x12\xee\x11\xee\x12\xee\x14\xee\x14\xee\x12\xeey\xdf'\xd9'\xd9(\xd9(\xd9'\xd9(\xd9(\xd9(\xd9)\xd9)\xd9)\xd9)ٳ\xe6\xab\xf1\xab\xf1\xac\xf1\x8d\xf2\f\xf6\x0e\xf6\x0e\xf6\x0e\xf6\x0e\xf6\x0e\xf6\x0e\xf6\x0e\xf6\x0e\xf6\x0e\xf6\x0e\xf6\x0f\xf6\x0f\xf6\x0f\xf6\x0f\xf6[\xf5,\xf5,\xf5,\xf5,\xf5\x82\xf6L\xf3\xad\xe4\x00\xee\x00\xee\x00\xee\x02\xee\x02\xee(\xed\xae\xec\xae\xec\xae\xec\xaf\xec\xaf\xec\xae\xec\xae\xec\xae\xec\xae\xec\xae\xec\xae\xec\xad\xec\xae\xec\xae\xec\xae\xec\xae\xec\xae\xec\xae\xec\xae\xec\xaf\xec\xae\x04W\vW\vW\vW\vW\vW\vW\vW\vW\vV\vV\vV\vV\vV\vW\vV\vT\vn\x0f\a!\a!\a!\a!\a!\a!\x84\"\xa4#\xa4#\xa4#\xa4#\xa4#\xd3\"\xb3\x0e\x8f\r\x8f\r\x8f\r\x8e\r\x8e\r\xcc\x16\xc6#\xc5#\xc5#\xc6#\xc6#\xc6#X\x10V\vT\vT\vT\vT\vu\v3\v3\b3\b3\bo\x03`\x02\x1f\x11h\x19h\x19h\x19h\x19h\x19\x1e\x19\xb8\x16\xb8\x16\xb8\x16\xb8\x16\xb6\x16\xb6\x16\t\x10\xf6\b\xf5\b\xf5\b\xf5\b\xf5\b\xf5\b\x18\x04R\x03P\x03P\x03R\x03R\x03}\x05\xed\t\xed\t\xed\t\xed\t\xed\t\xed\tw\x15H\x1aG\x1aG\x1aH\x1aH\x1a\x18\x16\xde\x01\xde\x01\xdf\x01\xdf\x01\xdf\x01\xde\x01\xde\x01\xdf\x01\xdf\x01\xdf\x01\xac\x03P\vQ\vQ\vQ\vQ\vQ\vQ\vP\vk\f*\x0e*\x0e*\x0e*\x0e*\x0e*\x0e\xc2\x0e\xf2\x0e\xf2\x0e\xf2\x0e\xf2\x0e\xf0\x0e\x1b\x0eP\vP\vP\vP\vQ\vP\vP\vP\vP\vP\vP\vP\vP\vP\vP\vP\vP\vP\vO\v\xd1\vv\fu\fs\fu\fu\fu\f\\\r\x90\r\x8f\r\x8f\r\x8f\r\x8f\r\xd8\r\x96\x0e\x96\x0e\x95\x0e\x95\x0eH\ni\tD\a!\x06\"\x06\"\x06\"\x06\"\x06S\b\x05\x19\x05\x19\x05\x19\x05\x19\x03\x19\x03\x19\xc6\x0f!\x06!\x06!\x06!\x06!\x06!\x06\"\x06\"\x06!\x06!\x06!\x06 \x06\x01\x0ex\x1ez\x1ex\x1ex\x1ex\x1ex\x1e\x87\r!\x06 \x06 \x06 \x06 \x06\xf6\tw\x1ew\x1et\x1et\x1eu\x1eu\x1e\xca\x11\x87\x06\x87\x06\x87\x06\x87\x06\x87\x06\x87\x06)\x06 \x06 \x06 \x06 \x06 \x06 \x06\xa5\x06\x0e\b\x0e\b\x0e\b\x0e\b\f\b\xb1\n\xab\v\xab\v\xab\v\xa9\v\xa9\vB\f\xbd\x0e\xbd\x0e\xbd\x0e\xbd\x0e\xbd\x0e\xbd\x0e\xaa\x18\\ \\ [ [ [ Y D C D D C C K Y X X X X X X X X W W W \x86\x1f\xab\x1c\xab\x1c\xab\x1c\xab\x1c\xad\x1c\xaa\x1c\xa1\x11\xf9\t\xf9\t\xf9\t\xfb\t\xf9\tl\n\xbf\x12\x1f\x13\x1f\x13\x1f\x13\x1f\x13\x1f\x13~\x0eE\xfe*\xea*\xea+\xea+\xea+\xea*\xea+\xea+\xea+\xea+\xea+\xea\x15\xf0m\x02m\x02m\x02m\x02m\x02m\x02.\x00\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\xc3\xfe\x8c\xfdz\xed\x16\xed\x15\xed\x15\xed\x16\xed\x16\xed\f\xf6\xa3\x01\xa3\x01\xa3\x01\xa3\x01\xa3\x01\xa3\x01\xc6\x00\x90\x00\x90\x00\x90\x00\x90\x00\x90\x00\xc4\xfa+\xea+\xea+\xea+\xea+\xea+\xeaU\xf9f\x02f\x02f\x02f\x02h\x02\xdd\x00\xb7\xef\x8b\xef\x8b\xef\x8b\xef\x8b\xef\x8b\xef4\xf4\xf7\xf9\xf7\xf9\xf7\xf9\xc4\xfc\xdd\x1a\x10\x1b\xf3 N\"N\"M\"M\"M\"\x06\x1d\xa4\x0e\xa4\x0e\xa4\x0e\xa4\x0e\xa4\x0e\xa4\x0e\x81\fD\vD\vE\vE\vE\vg\rk#{#{#|#|#{#\x86\x18E\vE\vD\vD\vD\v\xf3\x00\xdb\x05q\x04h\x03h\x03h\x03h\x03\xa7\x01\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf3\xfc\b\xff9\x009\x009\x009\x009\x00\xaa\x01\x88\x10\x95\x10\x93\x10\x93\x10\x95\x10\x95\x10[\a#\xfc#\xfc#\xfc%\xfc%\xfc%\xfc%\xfc%\xfc%\xfc#\xfc%\xfc&\xfc&\xfc\x05\xf69\xd99\xd99\xd99\xd99\xd9\x06\xda}\xda}\xda~\xda~\xda~\xda\xf1\xda\xdd\xdf\xeb\xdf\xeb\xdf\xeb\xdf\xec\xdf\xecߛݰڱڱڱڱڱڷ\xdb\xf6\xdb\xf6\xdb\xf8\xdb\xf8\xdb\xf9\xdbH\xdb@\xd9A\xd9A\xd9A\xd9A\xd9B\xd9B\xd9B\xd9D\xd9D\xd9D\xd9D\xd9\xe2\xd9\x19\xe2M\xe2L\xe2N\xe2N\xe2N\xe2o\xdeF\xd9F\xd9F\xd9H\xd9H\xd9H\xd9H\xd9H\xd9H\xd9I\xd9I\xd9I\xd9I\xd9K\xd9K\xd9K\xd9K\xd9K\xd9K\xd9uِّّّّٶ\xda\x1d\xf0'\xf1(\xf1(\xf1\xd7\xf1N\xf2p\xf2\x9e\xf2\x9d\xf2\x9e\xf2\x9e\xf2\x9e\xf2\xa0\xf2\xad\xe0/\xdb/\xdb/\xdb/\xdb/\xdb.\xdb(\xdb(\xdb(\xdb(\xdb)\xdb)ۛ\xe8\xa0\xf2\xa0\xf2\xa0\xf2\xa1\xf2\xa1\xf2\x93\xf2\x96\xf0n\xf0n\xf0p\xf0p\xf0p\xf0\x1f\xe8\x87ڇڇڇڇڇ\xda\x06\u070e\u070e\u070e\u070e\u070e\xdc\xdc\xdfh\xeeh\xeeh\xeeh\xeeh\xeek\xee\xa9\xe3\x8bڋڌڌڌڌ\xda,\xf0\xa4\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa5\xf2=\xf2v\xf1v\xf1x\xf1x\xf1x\xf1x\xf10\xe6_\xe1_\xe1_\xe1_\xe1]\xe1\xef\xe3\xa7\xf2\xa7\xf2\xa7\xf2\xa7\xf2\xd7\xf1x\xf1Z\xe5h\xd9h\xd9h\xd9h\xd9h\xd9h\xd9h\xd9i\xd9i\xd9j\xd9j\xd9i\xd9j\xd9j\xd9j\xd9l\xd9l\xd9j\xd9j\xd9l\xd9l\xd9m\xd9m\xd9l\xd9l\xd9_\xdap\xe2p\xe2p\xe2o\xe2o\xe2p\xe2X\xe9|\xf1|\xf1|\xf1|\xf1|\xf1}\xf1}\xf1}\xf1|\xf1}\xf1}\xf1}\xf1}\xf1}\xf1|\xf1|\xf1\u007f\xf1\u007f\xf1\u007f\xf1\x14\xf0+\xef+\xef+\xef+\xef,\xef\xf9\xedE\xdaw\xd9w\xd9x\xd9x\xd9x\xd9x\xd9x\xd9y\xd9y\xd9{\xd9{\xd9{ٹ\xeb\x80\xf1\x82\xf1\x82\xf1\x80\xf1\x82\xf1\x9c\xec|\xd9|\xd9|\xd9~\xd9~\xd9~\xd9~\xd9~\xd9~\xd9~\xd9\u007f\xd9\u007f\xd9\u007f\xd9\u007f\xd9\u007fــ\xd9b\xe9(\xf7\x1b\xfa\x95\xff\x95\xff\x97\xff\x97\xff\x95\xff\x95\xff\xde\tE\x0eF\x0eF\x0eE\x0eE\x0ef\x0e)\x0f)\x0f)\x0f)\x0f*\x0f*\x0f)\x0f)\x0f*\x0f*\x0f*\x0f*\x0f*\x0f,\x0f,\x0f*\x0f*\x0f*\x0f*\x0fk\x0e\x8f\f\x8f\f\x8f\f\x8f\f\x90\f\x90\f\x00\xff3\xf73\xf73\xf73\xf73\xf7\xfa\xf8\xf4\rT\x0eU\x0eU\x0eW\x0eW\x0e;\r\xbf\v\xbf\v\xbf\v\xbf\v\xbf\v\xc1\v1\xfe8\xfa7\xfa7\xfa7\xfa8\xfal\xfa,\xfb,\xfb,\xfb-\xfb,\xfb,\xfb\xf4\xf8:\xf7:\xf7:\xf7:\xf7;\xf7Q\xf7\a\r-\x0f,\x0f-\x0f-\x0f-\x0f-\x0f-\x0f-\x0f/\x0f/\x0f/\x0f/\x0fp\x00\x0f\xfa\x0f\xfa\x0f\xfa\x0f\xfa\x0f\xfa\xf9\xfc/\x0f/\x0f/\x0f/\x0f-\x0f-\x0f\x9f\x06L\xff\x12\x02\x12\x02\x11\x02\x11\x02\x11\x02\xe1\xfc\xd2\xfb\xd3\xfb\xd3\xfb\xd2\xfb&\xfd\"\x04U\x13N\x1fN\x1f\x86 \xab \xac \xbc$H'H'H'H'F'F'F'F'E'E'E'E'\xaf\x1e\xc2\x11\xc2\x11\xc2\x11\xc2\x11\xc2\x11\xc2\x11\xa3\x16R\x18Q\x18Q\x18Q\x18Q\x18\xfb\x17\x84\x16\x84\x16\x82\x16\x82\x16\x82\x16\x81\x16\xbb\x12W\x0fW\x0fW\x0fW\x0fU\x0fU\x0f&\"\xf6$\xf6$\xf5$\xf5$\xf5$\x86$\x8e#\x8e#\x8d#\x8d#\x8d#\x8d#\x86\x16\x8a\x0f\x8a\x0f\x8a\x0f\x8a\x0f\x88\x0f\x9b\x11\x10'6'6'4'4'6''\x1e*\x12)\x12)\x12*\x12*\x12*\x12\x02\x11\xaa\x10\xaa\x10\xa9\x10\xa9\x10\xaa\x10b\x10P\x0fP\x0fP\x0fQ\x0fQ\x0fP\x0fP\x0fP\x0fP\x0fP\x0fP\x0fN\x0fN\x0fQ\x11\x8d\x11\x8d\x11\x8d\x11\x8d\x11\x8d\x11\xa6\x18)')')')')')'('4#K I I I \x9d\x1f\xde\x19\xde\x19\xde\x19\xe0\x19\xde\x19\xde\x19\x1b\x12n\bn\bn\bn\bn\bn\b'\x1bE E D D D i\x1b2\n0\n/\n/\n0\n0\n\x89\x16A A A A A A \xd4\nl\bl\bj\bj\bl\b\x84\r\xbe\x17\xbe\x17\xbd\x17\xbd\x17\xbd\x17\xbd\x17\xd8\x0e~\n~\n}\n}\n}\n\xc2\f9 9 9 9 9 7 \xac\x15i\bi\bg\bg\bg\bg\b\xff\x1a6 6 5 5 5 \xde\x1f\xa0\x1e\x9e\x1e\x9e\x1e\x9e\x1e\x9e\x1e\x9e\x1en\x12\x80\b\x80\b\u007f\b\u007f\b\u007f\b\u007f\bi\be\bc\bc\bc\bc\b=\t\x0e\v\x0e\v\f\v\f\v\f\v\f\vM\tc\bb\bb\bb\bb\bI\n\xe6\x1d\r\x1e\v\x1e\n\x1e\v\x1e\n\x1e\xdc\x14c\bb\bb\ba\ba\ba\b#\r\xa4\x0e\xa4\x0e\xa5\x0e\xa4\x0e\xa4\x0e\xb4\x11\xc1\x1e\xc1\x1e\xc1\x1e\xc1\x1e\xbf\x1e\xbf\x1e{\x1f# ! ! ! ! ! V\x1e\x82\x1c\n\x1c\t\x1c\x14\x17\xb9\x11T\x12\xce\x13\xce\x13\xce\x13\xce\x13\xce\x13\xce\x13\xa7\x05,\xfd,\xfd,\xfd*\xfd*\xfdQ\xfd\xc6\xff\xe1\xff\xe1\xff\xe1\xff\xe1\xff\xe1\xff\xef\x04\xea\f\xea\f\xea\f\xea\f\xea\f\xea\f\xc5\x0e}\x0f}\x0f}\x0f}\x0f|\x0f\xd0\f\xc0\xfd\xc0\xfd\xc0\xfd\xc0\xfd\xc0\xfd\xc0\xfdc\b\xc3\x13\xc3\x13\xc3\x13\xc3\x13\xc3\x13\xc3\x13d\x00\v\xfc\v\xfc\f\xfc\f\xfc\f\xfc\xf5\x00:\x10:\x10:\x10:\x10:\x108\x10\x94\x04\v\xfc\v\xfc\t\xfc\t\xfc\t\xfc\f\xfca\xfe\x9c\xfe\x9e\xfe\x9e\xfe\x9c\xfe\x9c\xfe\xb2\x05\xbb\x13\xbb\x13\xbb\x13\xbb\x13\xbb\x13\xb9\x13Q\x06\xa2\xff\xa2\xff\xa2\xff\xa2\xff\xa2\xff\xf3\xff\n\x03\r\x03\v\x03\v\x03\v\x03\r\x03E\bW\x0fW\x0fW\x0fU\x0fU\x0fU\x0f`\x12]\x13\\\x13\\\x13\\\x13Z\x13\x8c\x0fb\xfeb\xfea\xfea\xfea\xfea\xfe)\xfe\xf3\xfd\xf2\xfd\xf2\xfd\xf2\xfd\xf2\xfd\xf2\xfd\x05\x01\x9b\x01\x99\x01\x99\x01\x9b\x01\x99\x01t\x04X\fV\fV\fV\fV\fV\f\x9c\x02\x04\xfc\x02\xfc\x02\xfc\x01\xfc\x01\xfcm\xfc\xbe\x0eE\x10E\x10C\x10C\x10C\x10/\t\x01\xfc\x01\xfc\x00\xfc\x00\xfc\x01\xfc\x01\xfc\x02\xfc\x02\xfc\x01\xfc\x01\xfc\x00\xfc\x00\xfc\x00\xfc\x00\xfc\x00\xfc\x00\xfc\x00\xfc\x00\xfc\x00\xfcs\xfd\xef\xf9\a\xeft\xee\xed\xea\xed\xea\xed\xea^\xe8\x8b\xe7\x8b\xe7\x8b\xe7\x8b\xe7\x8b\xe7\xc1\xebm\xfe\x93\xf9\x83\xf9\x84\xf9\x84\xf9\x84\xf9\xae\xed/\xe2-\xe2/\xe20\xe20\xe20\xe2\x84\xe4\xfa\xe4\xfa\xe4\xfb\xe4\xfb\xe4\xfb\xe4T\xea\xd0\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd1\xf9\xd3\xf9\xd3\xf9\xd3\xf9\xd3\xf9\xd3\xf9\xd3\xf9\xd3\xf9\xa5\xe67\xe27\xe28\xe28\xe27\xe27\xe2z\xe5\xd4\xf9\xd4\xf9\xd4\xf9\xd4\xf9\xd4\xf9\xd7\xf9\xd7\xf9\xd5\xf9\xd5\xf9\xd4\xf9\xd5\xf9\xd5\xf9\xd5\xf9\xd5\xf9\xd7\xf9\x8e\xed5\xe55\xe55\xe55\xe55\xe55\xe55\xe5\xc7\xe2A\xe2?\xe2?\xe2?\xe2A\xe2A\xe2A\xe2B\xe2B\xe2B\xe2C\xe2C\xe2C\xe2C\xe2\xe4\xe3\x9a\xe6\x9a\xe6\x9a\xe6\x9a\xe6\x9a\xe6\x9a\xe6\x9a\xe6\t\xef\x9c\xf4\x9d\xf4\x9d\xf4\x9c\xf4\x9d\xf4\x9d\xf4\x9d\xf4\xf5\xf8\xdb\xf9\xdb\xf9\xdb\xf9\xdc\xf9\xdc\xf9\xdc\xf9`\xf6\xdb\xe2\xdb\xe2\xdb\xe2\xdb\xe2\xdb\xe2\xdc\xe2\xdc\xe2\x8a\xeb\xdc\xf9\xdc\xf9\xdc\xf9\xdc\xf9\xdc\xf9\xde\xf9\xde\xf9\xde\xf9\xde\xf9\xde\xf9\xde\xf9\xde\xf9\xdf\xf9\xdf\xf9\xdf\xf9\xa5\xf5\xc3\xf4\xc5\xf4\xc3\xf4\xc3\xf4\xc5\xf4\xc5\xf4\x03\xf2S\xe2S\xe2S\xe2U\xe2U\xe2U\xe2U\xe2\x9a\xe3\xb9\xe5\xbb\xe5\xbb\xe5\xbb\xe5\xbb\xe5\xbb\xe5\xbb\xe5\xc6\xf1\xe0\xf9\xe2\xf9\xe2\xf9\xe2\xf9\xe2\xf9\xe3\xf9\xe3\xf9\x8f\xe6\\\xe2Z\xe2Z\xe2Z\xe2Z\xe2\\\xe2\xb4\xe5\xe4\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe3\xf9\xe3\xf9\xe4\xf9\x11\xf9\xa2\xf7\xa2\xf7\xa4\xf7\xa4\xf7\xa4\xf7\xa4\xf7\xa4\xf7\xf8\xf8\xe4\xf9\xe4\xf9\xe4\xf9\xe5\xf9\xe5\xf9\xe5\xf9\xe5\xf94\xf8\xce\xf7\xce\xf7\xce\xf7\xce\xf7\xce\xf7\xce\xf7\x81\xf7m\xf5m\xf5n\xf5n\xf5n\xf5n\xf5n\xf5\xff\xf6\xe7\xf9\xe8\xf9\xe8\xf9\xe8\xf9\xe8\xf9\xe8\xf9\xe9\xf9\u007f\xeck\xe2k\xe2l\xe2l\xe2l\xe2l\xe2l\xe2l\xe2n\xe2n\xe2n\xe2n\xe2n\xe2o\xe2\xf6\xe4|\xf9|\xf9|\xf9|\xf9}\xf9}\xf9}\xf9C\xf8\xc6\xf5\xc6\xf5\xc6\xf5\xc6\xf5\xc7\xf5\xc7\xf5\xc9\xf5\xe2\xf2\x80\xf0\x80\xf0\x80\xf0\x80\xf0\x80\xf0\x80\xf0\x80\xf0\xf7\xe8\xb2\xe6\xb2\xe6\xb2\xe6\xb3\xe6e\xed\x97\xfe<\xfek\xfaq\xfb\x92\xfb\x92\xfb\x92\xfb\x93\xfb\x93\xfb\x93\xfb\x94\xfb\x94\xfb\x94\xfb\x94\xfb\x94\xfb\x94\xfb\x94\xfb\x94\xfb\x96\xfb\x96\xfb\x96\xfb\x96\xfb\x96\xfb\x96\xfb\x96\xfb\xdd\xff^\x01^\x01^\x01]\x01]\x01]\x01J\x02\x9c\x11Y\x12Y\x12Y\x12Z\x12Z\x12Z\x12\x89\x12\b\x13\b\x13\b\x13\t\x13\t\x13\t\x13\b\x13\xeb\x0f\xa8\f\xa8\f\xa8\f\xa8\f\xa8\f\xa8\f\xa9\f\x1d\x11\xfc\x12\xfd\x12\xfc\x12\xfc\x12\xfd\x12\xfd\x12\x93\x12\x1b\xfeJ\xfcJ\xfcK\xfcK\xfcK\xfcK\xfc%\xfc\xa2\xfb\xa2\xfb\xa2\xfb\xa2\xfb\xa3\xfb\xa3\xfb\xa5\xfb$\x06\v\x13\v\x13\f\x13\v\x13\v\x13\v\x13\f\x13\f\x13\f\x13\f\x13\f\x13\f\x13\f\x13\r\x13\r\x13\x86\xfe\xaa\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\x19\x00\r\x13\r\x13\r\x13\r\x13\r\x13\r\x13\r\x13\x9b\t\xad\xfb\xad\xfb\xad\xfb\xad\xfb\xad\xfb\xad\xfb\xad\xfb\xa0\a\f\x0f\f\x0f\f\x0f\f\x0f\f\x0f\f\x0f\f\x0f`\x00a\xfda\xfda\xfda\xfda\xfda\xfdm\x00\x0f\x13\x0f\x13\x0f\x13\x0f\x13\x10\x13\x12\x13\x10\x13k\x11k\x0ek\x0ek\x0ek\x0em\x0em\x0ek\x0e\xd7\x03\xb6\xfb\xb6\xfb\xb6\xfb\xb8\xfb\xb8\xfb\xb6\xfb\xb6\xfb\xb5\xfc\xfe\xfc\xfe\xfc\xfe\xfc\xfe\xfc\x9e\x00\xcd\t2\f;%\x93%\x93%\x92%\x92%\x93%\x92%\xfc\x1f\xc0\x12\xc0\x12\xbf\x12\xbf\x12\xbf\x12\xbf\x12\xbf\x12\xf0\x1c\xd9&\xdb&\xd9&\xd8&\xd9&\xd9&\xd9&B$>#>#=#=#=#>#U#\x92&\xd5&\xd5&\xd5&\xd5&\xd4&\xd4&[!\x80\x0f\u007f\x0f\u007f\x0f\u007f\x0f\u007f\x0f\u007f\x0f\u007f\x0f\xc7\x10g\x12g\x12g\x12g\x12g\x12g\x12g\x12\x81\x10\u007f\x0f}\x0f}\x0f}\x0f}\x0f|\x0f|\x0f\x04\"\b%\b%\b%\b%\a%\x05%k!z\x0fz\x0fz\x0f|\x0fz\x0fz\x0fz\x0f2\x18\xc6&\xc6&\xc6&\xc6&\xc5&\xc5&\xc3&b\x19\xbf\x0f\xbf\x0f\xbf\x0f\xbf\x0f\xbf\x0f\xbf\x0f\xbf\x0f*\x10I\x10G\x10G\x10G\x10G\x10G\x10,\x12i$\x86$\x86$\x86$\x84$\x83$\x83$\x96\x1e\xb7\x10\xb7\x10\xb7\x10\xb7\x10\xb7\x10\xb7\x10\xb7\x10\x87\x1a&$&$&$%$%$%$%$\xa4#n#n#p#n#n#n#g#\xd4!\xaf!\xaf!\xae!\xae!\xae!\xae!\xc6\"\xb3&\xb1&\xb1&\xb1&\xb3&\xb3&\xb1&\xb0&\xb0&\xb0&\xb0&\xaf&\xaf&\xaf&\xaf&w\x19\x95\x11\x94\x11\x94\x11\x94\x11\x94\x11\x93\x11\x93\x11\xca\x0fm\x0fm\x0fm\x0fm\x0fk\x0fk\x0f~\x12\xa8&\xa8&\xa8&\xa8&\xa8&\xa8&\xa6&\xde\x1ek\x0fk\x0fk\x0fk\x0fj\x0fj\x0fj\x0f\x12\x12]\x14]\x14]\x14]\x14]\x14]\x14\\\x14\xdb!\xa1&\xa1&\x9f&\x9f&\x9f&\x9f&j&\xcd!\x80!\u007f!\u007f!\x80!\u007f!\u007f!\x1a\"\x05$\x05$\x05$\x05$\x04$\x04$\x04$C$\x93$\x93$\x95$\x93$\x93$\x92$\x92$\xdd%\x96&\x97&\x96&\x96&\x96&\x96&\x96&&$\xb3#\xb3#\xb2#\xb2#\xb2#\xb2#\xcf b\x0fb\x0fb\x0fb\x0fb\x0fb\x0f`\x0f\x8f\x10\xd8\x12\xd8\x12\xd6\x12\xd6\x12\xd6\x12\xd5\x12\xd5\x12F\x15Y\x17Y\x17Y\x17Y\x17Y\x17Y\x17Y\x17g \x9c#\x9c#\x9c#\x9c#\x9c#\x9c#\xb7#X&\x87&\x87&\x87&\x87&\x87&\x85&,#A\x18A\x18A\x18@\x18?\x18@\x18?\x18>\x1b2\x1f2\x1f2\x1f1\x1f1\x1f2\x1f2\x1f\xf3\x18D\x15C\x15C\x15C\x15A\x15A\x15A\x15`\x10X\x0fX\x0fX\x0fW\x0fW\x0fU\x0f\xd5\x11\xc5#\xc5#\xc4#\xc4#\xc4#\xc2#\xc2#\x17\"\x85\x1e\x85\x1e\x85\x1e\x83\x1e\x83\x1e\x85\x1e\x83\x1e\x99\"v&v&v&u&u&u&u&s&s&s&r&r&r&r&_&]\x18\xde\x16\xde\x16\xdd\x16\xdd\x16\xdd\x16\xdd\x16\xe1\x18\x00!\x00!\x00!\x00!\xff \xff \xff \xe1\x1c\x86\x16\x85\x16\xd2\x15\xef\x0e\xda\x0e\xda\x0eh\xfcU\xfc+\x02+\x02+\x02+\x02\x05\x05\n$\xab$\xac\x15\xd9\xfc\x1d\xf3\x1d\xf3\x93\xf4\xff\xf6\xff\xf6\xac\xf8\x11\r\xac\r\xab\ry\xf9\x8a\xef\x8a\xef\xc7\xf8c\x0e\x1a\x1e\x95\x1eM%\x93%\x93%P\x0f\xe1\x03\xe1\x03\x97\x0e\xd1!\xd1!\xf9 ,\x13t\x12t\x12\xf6\b\xdf\x03\xdf\x03b\x057\b7\b\u007f\t\x84#\xe1\x12s\x0fs\x0fs\x0fr\x0f>\x0f\xd9\x0e\xa9\x0e\x8b\r&\x0fF\x0fF\x0fF\x0fF\x0fF\x0f\xa6\x15\xe3\"\xe3\"\x9c\"\xd7\x16\xcb\x15\xba\b\x1a\v\r\x11B\x12B\x12B\x12B\x121\x12\v\fj\v\xd6\xfb\xa1\xe4\xb0ٰٰٰٰٰ\xd9S\xee\xbe\xf0\xbe\xf0~\xf6x\xfax\xfa\xc2\xf8:\xf0v\xddv\xdd>\xdc\x13\xdc\x13ܝ\xee0\xfc\xa5\xfd\xa6\xf6\x97ݗݗݗݗݗ\xddX\xe9x\xf2x\xf2\x92\xf2\xe1\xf2\xe1\xf2\xe1\xf2\xe1\xf2\xcf\x02\x17\bR\xfa\xf5\xee\xf5\xee\x8d\xf4\xe1\x05\x05\x05\xf8\xf1\xce\xf3j\xf4j\xf4\x93\xf88\xfc8\xfc\xdd\xf5W\xe0W\xe0W\xe03݄܄\xdc\x19\xdbQ\xe3\xc6\xf0\xc6\xf0\xc8\xf0\xc8\xf0\xc8\xf0\xc8\xf0\xc8\xf0\xf2\xe5\xff\xef8\x019\x019\x019\x019\x01:\x01\xfd\xfea\xfea\xfe#\xf0\xe0\xe0\xe0\xe0\x1e\xe6\xc0\xfe\x06\x03'\x03'\x03'\x03'\x03'\x03'\x03\x86\x02\x83\xfb\xec\xdf\xec\xdf\xec\xdf\xee\xdf\xee\xdf\xec\xdf\x17\xde\xd1\xdb\xd1ۊ\xdb\xd3\xd9\xd3\xd9\xd3٨ۃ\xde\xca\xdfs\xed\xbf\xff\xbf\xff\xcb\xfd\xb2\xef\xb2\xef\x9c\xffa\a\x0f\t\x0f\t\xa5\n\xf4\f\xf4\fb\t\xde\xe9\xdd\xe9\xdd\xe9\xdd\xe9\xdd\xe9\xdd\xe9\xdd\xe9-\xe7r\xe0?\xe3+\x02\xe2\x02\xe3\x02\xe2\x02\xe2\x02\x8f\nC\x13C\x13C\x13/\x11E\xf2\xd4\xf0\xd4\xf0\xdf\xe1\xe2\xd9\xe2\xd9Z\xda:\xdb:\xdb]\xdbz\xde\xd4\xe2\xa5\xe3\xe6\xe1\xdd\xe0\xdd\xe05\xe0\xd8\xde\xfa\xe0J\xf1\xe7\tB\fC\f\xe6\r\xf5\x0e\xf5\x0e(\x0eK\fM\fM\f\x9a\a\b\a\b\a\xf1\x06\xc0\xfe\x01\xf6\xb3\xf5\xe4\xf4\xe3\xf4\xe3\xf4_\xfb[\xfcC\xfe:\xff<\xff<\xff\x94\xf9\xef\xe8\xf0\xe8\xf0\xe8\xae\xf2y\xf4y\xf4{\xf4{\xf4{\xf4\x8c\xfb\xf3\x10\xe9\x00\xe9\x00c\xe6\x8a\xe0\x8a\xe0}\xdf\u007f\xde#\xe4\xa8\xf3\x83\xfe\x83\xfe\x83\xfe\x05\x00i\x00i\x00G\xfb\xd9\xf5\xd9\xf5D\xf5\x8d\xf2\x8d\xf2\x8e\xf2\x8e\xf2\xf5\xfd\xab\x03\xb6\xf9\a\xee\a\xeeR\xee\xe8\xef\xe8\xef\x1eߡއ߈\xdf$\xec\x94\xfc\x95\xfcK\xff\v\x12\v\x12\v\x12q\xf9\xa3\xef\xa3\xef\xa5\xfdx\vn\xfcn\xfcn\xfcn\xfcn\xfc*\xe8\xf3\xde\xfb\xe9\xea\xfc~\b~\bD\t\xf3\x12L\x13N\x13\xf3\x00z\xf7z\xf7|\xf7|\xf7|\xf7\xb4\xf6\x0e\xe6\x98\xe7 \xe8\xb8\xe1\xf6\xdd\xf6\xdd\xf6\xdd\xf7\xdd*\xe0\xf1\xf0:\xf3t\xf3t\xf3\xb0\xf4\x82\xf5\x82\xf5A\xf6\x0f\xf8\x11\xf8\x11\xf8\xc6\xf1\xea\xf0\xec\xf0\xec\xf0\t\xf0\x06\xef\a\xef\a\xef\a\xef\a\xefi\xf0\xa8\xf0\x86\xf1\xff\xf1\xff\xf1\xff\xf1\xa0\xf1`\xf0`\xf0`\xf0t\xf1\xb0\xf1\xb0\xf14\xf3\xaa\xf4\xac\xf4\xed\xf3\xf0\xf0\xf0\xf0\xf0\xf0\xf0\xf0\xee\xf0\xf0\xf0^\x01S\x13!\r\xbd\xfb\xf1\xf7\xf1\xf7\xf1\xf7\xf1\xf7\xf2\xf7\xf2\xf7\xd2\xf4\xf1\xf0\xf1\xf0\xf3\xf0\xf3\xf0\xf3\xf0\xf3\xf0\xe4\xf8\x97\xfa\xc3\xf9)\xf8\xe3\xf5\xe3\xf5\xe3\xf5\xe3\xf5\xe3\xf5\r\xeaP\xe9a\xeaa\xea\x01\xe8\x1c\xe4\x1e\xe4\xbf\xe4\xe6\xec6\xed7\xed\xac\xf0}\xf2}\xf2}\xf2P\xefY\xe5*\xe5r\xe0\x14\xe0\x13\xe0%\xe1\xcd\xe1\\\xdf\t\xe6\x8e\xfc\x8e\xfc\x8e\xfc\xbe\xdd.\xda/\xda/\xda/\xda/\xdaQ\xdc\xee\xe1\xee\xe1\xee\xe1\xee\xe10\xf3\xb8\xf8Y\x02:\n:\n\xaf\x06s\xfbR\xfb\xe0\xf7\\\xf3u\xf2u\xf2b\x01\x83\x0f\x83\x0fB\x0fF\x0eF\x0eF\x0eF\x0eG\x0eG\x0eF\x0e,\x10\\\x13\xa8\r\xfe\xf0\xfe\xf0\xfe\xf0\x9b\xf8!\xfb\xcc\xf7\x18\xff\xf6\v\xf5\v\xb0\t7\xfa\x96\xfa\x9e\xfa\xc4\xee\xfe\xe9\xff\xe9\xa4\xf1\x06\xfd\x06\xfd\b\xfd\b\xfd\x18\x11\xc7\x13\xd8\x0f\xf2\r\xf4\r\xe9\n\x92\x05\x02\x05\x12\x01\x17\xf24\xf14\xf1\t\xef\xc9\xed\xcb\xed\xde\xee\x1c\xf1\x1c\xf1&\xf1\xd1\xf3\x17\xf4\x17\xf4\x9c\xf4\xef\xe9\x8b\xdemߦ\xe1\xa7\xe1\xa7\xe1\xf4\xdb\x15\xdb\xc7\xe6\xcf\xec\xcf\xec\xd0\xec\x16\xf5\xc8\x0e\xc9\x0e\xc9\x0e\x9f\x10\x02\x11\x02\x11\xfa\x04\xa4\xf9\xa4\xf9k\xf7\xd4\xec\x1e\xdc\x02\xdc\x02\xdc\x02\xdc\x02\xdc\x02\xdc\x02ܥ\xe0\x81\xed\x82\xed\x82\xed\x82\xed\x0e\a\xda\x0f\xda\x0f\xac\b8\xff8\xff\x1d\xfe\xdc\xf5\xdc\xf5\xdc\xf5n\xfc\xf6\xfd\x1b\xfd\x1b\xfd\x1b\xfd\x1b\xfd\x1b\xfd\x1b\xfd\x1d\xfd`\x0e\xbf\x13\xbf\x13\xbf\x13\xcb\x12\xfe\x10\xfe\x10\xf1\x10\x81\x0fc\x0fc\x0f\x14\x11%\x12%\x12\xe9\n\b\xf6'\xe5'\xe5\xfc\xdb\xca\xda\xca\xda9ۋ۟\xe3\xde\xf1\xde\xf1\xde\xf1\xde\xf1%\xf22\xf22\xf2\xe3\xf0\xb6\xef\xb6\xef\xc7\xf6\xf6\x10\xf6\x10\xf6\x10\xf7\x10K\x03&\xfd\x8b\xef\xd6\xe0\xd6\xe0;\xe1)\xe3)\xe33\xf4\xc1\xf9\xa1\xfa\x9f\xfa\x81\xf7r\xf3r\xf3\x8f\xf3b\xf4b\xf4b\xf4/\xf2?\xf1A\xf1A\xf1\x8f\xf1U\xf2F\xf2\x88\xf1\x82\xf1\x82\xf1\x82\xf1\x82\xf1[\xf0\x9f\xfa>\x11>\x11>\x11>\x11?\x11?\x11i\b\xb2\x02\xb2\x02\xb3\x02\xb3\x02\xb3\x02\xb3\x02X\xf0\xf3\xe1k\xdej\xdek\xdek\xde\xdd\xde>\xe0\x1e\xe0\xf4\xdcg\xde\xc5\xde\xc5\xde\x12\xe0P\xe1Q\xe1\"\xe0E\xdbE\xdbF\xdbF\xdbF\xdbF\xdbz\xe8\xca\xf7\x9b\xf7\x1e\xf8\x14\xfb\x14\xfb\x14\xfbw\xe4\xf1\xdb\xe7\xdb\xdc\xdb\xdb\xdb\xdc\xdb\x13\xdf\xf2\xfc<\xfd>\xfd\"\x02{\x04{\x04{\x04{\x04{\x04|\x04|\x04m\xffo\xfe\xfe\xe8!\xdc!\xdc!\xdc!\xdc\x04\xdc\xf6\xdaE\xdf\xce\xdf\xcf\xdf\xea\xec\xa9\xf6\xa9\xf6]\xf8E\xfdE\xfdE\xfd\x00\xe2\xb3ܳ\xdc\xcc\xdbO\xe2\x9f\xec\xae\xf3\xeb\x0e\xeb\x0e\xeb\x0e\xeb\x0e\xec\x0e\xc0\x11\xc8\x03\x91\xf1\x91\xf1\x91\xf1\x91\xf1\x91\xf1\x92\xf1\xa4\n\xda\x13\xdc\x13\xdc\x13\xdd\x13\xdd\x13\xdd\x13\xdd\x13\xd1\xffM\xfdM\xfdM\xfdM\xfdJ\xf1\x18\xdc'\xdcz\xdcz\xdcz\xdcy\xdc$\xdf\xc2\xe0\xc2\xe08\xe6\x91\xf2\x92\xf2\x92\xf2\x19\xf0\xc8\xef\xc8\xef{\xf3\xa1\xf6\b\xf7]\xfd&\x10&\x10&\x10h\xf7T\xf2\xda\xe5?\xdd`\xdcb\xdc`\xdc`\xdc`\xdcb\xdc\n\xe9\xbd\xec\xbd\xec0\xf8\xc8\x05\xc8\x05\xc9\x05\xd2\x05\x8e\x06\x9d\x06\x9a\x055\x055\x055\x055\x05\xb6\x01\xda\xf0\xd8\xdcW\xdcW\xdcX\xdcX\xdcX\xdc\x1eݙޚޚޚޚޚޚ\xdeG\xdf\xf7\xdf\xf7\xdf\xf7\xdf\xf7\xdf\xf7\xdfm\xe0\x80\xe0I\xde\x16\xdd\x16\xdd\x14ݼ\xe4\xd6\xfe\xd6\xfe\xd6\xfe\xba\v\xe3\x0e\xe3\x0e@\x0e\x93\r\x93\r\x92\r\xdb\r\xfe\x114\x12\x84\x11I\x11I\x118\x11%\x11h\fG\xfbh\xfdg\xfdh\xfdh\xfdi\xfdi\xfdi\xfdi\xfdk\xfdi\xfd?\xfd>\xfd>\xfd\x8e\xfc\xc5\a\x9f\x12f\x11\x99\x0e\x99\x0e\x99\x0e\x9a\x0e\x9a\x0e\xf5\x003\xfd\x85\xff\x86\xff\x86\xff\x86\xff\x86\xff\x86\xff\x11\xe7\xae\xe1\xae\xe1\xb0\xe1\xb0\xe1\xb0\xe1\xc0\xe6\xab\xfdR\x00i\x00i\x00i\x00k\x00\xae\xfd,\xfaw\xfe\"\r\"\r\"\r\"\rz\x11d\x13d\x134\x10\xe9\n\xe9\n\x9b\n\xd4\x05\x91\x05\x91\x05\x91\x05\xd7\t\x90\x13\xa6\x12v\xff\x0e\x02\x0e\x02\x10\x02\x0e\x02\x91\xfd\x91\x06\x14\x0f\x13\x0f\x13\x0f\x13\x0f\x13\x0f\x13\x0f\xc6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb4\x0e:\x10\xd3!f\"g\"g\"e\"V\x18u\v\x13\a\x0e\xf8\x0e\xf8\x0e\xf8\x0e\xf8\x88\xf01\xed1\xed\x16\xed\xe7\xec\xe6\xec\xe1\xec{\xect\xect\xect\xec\x1d\xf8\xdf\x02\x1d\x03\xa8\x03\xa8\x03\xa8\x03\xb2\x04\xd7\x04\xd6\x04\x12\x05B\x05B\x05S\x05\x86\x05\x86\x05\x86\x05\xae\x02\x06\x02\x06\x02\x06\x02\x06\x02\x06\x02\x06\x02\xdc\x00\r\xf1Q\xf0Q\xf0Q\xf0Q\xf0P\xf0P\xf0+\xf1Z\xf4Z\xf4Z\xf4Z\xf4Z\xf4Z\xf4Z\xf4\x87\xf1p\xecp\xecp\xecp\xeco\xeco\xecA\x01]\x18\x00\"\x00\"\xff!\xff!\xff!\xb5\x11\x0f\x0f\x04\x0f\xfe\x0e\xfe\x0e\xfe\x0e\xdc\v\xa9\x00\xa7\x00\xa7\x00\xe5\x00\xf4\x00\xf4\x00\xa6\x0e'\x1e&\x1e\xbf\x1e\x8b!\xf9\x12\xe5\x11\xe5\x11\xe4\x11\xe4\x11?\x04\xac\xef\x13\xf3\x18\x02\x18\x02\x16\x02\x16\x02\x05\x18\xe0#\xe0#M!\x02\x1c\x00\x1c\xe8\x1b\xdb\rE\fE\fE\f\xc6\x0e\x9b\x11Y\r\xf8\x00\xfa\x00\xfa\x00\xfa\x00\xf8\x000\xf7q\xf3\xd5\xf5\xd5\xf5\xf6\xfa\xc4\x11\xc4\x11\xc4\x11@ \xf6$\xf6$Z\x161\x031\x031\x03\x1f\x03\xa8\xf1\x9e\xefR\xef,\xef+\xef+\xef+\xef\xe6\xf1(\x03K\x1en n \xbe\";$;$\xa5\x1f.\x14.\x14.\x14Q\t|\a|\a|\a\xfb\x06M\x06a\x02\xb6\xf3\xb6\xf3\xb6\xf3\xb5\xf3\xb5\xf36\xf1\xfe\xee\xa0\xee\x9e\xee\x9c\xee\x87\xee\x87\xee\x87\xee\f\xfc\xad\x01\xad\x01\xf4\r\xbd!\xbd!\xbb!\xbb!b $ E\f\x97\x00\x97\x00\x97\x00\x97\x00\xbb\x00#\x02h\x1a\xd4\x1d\xd3\x1d\xf8\x1f\xb4!\xb3!m\"\xdd$\xdd$\xdd$1\x1c\x03\x1a\x03\x1a\x03\x1a\x02\x1a\x02\x1ao\x16\xa8\x02\xa8\x02\xa8\x02\xa8\x02\xa8\x02A\x02}\x0f\x0e$\x0e$\x0f$\x0f$\x0e$\x0e$\x0e$x$\xd5$\xd4$\xd4$\xd4$\xd4$\xa0$\x99$\x99$c\"\xb2 \xb0 \xb0 \xb0 \xb0 \xb0 \x8e!\xc4!\r\x1a\xc6\x14\xc6\x14\xc6\x14\xc6\x14\xc6\x14\xc6\x14\xc6\x14\xc6\x14\xe4\x10\x88\x0e\xa6\x00[\xec[\xec[\xec[\xecZ\xecZ\xecT\xfc\xed\x04\xec\x049\a\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\x1c\x00\x8b\b;\x139\x13;\x139\x139\x139\x138\x13\xe7\x12\xc6\x12\xc6\x12\xc6\x12\xc4\x12\xc4\x12\xc3\x12\xc3\x12\xc3\x12\xe3\x1f\xbc$\xbc$\xbc$\xbc$\xbb$\xf8!\x80\x03\x90\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8e\x02\x8d\x02\x8d\x02\x8d\x02\t\xff\x0f\xfe\x0f\xfe\r\xfe\f\xfe\f\xfe\r\xfe\f\xfe\r\xfe\b\xfbM\xfaK\xfaK\xfaK\xfaK\xfaJ\xfaJ\xfaJ\xfa#\x01w\x1a\xac#\xac#\xac#\xac#\xac#\xab#\xab#T\x1c\x14\x1b\xb0\t\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd1\xff\xd1\xff\x0e\xf6\xb3\xf4\xb3\xf4\xb3\xf4\xb1\xf4\xb1\xf4\xc7\xf4\x0e\xf5\b\xf5\xf8\xf2\xaf\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xee\xf3\xa9\xf7\x8a\x0e\xe3\x10\x16\x11\x16\x11\x16\x11\x14\x11\\\rL\aL\a\b\a\xbd\x02{\x02{\x02z\x02z\x02z\x02\xa6\v\xc8!|!\x04\x1e\xe3\x1d\xe3\x1d\xe2\x1d\x83\x1e\x12\x1f\x12\x1fi\x1f\xed \xd3\"\xda\"\xda\"\xdb\"\xda\"\xb0\x13\xb4\x00\xb4\x00\xe8\x00s\x02s\x02s\x02s\x02r\x02r\x02\x00\x05\xa4\tt\tM\bM\bM\bM\b\a\x04\x1b\x01\x8a\x00\x9e\xff\xa7\xfd\x1e\xf3\x1e\xf3\xf5\xf1\xb7\xf1\xb7\xf1\xbb\xf7\xa7\xfd\xa7\xfd\x86\xfc>\xf7>\xf7>\xf7w\x02f\x06f\x069\b\xcd\n\x0e\f7\x117\x118\x118\x11\x9a\x11\xcf\x11\xcf\x11\xd1\x110\r-\xfc-\xfc.\xfc.\xfc.\xfcT\xfd:\xfe;\xfeC\xfe]\xfe^\xfe^\xfel\xe5\xf9\xde\xf9\u07b3\xdd?\xdc\x19ܡۡۡۡۜ\xf3\xc3\xfd\xc3\xfd\xc3\xfd\xc9\x02\xb4\x10\xb4\x10\xb4\x10\xb4\x10\xb5\x10\xb5\x10\xb5\x10\xb5\x10\r\r\xba\x03\xbb\x03\xbb\x03&\xe7\xe1\xe1\xe1\xe1\xe1\xe1\xe1\xe1\x1b\xe0\xe0۫۫۫۫۬۬۬\xdb\xe1\xe1|\xef|\xf2\xcc\x0eG\x0fI\x0fG\x0fG\x0fI\x0f\x95\a\xd2\xf7\xd4\xf7\xd4\xf7\xd2\xf7\xd4\xf7\xd4\xf7\xd5\xf7\xd5\xf7\x15\xf5X\xf6\xdb\v\xdc\v\xdc\v\xdc\v\xdc\v\xdc\vf\bp\xfff\xf6f\xf6g\xf6g\xf6h\xf6h\xf6g\xf6g\xf6\xb3\xf4\xcb\xf1\xcb\xf1\xcc\xf1\xcc\xf1\xcc\xf1\xcc\xf15\x03\xb6\x0e\xb7\x0eq\x0fc\x11c\x11c\x11c\x11c\x11c\x11\x88\x0f+\x05 \xf8 \xf8 \xf8 \xf8 \xf8\x81\xfaY\xfbY\xfb\x82\xfc4\xfe4\xfe5\xfe5\xfe5\xfe5\xfe\x94\xea|\xdfX\xdf&\xdf(\xdf(\xdf&\xdf&\xdf&\xdf(\xdf\x1b\xdf\x10\xdf\x12\xdf\x12\xdf\x12\xdf\x12\xdf\x13\xdfR\xe0\xb0\xe0\xb0\xe0\x85\xea\xf9\xf6\xfa\xf6\xfa\xf6\xfc\xf6\xfc\xf6\xfc\xf6j\xf2,\xf0,\xea\xdf\xe2\xe0\xe2\xe0\xe2\xe0\xe2\xe0\xe2\xe0\xe2\xe1\xe2X\xf6\xd3\x04\xac\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04}\xf1\xd8\xdb\xd8\xdb\xda\xdb\xda\xdb\xda\xdb\xda\xdb\xdb\xdb\xdb\xdbd\xe6\xdd\xf1\xdd\xf1\xdd\xf1\xdd\xf1\xdd\xf1\xdd\xf1\xdd\xf16\x069\t\xf3\xfd\xf3\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf5\xfd\x19\xed\xe2\xdb\xe2\xdb\xe3\xdb\xe3\xdb\xe3\xdb\xe3\xdb\xe5\xdb\xe5\xdb\xe5\xdb@\xe9\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xfa\xfd\xfa\xfd5\xfd\xea\a\xb7\x124\v\xaf\xf8\xaf\xf8\xb0\xf8\xaf\xf8\xb0\xf8\xb0\xf8\\\x05J\x11J\x11\xc7\x11\xf5\x13\xf5\x13\xf5\x13\xf5\x13\xf6\x134\x11\xa4\r,\f.\f\x1c\fa\v\\\v\\\v[\v\x93\x02\xb1\xfa\xb5\xfb\x04\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe4\xfe[\xfe]\xfe\x8f\xf7M\xdcM\xdcM\xdcN\xdcN\xdcv\xe6@\xef@\xef@\xefp\xf2\xb7\x10M\x11M\x11N\x11\xe1\x10\x85\x10\x85\x10\x85\x10\x85\x10\x85\x10\xba\n\xea\t\xea\t\xca\v_\r_\r_\r`\r`\r`\r\xb4\t\x99\br\x05\xd6\x02\xd7\x02\xd8\x02\xd8\x02\xd8\x02\xd8\x02\xd8\x02\xe6\xf3O\xe3\x06\xdc\a\xdc\b\xdc\b\xdc\b\xdc\a\xdc\b\xdc\b\xdc\x1c\xe8\xf3\xf1\xf4\xf1\xf4\xf1\xf4\xf1\xf4\xf1\xf5\xf1\xf5\xf1\xf5\xf1\xda\xe5\x05\xe8!\xf7!\xf7\"\xf7\"\xf7\"\xf7\"\xf7\"\xf7\"\xf7$\xf7(\xee\x11\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\xe5\xe80\xf30\xf3\xe4\xf5d\xffd\xffd\xffd\xffd\xff\xaa\xfe\x1a\xfe\"\xfe\"\xfe\"\xfe\"\xfe#\xfe#\xfe#\xfev\x00U\x02U\x02U\x02U\x02U\x02V\x02V\x02W\x02\x0e\x01\x06\x00\a\x00\x8e\xf8\x01\xde\x01\xde\x01\xde\x01\xde\x01\xde\xf8\xdc!\xdc!\xdc!\xdc\xc9\xdf\b\xfe*\xfe,\xfe,\xfe4\n\a\x14\a\x14\a\x14\a\x14\a\x14\a\x14\b\x14\a\x14\a\x14\a\x14\b\x14\xe6\x13n\x13n\x13n\x13\x9f\x11\x14\x11\xae\a\xdc\xff\xdd\xff\xdd\xff&\x00\xc5\x02\xcd\x02\xcf\x02\xd0\x02)\f\v\x14\v\x14\v\x14\a\x14\xeb\x13m\x11\x12\x11\x10\x11\xf3\x06O\xfeO\xfeO\xfeP\xfeP\xfeP\xfei\x01h\x02\xd5\tL\x10L\x10L\x10M\x10M\x10M\x10M\x10y\xfa9\xf0\xec\xf1\xec\xf1\xec\xf1\xec\xf1\xec\xf1\xee\xf1\xee\xf1\xed\xf1\x16\xf9\x8d\xff\x8d\xff\x8d\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\x8f\xff\xd2\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfe%\xfeW\t\xf1\x13\xa4\f\xce\xfa\xcd\xfa\xcd\xfa\x99\xf3B\xf2B\xf24\xe7x\xdcx\xdc\xd4\xdda\xe4a\xe4a\xe4\x0e\xf7*\xfe\x0f\t\xf3\x13\xf5\x13\xf5\x13\xf5\x13\xf5\x13\xf5\x13\xf5\x13\xf5\x137\t0\xfe0\xfe0\xfe0\xfe0\xfe0\xfe0\xfe0\xfe1\xfe3\xfe1\xfe1\xfe1\xfe1\xfe3\xfe3\xfe4\xfe\x92\b\xc0\x10(\v(\v(\v(\v(\v(\v(\v@\x03D\xfa\x0e\xf5\xd6\xe4\xd6\xe4\xd6\xe4\xd8\xe4\xd8\xe4\xd8\xe4\x9e\xe2\xfd\xdf\xfdߜ\xe2{\xf5{\xf5{\xf5K\xfb\x1d\xfe\x1d\xfe\x1e\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe\x1f\xfe!\xfe\x8e\xfal\xf8q\xf9r\xf9r\xf9r\xf9r\xf9r\xf9r\xf9s\xf9\xf9\xecF\xdcF\xdcG\xdcG\xdcG\xdcG\xdcG\xdcG\xdc_\xdeL\xe2\x90\xe4\x91\xe4\x91\xe4+\xe8\xc8\xe8\xc8\xe8\xc8\xe8\xc1\xe6\xc7\xe3\xc7\xe3\xc7\xe3\xc7\xe3\xc9\xe3\"\xf7J\xfeK\xfe#\x00\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x02\x03\xe3\xee\xbf\xe1\xf6\xdf\v\xdd\v\xdd\v\xdd\v\xdd\n\xdd\v\xdd\r\xddn\xdd\xd7\xdd\xd8\xdd\x00\xdf\x02\xe6\x02\xe6\x02\xe6\x02\xe6\x02\xe6\x03\xe6\xae\xed\xb6\xfb\xb6\xfb\x92\xfb\x00\xf3\x0e\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x10\xf2\x10\xf2\x10\xf2\xe9\x00\a\x14\a\x14\a\x14\a\x14\a\x14\b\x14\b\x14\b\x14\b\x14\xbc\x06\x17\xe9\x17\xe9\x17\xe9\x18\xe9\x18\xe9\x18\xe9\x18\xe9\x1a\xe9~\xea\xd4\xed\xd6\xed\xd6\xed\xd6\xedD\xfa\xdf\xfe\xdf\xfe\xe0\xfe\x82\xff$\x01$\x01$\x01&\x01&\x01\xb2\x03c\x05b\x05\xe3\b\xca\x12\xca\x12\xca\x12\xca\x12\xcb\x12\xcb\x12\xcf\x02\xda\xf0\xdd\xebs\xdcs\xdcs\xdcs\xdcs\xdcu\xdcv܂\xe6\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xfa\xf9\xfb\xf9\xfb\xf9\xfb\xf9\xfa\xf9\xf2\xf9\xd3\xf9\xd3\xf9\xd3\xf9\x8e\xf8&\xf8&\xf8%\xf8%\xf8\xa1\xfbX\nX\nZ\nZ\n7\xfb!\xf2!\xf2!\xf2\x17\xf2\xe2\xf1\xe2\xf1\xe3\xf1\xe3\xf1\xe5\xf1\xa0\xfe\xb8\v\xb8\vV\v+\t+\t+\t+\t+\t,\t\x16\fG\x11\xea\x0e\x83\xff\x83\xff\x83\xff\x85\xff\x85\xff\x86\xff\x86\xff\x86\xff\xd6\x01\x13\x14\x16\x14\x17\x14\x17\x14\x17\x14\x16\x14\x16\x14\x17\x14\x19\x12\xf5\xfe\u007f\xfe\u007f\xfe\xa2\xed\x14\xe4\x14\xe4\x14\xe4\x14\xe4\x14\xe4\x15\xe4\x15\xe4\x15\xe4\x17\xe48\xe2_\xe0_\xe0_\xe0`\xe0b\xe0b\xe0c\xe0c\xe0c\xe0c\xe0c\xe0c\xe0I\xe0\xf0ܛܝܝܝܝܞܞܞܞ\xdcN\xe38\xe58\xe58\xe58\xe58\xe59\xe59\xe5@\xe6h\xe6h\xe6h\xe6h\xe6\x15\xed\xb5\xfc\xb5\xfc\xb5\xfcE\x01\x1c\x02\x1c\x02\x1c\x02\x1c\x02\x1d\x02\xe7\xfd\x02\xe8\x02\xe8 \xf4\xc7\xf6\xc9\xf6\xc9\xf6\xc9\xf6\xc9\xf6\xca\xf6\xc9\xf6\xc9\xf6\xc5\xe8-\xe5\xba\xe91\xed2\xed3\xed5\xed5\xed5\xedV\xea{\xe9{\xe9\xfe\xe3\xb0ܲܲܲܲܿ\xec;\xf2;\xf2;\xf2;\xf2=\xf2=\xf2=\xf2}\x0e\xa1\x06Y\x01Y\x01Z\x01Z\x01[\x01[\x01[\x01[\x01\xbd\x03\xcc\x04\xcc\x04\xed\xf8N\xe3\x97\xe4\xae\xe4\r\xee,\xefd\xf9\x83\xfe\x85\xfe\x85\xfe\x83\xfe\x83\xfe\x83\xfe\x83\xfe\x83\xfe7\xf1\xae\xe9\xae\xe9\xae\xe9\xae\xe9\xaf\xe9\xae\xe9\xad\xe9\xad\xe9\xb3\t!\x1e!\x1e \x1e \x1e \x1e \x1e \x1e \x1eq\x1fb b b $\x1c}\xfe}\xfe~\xfe~\xfe~\xfe}\xfe}\xfe{\xfe{\xfe{\xfe{\xfe{\xfez\xfeU\xfe3\xfe3\xfe3\xfe3\xfe3\xfe1\xfe1\xfe1\xfeA\xf4\x14\xea\x14\xea\x14\xea\x14\xea\x14\xea\x14\xea\x14\xea\x14\xea3\xeb\u007f\xec\u007f\xec\u007f\xec\u007f\xec\u007f\xec~\xec~\xec~\xec\x89\xf5[\x01[\x01[\x01[\x01[\x01[\x01[\x01[\x01\x9d\xfaq\xf7u\bt\bt\bI\rG\x0eG\x0eG\x0ev\x11\xe1\x16\xe1\x16\xe1\x16\xe1\x16\xe1\x16\xa1\f\xdc\a\xdb\a\x00\xfe\xb2\xea\xb2\xea\xb2\xea\xb2\xea\xb2\xea\xb2\xea\xb2\xea\xb2\xea,\xea\xd6\xe9m\xedm\xedm\xed\xf4\x02\xd8\n\xd9\n\xd9\n\xd9\n\xd9\n\xd9\n\xd9\n\xd9\n\xd9\n\xd9\n\xd9\n\xd8\nT\n\xb5\b\xb3\b\xb3\b\xb3\b\xb5\b\xb3\b\xb3\b\xb3\b6\aw\x01w\x01w\x01v\x01P\x10\x05\x19\x05\x19\x05\x19\xad\x19\xdc\x1c\xdc\x1c\xdc\x1c\xdc\x1c\xdc\x1c\xdb\x1c\xdb\x1c\xdb\x1c\x1f\x1cg\x17g\x17g\x17g\x17g\x17e\x17e\x17e\x17.\x16m\v@\v@\v@\v\x8c\xfc7\xef7\xef7\xef\xd1\xeeL\xe9\xfb\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xf8\xe8\x91\xe9~\xfc[\xfeZ\xfe\xe7\x0fO\x1dO\x1dO\x1dO\x1dO\x1d\x02\x10!\x0e!\x0e!\x0e\xc7\x05\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xb3\x06(\t(\t'\t'\t(\t'\t'\t'\t\x9a\xfd\xb7\xfa\xb7\xfa\xb7\xfa\xb7\xfa\xb7\xfa\xb7\xfa\xb7\xfa\xb7\xfa2\xed\xf8\xe8\xf7\xe8\xf7\xe8\x1a\xea\xbd\xec\xbd\xec\xbd\xec\xbd\xec.\xfc\x14\x02\x14\x02\x14\x02\x14\x02\x86\x01O\xfeO\xfeO\xfe\xab\xfa\xfa\xf8\xfa\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xf9\xf8\xa8\xeel\xea\xb8\xeb\xb8\xeb\x00\xecB\xedB\xedB\xedA\xed'\xf9\xf0\x00\xf0\x00\xf1\x00\xf1\x00\xb5\x01\xd5\x1b|\x1e|\x1ed\x13\xca\n\xca\n\xc9\n\xc9\n\xc9\n\xc9\n\xc8\n\xc6\n\xee\x11|\x18|\x18|\x18|\x18|\x18{\x18{\x18{\x18\xb5\x1b3\x1f2\x1f2\x1f2\x1f2\x1f\x1b\r,\b,\b!\b\x15\b\x15\b\x13\b\x13\b\x13\b\x15\b\x13\b\x13\b\t\x00\xfb\xf1_\xed^\xed^\xed^\xed^\xed]\xed]\xed^\xed^\xed^\xed]\xed]\xed]\xed\xf9\xec\xc5\xec\xc4\xec\xbb\xee\x1d\xf3\x1d\xf3\x1d\xf3\x1d\xf3\x1d\xf3\x1d\xf3\x1d\xf3\x1d\xf3\xb7\xf5F\xfd.\xff.\xff.\xff.\xff.\xff.\xff-\xff/\x00\xc7\x03\xc7\x03\xc7\x03\xc7\x03\xc7\x03\xa6\r\x9e\x16\x9e\x16\x01\x14\xc4\a\xc4\a\xc5\a\xc4\a\xc4\a\xc4\a\xc4\a\xc2\aq\aU\x05\x9c\x04\x87\x04\x87\x04\xcf\x02e\x01e\x01d\x01\xc2\x01\xa8\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x05\xcd\x052\x05,\xf7\xed\xf5\xed\xf5\xed\xf5\xed\xf5\xeb\xf5\xed\xf5\xeb\xf5\xeb\xf5\x91\xea\xf0\xe8\xf0\xe8\xef\xe8\x10\xf3\xad\x01\xab\x01\xab\x01\xab\x01\xa7\x04H\x05H\x05F\x05F\x05F\x05F\x05F\x05F\x05A\x00\xda\xfe\xda\xfe\x8d\xfe(\xfe&\xfe&\xfe&\xfe&\xfe&\xfe\x1c\xf1\xef\xe8\xef\xe8\xef\xe8\xed\xe8\xed\xe8\xed\xe8O\xe9f\xe9f\xe9I\xf0\xbc\xf8\xbc\xf8\xbe\xf8\xbe\xf8\xbe\xf8\xbc\xf8\xbc\xf8\xbc\xf8\xbb\xf8\xd3\x03\xe7\x1f\xe7\x1f\xe7\x1f\xe6\x1f\xe6\x1f\xe7\x1f\xe7\x1f6 \xc9 \x87\x1es\rs\r\x87\r\x8c\r\x8c\r\x8c\r\x1d\r\x1a\f\x19\f\x19\f;\v\x0f\v\x0f\v\x0f\v\x0e\v\x0e\v\x0e\v\x0e\v\f\v\x0e\v\xee\n\xde\n\xdf\n\xd5\n\xc1\n\xbf\n\xbf\n\xbf\n\xbf\n\xbf\n\x89\f[\x0e[\x0e[\x0e[\x0e[\x0e[\x0e\x88\x0f\x17\x10\x17\x10\xe7\x0ez\fz\fz\fz\fy\fy\fy\fy\fx\fN\x0fp\x1ep\x1ep\x1ep\x1eo\x1em\x1em\x1em\x1em\x1em\x1e\xca\x0ey\fx\fx\fx\fx\fx\fv\fv\fv\fA\v\xbc\n\xbc\n\xbc\n\xbb\n\xba\n\xba\n\xba\n\xbb\n\xbb\n\xe6\x15\xdc\x1f\xdc\x1f|\x1b0\a0\a0\a\xb9\x05\x1f\x05\x1f\x05\xdd\xfd\xc4\xf0\xc4\xf0\xc4\xf0\xc4\xf0\xc4\xf0\xc4\xf0\xc2\xf0\xc2\xf0\xc2\xf0F\xf5F\tF\tF\tF\tE\tE\tE\tE\tE\t[\tv\x10P\x11P\x11N\x11N\x11N\x11\xb5\x12\xb9\x18\xb9\x18\xb9\x18:\x11Q\x0eQ\x0eQ\x0eQ\x0eP\x0eN\x0eN\x0eN\x0eP\x0e\x18\x0e\xea\r\xea\r\xa1\ao\xedo\xedm\xedo\xedm\xedl\xed\xb6\xec\x81\xeb\x81\xeb\x81\xeb\x81\xeb\x81\xeb\x81\xeb\x81\xeb\u007f\xeb\u007f\xeb\xc0\xec\xe2\xf1\xe2\xf1\xe1\xf1\xe2\xf1\xe2\xf1\xe1\xf1\xe1\xf1\xe1\xf1\xdf\xf1$\xf2Z\xfe\xb7\xff\xb7\xff\xb7\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\xb5\xff\t\xfd\x05\xfc\x05\xfc\x05\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\x04\xfc\xb6\xf2\x05\xeb\x05\xeb\x05\xeb\x04\xeb\x04\xeb\x04\xeb\xd9\xfep\x06p\x06L\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\x0e\x06\r\x06\r\x06\r\x06\r\x06t\x06+\b+\b+\bq\xf4\xc0\xec\xc0\xec\xbf\xec\xbf\xec\xbd\xec\xb6\xec\xd9\xea\x9f\xea\x9d\xea\x9d\xea\x9d\xea\x9d\xea\x9d\xea\x9d\xea\x9d\xea\x9c\xea=\xf6\xe0\xfa\xe0\xfa\x94\x05\x94\x18\x94\x18\x94\x18\x92\x18\x92\x18\x92\x18^\x12\xf8\f\xf8\f\xf6\f\xf6\f\xf6\f\xf6\f\xcd\x0f\xf7\x10\xf7\x10\xbe\x16S!S!S!Q!Q!Q!Q!P!P!\xc2\x1fD\x18D\x18D\x18Y\x13;\x11;\x11;\x11;\x11;\x11;\x11\x86\tc\be\bb\bb\bc\bU\x04b\xefb\xefd\xef\xf0\xedG\xedG\xedG\xedG\xedG\xedG\xedG\xedG\xedE\xed\xea\xeb\x95\xea\x94\xeah\xef\x96\n\x96\n\x96\n\x96\n\x94\n\x94\nT\x11\x8c\x1f\x8c\x1f\x8c\x1f\x8b\x1f\x8b\x1f\x8b\x1f\x8b\x1f\x8b\x1f\x8b\x1f\x11\x19}\xf0}\xf0}\xf0}\xf0|\xf0|\xf0|\xf0{\xf0{\xf0{\xf0_\xfd\xef\xff\xef\xffm\x00\xfa\x00\xfa\x009\x01\xfe\x02\xfe\x02\xfe\x02k\xf98\xf48\xf48\xf48\xf47\xf47\xf48\xf48\xf48\xf4n\xf9~\xff~\xff}\xff}\xff}\xff}\xff{\xff{\xff{\xff\x18\xff\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\x13\xfe\xe3\n\xef\x1a\xef\x1a\r\x1bH\x1cS\x1cS\x1cS\x1cS\x1cS\x1cS\x1cS\x1cR\x1cR\x1cO\x1cO\x1cP\x1c\r\x10s\xffs\xffs\xffs\xffr\xffr\xffr\xffp\xffp\xffp\xffo\xffo\xffo\xffo\xffo\xffm\xff\x92\x043\f3\f2\f2\f2\f2\f2\f2\f0\f0\f2\f2\f0\f0\f0\f/\f\xe9\x013\xf13\xf1\x1b\xf1~\xed\x18\xed\x16\xed\x16\xed\x18\xed\x18\xed\x16\xed\x15\xed\x15\xed\x16\xed\x8c\xef\x88\xf0\x88\xf0\xf9\xf2f\xf7f\xf7f\xf7R\x0ex\x11x\x11k\x16\xf6\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf3\x1a\xf3\x1a\xf2\x1c\x12!B!B!\xbe\x1d$\x1d$\x1d#\x1d#\x1d#\x1d!\x1d!\x1d!\x1d!\x1d \x1d \x1d \x1d\x1f\x1d\x1d\x1d\x1f\x1d\x1f\x1d\xf5 \xc6!\xc6!\xc6!\xc6!\xc6!\xc5!\xc5!\xc4!\xc4!\xdc\x1e(\x1d'\x1d%\x1d%\x1d$\x1d$\x1d$\x1d$\x1d$\x1dr\x11K\x02K\x02L\x02L\x02J\x02J\x02K\x02K\x02J\x02\t\x03l\x05l\x05l\x05l\x05j\x05i\x05\x11\a\x83\t\x83\tO\n\xe0\x190\x1b0\x1b0\x1b0\x1b/\x1b/\x1b/\x1b/\x1b/\x1b\x03\f_\x06_\x06^\x06^\x06\\\x06$\x06\xb4\xf4\x8b\xf2\x8b\xf2\x96\xf4c\xf6c\xf6c\xf6b\xf6b\xf6b\xf6b\xf6b\xf6b\xf6z\xf6\xaa\xf6\xa9\xf6\xa9\xf6\x93\xf4:\xf4:\xf4A\xf3E\xf2E\xf2\xb5\xf1$\xee#\xee#\xee#\xee!\xee!\xee!\xee \xee \xee \xee\x1f\xff\xe5\x02\xe5\x02g\x0e\x03\x1c\x03\x1c\x02\x1c\x02\x1c\x02\x1c\x03\x1c\x00\x1c\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xfd\x1b\xfd\x1b\xfd\x1b\xfc\x1b\xfb\x1bO\x1c;!\x92!\x91!\x91!\x92!\x91!\t\x1d\xce\f\xcd\f\xcd\f\xcd\f\xcd\f\xcd\f\xcd\f\xcd\f\xcd\f\xd4\fC\x0eo\x0eo\x0ef\xfc\xd0\xec\xcf\xec\xcf\xec\xd0\xec\xd0\xec\xcd\xec#\xec\xd9\xeb\xd9\xeb\xd9\xeb\xd7\xeb\xd7\xeb\xd7\xeb\xd7\xeb\xd7\xeb\xd6\xeb\x81\xeb(\xeb(\xeb\x8c\xec\xa4\xf5\xa4\xf5\xa4\xf5\xa2\xf5\xa2\xf5\xa2\xf5\xa2\xf5\xa2\xf5\xa2\xf5\xa2\xf5\xa1\xf5\xa1\xf5\xa1\xf5\xa1\xf5\xa1\xf5\x9f\xf5\a\xf75\x05\xc0\x05\xbd\x050\x11\xa8\x18\xa8\x18\xaf\x19\xdf\x1c\xde\x1c\xdc\x1c[ r!r!r!r!q!q!q!p!p!|\x18d\x11d\x11d\x11d\x11d\x11d\x11c\x11c\x11c\x11\b\x17i!i!i!i!i!g!g!g!f![\x1e\xb4\f\xb4\f\xb4\f\xb4\f\xb4\f\xb4\f\xb7\t\xd3\x02\xd3\x02\xd3\x02\"\x02\xfb\x01\xfb\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xf8\x01\xf8\x01\x06\x05\xf5\x06\xf5\x06\xf4\x06\xf5\x06\xf4\x06\xf4\x06\xf4\x06\xf3\x06\xf3\x06\x9b\x11C!C!C!C!B!A!A!A!A![\x1e\x8a\x12\x8a\x12\x89\x12\x89\x12\x89\x12\x89\x12\x88\x12\x88\x12\x88\x12\x88\x12G\x1f:!:!B!J!J!J!I!I!I!\xb4\x13\xa5\f\xa4\f\xa4\f\xa2\f\xa2\f\xa2\f\xa2\f\xa2\f\xa1\f\xf5\f\\\r\\\r\\\r[\r[\r[\r\xf3\x00\xb4\xf8\xb3\xf8\xd2\xfc\f\n\f\n\f\n\f\n\v\n\v\n\v\n\v\n\n\n\x03\n=\t'\t'\t%\t$\t$\t)\v{\x14{\x14z\x14L\x1d3!3!3!3!3!3!1!1!1!\x16 \xe5\x1e\xe5\x1e\xe3\x1e\xe3\x1e\xe2\x1e\xe2\x1e\xe2\x1e\xe2\x1e\xe1\x1e\x05\x1b|\x10|\x10|\x10\xef\b\xd7\x06\xd9\x06\xd7\x06\xd7\x06\xd6\x06\xa8\x06\xd4\x03\x9c\x03\x9c\x03\x9a\x03\x9a\x03\x9a\x03\x9a\x03\x99\x03\x99\x03\x99\x03\x01\x0e\x14\x12\x14\x12\x14\x12\x13\x12\x13\x12\x13\x12p\x1a\xb5\x1b\xb4\x1b\x0f\x14\x89\f\x89\f\x89\f\x89\f\x89\f\x89\f\x89\f\x88\f\x88\fw\x12\x15!\x16!\x16!\x16!\x15!\x15!\x15!\x14!\x14!\xb7\x1f\\\x0fu\x0eu\x0e\xf4\r\x99\r\x97\r\x97\r\x97\r\x97\r\x97\r)\f\xa6\v\xa6\v\xa5\v\xa5\v\xa4\v\xa2\v\xa2\v\xa2\v\xa2\v\xb7\a\x10\x04\x0e\x049\x00\xf0\xea\xf0\xea\xf0\xea\xf0\xea\xf0\xea\xf0\xeat\xef\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfd\xf9\xfb\xf9\xfb\xf9\xfb\xf9\x1b\xfd\xb3\x1e> > \xae \xfc \xfc \xfb \xfb \xfb \xfb \x17\x12\xe2\f\xe0\f\xe0\f\xe2\f\xe0\f\xde\f\xde\f\xdf\f\xdf\f\b\x17\\ [ [ [ [ Y Y Y Y (\x1cp\x12p\x12p\x12o\x12o\x12o\x12o\x12n\x12l\x12\x9b\x11\xba\bP\bO\b,\xfe0\xf70\xf7/\xf7/\xf7/\xf7/\xf7\x02\x05\xf1\t\xf0\t\xf0\t\xf0\t\xf0\t\xf0\t\xf0\t\xf0\t\xf0\t\x17\xfc\x0f\xef\x0e\xefp\xee\xde\xea\xde\xea\xde\xea\xde\xea\xde\xea\xdd\xea\xda\xeb=\xee=\xee=\xee=\xee=\xee<\xee<\xee<\xee<\xeeA\xef\x1f\xfc\xe3\xfc\xe1\xfc$\x10\x0f\x1e\x0f\x1e\x0f\x1e\x0e\x1e\r\x1e\r\x1e\xcc\x1a\x8e\x19\x8e\x19\x8e\x19\x8e\x19\x8e\x19\x8e\x19\x8d\x19\x8d\x19\x8b\x19\x9b\x13\xa9\r\xa9\r\x17\n\xb7\xf2\xb7\xf2\xb7\xf2\xb7\xf2\xb7\xf2\xb6\xf2L\xf26\xf16\xf16\xf16\xf16\xf16\xf14\xf14\xf13\xf1\x8a\xf1y\xf7\xf5\xf7\xf5\xf7\xf5\xf7\xf4\xf7\xf4\xf7,\xfd9\x139\x138\x13\xbc\x18\x18\x1b\x18\x1b\x16\x1b\x15\x1b\x15\x1b\x15\x1b\x14\x1b\x14\x1b\x15\x1bw\x1c\xfe\x1d\xfc\x1d\xfc\x1d\xfc\x1d\xfc\x1d\xfc\x1d\a\n\x8d\xfd\x8c\xfd\xea\xf8\xcb\xea\xcb\xea\xcb\xea\xca\xea\xca\xea\xc8\xea\xc8\xea\xc8\xea\xc8\xea\xe7\xeaQ\xf0\xf5\xf0\xf4\xf0\xf4\xf0\xf4\xf0\xf4\xf0\xf4\xf0\xf4\xf0\xf4\xf0\xf3\xf0\x82\x00?\b>\b>\b>\b=\b=\b;\b;\b;\b[\r\xe7\x13\xe7\x13\xe6\x13\xe6\x13\xe6\x13\xe6\x13\xe4\x13\xe4\x13\xe4\x13\x94\x11\xe0\b\xe2\b\xe0\b\\\f\xbb\r\xbb\r\xbb\r\xba\r\xba\r\xba\r1\x18\xf2\x19\xf2\x19\xf0\x19\xf0\x19\xf0\x19\xf0\x19\xf0\x19\xef\x19\xef\x19\x15\x1e\x92 \x91 \x91 \x91 \x91 \x91 D\x1d>\x1c>\x1cs\x15\x00\v\xff\n\xfd\n\xfd\n\xfd\n\xfd\n\xfd\n\xfc\n\xfb\n\xfb\n \vg\x0e\xb7\x0e\xb7\x0e\xb6\x0e\xb4\x0e\xb4\x0e\xb4\x0e\xb4\x0e\xb4\x0e-\x12\x02\x13\x90\x1a\x80 \x80 \x80 \u007f \u007f \u007f \u007f } } } \xd3\x1d]\x13]\x13]\x13\\\x13\\\x13\\\x13\\\x13Z\x13Z\x13Z\x13\xd9\x102\v2\t\xd2\xfc*\xef*\xef*\xef*\xef(\xef(\xef(\xef(\xefp\xf5a\x00a\x00a\x00`\x00`\x00`\x00`\x00^\x00]\x00^\x00`\x00}\x01\xa5\x01\xa5\x01$\x00\xb8\xfe\xb8\xfe\xb7\xfe\xb7\xfe\xb7\xfe\xb7\xfe\xb7\xfe\xeb\xf5\xdf\xed\xdf\xed\xdf\xed\xde\xed\xde\xed\xde\xed\xde\xed\xde\xed\xde\xed\xdc\xed\xe6\xed\x18\xee\x18\xee\x16\xee\x16\xeeo\xee\xb7\xee\xb7\xee\xc1\xef\xba\xf4\xba\xf4\xba\xf4\x11\x01\xf4\x06\xf4\x06\xf4\x06\xf4\x06\xf3\x06\xf3\x06\xf3\x06\xf1\x06\xf0\x06\xf0\x06\x1c\x05'\x01'\x01'\x01'\x01'\x01$\x01$\x01$\x01\xab\xff\xd0\xf9\xd0\xf9\xc1\xee\xdd\xeb\x99\xeb\x99\xeb\x99\xeb\x99\xeb\x98\xeb\x98\xeb\x98\xeb\x98\xeb\x97\xeb\x1f\xeb\x95\xea\x95\xea\x95\xea\x95\xea\x95\xea\x94\xea\x94\xea\x94\xea\x92\xea\x92\xea\xc4\xead\xecm\xec0\xf1\xf2\xf2\xf1\xf2\x95\xfc\xdc\r\xdc\r\xdc\r\xdc\r\xdc\r\x1f\x199 9 9 9 9 7 7 5 5 5 \xef\x1b\xfd\x0e\xfd\x0e\xfb\x0e\xfa\x0e\xfa\x0e\xfa\x0e\xfa\x0e\xf9\x0e\xf9\x0e\xe7\x0e\xf8\x04>\x10D\x14D\x14C\x14C\x14C\x14C\x14C\x14A\x14@\x14@\x14\x93\f\x9f\x00\x9f\x00\x9e\x00\x9e\x00\x9e\x00\x9e\x00\x9e\x00\x9c\x00\x9c\x00\x9b\x00\x9b\x00\x9b\x00\x9c\x00\x9b\x00Q\xf6\n\xed\n\xed\n\xed\n\xed\n\xed\t\xed\t\xed\x04\xf5\x13\xfc\x10\xfc\x10\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\x0f\xfc\xc5\xf9\xec\xed\xec\xed\xec\xed\xea\xed\xea\xed\xea\xed\xe9\xed\xe9\xed\xe9\xed\xe9\xed\xe9\xed\x99\va\x1aa\x1a_\x1a_\x1a^\x1a^\x1a^\x1a^\x1a^\x1a]\x1a[\x1aZ\x1aZ\x1aZ\x1aZ\x1aZ\x1aX\x1aX\x1aX\x1aW\x1aV\x1aV\x1aV\x1aT\x1aT\x1aS\x1aS\x1aS\x1aR\x1aR\x1a\xcd\x1e\xe7\x1f\xe6\x1f=\x1e\a\x1c\a\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x04\x1c\x04\x1c\x04\x1c\x1f\x1bv\rg\ff\fd\fd\fd\fd\fc\fc\fc\fb\f@\x17\xd7\x1f\xd7\x1f\xd7\x1f[\x1f6\x18\xae\x17\xad\x17\xab\x17\xab\x17\xab\x170\x17\x13\x15\x13\x15\x13\x15\x11\x15\x10\x15\x10\x15\x11\x15\x10\x15\x10\x15\x10\x15\x0e\x15\xd3\x16\xa3\x17\xa3\x17\xa2\x17\xa0\x17\xa0\x17\xa0\x17\xa0\x17\x9f\x17\x9d\x17\x9d\x17h\x11\xa3\x03\"\x04\"\x04 \x04-\x043\x043\x043\x043\x042\x042\x04l\x14G\x18F\x18D\x18D\x18C\x18C\x18D\x18C\x18C\x18A\x18\x9a\x0f.\x04.\x04.\x04.\x04.\x04-\x04+\x04*\x04*\x04+\x04\x03\x05\xee\x12\xd2\x04\x12\x01\x12\x01\x12\x01\x12\x01\x11\x01\x11\x01\x11\x01\x10\x01\x10\x01T\xf8'\xf1'\xf1'\xf1'\xf1'\xf1'\xf1&\xf1&\xf1$\xf1$\xf1\x91\xf1\xa7\xf3\xa7\xf3\xa8\xf3\xa8\xf3\xa7\xf3\xa7\xf3\xa5\xf3\xa5\xf3\xa4\xf3\xa4\xf3\xa4\xf38\xf3S\xef,\xec,\xec+\xec+\xec+\xec*\xec*\xec*\xec(\xec\r\xf1]\xfd]\xfd]\xfd]\xfd]\xfd]\xfd\\\xfd\\\xfd[\xfdY\xfdY\xfd\x85\xeeD\xeaD\xeaB\xeaD\xeaD\xeaB\xeaB\xeaB\xeaA\xeaA\xea\a\xefe\xfd\x81\x10\x80\x10\x80\x10\x03\x16\xb1\x17\xb1\x17\xaf\x17\xaf\x17\xae\x17\xad\x17\xf4\x17\xfd\x17\xfd\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfa\x17\xfa\x17\xf8\x17\xf8\x17 \x1b@\x1e@\x1e>\x1e>\x1e>\x1e=\x1e=\x1e<\x1e<\x1e:\x1eb\x1e\x8b\x1f\x8c\x1f\x8c\x1f\x8b\x1f4\x0f0\xfe0\xfe0\xfe.\xfe.\xfe.\xfeQ\xf2\xae\xea\xae\xea\xae\xea\xad\xea\xad\xea\xad\xea\xad\xea\xab\xea\xaa\xea\xab\xea\xff\xea \xec \xec\x1f\xec\x1f\xec\x1f\xec\x1f\xec\x1d\xec\x1d\xec\x1c\xec\x1c\xec\x1a\xec\xbc\xea+\xea,\xea,\xea*\xea*\xea*\xea)\xeau\xf1\x92\xf4\x92\xf4\xf3\x00\xf0\x1a\xef\x1a\xef\x1a\xef\x1a\xed\x1a\xed\x1a\xec\x1a\xec\x1a\xed\x1a\xec\x1a\xeb\x1a<\x1a\x11\x1a\x11\x1a\x10\x1a\x10\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\r\x1a\x91\x17$\x14#\x14\"\x14\"\x14\"\x14 \x14 \x14 \x14 \x14 \x14U\x147\x1a\xdd\x1a\xda\x1a7\x1d]\x1f[\x1f[\x1f[\x1fZ\x1fZ\x1fY\x1f \x1e\xf9\x1c\xf9\x1c\xf9\x1c\xf9\x1c\xf9\x1c\xf8\x1c\xf8\x1c\xf8\x1c\xf7\x1c\xf4\x1c\xc8\x1ax\vm\vk\vk\vj\vj\vj\vh\vh\vh\vg\vs\x17K\x1fI\x1fH\x1fG\x1fG\x1fG\x1fG\x1fG\x1fE\x1ft\x1e]\x1b\xcd\x15\xcd\x15\xcd\x15\xcb\x15\xcb\x15\xca\x15\xca\x15\xca\x15\xc9\x15\xc9\x15\xc9\x15K\r\x8e\t\x8e\t\x8d\t\x8d\t\x8b\t\x8b\t\x8b\t\x8a\t\x8a\t\x8a\t_\v\xa5\x0f\xa5\x0f\xa5\x0f\xa4\x0f\xa4\x0f\xa4\x0f\xa2\x0f\xa2\x0f\xa1\x0f\xa1\x0f\xa1\x0f\xe8\x17@\x1a>\x1a>\x1a>\x1a\x97\x1a\xa0\x1c\x9f\x1c\x9f\x1c\x9f\x1c\x9d\x1c\x95\x1d$\x1f$\x1f$\x1f$\x1f$\x1f#\x1f#\x1f!\x1f!\x1f!\x1f \x1f;\x18\x1e\x17\x1e\x17\x1e\x17\x1c\x17\x1c\x17\x1b\x17\x1b\x17\x1b\x17\x1b\x17\xad\x16\x1f\x12\xdf\n\xa0\x05\x12\x03\xdf\x01\xcf\x01\xd0\x01\xd0\x01\xd0\x01\xd2\x01\xd3\x01\xae\x01\xcf\xff\xb0\xff\xb0\xff\xb1\xff\xb1\xff\xb0\xff\xb1\xff\xb2\xff\xb2\xff\xb2\xff\xb4\xffm\xff2\xff4\xff4\xff4\xff4\xff4\xffN\xed\xc2\xe3\xc2\xe3Q\xe3\xf6\xe4\xee\xf5\xee\xf5\xee\xf5\xee\xf5\xee\xf5\xf1\xf5\xf2\xf5\xf1\xf5\xf1\xf5\xf1\xf5\xf2\xf5`\xf2*\xf0*\xf0+\xf0+\xf0+\xf0+\xf0,\xf0,\xf0,\xf0.\xf0n\xf1\xf8\xf5\xf9\xf5\xf9\xf5%\xf9s\xfas\xfas\xfau\xfav\xfav\xfav\xfa\xda\xec\xb1\xe6\xb1\xe6\xb1\xe6\xb2\xe6\xb3\xe6\xb3\xe6\xb3\xe6\xb5\xe6\xb5\xe6\xb5\xe6P\xecF\xfaF\xfaF\xfaG\xfaG\xfaG\xfaI\xfaJ\xfaJ\xfaK\xfaK\xfa\xbd\x0eR\x15R\x15S\x15U\x15U\x15U\x15U\x15U\x15U\x15V\x155\x14&\x12(\x12(\x12(\x12(\x12)\x12*\x12*\x12,\x12,\x12,\x127\x0f\x93\x0e\x93\x0e\x93\x0e\x95\x0e\x95\x0e\x95\x0e\x95\x0eF\x0fr\x0fr\x0f\x0f\fV\aW\aX\aX\aX\aX\aZ\aZ\a[\a[\a\x89\a\x85\x14?\x16?\x16?\x16@\x16@\x16@\x16@\x16A\x16C\x16C\x16\xbb\x12\xe1\x0e\xe1\x0e\xe1\x0e\xe1\x0e)\nB\tB\t\xb0\xfc\xa2\xed\xa3\xed+\xee\x9a\xf4\x04\xf5\x04\xf5\x05\xf5\x05\xf5\a\xf5\a\xf5\b\xf5\b\xf5\b\xf5\v\xf5q\xee\xb9\xe8\xb9\xe8\xba\xe8\xba\xe8\xba\xe8\xbc\xe8\xbd\xe8\xbd\xe8\xbd\xe8\xbf\xe8R\xea\x0e\xf5\x0f\xf5\x0f\xf5\x10\xf5\xdd\x01T\x0fU\x0fU\x0fU\x0fW\x0fW\x0f\xb6\x03\xad\xfb\xad\xfb\xad\xfb\xae\xfb\xae\xfb\xae\xfb\xae\xfb\xb0\xfb\xb0\xfb\xb1\xfb\x81\xfc#\x00#\x00$\x00&\x00&\x00&\x00'\x00'\x00(\x00(\x00(\x00Q\xfd\xbb\xfb\xbb\xfb\xbc\xfb\xdc\xf8\x85\xe5\x85\xe5\x85\xe5\x85\xe5\x85\xe5\x86\xe5\x14\xea.\xf9.\xf9.\xf90\xf90\xf91\xf91\xf93\xf94\xf94\xf94\xf9]\xee\x83\xe9\x83\xe9\x85\xe9\x87\xe9\x86\xe9\x87\xe9\x87\xe9\x89\xe9\x89\xe9\x89\xe9\xb8\xe8\x98\xe6\x9a\xe6\x9a\xe6\x9b\xe6\v\xe4\x93\xe2\x94\xe2\x94\xe2\x96\xe2\x96\xe2\x97\xe2\x81\xedf\xf1g\xf1g\xf1g\xf1i\xf1i\xf1j\xf1k\xf1k\xf1k\xf1m\xf1{\xeeE\xe2E\xe2F\xe2F\xe2G\xe2G\xe2I\xe2I\xe2I\xe2J\xe2J\xe2J\xe2\xf6\xe1\xaa\xe1\xaa\xe1\xac\xe1\xac\xe1\xac\xe1\x9d\xfa\xe8\x02\xe8\x02\xe9\x02\xe9\x02\xea\x02\xea\x02O\x139\x169\x16;\x16;\x16<\x16<\x16<\x16=\x16?\x16?\x16?\x16\xad\x114\a6\a6\a7\a9\a9\a:\a:\a;\a;\a=\a=\a\x12\a\xfa\x06\xfa\x06\xfa\x06\xfb\x06\xfb\x06\xfc\x06\xfc\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x06\b\b\x12\x13\xac\x13\xad\x13\xad\x13\xae\x13\xae\x13\xb0\x13\xb0\x13\xb0\x13\xb1\x13\xb1\x13\xb2\x13,\r\x99\x03\x99\x03\x9a\x03\x9a\x03\x9a\x03\x9c\x03\x9c\x03\x9d\x03\x9d\x03\x9d\x03\x9d\x03\x9f\x03<\x03\x18\x03\x18\x03\x18\x03\x1b\x03&\x01j\xecI\xebK\xebK\xebL\xebL\xeb\x9a\xee\xdc\xfd\xdc\xfd\xdd\xfd\xdd\xfd\xde\xfd\xde\xfd\xe0\xfd\xe0\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xe1\xfd\xbb\xfa\x8e\xf7\x8f\xf7\x90\xf7\x90\xf7\x92\xf7\x92\xf7\x92\xf7\x93\xf7\x93\xf7\x94\xf7\x94\xf7\x94\xf7\xcc\xee\xd6\xec\xd7\xec\xd7\xec\xd8\xec\xd8\xec\xd8\xec\xd8\xec\xdb\xec\x02\xfc\a\x04\a\x04\xef\x05/\v0\v0\v2\v2\v2\v3\v3\v6\v6\v6\v6\v3\x06\xa0\x02\xa0\x02\xa1\x02\xa1\x02\xa1\x02\xa1\x02\xa3\x02\xa4\x02\xa4\x02\xa4\x02\xa5\x02\xa7\x02\xa8\x02\xaa\x02\xaa\x02\xaa\x02\xab\x02\xac\x02\xac\x02\xac\x02\xc0\a\x8e\bE\t\xbe\tA\bm\x05m\x05m\x05l\x05l\x05l\x05j\x05i\x05g\x05f\x05f\x05f\x05f\x05f\x05e\x05c\x05b\x05b\x05b\x05b\x05a\x05a\x05a\x05_\x05_\x05_\x05^\x05\\\x05\\\x05\\\x05[\x05Z\x05X\x05X\x05X\x05X\x05W\x05W\x05W\x05U\x05H\x05\x8e\x03Z\x03Y\x03\xf3\x02\x90\x02\x90\x02\x8e\x02\x8e\x02\x8e\x02\xae\x10\xfa\x15\xf8\x15\xf8\x15\xf7\x15\xf4\x15\xf4\x15\xf4\x15\xf4\x15\xf3\x15\xf3\x15\xf1\x15\xd5\x15K\x15K\x15K\x15J\x15J\x15H\x15G\x15G\x15G\x15G\x15D\x15D\x15J\f~\x02}\x02}\x02}\x02{\x02{\x02z\x02z\x02x\x02\xa5\x02~\x03~\x03\xb3\x04e\x05\x95\x05\x94\x05\x94\x05\x94\x05\x92\x05\x92\x05\x8f\x05\x8f\x05\x8f\x05\x8e\x055\x05\x05\x04\x05\x04\x05\x04\x05\x04\x02\x04\x02\x04\x00\x04\x00\x04\xff\x03\xfe\x03\xfe\x03\xfc\x03\xfc\x03\xfc\x03\xfc\x03\xfb\x03\xfa\x03\xfa\x03\xf8\x03\xf8\x03\xf8\x03\xf7\x03\xf5\x03\xf5\x03\xf5\x032\x14Q\x17O\x17O\x17N\x17N\x17N\x17M\x17\xc5\x01B\xfcB\xfcB\xfc%\xf7\xa7\xe9\xa6\xe9\xa6\xe9\xa4\xe9\xa4\xe9\xa4\xe9\xa2\xe9\xa2\xe9\xa0\xe9\xa0\xe9\xa0\xe9\x9f\xe9\xa3\xf3\xeb\xfa\xeb\xfa\xe9\xfa\xe9\xfa\xe8\xfa\xe7\xfa\xe7\xfa\xe5\xfa\xe4\xfa\xe3\xfa\xe3\xfa\xa8\xfa\xf7\xea\xd5\xe8\xd3\xe8\xd3\xe8\xd0\xe8\xd0\xe8\xcf\xe8\xcf\xe8\xcf\xe8\xce\xe8\xcc\xe8\xcc\xe8\xcc\xe8\xcc\xe8\xcc\xe8\xcb\xe8\xca\xe8\xc8\xe8\x8c\xec\xdb\xef\xd8\xeff\xf0\xd0\xf3\xd0\xf3\xd0\xf3\xce\xf3\xce\xf3\xce\xf3\xcd\xf3\xcc\xf3\xcc\xf3\xcc\xf3\xca\xf3\xc9\xf3\xc7\xf3\xc7\xf3\xc7\xf3\xe3\xf3\xd5\xf5\x04\xf6\x04\xf6\x03\xf6\x01\xf6\x01\xf6\x01\xf6\x00\xf6\x00\xf6\xfd\xf5\xfd\xf5\xfd\xf5D\xf5\xe7\xf3\xe6\xf3\xe6\xf3\xe4\xf3\xe3\xf3\xe2\xf3\xe2\xf3\xe2\xf3\xe0\xf3\xe0\xf3\xdf\xf3\xdd\xf3\xc2\xf3\xb3\xf3\xb3\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb0\xf3h\x00\"\x05\"\x05\"\x05 \x05#\x05\\\x05_\x05_\x05\\\x05\\\x05\\\x05Z\x05Z\x05Z\x05X\x05W\x05W\x05\xd4\n\x1f\x14\x1f\x14\x1e\x14\x1e\x14\x1b\x14\x1b\x14\x1b\x14\x19\x14\x18\x14\x17\x14\x17\x14\x17\x14\xe4\x16H\x18H\x18H\x18G\x18F\x18F\x18D\x18C\x18C\x18A\x18A\x18@\x18=\x18=\x18<\x18<\x189\x188\x188\x186\x186\x184\x184\x18\xb5\x18\x05\x181\x160\x160\x16.\x16.\x16-\x16+\x16*\x16*\x16)\x16'\x16'\x16\x18\v\xe5\x05\xe4\x05\xe2\x05\xe1\x05\xdf\x05\xdf\x05\xde\x05\xdd\x05\xdb\x05\xdb\x05\xda\x05\x10\x06\xd9\a\xe6\a\xe4\a\xe2\a\xe2\a\xe2\a\xdf\a\xde\a\xde\a\xde\a\xdb\a\xdb\a!\b\x8f\b\x8e\b\x8e\b\x8e\b\x8b\b\x8b\b\x8a\b\x89\b\x87\b\x84\b\x84\b\x83\b\xc3\x13\xf0\x18\xf0\x18a\x17\x93\x13\x93\x13\x91\x13\xab\x04\v\x00\v\x00\n\x00\n\x00\t\x00\x06\x00\x05\x00\x03\x00\x03\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\xfe\xff\xfe\xff\xfd\xffo\xf8\xcf\xec\xcd\xec\xcd\xec\xcd\xec\xcc\xec\xc9\xec\xc9\xec\xc9\xec\xc7\xec\xc5\xec\xc5\xec\xc4\xec\xc4\xec\xc4\xec\xc3\xec\xc0\xec\xbf\xec\xbd\xec\xbd\xec\xbc\xec\xbc\xec\xbc\xec\xb9\xec\xb9\xecb\xee\v\xfd\x97\xfd\x95\xfd\x94\xfd\x93\xfd\x93\xfd\x8e\xfd\x8e\xfd\x8e\xfd\x8d\xfd\x8c\xfd\x8a\xfdU\xf7f\xed\x11\xed\xb5\xea\xae\xea\xad\xea\xab\xea\xaa\xea\xa7\xea\xa7\xea\xa6\xea\xa6\xea\xa4\xea\xf2\xf6\x05\xfd\x04\xfd\x04\xfd\x02\xfd\x02\xfd\x00\xfd\xfe\xfc\xfd\xfc\xfd\xfc\xfb\xfc\xf9\xfcn\xfc\xd1\xf6\x82\xf6\x81\xf6\x80\xf6~\xf6|\xf6|\xf6y\xf6y\xf6w\xf6v\xf6u\xf6\xd2\xf8\xe3\xfc\xdc\xfc\xdc\xfc\xf6\xfa\x97\xfa\x96\xfa\x97\xfa\x97\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x97\xfa\x97\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x9a\xfa\x9a\xfa\x9a\xfa\x92\xfa\f\xfa\x02\xfa\x02\xfa\x02\xfa\x8d\x01\xdb\f\xdc\f\xdc\f\xdc\f\xde\f\xde\f\xde\f\x8b\x06d\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfaf\xfag\xfa\x86\xfdo\xffp\xffp\xffp\xffp\xffp\xffs\xffr\xffp\xffr\xffr\xff\xe1\xff\n\fx\rx\rx\ry\ry\ry\ry\ry\ry\rz\ry\rj\f\f\n\f\n\f\n\x0e\n\x0e\n\x0e\n\x0f\n\x0f\n\x0f\n\x0f\n\x0f\n\x11\n.\t\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8e\b\x8e\b\x8e\bB\v\x14\x13\x14\x13 \x14L\x14L\x14N\x14L\x14L\x14N\x14N\x14O\x14O\x14O\x14O\x14\xe0\x14o\x16o\x16p\x16p\x16p\x16p\x16p\x16s\x16s\x16s\x16s\x16s\x16\x12\r\n\x05\v\x05\v\x05\v\x05\v\x05\r\x05\r\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\xcf\x069\a:\a:\a:\a;\a=\a=\a=\a=\a>\a>\a?\a\xa1\b6\x17\x13\x18\x14\x18\x14\x18\x14\x18\x15\x18\x17\x18\x18\x18\x18\x18\x18\x18\x18\x18\x19\x18\x1b\x18\x91\x17\xac\x15\xac\x15\xac\x15\xac\x15K\x16\xcb\x16\xc9\x16\xe9\x16\x81\x17\x8b\x17\x8c\x17\x8c\x17\x8c\x17}\x17`\x17^\x17^\x17^\x17^\x17^\x17^\x17^\x17^\x17^\x17^\x17^\x17`\x17]\x0f\b\a\b\a\b\a\b\a\n\a\b\a\b\a\n\a\n\a\n\a\n\a\n\a\n\a\xd9\x12\xf8\x19\xf8\x19\xf8\x19\xf7\x19\xf7\x19\xf7\x19\xf8\x19\xf8\x19\xf8\x19\xf8\x19\xf8\x19\xf8\x19\xf8\x19\xf6\x19\xf6\x19\xf7\x19\xf7\x19\xf7\x19\xf6\x19\xf6\x19\xf6\x19\xf6\x19\xf6\x19\xf6\x19\xf6\x19\xf6\x19\xf6\x19\xf6\x19\xf4\x19\xf4\x19\xf4\x19\xf6\x19\xf6\x19\xf4\x19\xf3\x19\xf3\x19\xf4\x19\xf4\x19\xf4\x19\xf4\x19\x19\x173\a3\a3\a2\aA\x02\x01\xfd\x01\xfd\x1b\xfbY\xe74\xe64\xe64\xe64\xe6\x98\xe6\x95\xe7\x95\xe7\x95\xe7\x94\xe7\x95\xe7\x95\xe7\x95\xe7\x95\xe7\x95\xe7\x95\xe7\x95\xe7\x95\xe7\x95\xe7!\xee\x90\xf7\x90\xf7\x8f\xf7\x8f\xf7\x8f\xf7\x90\xf7\x90\xf7\x90\xf7\x90\xf7\x90\xf7\x90\xf7\x90\xf7\x90\xf7,\xee\x14\xe6\x14\xe6\x14\xe6\x14\xe6\x14\xe6\x14\xe6\x13\xe6\x14\xe6\x14\xe6\x14\xe6\x14\xe6\x14\xe6\x14\xe6\x14\xe6\x15\xe6\x15\xe6\x15\xe6\x15\xe6\x15\xe6\x15\xe6\x15\xe6\x15\xe6\x15\xe6\x15\xe6\x17\xe6\x17\xe6\x17\xe6.\xeaH\xebH\xebH\xebH\xebH\xebH\xebG\xebH\xebH\xebH\xebH\xebI\xeb\xe9\xeb\xb8\xf7\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xee\xf8\xee\xf8\xee\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8K\xf8r\xf5G\xfc\x10\xfd\x10\xfd\x10\xfd\x10\xfd\x10\xfd\x10\xfd\x10\xfd\x10\xfd\x10\xfd\x10\xfd\x10\xfd\xb3\xf8\x00\xee\xff\xed\xff\xed\x00\xee\x00\xee\x00\xee\x00\xee\x00\xee\x00\xee\x00\xee\xff\xed\x00\xee\x00\xee\x8a\xef\xbe\xf1\xc0\xf1\xc0\xf1\xc0\xf1\xc0\xf1\xc0\xf1\xc0\xf1\xc0\xf1\xc0\xf1\xc0\xf1\xc0\xf1\xc0\xf1\xc1\xf1\xf8\xf5\xb6\xf9\xb7\xf9\xb7\xf9\xb7\xf9\xb6\xf9\xb6\xf9\xb6\xf9\xb6\xf9\xb6\xf9\xb6\xf9\xb6\xf9\xb6\xf9\xb6\xf9\xb2\xf3n\xf0n\xf0n\xf0n\xf0n\xf0n\xf0n\xf0n\xf0n\xf0n\xf0n\xf0n\xf0n\xf0I\xf1\x8d\xf1\x8d\xf1\x8d\xf1\x8d\xf1\x8d\xf1\x8d\xf1\x8d\xf1\x8d\xf1\x8d\xf1\x8d\xf1\x8d\xf1\x8d\xf1\u007f\xf1t\xee\t\xee\t\xee\a\xee\t\xee\t\xee\t\xee\t\xee\t\xee\t\xee\t\xee\t\xee\t\xee\x11\xeeP\xeeP\xeeO\xeeO\xee\xb6\xeao\xe6o\xe6o\xe6o\xe6o\xe6o\xe6o\xe6o\xe6^\xe6+\xe6+\xe6+\xe6+\xe6+\xe6+\xe6+\xe6+\xe6+\xe6+\xe6+\xe6+\xe6+\xe6\xa9\xecO\xf8O\xf8O\xf8O\xf8N\xf8O\xf8O\xf8O\xf8O\xf8O\xf8O\xf8O\xf8O\xf8\xd5\xf5\x05\xf3\x05\xf3\x05\xf3\x05\xf3\x05\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x04\xf3\x05\xf3\x05\xf3\x97\xeb1\xe61\xe61\xe61\xe62\xe62\xe62\xe61\xe61\xe61\xe62\xe62\xe62\xe6\x0f\xf3\xdf\xf8\xdf\xf8\xe0\xf8\xe0\xf8\xe0\xf8\xe0\xf8\xe0\xf8\xe0\xf8\xe0\xf8\xe0\xf8\xe0\xf8\xe0\xf8\xe0\xf8\xfe\xe95\xe65\xe65\xe65\xe65\xe65\xe65\xe65\xe65\xe65\xe65\xe64\xe6\xb9\xe6\b\xf2I\xf3H\xf3I\xf3I\xf3H\xf3H\xf3H\xf3J\xf3J\xf3I\xf3I\xf3I\xf3\x19\xf4\xdc\xf8\xdc\xf8\xde\xf8\xde\xf8\xdc\xf8\xdc\xf8\xdc\xf8\xde\xf8\xde\xf8\xde\xf8\xde\xf8\xde\xf8\xde\xf83\xf4<\xe6<\xe6<\xe6;\xe6;\xe6<\xe6<\xe6<\xe6<\xe6<\xe6;\xe6;\xe6<\xe6\x11\xe8h\xebh\xebh\xebh\xebh\xebh\xebh\xebh\xebh\xebh\xebh\xebh\xebh\xebK\xea\xf7\xe8\xf8\xe8\xf8\xe8\xf8\xe8\xf7\xe8\xf8\xe8\xf8\xe8\xf7\xe8\xf7\xe8\xf7\xe8\xf7\xe8\xf7\xe8\xf7\xe8\xed\xefj\xf5j\xf5j\xf5j\xf5j\xf5j\xf5j\xf5l\xf5l\xf5l\xf5l\xf5l\xf5j\xf5\xa6\xec\x1c\xe8\x1c\xe8\x1e\xe8\x1e\xe8\x1e\xe8\x1e\xe8\x1e\xe8\x1e\xe8\x1e\xe8\x1e\xe8\x1e\xe8\x1e\xe8\x1e\xe8\xb8\xe6D\xe6F\xe6F\xe6D\xe6D\xe6D\xe6F\xe6F\xe6F\xe6D\xe6D\xe6F\xe6F\xe6\xca\xe7\n\xe8\n\xe8\n\xe8\n\xe8\n\xe8\n\xe8\n\xe8\n\xe8\n\xe8\n\xe8\n\xe8\n\xe8\x8e\xe9\x0f\xf8\xd4\xf8\xd4\xf8\xd4\xf8\xd5\xf8\xd5\xf8\xd4\xf8\xd5\xf8\xd5\xf8\xd5\xf8\xd5\xf8\xd5\xf8\xd5\xf8\xb1\xf8\x12\xf8\x12\xf8\x12\xf8\x12\xf8\x12\xf8\x12\xf8\x12\xf8\x12\xf8\x12\xf8\x11\xf8\x11\xf8\x11\xf8\x12\xf8g\xf3y\xe7y\xe7y\xe7y\xe7y\xe7y\xe7w\xe7w\xe7y\xe7y\xe7y\xe7y\xe7w\xe7\xf9\xed\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd0\xf8\xd0\xf8\xd0\xf8\xd0\xf8\xd0\xf8\xd0\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd0\xf8\xd0\xf8\xd0\xf8\xd0\xf8\xd0\xf8\xce\xf8\xce\xf8\xce\xf8\xce\xf8\xd0\xf8\xd0\xf8\xd0\xf8\xd0\xf8\xd0\xf8\xd0\xf8\xce\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xce\xf8\xce\xf8\xd0\xf8\xd0\xf8\xce\xf8\xce\xf8\xce\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcc\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcd\xf8\xcd\xf8\xcd\xf8t\xea\\\xe6Z\xe6Y\xe6Z\xe6\\\xe6\\\xe6\\\xe6\\\xe6\\\xe6\\\xe6\\\xe6\\\xe6\\\xe6\\\xe6\xc4\xf3\xc9\xf8\xc9\xf8\xc9\xf8\xc9\xf8\xc9\xf8\xc9\xf8\xc9\xf8\xca\xf8\xca\xf8\xc9\xf8\xc9\xf8\xc9\xf8\xc9\xf8\xca\xf8\xca\xf8\xc9\xf8\xc7\xf8\xc9\xf8\xc9\xf8\xc9\xf8\xc7\xf8\xc7\xf8\xc7\xf8\xc9\xf8\xc9\xf8\xc9\xf8\xc9\xf8\xc9\xf8\xca\xf8Y\xeda\xe6a\xe6a\xe6a\xe6a\xe6a\xe6a\xe6a\xe6a\xe6a\xe6a\xe6a\xe6a\xe6a\xe6d\xe6d\xe6d\xe6d\xe6c\xe6c\xe6c\xe6d\xe6d\xe6d\xe6c\xe6d\xe6d\xe6d\xe6d\xe6\xb5\xe6\x01\xe7\x01\xe7\x02\xe7\x02\xe7\x02\xe7\x02\xe7\x01\xe7\x02\xe7\x02\xe7\x02\xe7\x02\xe7\x02\xe7\x02\xe7\x03\xe7\x14\xef\xc3\xf8\xc5\xf8\xbc\xf9K\rr\x0fr\x0f\x8d\x11\xb9\x13\xb9\x13\xb9\x13\xb9\x13\xb9\x13\xb9\x13\xb9\x13\xb8\x13\xb8\x13\xb8\x13\xb8\x13\xb8\x13\xb8\x13\xb8\x13\xb8\x13\xb7\x13\xb7\x13\xb7\x13\xb7\x13\xb8\x13\xb8\x13\xb8\x13\x8f\r`\x01`\x01`\x01`\x01`\x01`\x01a\x01`\x01`\x01`\x01`\x01`\x01a\x01`\x01\x02\x06>\x12>\x12>\x12>\x12>\x12>\x12?\x12>\x12>\x12>\x12>\x12>\x12>\x12>\x12~\x12n\x13n\x13o\x13o\x13o\x13o\x13o\x13o\x13o\x13p\x13o\x13n\x13n\x13o\x13w\x13\xb0\x13\xb0\x13\xb0\x13\xb0\x13\xb1\x13\xb0\x13\xb0\x13\xae\x13\xae\x13\xae\x13\xb0\x13\xae\x13\xae\x13\xae\x13&\x12a\x02e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01e\x01f\x01f\x01f\x01f\x01p\x01d\x03\xaa\x03\xaa\x03\xa8\x03\xa8\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xa8\x03\xa8\x03\xa7\x03\xa7\x03\xd4\x01i\x01h\x01h\x01i\x01h\x01h\x01h\x01h\x01h\x01i\x01i\x01i\x01h\x01h\x01i\x01k\x01k\x01i\x01i\x01k\x01k\x01k\x01k\x01i\x01i\x01i\x01k\x01k\x01i\x01-\r\xe3\x12\xe1\x12\xe1\x12\xe3\x12\xe3\x12\xe3\x12\xe4\x12\xe3\x12\xe3\x12\xe3\x12\xe3\x12\xe1\x12\xe1\x12\xe1\x12F\x13\x89\x13\x89\x13\x89\x13\x89\x13\x89\x13\x8a\x13\x89\x13\x89\x13\x89\x13\x89\x13\x89\x13\x89\x13\x89\x13\x89\x13\x97\x13\xa2\x13\xa2\x13\xa2\x13\xa2\x13\xa2\x13\xa2\x13\xa1\x13\xa1\x13\xa2\x13\xa1\x13\xa1\x13\xa1\x13\xa2\x13\xa1\x13\xa1\x13\x9f\x13\x9f\x13\x9f\x13\x9f\x13\xa1\x13\xa1\x13\xa1\x13\x9f\x13\x9f\x13\x9f\x13\x9f\x13\x9f\x13\x9f\x13\x9f\x13\b\x12q\x0fo\x0fo\x0fo\x0fo\x0fo\x0fo\x0fo\x0fo\x0fo\x0fo\x0fn\x0fn\x0fo\x0f\x97\v\xcb\x02\xc9\x02\xc9\x02\xc9\x02\xcb\x02\xcb\x02\xcb\x02\xcb\x02\xcb\x02\xcb\x02\xcb\x02\xcb\x02\xcb\x02\xcb\x02\xea\x05\x9c\x10\x9c\x10\x9c\x10\x9c\x10\x9c\x10\x9c\x10\x9b\x10\x9b\x10\x9b\x10\x9c\x10\x9c\x10\x9c\x10\x9c\x10\x9c\x10b\x0et\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01t\x01s\x01>\x02r\fM\rM\r\x8a\xfff\xf2f\xf2f\xf2f\xf2f\xf2f\xf2f\xf2f\xf2g\xf2g\xf2g\xf2\xb7\xf7\xad\xf8\xad\xf8\xad\xf8\xad\xf8\xad\xf8\xad\xf8\xad\xf8\xad\xf8\xaf\xf8\xaf\xf8\xaf\xf8\xaf\xf8\xaf\xf8\xaf\xf8\u007f\xebg\xe7g\xe7g\xe7g\xe7g\xe7g\xe7g\xe7h\xe7h\xe7h\xe7h\xe7h\xe7h\xe7h\xe7!\xf3\xac\xf8\xac\xf8\xac\xf8\xaa\xf8\xaa\xf8\xac\xf8\xac\xf8\xac\xf8\xac\xf8\xac\xf8\xaa\xf8\xac\xf8\xac\xf8\xad\xf8\xf3\xed\x98\xe6\x98\xe6\x98\xe6\x97\xe6\x97\xe6\x98\xe6\x98\xe6\x98\xe6\x98\xe6\x98\xe6\x98\xe6\x98\xe6'\xee9\xf0`\xf9G\x02E\x02E\x02E\x02G\x02G\x02G\x02H\x02G\x02G\x02G\x02G\x02G\x02G\x02E\x02E\x02E\x02D\x02D\x02D\x02E\x02G\x02E\x02D\x02E\x02E\x02E\x02E\x02E\x02{\xfd\xb2\xf3\xb2\xf3\xb2\xf3\xb0\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb0\xf3\xb2\xf3\xb2\xf3,\xf7C\x02C\x02A\x02A\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02C\x02>\x02(\x02(\x02(\x02(\x02(\x02(\x02\xfa\x04\xbf\x12\xbf\x12\xbf\x12\xbe\x12\xbe\x12\xbf\x12\xbf\x12\xbf\x12\xd4\x12\xd5\x12\xd5\x12\xd5\x12\xd5\x12\xd5\x12\xd5\x12\xd5\x12\xd5\x12\xd5\x12\xd5\x12\xd4\x12\xd4\x12\xd5\x12\xd5\x12\x02\x05\xf5\x01\xf5\x01\xf5\x01\xf4\x01\xf4\x01\xf4\x01\xf5\x01\xf5\x01\xf4\x01\xf4\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x011\x02[\x02[\x02Z\x02Z\x02Z\x02[\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02[\x02[\x02Z\x02\xd6\x01\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00Z\x02G\x0e\xb7\x0e\xb7\x0e\xb7\x0e\xed\x050\xf90\xf90\xf90\xf91\xf91\xf90\xf90\xf90\xf90\xf90\xf9\x1d\xefY\xebY\xebY\xebW\xebW\xebW\xebY\xebY\xebW\xebW\xebW\xebW\xebW\xebW\xebW\xeb\x0e\xf4@\xfdB\xfdB\xfdB\xfdC\xfdB\xfdC\xfdC\xfdC\xfdC\xfdC\xfdB\xfdB\xfdB\xfdC\xfdC\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfdB\xfdB\xfdB\xfdB\xfdB\xfd@\xfd@\xfd@\xfd@\xfd@\xfd@\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd?\xfd>\xfd>\xfd?\xfd?\xfd?\xfd?\xfd?\xfd\xb8\xfb\xc1\xfa\xc1\xfa\xb0\xff\x88\x14\x88\x14\x88\x14\x88\x14\x88\x14\x88\x14\x88\x14\x88\x14\x88\x14\x89\x14\x88\x14\x88\x14\xf0\x0e6\x056\x056\x058\x058\x058\x058\x058\x058\x058\x058\x056\x056\x056\x056\x055\x05-\x05-\x05-\x05-\x05+\x05+\x05-\x05-\x05-\x05+\x05+\x05+\x05+\x05-\x05+\x05+\x053\x11\xc2\x15\xc2\x15\xc2\x15\xc2\x15\xc2\x15\xc2\x15\xc2\x15\xc2\x15\xc2\x15\xc2\x15\xc2\x15\xc2\x15\xc2\x15\xc2\x15\xc0\x15\x94\x15d\x15d\x15d\x15d\x15c\x15c\x15d\x15d\x15d\x15c\x15c\x15c\x15d\x15c\x15c\x15\xbc\x15\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xfa\x16\xc7\x15\xc7\x13\xc6\x13\xc6\x13\xb8\xfd\x92\xf8\x90\xf8\x92\xf8\x93\xf8\x93\xf8\x93\xf8\xeb\xe9\xca\xe6\xca\xe6\xca\xe6\xca\xe6\xc8\xe6\xca\xe6\xca\xe6\xca\xe6\xca\xe6\xca\xe6\xca\xe6\xca\xe6\xca\xe6\xca\xe6\xca\xe6;\xe7\x90\xe7\x90\xe7\x90\xe7\x90\xe7\x90\xe7\x90\xe7\x90\xe7\x90\xe7\x90\xe7\x90\xe7\x91\xe7\x91\xe7\x91\xe7\x90\xe7\x91\xe7\xd7\xec\x8e\xf8\x8e\xf8\x8e\xf8\x8e\xf8\x8e\xf8\x8e\xf8\x8e\xf8\x8e\xf8\x8e\xf8\x8e\xf8\x8f\xf8\x8f\xf8\x8e\xf8\x8e\xf8\x8f\xf8\xd1\xf7K\xeb\xfa\xe9\xfa\xe9\xf8\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xfa\xe9\xef\xe7\xd3\xe6\xd3\xe6\xd2\xe6\xd2\xe6\xd2\xe6\xd3\xe6\xd3\xe6\xd3\xe6\xd3\xe6\xd3\xe6\xd3\xe6\xd3\xe6\xd3\xe6\xd5\xe6\xd5\xe6\x1a\xecy\xf4}\xf7[\x10r\x11r\x11h\x13\x19\x15\x19\x15\x19\x15\x19\x15\x19\x15\x18\x15\x18\x15\x18\x15\x19\x15\x98\x15\x15\x19)\x19)\x19)\x19'\x19'\x19)\x19)\x19)\x19)\x19'\x19'\x19'\x19)\x19)\x19)\x19&\x19&\x19&\x19&\x19&\x19&\x19&\x19&\x19%\x19%\x19&\x19&\x19&\x19&\x19%\x19%\x193\x11{\ay\ay\ay\ay\a{\a{\a{\a{\a{\a{\a{\a{\ay\ay\a\xaf\n \x19 \x19 \x19 \x19 \x19 \x19 \x19 \x19 \x19 \x19 \x19 \x19 \x19 \x19 \x19 \x19\x8b\v}\a}\a}\a|\a|\a|\a}\a|\a|\a|\a|\a|\a|\a|\a}\a\xb6\x0e\xf6\x15\xf6\x15\xf4\x15\xf4\x15\xf4\x15\xf6\x15\xf6\x15\xf6\x15\xc2\x0e[\xf5[\xf5\\\xf5\\\xf5\\\xf5\\\xf5\x16\xf2\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe5\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6\xe6(\xf5~\xf8~\xf8~\xf8\x80\xf8\x80\xf8\x80\xf8\x80\xf8~\xf8\x80\xf8\x80\xf8\x80\xf8\x80\xf8\x80\xf8\x80\xf8\x80\xf8\x14\xf1\x9d\xea\x9d\xea\x9d\xea\x9d\xea\x9d\xea\x9d\xea\x9d\xea\x9f\xea\x9f\xea\x9f\xea\x9f\xea\x9f\xea\x9d\xea\x9d\xea\x9d\xea\x05\xeb,\xec,\xec.\xec.\xec,\xec,\xec,\xec,\xec,\xec.\xec.\xec.\xec.\xec.\xec.\xec.\xec,\xec\xf5\xf1\x80\xf4\x80\xf4\x80\xf4\x82\xf4\x82\xf4\x82\xf4\x80\xf4\x82\xf4\x82\xf4\x82\xf4\x82\xf4\x82\xf4\x82\xf4\x82\xf4\x82\xf4\x82\xf4m\xf43\xf43\xf43\xf43\xf43\xf43\xf43\xf43\xf43\xf43\xf43\xf43\xf43\xf43\xf43\xf43\xf43\xf4\xa0\xed\xde\xea\xde\xea\xde\xea\xe0\xea\xe0\xea\xe0\xea\xde\xea\xde\xea\xde\xea\xe0\xea\xe0\xea\xe0\xea\xe0\xea\xe0\xea\xe0\xea\xe0\xea\xa9\xeev\xf8v\xf8v\xf8w\xf8w\xf8w\xf8w\xf8w\xf8w\xf8w\xf8w\xf8w\xf8v\xf8w\xf8w\xf8w\xf8w\xf8\x03\xf6\xff\xf4\x00\xf5\x01\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\xf8\xf5s\xf8s\xf8u\xf8u\xf8u\xf8s\xf8s\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8u\xf8s\xf8r\xf8s\xf8s\xf8r\xf8r\xf8r\xf8s\xf8s\xf8s\xf8s\xf8s\xf8s\xf8s\xf8s\xf8s\xf8s\xf8\xdc\xf7D\xf6D\xf6D\xf6D\xf6E\xf6E\xf6D\xf6D\xf6D\xf6D\xf6D\xf6E\xf6E\xf6D\xf6D\xf6D\xf6E\xf6\xc6\xf7o\xf8o\xf8o\xf8q\xf8q\xf8q\xf8q\xf8o\xf8q\xf8q\xf8o\xf8o\xf8q\xf8q\xf8q\xf8q\xf8\x00\xf5!\xeb!\xeb!\xeb \xeb \xeb \xebA\xf2\x15\a\x15\a\x13\a\x13\a\x13\a\x15\a\x13\a\x13\a\x13\a\x13\a\x9d\x05\xea\x04\xea\x04\xea\x04\xea\x04\xea\x04\xea\x04\xea\x04\xea\x04\xea\x04\xea\x04\xec\x04\xec\x04\xec\x04\xea\x04\xea\x04\xea\x04p\b\xbb\x13\xbb\x13\xbb\x13\xbc\x13\xbc\x13\xbb\x13\xbb\x13\xbc\x13\xbc\x13\xbc\x13\xbb\x13\xbb\x13\xbc\x13\xbb\x13\xbb\x13\xbb\x13\xbc\x13#\x14Z\x14Y\x14Y\x14Z\x14Z\x14Z\x14Z\x14Z\x14Y\x14Y\x14Y\x14Y\x14Y\x14Y\x14Z\x14Z\x14\xb4\x10\x00\x03\x00\x03\x00\x03\x00\x03\x02\x03\x02\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\xa7\x05\f\x12\f\x12\f\x12\f\x12\v\x12\v\x12\f\x12\v\x12\v\x12\v\x12\f\x12\f\x12\f\x12\v\x12\v\x12\v\x12\v\x12M\f6\b7\b7\b6\b6\b6\b7\b6\b6\b6\b6\b6\b7\b6\b6\b6\b\x8e\ab\x03V\x03V\x03W\x03W\x03W\x03W\x03W\x03W\x03V\x03\f\xfb6\xf46\xf46\xf46\xf46\xf46\xf4\xd4\xf4\\\xf5[\xf5[\xf5[\xf5[\xf5^\xf5\\\xf5[\xf5\\\xf5\\\xf5\\\xf5\\\xf5\\\xf5\\\xf5\\\xf5[\xf5_\xf5|\xf5}\xf5}\xf5}\xf5|\xf5}\xf5}\xf5}\xf5}\xf5|\xf5}\xf5}\xf5}\xf5}\xf5}\xf5}\xf5}\xf5\xbb\xf4\xee\xf3\xee\xf3\xee\xf3\xee\xf3\xee\xf3\xee\xf3\xee\xf3\xee\xf3\xee\xf3\xee\xf3\xee\xf3\xed\xf3\xee\xf3\xef\xf3\xef\xf3\xee\xf3b\xf4\xe9\x00\x95\x02\x95\x02\x94\x02\x94\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02\x95\x02R\xfc\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf1\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3I\xf4q\xf4q\xf4q\xf4q\xf4q\xf4r\xf4r\xf4q\xf4q\xf4q\xf4r\xf4r\xf4r\xf4q\xf4q\xf4r\xf4r\xf4i\xf4\x01\xf4\xf8\xf3\xf8\xf3\xf8\xf3\xf6\xf3\xf6\xf3\xf8\xf3\xf8\xf3\xf8\xf3\xf6\xf3\xf6\xf3\xf6\xf3\xf6\xf3\xf6\xf3\xf6\xf3\xf8\xf3\xf8\xf3\xf6\xf3\x9a\xf8w\x02w\x02w\x02w\x02w\x02x\x02x\x02w\x02w\x02w\x02w\x02w\x02w\x02w\x02x\x02x\x02x\x02w\x02\x99\x02\xb6\x02\xb6\x02\xb6\x02\xb6\x02\xb6\x02\xb6\x02\xb6\x02\xb6\x02\xb7\x02\xb6\x02\xb6\x02\xb6\x02\xb6\x02\xb5\x02\xb5\x02\xa0\xff\xf4\xf5\xf4\xf5\x10\xea9\xe79\xe79\xe79\xe79\xe79\xe79\xe79\xe79\xe79\xe79\xe79\xe79\xe79\xe79\xe79\xe79\xe7\xdc\xe9\xd4\xf4\xd4\xf4\xd4\xf4\xd4\xf4\xd4\xf4\xd4\xf4\xd4\xf4\xd4\xf4\xd4\xf4\xd4\xf4\xd4\xf4\xd4\xf4\xd5\xf4\xd5\xf4\xd5\xf4\xd5\xf4\xd4\xf4\xd4\xf4\xeb\xf5Y\xf7Y\xf7Y\xf7Y\xf7X\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Y\xf7Z\xf7Z\xf7Z\xf7Z\xf7Y\xf7\x1a\xed\t\xe8\t\xe8\t\xe8\t\xe8\t\xe8\t\xe8\t\xe8\t\xe8\n\xe8\n\xe8\t\xe8\t\xe8\t\xe8\n\xe8\n\xe8\t\xe8\t\xe8\x1e\xe8{\xe9\xa3\xe9\xa3\xe9\xa3\xe9\xa3\xe9\xa3\xe9\xa3\xe9\xa3\xe9\xa3\xe9\xa3\xe9\xa3\xe9\xa3\xe9\xa3\xe9\xa3\xe9\xa3\xe9\xa4\xe9\xa4\xe9\xa3\xe9\x8a\xe9L\xe9L\xe9L\xe9L\xe9L\xe9L\xe9L\xe9L\xe9L\xe9L\xe9L\xe9L\xe9L\xe9L\xe9L\xe9L\xe9L\xe9K\xe9\x8a\xeb\xab\xed\xab\xed\xab\xed\xab\xed\xab\xed\xab\xed\xab\xed\xab\xed\xab\xed\xab\xed\xab\xed\xab\xed\xab\xed\xab\xed\xab\xed\xab\xed\xab\xed\xab\xed \xf4^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6_\xf6_\xf6^\xf6^\xf6^\xf6`\xf6_\xf6^\xf6^\xf67\xf5\x9d\xecL\xecL\xecL\xecL\xecL\xecL\xecL\xecL\xecL\xecL\xecL\xecL\xecL\xecL\xecL\xecL\xecN\xec_\xf0F\xf8F\xf8F\xf8F\xf8D\xf8F\xf8F\xf8F\xf8F\xf8F\xf8F\xf8F\xf8F\xf8F\xf8F\xf8F\xf8F\xf8D\xf8C\xf8B\xf8C\xf8C\xf8C\xf8D\xf8D\xf8C\xf8C\xf8C\xf8C\xf8D\xf8D\xf8C\xf8C\xf8D\xf8D\xf8D\xf8D\xf8\x05\xebY\xe7R\xe8T\xe9T\xe9T\xe9T\xe9T\xe9T\xe9T\xe9S\xe9T\xe9T\xe9T\xe9T\xe9T\xe9T\xe9T\xe9\xf6\xeaI\xf4I\xf4I\xf4H\xf4H\xf4H\xf4H\xf4H\xf4I\xf4I\xf4H\xf4H\xf4H\xf4I\xf4I\xf4H\xf4I\xf4I\xf4b\xf6\x05\xfa\x04\xfa\x04\xfa\x04\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x04\xfa\x04\xfa\x04\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\\\xf0[\xe9]\xe9]\xe9]\xe9]\xe9]\xe9]\xe9[\xe9]\xe9]\xe9]\xe9]\xe9]\xe9]\xe9]\xe9]\xe9]\xe9]\xe9]\xe9\x94\xeb\xcb\xec\xcb\xec\xcb\xec\xcb\xec\xcb\xec\xcc\xec\xcb\xec\xcb\xec\xcb\xec\xcb\xec\xcb\xec\xcb\xec\xcb\xec\xcb\xec\xcb\xec\xcc\xec\xcc\xec\xcb\xec\xcb\xecC\xf4Q\xf7Q\xf7Q\xf7O\xf7O\xf7Q\xf7Q\xf7Q\xf7Q\xf7Q\xf7Q\xf7Q\xf7Q\xf7Q\xf7R\xf7R\xf7R\xf7Q\xf7Q\xf7\x97\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf6\xf8\xf6\xf8\xf6\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf6\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xf8\xfb\xf91\xfa1\xfa0\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa1\xfa\x9e\xf9P\xebl\xe9j\xe9j\xe9j\xe9j\xe9j\xe9j\xe9j\xe9j\xe9l\xe9l\xe9l\xe9l\xe9l\xe9m\xe9l\xe9l\xe9l\xe9\xa6\xe9\xcb\xeb\xf0\xeb\xf1\xeb\xf1\xeb~\xf6\xdb\t\xdb\t\xdb\t\xd9\tb\x02\xbc\xfd\xbc\xfd\xbc\xfdz\xfd\x0f\xf5\xdc\xf3\xdc\xf3\xdc\xf3\xdd\xf2\x1f\xf0a\xee]\xee^\xee\x90\xeaw\xe7w\xe7w\xe7w\xe7\xd0\x01\xcf\a\xcf\a\xcf\aW\a\xbd\x05\xbd\x05\xbd\x05\xbd\x05\xd7\x05\xf5\x05+\t\xad\x16\xad\x16\x97\x11\xe4\x0f\xe4\x0f\xe4\x0fC\x11\x86\x18\x86\x18\x85\x18\x85\x18\x85\x18\x86\x18\x86\x18\x86\x18\x85\x18$\x04\xb4\xfa\xb3\xfa\xb0\xf6'\xec\xe5\xff(\x01(\x01(\x01\x84\x03\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd2\a\xd2\a\xd2\a\xb2\a\x80\x03\xe6\x02\xe6\x02\xe6\x02\xff\x04:\n:\n:\n:\n\x94\xf9\xcc\xeb\xce\xeb\xce\xeb\xce\xebb\x04:\n:\n:\n6\x03\xe7\xe9\xe7\xe9\xe7\xe9\xe7\xe9X\xf9p\t\xd6\a\xd7\a\xd7\a\xbc\x008\xfe8\xfe8\xfe\xe5\xfa\x8d\xe7\x84\xe7\x84\xe7\x84\xe7\xb2\xf3r\x05r\x05r\x05r\x05\xa5\x02>\x01>\x01@\x01\x81\x00\x01\xf8F\xf7F\xf7F\xf7y\xf4\xe9\xee\xe8\xee\xe8\xee\xe8\xee\x85\xea\x88\xe7\x88\xe7\x88\xe7\x98\xe7K\xfe+\x02-\x02\xe2\b\a\x144\x184\x184\x184\x18[\a\xe4\xf7\xe4\xf7\xe4\xf7\xe4\xf7\xe6\xf7\xe6\xf7\xe6\xf7\xe4\xf7\xe4\xf7\xe6\xf7\xe6\xf7\xe6\xf7\xe6\xf7\x00\x03\xc3\x10\xc3\x10\xc3\x10\xc3\x10r\x15g\x17g\x17g\x17\x85\x16\xe0\x0f\x99\x0f\x99\x0f\x99\x0f\xb5\x10\x9a\x12\x9a\x12\x9a\x12\x9a\x12\x9f\x15\x02\x17\x97\x16\x96\x16h\x15\xe7\xf9I\xf6I\xf6J\xf6J\xf6I\xf6I\xf6J\xf6J\xf6\x19\b\x84\x16\x84\x16\x85\x16\x85\x16z\x00V\xfb\xc7\xf3\xa0\xec\xa7\xec\xba\xec\xba\xec\xba\xec\xb9\xec\xde\xf8L\x06L\x06L\x06L\x06p\x05 \x05 \x05 \x05x\x05\x9d\a\xa4\a\xa5\a\xa5\ag\b\x92\t\x92\t\x92\t\x92\td\xfc\\\xf5\\\xf5\\\xf5\xc3\xf6L\v|\rz\rz\r\x9f\x04[\xf1[\xf1[\xf1[\xf1\xc0\x01\v\x0e\x85\x0e\xca\x0f\xca\x0f\x01\xf9F\xf4F\xf4F\xf4\x8b\xfbc\x13c\x13c\x13a\x13\u007f\x12\x95\x11\x95\x11\x95\x11\x95\x11\a\x0f(\x0e(\x0e(\x0e6\fE\x01E\x01E\x01D\x01\x92\x06Q\x0eQ\x0eQ\x0eR\x0e\xde\x02\v\xfd\v\xfd\f\xfd\xf2\xfc\xa1\xfb\x83\xfb\x83\xfb\x81\xfb\x1f\x049\x16\xcf\x15\xc9\a\xbd\x05n\xf8\xb6\xee\xb7\xee\xb7\xee\xb7\xeeu\xeb\xcf\xea\xcf\xea\xcf\xea\xba\xf1\xe4\a\xe4\a\xe6\a\xe6\a\xe6\a\xe4\a\xe4\a\xe6\a\xe6\a\xc9\xef\xa4\xe7\xa4\xe7\xa3\xe7\x86\xec\xe9\a\xe9\a\xe9\a\xe9\a\x17\xfe\xb4\xef\xb4\xef\xb4\xef\xb4\xef\r\xeb\xa7\xe8\xa7\xe8\xa7\xe8\xff\xe9\xd1\xfb\x98\xfd\xf4\x05(\vo\x0ez\x15z\x15z\x15z\x15\x02\x037\xf58\xf58\xf58\xf5\xdf\x0fz\x15z\x15z\x15;\x14\v\x10\v\x10\v\x10\v\x10w\x04\x15\xf8\x15\xf8\x15\xf8\x15\xf8\x15\xf8\x15\xf8\x15\xf8\x13\xf88\xf8\x1b\xf9\x1c\xf9\x1c\xf9\x1c\xf9^\x05U\x18U\x18U\x18U\x18?\x16\x1b\x15h\x0f\xb2\x04\xd8\x04\x86\a\xd7\a\xd7\a\xd9\a\xb1\x00\x1c\xf0\x1c\xf0\x1c\xf0\x1c\xf0\x11\xed\x9d\xea\x9f\xea\x9f\xea\x9f\xeaB\xe8\xaf\xe7\xaf\xe7\xaf\xe7\u007f\xe8\x92\xeb\x92\xeb\x92\xeb\x92\xeb\xd6\xe9\xce\xe7\xce\xe7\xce\xe7\xce\xe7=\xe9\xd0\xe9\xd0\xe9\xd0\xe9\xf0\xec\x03\x04\xf4\x04\xf4\x04\xf4\x04z\x03\xed\x00\xea\x00\xd5\x00\xd3\x00j\xf2\x87\xe9\x87\xe9\x87\xe9\x13\xea\xbd\x001\x042\x042\x04\xde\x04\xae\x06\xae\x06\xae\x06\xac\x06V\a\xf1\a\xf1\a\xf1\a\xf1\a\x97\x14F\x18F\x18F\x18F\x16\xe9\f\xe9\f\xe9\f\xe7\f\x11\x045\xf85\xf85\xf85\xf8|\xfc\x83\xfe\x83\xfe\x85\xfez\xfe\x00\xfe\xf5\xfd\xf3\xfd\xf3\xfd\a\x04r\x10r\x10r\x10r\x10\xfa\x14D\x18D\x18D\x18D\x18D\x18D\x18D\x18D\x18s\x17\xb9\x14\xb9\x14\xbb\x14\xb9\x14n\x0f\xb3\t\xb3\t\xb1\t\xb3\t\xb3\t\xb3\t\xb3\t\xb3\t\xb3\t\xb3\t\xb3\t\xb3\t\xb1\tv\xfd{\xe9{\xe9{\xe9{\xe9N\xec\xf7\xed\xf7\xed\xa9\xedL\xec\x06\xeeD\xeeD\xeeD\xee\x1a\xf5\xd2\x06\xd2\x06\xd2\x06\xd2\x06\x9a\xfa\xa7\xef\xa7\xef\xa5\xef\xa5\xef\x85\xe9\xc4\xe7\xc4\xe7\xc4\xe7\xc4\xe7\xc4\xe7\xc4\xe7\x11\xe9\x8e\xe9\x8d\xe9\x8d\xe9\x8e\xe9\x8e\xe9\x8e\xe9\xd2\xeb\xe9\xec\xe9\xec\xea\xeck\xee\x00\x00\x9b\x01\x9b\x01\x9b\x01\x02\xfa\xe5\xe9\xe5\xe9\xe5\xe9\xe7\xe9z\xfa\xed\v\xbf\x12\xbf\x12\xbf\x12\xbf\x12\xbe\x12\xbe\x12\xbf\x12\xeb\rc\xfcc\xfcc\xfcd\xfc\x1a\xf9J\xf5L\xf5L\xf5L\xf5\xf8\xf6\xa4\xf7\xa4\xf7\xa4\xf7\x1a\xf9r\x04\xff\x04\xff\x04\xff\x04\xce\t}\x12}\x12}\x12}\x12{\x02\x01\xf8\x00\xf8\x01\xf8\x01\xf8\x01\xf8\x01\xf8\x00\xf8\x01\xf8\x05\x002\x182\x182\x181\x180\x18.\x180\x180\x180\x18.\x18.\x180\x18.\x18\xa9\x13U\xf8\x00\xf8\xff\xf7\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\x00\xf8\xff\xf7\xff\xf7\xfc\xf7\xf8\xf8K\x14+\x18+\x18+\x18\xaa\x10\x10\xfd\x10\xfd\x10\xfd\x10\xfdj\xfa\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xff\xf7\xfd\xf7\xfc\xf7\xfd\xf7a\xfc\x86\x12\x10\x12\xfd\x11\xfd\x11L\x14\x9d\x17\x9f\x17\x9f\x17\x9f\x172\x15\xec\x13\xec\x13\xec\x13\xeb\x13\xeb\x13\xec\x13\xec\x13\xec\x13-\x13h\x11h\x11h\x11h\x11\xab\xfb_\xe9_\xe9_\xe9_\xe9P\xec\x19\xed\x18\xed\x18\xed\x8d\xf1H\x05F\x05F\x05H\x05C\xfc8\xf08\xf08\xf09\xf0E\x01\x8b\t\x8b\t\x8b\f2\x172\x172\x172\x172\x17\xa7\x0f\x1f\xff\x1f\xff\x1f\xff\x1e\xff\x02\x06|\v|\vz\vz\v\x8a\r\r\x0e\x0f\x0e\x0f\x0ef\x0e\xce\x0f\xce\x0f\xd9\x06\xc0\x03\xd9\xf8\xfd\xea\xfb\xea\xfb\xea\xfb\xea\xfb\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xeap\xf1\xfd\xfe\xfd\xfe\xfd\xfe\xfd\xfe\x1a\xf4\xf0\xeb5\xec5\xec5\xec\xf1\xf3\xc5\xf5\xc5\xf5\xc3\xf5\xc5\xf5\xc5\xf5\xc5\xf5\xc5\xf5\xc5\xf5\xe6\xf1\x18\xed\x18\xed\x18\xed\x18\xedp\xf1g\xf3g\xf3g\xf3\xea\xf5\x9e\x0f\xb2\x11\xb2\x11\xb2\x11t\x12\t\x14\t\x14\t\x14\t\x14\xe3\x14\x89\x15\x89\x15\x89\x15\x89\x15\x88\x15\x88\x15\x89\x15\x89\x15\x89\x15\x89\x15\x89\x15\x89\x15\x89\x15\xe3\r_\x04_\x04_\x04_\x04\x14\x04\xf3\x03\xf3\x03\xf3\x03\xf5\x03\t\x04\n\x04\n\x04\n\x04\n\x04\n\x04\n\x04\n\x04\n\x04\x88\xf4\x85\xe8\x85\xe8\x85\xe8\x85\xe8e\x00-\x06-\x06-\x06\xa7\x05\x88\x03\x88\x03\x88\x03\x8a\x03n\xf7\xf2\xe7\xf2\xe7\xf2\xe7\xf2\xe7\xcd\xfd\x15\b\x15\b\x15\bx\aY\x00\xb1\xff\xb1\xff\xb2\xffl\xfe\xa1\xfb\xa1\xfb\xa1\xfb\xa1\xfbg\xfah\xf9h\xf9h\xf9h\xf9V\xfb\xd5\xfb\xd5\xfb\xd5\xfb\xd5\xfb\xd3\xfb\xd3\xfb\xd3\xfb\xd3\xfb3\xfa\x00\xf8\x00\xf8\x00\xf8\x00\xf8S\xed\xfb\xe7\xfb\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\x18\xe81\xe81\xe81\xe81\xe81\xe81\xe82\xe82\xe8&\xed\x92\x05\x92\x05\x92\x05\x91\x05\x99\x06\x1a\b\x1a\b\x1a\b\x1a\b\x1a\b\x1a\b\x1a\b\x1a\b\xe4\x06\xad\xeb\xfe\xe7\xfe\xe7\xfe\xe72\xe8\xb9\xe8\xb9\xe8\xb9\xe8\xb9\xe8\xbc\xe8\xbd\xe8\xbc\xe8\xbc\xe8\xbc\xe8\x8f\xeev\xf0v\xf0v\xf0r\xef\x85\xe9u\xe9t\xe9t\xe9t\xe9t\xe9u\xe9u\xe9u\xe9\x8e\xe8\x02\xe8\x02\xe8\x02\xe8l\xe8l\a\x96\f\x96\f\x96\f\xfa\r)\x12)\x12(\x12(\x12l\x03\xe7\xf3\xe7\xf3\xe7\xf3\xe7\xf3\xe7\xf3\xe7\xf3\xe7\xf3\xe6\xf3\xe6\xf3\xe6\xf3\xe6\xf3\xe7\xf3\xe7\xf3\x89\xfdI\x0fI\x0fI\x0fI\x0f}\x00,\xf6,\xf6+\xf6+\xf6,\xf6,\xf6+\xf6+\xf6\x17\xfdC\x16C\x16C\x16C\x16C\x16C\x16C\x16C\x16C\x16\x87\f\"\b\"\b$\b$\b$\b$\b$\b$\b\f\xfe\r\xe8\r\xe8\r\xe8\r\xe8\r\xe8\r\xe8\r\xe8\r\xe8\r\xe8\x9d\xec\xd8\xed\xda\xed\xda\xed\xbd\xf1C\x04\x1d\x10\xee\x11\xef\x11\xef\x11\xef\x11\xee\x11\xee\x11\xee\x11\xa7\x14#\x16\"\x16\"\x16\x1f\x15h\xfd#\xfa#\xfa\"\xfa\"\xfa#\xfa#\xfa#\xfa#\xfa\x12\xf8\x0e\xf6>\xf6\xfa\xf6\xfa\xf6\xb3\x0e\xf3\x16\xf4\x16\xf4\x16\xf7\x16\f\x17\r\x17\r\x17\r\x17\xf4\x16\xcb\x16\xcb\x16\xcb\x16\xcb\x16X\x15^\x14^\x14`\x14`\x14N\x0fM\x0eM\x0e\xa0\x0e\xd3\f\xa5\x04\xa5\x04\xa4\x04\xa4\x04\xa5\x04\xa5\x04\xa5\x04\xa5\x04\xa4\x04\xcb\xfb\xd5\xf7\xd5\xf7\xd5\xf7\xd5\xf7\xd5\xf7\xd5\xf7\xd7\xf7\xd7\xf7\xd7\xf7\xd7\xf7\xd5\xf7\xd5\xf7\xd5\xf7,\xf4 \xf1 \xf1 \xf1 \xf1r\xf2\xd2\xf2\xd3\xf2\xd3\xf2\xa3\xf3\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xf2\xf7N\xf8N\xf8N\xf8N\xf8N\xf8N\xf8N\xf8N\xf8\xb0\xf8A\bG\x04\xb2\x01\xb2\x01Y\x03/\b/\b/\b/\b/\b/\b0\b/\b/\b/\b/\b/\b/\b/\b/\b/\b/\b0\b\xdf\x00\x95\xf2\x12\xf3S\x00>\x02J\xfc\xce\xf7\xce\xf7\xcd\xf7\xcd\xf7\xce\xf7\xce\xf7\xce\xf7\xce\xf7\x05\xfdh\x13h\x13h\x13h\x13h\x13h\x13i\x13i\x13i\x13w\x12\xf7\x11\xf7\x11\xf6\x11\xf7\x10#\xfb)\xf8)\xf8)\xf8'\xf8'\xf8'\xf8'\xf8'\xf8|\xfa\xca\xfc\xca\xfc\xca\xfc\xca\xfc\xca\xfc\xc8\xfc\xc8\xfc\xc8\xfc3\xfc\xfc\xf7\xcd\xf7\xcd\xf7\xcd\xf7m\xf3B\xebB\xebB\xebB\xebB\xebB\xebB\xebB\xebB\xeb9\x05K\vK\vK\vK\vK\vK\vK\vK\v\xe1\xfe!\xee \xee \xee!\xeeL\xec\xcd\xf06\xf66\xf64\xf6'\xf6$\xf6$\xf6$\xf6\xd1\xfe+\x16+\x16+\x16*\x16*\x16*\x16*\x16+\x16+\x16*\x16*\x16*\x16+\x16\x98\x15Y\x11(\x11(\x11\x87\n\xde\x03\xdd\x03\xdd\x03\xdd\x03\xdd\x03\xbd\x06\xe0\b\xe0\b\xe0\b\xe0\b\xe0\b\xe0\b\xe0\b\xe0\b\xe6\x02\xdd\xe8\xdd\xe8\xdd\xe8\xdd\xe8H\xec\x18\xf1\x18\xf1\x18\xf1\x18\xf1\x18\xf1g\xf8\xfd\xff\xfd\xff\x00\x00O\x00Z\x00Z\x00Z\x00\xb5\xff\xcb\xfd\xcb\xfd\xcb\xfd\xcb\xfd\xdd\xfa\xc2\xf7\xc2\xf7\xc2\xf7\xc2\xf7{\x00\xfb\x03\xfb\x03\xfc\x03\xfc\x03\xfc\x03\xfc\x03\xfb\x03\x8e\xfc\x97\xfaE\aE\aE\aF\aE\aE\aE\aF\aF\a\n\bA\bA\bA\b\x95\x03\x97\xeb\x97\xeb\x98\xeb\x98\xebI\xea@\xe8@\xe8@\xe8@\xe8\x06\xfc\x8b\x0e\xda\x15\xdb\x15\xda\x15\xda\x15\xda\x15\xdb\x15\xda\x15g\x0f\x89\xf9\x89\xf9\x89\xf9\x89\xf9\x89\xf9\x89\xf9\x8b\xf9\x8b\xf9\x8b\xf9\xdb\f\xe1\x15\xe1\x15\xe1\x15\xb0\x15\f\x13\xc3\x12\xc3\x12\xb6\f\xab\x04v\x03v\x03t\x03t\x03\xf1\x05E\bE\bE\bE\bF\bE\bE\bF\bJ\x04B\xe9H\xe8J\xe8J\xe8H\xe8H\xe8G\xe8G\xe8G\xe8\xfa\xf5\x83\x05\n\r\n\r\n\r-\x0f\xb4\x0f\xb6\x0f\xb6\x0f\xbb\x10G\x15G\x15G\x15G\x15G\x15G\x15G\x15G\x15G\x15&\x14\x81\x13\x81\x13\x81\x13\f\x13\x87\xf9M\xf5M\xf5\xc6\xf4\xfa\xf3\xfa\xf3\xfa\xf3\xf9\xf3\xfa\xf3C\xff4\f4\f4\f4\f4\f4\f4\f4\f\xc1\fK\x13\xf3\x13\xf5\x13\xf5\x13\xf5\x13\xf3\x13\xf3\x13\xf3\x13\xf5\x13\xcd\x13\xa9\x13o\x14`\x17`\x17`\x17a\x17`\x17`\x17r\x13\xb0\xf8\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xff\t\xaa\x17\xaa\x17\xab\x17\xab\x17\xab\x17\xab\x17\xaa\x17\xaa\x17\xaa\x17Z\x17b\nP\bP\bP\bP\bP\bP\bP\bP\bP\bO\bO\bM\b\xfe\x036\x036\x036\x03\x8d\xff\xe8\xf2\xe8\xf2\xea\xf2\xea\xf2\xf8\xefY\xecY\xecY\xecY\xec[\xec[\xec]\xec]\xecL\xedK\xfdK\xffK\xffK\xffK\xffK\xffK\xffK\xffK\xff\xf2\xf3^\xe8^\xe8`\xe8`\xe8`\xe8^\xe8`\xe8`\xe8\x15\xeb\x0e\x03\xc2\x04\xc2\x04\xc2\x04J\xfc\x10\xea\x10\xea\x10\xea\x10\xea?\xe9\x8d\xe8\x8d\xe8\x8d\xe8\x8d\xe8\xb8\xe9\x15\xea\x15\xea\x15\xea\x15\xea\x15\xea\x14\xea\x14\xea\x15\xea\x04\xf5T\bV\bV\bV\bV\bT\b\xcc\b\x11\n\x11\n^\xf0\x1e\xea\x1e\xea\x1f\xea\x1f\xea\x1f\xea\x1f\xea\x1f\xea\x1f\xea\xfd\xf6\x0f\n\x0f\n\x0f\n\x0f\n\x0f\n\x0f\n\x0f\n\x0e\n\x0e\n\x0f\n\x0f\n\x0f\n\x0f\nF\tg\a\xd1\x12\v\x14\v\x14\xe2\t\x14\xfd\x14\xfd\x14\xfd\x14\xfd\xa1\x0e\x94\x17\x94\x17\x94\x17\x94\x17\x94\x17\x94\x17\x94\x17\x94\x17\xac\x10\xf3\xfc\xf3\xfc\xf3\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf3\xfc\xf3\xfc\xf3\xfc\xf3\x0e|\x0fq\tq\tq\tq\tq\tq\tq\t\n\x01\xff\xec\xff\xec\xff\xec\xfe\xec\xfe\xec\xfe\xec\xfe\xec\xff\xec\xff\xec\x89\x02V\nV\nT\nT\nT\nT\nT\nV\n\xd5\xff\xb4\xec\x9f\xf7\x9f\xf7\x9f\xf7\x9f\xf7\x9f\xf7\x9f\xf7\x9f\xf7\x9f\xf7o\xfaI\xfbI\xfbJ\xfbJ\xfbJ\xfbJ\xfbJ\xfbJ\xfb\xeb\xfb\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x05\xfd\x05\xfd\x05\xfd\x05\xfd\x05\xfd\xec\x0f\xa2\x13\t\x13\v\x13\v\x13\t\x13\t\x13\t\x13\v\x13;\bh\xf7h\xf7h\xf7h\xf7h\xf7g\xf7g\xf7g\xf7g\xf7\x95\x10\xdb\x15\xdb\x15\xdb\x15\xdb\x15\xdb\x15\xdb\x15\xdb\x15\xdb\x15\xdb\x15T\x12f\bf\bf\bf\bg\bf\bf\b\x19\b\xcd\xeei\xeah\xeah\xeah\xeai\xeai\xeai\xeai\xea\xa3\xea\xeb\xea\xec\xea\xec\xea\xec\xea!\xeb=\xeb<\xeb<\xebP\xeb=\xed\xda\xed\xf9\xed\xf8\xed\xd3\xf2:\x01:\x01:\x01<\x01:\x01:\x01:\x01:\x01<\x01+\x06q\bq\bq\bq\bq\bp\bp\bp\b\x8e\b\xdb\b\xdb\b\xdb\b\xdb\b\xdb\b\b\rc\x15a\x15a\x15(\x13;\x12:\x128\x12:\x12:\x12:\x12:\x12:\x12\xda\x0e\xc9\x06\xc9\x06\xc9\x06\xcb\x06\xc9\x06\xc9\x06\xc9\x06\xc9\x06\xc9\x06&\x0fP\x12P\x12P\x12{\x12\x00\x14\x19\x14\xe9\f\xa1\b4\bF\aF\aF\aF\ar\xfa\xf5\xee\xf5\xee\xf5\xee\xf5\xee\xc2\xee\xb0\xee\xb0\xee\xaf\xee\u007f\xee\x12\xed\x00\xed\x00\xed\x00\xed\x87\xec\x90\xeb\x90\xeb\x90\xeb\x90\xeb\xbc\xea\b\xea\b\xea\x02\xeb^\xf6\x82\xf7\x82\xf7\x82\xf7\x82\xf7\xa3\xfb~\x16a\x17c\x17c\x17c\x17c\x17a\x17a\x17a\x17\xfe\x13P\x11P\x11P\x11P\x11P\x11N\x11N\x11N\x11*\x12K\x17a\x17a\x17a\x17M\x17*\x17*\x17)\x17*\x17~\x15;\x14;\x14;\x14;\x14;\x14;\x14;\x14;\x14\xdc\x0f\xd5\xf7\xb6\xf7\xb6\xf7\xb6\xf7\xb6\xf7\xb6\xf7\xb7\xf7\xb7\xf7\xb7\xf7\xeb\t*\x17)\x17)\x17)\x17*\x17\xca\x15\xfe\x14\xfe\x14\x85\x14\x13\x12\x13\x12\x13\x12\x13\x12\x14\x12\x14\x12\x14\x12\x14\x12\x13\x12\xc9\x13\xfe\x14\xfe\x14\xfe\x14\xfe\x14\xff\x14\xff\x14\xfe\x14\xfe\x14\x9d\x0f$\xf5$\xf5$\xf5$\xf5%\xf5%\xf5-\xf5\x9e\xf5\xa9\xf5\x89\b\x84\x15\x82\x15\x82\x15\x82\x15\x84\x15\x84\x15\x84\x15\x84\x15\x84\x15\x82\x15\x82\x15\x82\x15\x82\x15>\t\xaa\xf5\xaa\xf5\xaa\xf5\xa9\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5#\xfa7\xfb7\xfb5\xfb_\xfb\x1f\xfc\x1e\xfc\x1e\xfc\x1e\xfc\x9c\x05\xa2\x14\xa2\x14\xa2\x14\xa2\x14\xa3\x14\xa3\x14\xa2\x14\xa2\x14\xa2\x143\x11c\x10b\x10b\x10b\x10b\x10b\x10b\x10b\x10\xea\a\x8c\xfa\x8c\xfa\x8c\xfa\x8c\xfa\xf6\xf8\xe6\xf7\x00\xf8>\xf8>\xf8>\xf8<\xf8>\xf8>\xf8_\xfdZ\x15Z\x15Z\x15Y\x15Y\x15Y\x15Y\x15Y\x15Z\x15\x88\x03u\xf7u\xf7u\xf7u\xf7u\xf7u\xf7u\xf7u\xf7(\xfb\xd1\f\xd1\f\xd1\f\xd3\fb\a\x9a\xfe\x9a\xfe\x9a\xfe\x9a\xfe@\x00e\x01e\x01e\x01e\x01\x19\a\x86\b\x86\b\x84\b\x84\b\x86\b\x86\b\x84\b\x84\b\x84\b\x84\b\x84\b\x84\b\x84\b\x84\b\x84\b\x84\b\x84\b\x84\b\xc9\xf0\x9c\xea\xec\xeaB\xebB\xebB\xebB\xebB\xebA\xebB\xebB\xebB\xebB\xebB\xeb\x8a\xed5\xef5\xef5\xef5\xef5\xef5\xef5\xef5\xef\xaf\xf2\t\x063\x063\x063\x063\x062\x063\x063\x063\x06\x99\x06\xa8\x06L\x06L\x06L\x06\xc5\a4\b4\b4\b4\b4\b4\b3\b3\b\x1a\a\v\x05\v\x05\v\x05\v\x05\v\x05\v\x05\v\x05\v\x05\v\x05v\xfa$\xf7$\xf7$\xf7\xbf\xf5|\xec!\xec#\xec#\xec\x95\xec{\xed{\xed{\xed{\xed\xd1\xfc\xe2\t\xe2\t\xe2\t\xe2\t\xe2\t\xe3\t\xe3\t\xe3\t\xe2\t\xe2\t\xe2\t\xe3\t\xe2\t\xf7\x01\xf9\xf0\xf9\xf0\xf8\xf0\xf9\xf0\xf9\xf0\xf9\xf0\xf8\xf0\xf8\xf0\xf8\xf0#\x03\xe2\t\xe2\t@\r8\x178\x178\x178\x176\x176\x176\x176\x178\x178\x17u\a\n\xf8\n\xf8\n\xf8\n\xf8\v\xf8\v\xf8\n\xf8\n\xf8Y\xfaN\x14\xe6\x16\xe6\x16\xe6\x16\xe8\x16\xe8\x16\xe8\x16\xe8\x16\xe6\x16p\x16\xf3\x15\xf2\x13\xf4\t\xf4\t\xf4\t\xf4\t\xf4\t\xf4\t\x06\n\x15\v7\v7\v7\v6\v7\v7\v7\v7\v7\v7\v7\v7\v7\v,\xf7(\xed(\xed(\xed(\xed(\xed(\xed(\xed(\xedC\xf30\a0\a\x8b\x06W\x06X\x06W\x06W\x06W\x06X\x06\xf5\x05\xbe\x05\xbe\x05\xbe\x05\xbc\x05\xf8\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03_\x05\xd4\a\xd3\a\xd3\a\xd3\a\xd4\a\xd4\a\xd4\a\xd4\a\xd4\a\xe9\xef\x9b\xea\xbc\xe9\xda\xe8\xda\xe8\xda\xe8\xda\xe8\xda\xe8\xda\xe8\xda\xe8\xda\xe8\xda\xe8\xda\xe8\xda\xe8 \xea\b\xeb\b\xeb\b\xeb\x06\xeb\x06\xeb\x06\xeb\b\xeb\b\xebv\xef\"\bc\bc\bb\bb\bc\bc\bc\bb\bb\bb\b\xd0\v\xe6\x16\xe6\x16\x0e\x05Z\xff\\\xff\\\xffZ\xffZ\xffZ\xff\\\xff\\\xff\xfb\x06\x1f\x17\x1f\x17\x1f\x17\x1f\x17\x1f\x17 \x17\x1f\x17\x1f\x17\x1f\x17 \x17\x1f\x17\x1f\x17\x1f\x17h\x14\xbe\xf9`\xf7`\xf7`\xf7`\xf7a\xf7a\xf7a\xf7_\xf7a\x06)\x16)\x16)\x16)\x16)\x16)\x16)\x16)\x16)\x16)\x16)\x16)\x16)\x16\xfe\x0e\x89\xf9\x89\xf9\x89\xf9\x89\xf9\x89\xf9\x88\xf9\x89\xf9\x89\xf9\x89\xf9\xca\f\x19\x17\x18\x17\x18\x17\x19\x17\x19\x17\x19\x17\x19\x17\x18\x17\x17\x17\x14\x17\x14\x17\x14\x17\x14\x17\x9f\x14\x1b\x11\x1b\x11\x1b\x11\x1b\x11\x1d\x11\x1b\x11\x1b\x11\x1d\x11\x1d\x11\x1d\x11\x1b\x11\x1b\x11\x1d\x11\x1d\r\xd8\xf9\xd8\xf9\xd8\xf9\xd8\xf9\xd7\xf9\xd7\xf9\xd8\xf9\xd8\xf9\xd8\xf9\x84\n\x13\x17\x13\x17\x13\x17\x13\x17\x13\x17\x10\x17\x0e\x17\x0e\x17\r\x17\r\x17\r\x17\r\x17\r\x17\xe7\x14\x81\x10\x81\x10\x81\x10\x81\x10\x81\x10\x81\x10\x81\x10\x81\x10\x81\x10\x81\x10\x81\x10\x81\x10\x81\x10h\x0e\xb3\xf9\xd5\xf7\xd7\xf7\xd7\xf7\xd5\xf7\xd5\xf7\xd5\xf7\xd5\xf7\xd5\xf7\xb5\x02k\x0ek\x0eg\x0eZ\x02\xed\x05\xb1\b\xb1\b\xaf\b\xaf\b\xaf\b\xaf\b\xaf\b\xaf\b\xaf\b\xaf\b\xaf\b\xaf\b\xaf\b\xaf\b\xaf\b\xaf\b\xaf\b\xaf\b\xb1\b\xaf\b\xaf\b\xaf\b\xaf\ba\xee\xfb\xe8\xfb\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfa\xe8\xfb\xe8\xff\xe8\xff\xe8\xff\xe8\x0f\xefj\xf3i\xf3i\xf3i\xf3i\xf3j\xf3j\xf3i\xf3i\xf3i\xf3i\xf3j\xf3j\xf3\xf1\xef\xff\xe8\xff\xe8\xff\xe8\xff\xe8\xff\xe8\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\xff\xe8\xff\xe8\xff\xe8\xff\xe8\xff\xe8\x99\xee\xb0\xf5\xb0\xf5\xb0\xf5\xb0\xf5\xb1\xf5\xb1\xf5\xb0\xf5\xb1\xf5\xb1\xf5\xb1\xf5\x87\b\xb7\x11\xb7\x11\xb7\x11\xb7\x11\xb7\x11\xb7\x11\xb7\x11\xb7\x11\xb7\x11\xb7\x11\xb7\x11\xb7\x11\xb5\x11\xed\x05\xb1\xf5\xb1\xf5\xb1\xf5\xb1\xf5\xb1\xf5\xb1\xf5\xb1\xf5\xb1\xf5\xb1\xf5\xb0\xf5\xb0\xf5\xe6\xf0\xe4\xeb\xe5\xeb\xe5\xeb\xe5\xeb\xe4\xeb\xe4\xeb\xe4\xeb\xe5\xeb\xe5\xeb\xe5\xeb\xe5\xeb\xe4\xeb\xe5\xeb\xe5\xeb\xe5\xeb\xe5\xeb\x98\xf2\xcd\xf4\xcd\xf4\xcc\xf4\xcd\xf4\xcd\xf4\xcd\xf4\xcc\xf4\xcd\xf4\xcd\xf4\xcd\xf4\xcd\xf4\xcd\xf4\xcd\xf4\xa3\xfd\x94\x06\x94\x06\x94\x06\x94\x06\x94\x06)\x06\xcb\x05\xcb\x05\xdd\x04\x17\xf4\xcc\xf1\xcc\xf1\xcc\xf1\xae\xf0#\xed#\xed$\xed$\xed#\xed#\xed#\xed#\xed$\xed\xde\xeb\x1d\xeb\x1d\xeb\x1d\xeb\x1d\xeb\x1d\xeb\x1d\xeb\x1d\xeb\x1d\xeb\x1d\xeb\x1f\xeb\x1f\xeb\x1f\xeb\x1d\xeb\x1d\xeb\x1d\xeb\x1f\xeb\x1f\xeb\x1f\xeb\xfb\xe9\x1b\xe9\x1b\xe9\x1a\xe9\x1a\xe9\x1b\xe9\x1b\xe9\x1a\xe9\x1a\xe9\x1a\xe9\x1b\xe9\x1b\xe9\x1b\xe9\x1a\xe9\x1a\xe9\x1a\xe9\x1a\xe9\x1a\xe9\x1b\xe9/\xefX\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5X\xf5\xe9\xf9\xc3\b\xc3\b\xc3\b\xc2\b\xca\x0e\xe0\x16\xe0\x16\xe0\x16\xe0\x16\xe0\x16\xe0\x16\xe0\x16\xe0\x16\xe0\x16\xde\x16\xde\x16\xe0\x16\xe0\x16\xe0\x16\xe0\x16\xe0\x16\xe0\x16\xe0\x16\xde\x16\xde\x16\xde\x16\xde\x16\xe0\x16?\x11\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x9a\f\xcd\v\x8b\x04\xf3\x03\xf3\x03\xf3\x03\t\xfe\r\xef\r\xef\r\xef\r\xef\r\xef\r\xef\r\xef\r\xef\r\xef\xa8\xeew\xeew\xeew\xeew\xeew\xeew\xeex\xeex\xeew\xeex\xeex\xeex\xeew\xee\r\xef\xed\xef\xee\xef\xee\xef\xee\xef\xa7\xf0'\xf1'\xf1'\xf1'\xf1'\xf1'\xf1'\xf1'\xf1\x96\xf4u\nL\x12\xf9\x13\xf9\x13\xf7\x13\xf7\x13\xf7\x13\xf9\x13\xf9\x13\xf9\x13\xfa\x13\xf9\x13\xf9\x13\xf9\x13\xf6\x15\xd0\x16\xd0\x16\xd0\x16\xd0\x16\xd0\x16\xd0\x16\xd0\x16\xd0\x16\xd0\x16\xd0\x16\xd0\x16\xd0\x16\xd0\x16\xd0\x16\xd0\x16\xd0\x16\xd0\x16\xd0\x16\x91\x16l\x16l\x16l\x16l\x16l\x16k\x16\xe6\x15\\\x15k\x15\xbe\x15\xbf\x15\xc0\x15\xc0\x15\xc0\x15\xc0\x15\xc0\x15\xc0\x15\xc0\x15\xc0\x15\xc0\x15\xbf\x15\xbf\x15\xbf\x15\xc0\x15\xc0\x15\xc0\x15\xc0\x15\xbb\x13\xb5\xfdu\xfbu\xfbu\xfbu\xfbu\xfbu\xfbv\xfbv\xfbv\xfbu\xfbv\xfbv\xfbv\xfb\v\xf8\"\xf6\"\xf6!\xf6!\xf62\xec%\xea&\xea&\xea%\xea&\xea'\xea'\xea&\xea\xa1\xf5t\tt\tt\tt\tt\tt\tt\tt\tt\ts\ts\tt\tt\ty\t\xba\t\xc1\t\xc1\t\xc1\t\xbf\t\xbf\t\xbf\t\xc1\t\xc1\t\xc1\t\xc1\t\xc2\t\xc2\t\xc1\t\xc1\t\xbf\t\xa1\r\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa6\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa6\x15\xa6\x15\xa6\x15\xa6\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa7\x15\xa6\x15\xa6\x15\xa7\x15\xa7\x15\xa7\x15\xa6\x15g\x0f\xe3\b\xe4\b\xe4\b\xe4\b\xe3\b\xe3\b\xe3\b\xa6\b\x98\a\x98\a\x96\a\x96\a\x96\a\x98\a\x98\a\x98\a\x96\a\x96\a\x98\a\x98\a\x98\a\x98\a(\xf1M\xe9M\xe9M\xe9O\xe9O\xe9O\xe9M\xe9M\xe9M\xe9O\xe9O\xe9O\xe9O\xe9\xc6\xf7\xe2\b\xe2\b\xe2\b\xe2\b\xbd\x10\x1e\x15\x1e\x15\x1e\x15\x1e\x15\x1e\x15\x1e\x15\x1e\x15\x1e\x15\x1e\x15\x1e\x15\x1e\x15\x1e\x15\x1e\x15n\x14/\x13/\x13/\x13/\x13/\x13/\x13/\x13/\x13/\x13/\x13/\x13/\x13/\x13<\x13\xda\x13\xec\x13\xec\x13\xec\x13\xfd\x13)\x14)\x14)\x14*\x14)\x14)\x14)\x14)\x14)\x14\xe3\x13\xb8\x13\xb7\x13\xb7\x13\xb7\x13z\x14\xaa\x14\xaa\x14\xaa\x14\xa9\x14\xa9\x14\xa9\x14\xa9\x14\xa9\x14\xaa\x14\xaa\x14\xaa\x14\xaa\x14\xaa\x14\xaa\x14\xaa\x14\xaa\x14\xaa\x14\xaa\x14\x94\xfe\x1a\xf5\x1a\xf5\x1a\xf5\x1a\xf5\x1a\xf5\x1a\xf5\x1a\xf5\x1a\xf5\x1a\xf5\x1a\xf5\x1a\xf5\x1a\xf5\x1a\xf5\x00\xfdX\bX\bX\bX\b\xa2\x0f\x9f\x11\x95\r\x96\r\x96\r\x96\r\x96\r\x96\r\x96\r\x95\r\x95\r\x95\r\x95\r\x95\r\x15\x04\a\xee\a\xee\a\xee\t\xee\t\xee\t\xee\t\xee\t\xee\t\xee\n\xee\t\xee\t\xee\t\xee\t\xee\t\xee\t\xee\t\xee\t\xee\a\xf4\x95\r\x95\r\x95\r\x95\r\x95\r\x95\r\x95\r\x95\r\x95\r\x95\r\x95\r\"\r\x9b\v\x9b\v\x9b\v\x9b\v\x9b\v\x9b\v\x9b\v\x9b\v\x9b\v\x9d\v\x9d\v\x9b\v\x9b\v\x9b\v\x9b\v\x9b\vQ\v\xf1\n\xf1\n\xf1\n\xf2\n\xe2\xf7\x12\xec\x12\xec\x12\xec\x12\xec\x12\xec\x12\xec\x12\xec\x12\xec\x12\xec\x12\xec\x12\xec\x12\xec\x11\xec\x11\xec\x12\xec\x12\xec\x12\xec\x12\xec\x12\xec\x12\xec\x12\xec\x12\xec\x12\xecT\xeb\xfa\xea\xfa\xea\xfa\xea\xbc\xeb\x15\x06\x81\n\x81\n\x81\n\x81\n\x81\n\x81\n\x81\n\x83\n\x83\n\x81\n\x80\n\x80\n\x81\n\x81\n\x81\n\x81\n\x81\n\x81\nE\b|\a{\a{\a{\a{\a{\a{\a{\a{\a{\a{\a{\a{\a\xf2\xfa\xfb\xea\xfb\xea\xfb\xea\xfd\xea\xfd\xea\xfd\xea\xfd\xea\xce\xea\x8d\xe9~\xe9~\xe9~\xe9~\xe9\u007f\xe9\u007f\xe9\u007f\xe9\u007f\xe9~\xe9~\xe9~\xe9~\xe9~\xe9~\xe9\u007f\xe9\u007f\xe9\u007f\xe9~\xe9~\xe9\u007f\xe9\u007f\xe9\u007f\xe9\u007f\xe9\x82\xe9V\xea{\xeaz\xeaz\xea{\xea{\xea{\xea{\xea{\xea{\xea{\xea{\xea{\xea{\xea\xe6\xf7\n\x03\t\x03\t\x03\t\x03\t\x03\t\x03J\a\xdb\r\xdb\r\xdb\r\xdb\r\xda\r\xda\r\xdb\r\xdb\r\xdb\r\xdb\r\xdb\r\xe4\x0eY\x10[\x10[\x10Y\x10Y\x10Y\x10Y\x10Y\x10Y\x10[\x10[\x10Y\x10Y\x10\x0f\x10\xe3\r\xc1\r\xc1\r\xbf\r%\r\xab\v\xab\v\xab\v\xab\v\xab\v\xab\v\xab\v\xab\v\xab\v\xab\v\xac\v\xac\v\xab\v\xab\v\x90\f\xc2\f\a\x0eb\x0fb\x0fb\x0fb\x0fc\x0fc\x0fb\x0fb\x0fb\x0fb\x0fb\x0fb\x0fb\x0fb\x0fb\x0fb\x0f/\x14p\x16p\x16o\x16o\x16o\x16o\x16n\x16n\x16o\x16n\x16n\x16n\x16o\x16o\x16o\x16o\x16o\x16p\x16Y\x16G\x16F\x16F\x16F\x16G\x16G\x16F\x16F\x16F\x16F\x16F\x16F\x16\xf2\x10\xf5\b\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x15\t\x13\t\x13\t\x13\t\x13\t\x12\t\x12\t\x12\t\x12\t\x12\t\x12\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x12\t\x12\t\x12\t\x13\t\x12\t\x12\t\x12\t\x13\t\x13\t\x12\x01\xee\xfe\xee\xfe\xec\xfe\xec\xfe\xec\xfe\xee\xfe\xee\xfe\xee\xfe\xee\xfe\xef\xfe\xf5\xfe\xf5\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xb9\x02\xcd\x04\xcd\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcf\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xcd\x04\xf3\x06\x19\t\x19\t\x1a\t\x1a\t\x19\t\x19\t\x19\t\x19\t\x19\t\x19\t\x19\t\x19\t\x19\t\x1a\t\x19\t\x19\t\x17\t\x17\t\xe9\b\x92\b\x92\b\x92\b\x92\b\xdb\t\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\xc8\ra\x12a\x12a\x12a\x12a\x12a\x12a\x12a\x12a\x12a\x12b\x12b\x12b\x12b\x12b\x12a\x12a\x12a\x12a\x12a\x12a\x12b\x12b\x12b\x12a\x12a\x12a\x12a\x12a\x12a\x12a\x12a\x12a\x12\x95\x0e3\r3\r3\r3\r3\r3\r3\r3\r1\r1\r1\r3\r3\r3\r3\r3\r3\r1\r1\r3\r3\r1\r1\r1\r1\r3\r3\r\xa2\n\x91\xf0\xee\xed\xee\xed\xee\xed\xee\xed\xee\xed\xee\xed\xee\xed\xee\xed\xee\xed\xee\xed\xee\xed\xee\xed\xee\xed\xee\xed\xee\xed\xf0\xed\xee\xed\xee\xed\x9c\x051\r1\r1\r1\r1\r1\r\x85\x10\xcd\x12\xcd\x12\xce\x12\xce\x12\xcd\x12\xcd\x12\xcd\x12\xcd\x12\xcd\x12\xcd\x12\xcd\x12\xcd\x12\xcd\x12\xcd\x12\xcd\x12\xcd\x12\x12\x0e\x9e\f\x9e\f\x9e\f\x9e\f\x9e\f\x9e\f\x9e\f\x9e\f\x9e\f\x9e\f\x9e\f\x9e\f\x9e\f\xa0\f\x9e\f\x9e\f\x9e\f\x9e\f\xf6\xff\x96\xf7\x96\xf7\x96\xf7\x96\xf7[\xf4c\xf3c\xf3b\xf3c\xf3e\xf3e\xf3c\xf3c\xf3b\xf3c\xf3c\xf3c\xf3c\xf3c\xf3\xcc\xf3\xe4\xf4\xe4\xf4\xe4\xf4\xec\x03\xc5\r\xc5\r\xc5\r\xc5\r\xc6\r\xc5\r\xc5\r\xc5\r\xc5\r\xc5\r\xc6\r\xc5\r\xc5\r\xc6\r\xc6\r\xc6\r\xc5\r\xc5\r\xc6\r\xc6\r\xc6\r\xc6\r\xc6\r\xa2\x11.\x14-\x14-\x14-\x14-\x14-\x14-\x14-\x14-\x14-\x14-\x14-\x14-\x14-\x14-\x14-\x14-\x14-\x14-\x14-\x14-\x14-\x14-\x14=\x14J\x14K\x14J\x14J\x14J\x14\xb0\r=\t=\t=\t=\t;\t;\t;\t;\t=\t=\t=\t;\t;\t=\t=\t=\t=\t\xaf\xf6\xd9\xe9\xda\xe9\xda\xe9\xda\xe9\xd9\xe9\xd9\xe9\xd9\xe9\xd9\xe9\xda\xe9\xda\xe9\xda\xe9\xda\xe9\xda\xe9\xd9\xe9\xd9\xe9\xd9\xe9\xd9\xe9\xd9\xe9\xd9\xe9\xd9\xe9\xda\xe9\xda\xe9\xda\xe9K\xef8\xf38\xf38\xf38\xf3\xd9\xfe\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd4\x02\xd4\x02\xd3\x02\xd3\x02\xd3\x02\xd3\x02\xd7\x02\n\x02\xd2\x00\xd2\x00\xd2\x00\xd2\x00\xfe\xfe\x97\xfd\x97\xfd\x97\xfd\x97\xfd\x97\xfd\x97\xfd\x98\xfd\x98\xfd\x97\xfd\x97\xfd\x97\xfd\x97\xfd\x97\xfd\x1a\xfdc\xfbc\xfbd\xfbd\xfbK\xf7\xcd\xf0\xcd\xf0\xcd\xf0\xcd\xf0\xcd\xf0\xcd\xf0\xcd\xf0\xcc\xf0\xcc\xf0\xcd\xf0\xcd\xf0\xcd\xf0\xcd\xf0\xcd\xf0\xcd\xf0\xcd\xf0\xcd\xf0\xcd\xf0x\xf3\xcd\xfd\xcd\xfd\xcb\xfd\xcb\xfd\xcb\xfd\xcb\xfd\xca\xfd\xca\xfd\xcb\xfd\xcb\xfd\xcb\xfd\xcb\xfd\xcb\xfd\xcb\xfd\xcb\xfd\xcb\xfd\xca\xfd\xcd\xfdi\xfd\xdb\xec\xf2\xe9\xf2\xe9\xf0\xe9\xf2\xe9\xf2\xe9\xf2\xe9\xf2\xe9\xf0\xe9\xf0\xe9\xf2\xe9\xf2\xe9\xf2\xe9\xf2\xe9\xf2\xe9\xf2\xe9\xf2\xe9\xf2\xe9\xf2\xe9\xf2\xe9\xf2\xe9\xf3\xe9\xf3\xe9\xf3\xe9\xf2\xe9\xf2\xe9\xf0\xe9\xf2\xe9\xf3\xe9\xf3\xe9\xf3\xe9\xf3\xe9\xf2\xe9\xf2\xe9\xf3\xe9\xf3\xe9\xf3\xe9\xf2\xe9\xf2\xe9\xf3\xe9\xf3\xe9\xf3\xe9\xf3\xe9\xb0\xfd\xc2\b\xc2\b\xc2\b\xc2\b\xc2\b\xc2\b\xc2\b\xc1\b\xc1\b\xc1\b\xc2\b\xc2\b\xc2\b\xc2\b\xc2\b\xc2\b\xc2\b\xc2\b\x06\x06\x14\x03\x19\x04\x19\x04\x19\x04\x19\x04\x19\x04\x19\x04\x19\x04\x19\x04\x19\x04\x19\x04\x19\x04\x19\x04\x19\x04\x19\x04\x19\x04\x19\x04\x19\x04J\x04\xd7\x04\xd7\x04\xd7\x04\xd7\x04\x82\x06\xd4\b\xd4\b\xd4\b\xd4\b\xd4\b\xd3\b\xd3\b\xd4\b\xd4\b\xd4\b\xd4\b\xd4\b\xd4\b\xd3\b\xd3\b\xd3\b\xd3\b\xd4\b>\t\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb3\n\xb3\n\xb3\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb3\n\xb3\n\xb4\n\xb4\n\xb4\n\xb3\n\xb3\n\xf3\x04]\xeb@\xea\f\xea\f\xea\f\xea\f\xea\f\xea\f\xea\f\xea\n\xea\f\xea\f\xea\f\xea\n\xea\n\xea\f\xea\f\xea\f\xea\f\xea\f\xea\f\xea\f\xea\f\xea\x97\xedJ\x02\xf3\x02\xf3\x02\xf3\x02\xf3\x02\xf4\x02\xf4\x02\xf3\x02\xf3\x02\xf4\x02\xf4\x02\xf3\x02\xf3\x02\xf3\x02\xf3\x02\xf3\x02\xf3\x02\xf3\x02\xf3\x02\xff\x03P\x04P\x04P\x04b\x04\xfb\x04\t\x05\n\x05\n\x05\n\x05\n\x05\n\x05\n\x05\t\x05\t\x05\t\x05\t\x05\t\x05\t\x05\t\x05\t\x05\t\x05\t\x05\t\x05\xaa\xf4m\xeeo\xee\xbe\xf0\xf5\xf8\xd0\xf7\xa6\xf7\xa6\xf7\xa5\xf7\xa6\xf7\xa6\xf7\xa6\xf7\xa6\xf7\xa6\xf7\xa6\xf7\xa6\xf7\xa6\xf7\xa6\xf7\xa6\xf7\xa6\xf7\xa5\xf7\xa5\xf7\xa6\xf7\xa6\xf7\xef\xfa\x85\xfc\x85\xfc\x85\xfc\x85\xfc\xea\xfeg\xffg\xffg\xffg\xffg\xffg\xffg\xffe\xffg\xffh\xffg\xffe\xffg\xffg\xffg\xffg\xffg\xffg\xff3\x06b\nb\nb\nb\n\xc9\x11\xf1\x13\xf1\x13\xf1\x13\xf2\x13\xf2\x13\xf1\x13\xf1\x13\xf1\x13\xf1\x13\xf1\x13\xf1\x13\xf1\x13\xf1\x13\xf1\x13\xf1\x13\xf1\x13\xf1\x13\xf1\x13\xf1\x13\xf1\x13\xf1\x13\xf1\x13\xf1\x13\xd5\x0f7\x0e7\x0e5\x0e5\x0e5\x0e7\x0e5\x0e5\x0e7\x0e7\x0e7\x0e7\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e\x90\xfe^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6_\xf6_\xf6_\xf6_\xf6_\xf6\xac\r\xa2\x15\xa2\x15\xa2\x15\xa2\x15\xa2\x15\xa2\x15\xa2\x15\xa2\x15\xa2\x15\xa2\x15\xa2\x15\xa2\x15\xa2\x15\xa2\x15\xa2\x15\xa2\x15\xa2\x15\xa1\x15\xa1\x15\xa2\x15\xa2\x15\xa2\x15\xa2\x158\xf87\xea7\xea9\xea9\xea9\xea7\xea7\xea9\xea9\xea9\xea9\xea9\xea7\xea9\xea9\xea9\xea9\xea9\xea9\xea9\xea9\xea9\xea9\xea9\xea9\xea9\xea9\xea9\xea\xaa\xff}\x06\u007f\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06}\x06|\x06^\x06\xe9\xee<\xea<\xea:\xea:\xea:\xea:\xea:\xea:\xea:\xea:\xea:\xea:\xea:\xea:\xea<\xea<\xea<\xea:\xea:\xea<\xea<\xea:\xea:\xea\x84\xf23\xf53\xf53\xf5Z\xf5\xfa\xf6+\xf7+\xf7+\xf7+\xf7)\xf7)\xf7)\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7+\xf7,\xf7+\xf7+\xf7,\xf7,\xf7+\xf7+\xf7+\xf7+\xf7\xe3\xf6D\xf54\xf54\xf54\xf53\xf53\xf53\xf54\xf54\xf54\xf54\xf56\xf56\xf56\xf54\xf54\xf54\xf56\xf56\xf56\xf54\xf54\xf54\xf54\xf54\xf54\xf54\xf54\xf56\xf56\xf56\xf54\xf56\xf56\xf54\xf54\xf54\xf54\xf56\xf56\xf56\xf56\xf56\xf56\xf56\xf56\xf54\xf54\xf56\xf56\xf56\xf56\xf56\xf56\xf56\xf54\xf54\xf54\xf56\xf56\xf56\xf54\xf5\xff\x01k\x14k\x14k\x14k\x14k\x14k\x14k\x14k\x14k\x14k\x14k\x14k\x14k\x14i\x14i\x14i\x14k\x14k\x14k\x14k\x14i\x14i\x14i\x14k\x14k\x14k\x14i\x14i\x14i\x14i\x14i\x14i\x14i\x14i\x14i\x14i\x14i\x14i\x14i\x14i\x14h\x14h\x14i\x14i\x14i\x14i\x14i\x14h\x14h\x14h\x14h\x14h\x14h\x14h\x14i\x14i\x14i\x14i\x14h\x14h\x14h\x14h\x14\x00\x00I\xf5J\xf5I\xf5I\xf5;\xf58\xf58\xf57\xf57\xf57\xf57\xf57\xf58\xf58\xf57\xf57\xf57\xf57\xf58\xf58\xf58\xf58\xf58\xf5C\xefp\xeap\xeap\xeap\xeap\xeap\xeap\xeap\xeap\xeap\xeap\xeap\xeaq\xeaq\xeaq\xeap\xeap\xeap\xeap\xeap\xeap\xeap\xeaq\xeaq\xeaq\xeaq\xeaq\xeaq\xeaq\xeaq\xeaq\xeaq\xeaq\xeap\xeaq\xeaq\xeaq\xeaq\xeaq\xeaq\xeap\xeaq\xeaq\xeaq\xeaq\xeaq\xeaq\xea\x00\xf5\x9e\t\x9e\t\x9e\t\x9e\t\x9e\t\x9d\t\x9d\t\x9d\t\x9d\t\x9e\t\x9e\t\x9d\t\x9d\t\x9d\t\x9e\t\x9e\t\x9e\t\x9e\t\x9d\t\x9d\t\x9d\t\x9d\t\x9d\t\x9d\t\x9d\t\x9d\t\x9d\t\x9d\t\x9d\t\x9d\t\x9d\t\x9d\t\x9d\t\x96\xfaB\xecB\xecB\xecB\xecB\xecD\xecD\xecB\xecB\xecD\xecD\xecD\xecD\xecD\xecD\xecB\xecB\xecB\xecB\xecD\xecD\xecD\xec\xcb\xec2\xed2\xed2\xed2\xed2\xed2\xed1\xed2\xed2\xed2\xed2\xed2\xed2\xed2\xed2\xed\xb5\xebb\xebb\xebb\xebb\xebb\xebb\xebb\xebb\xebb\xebb\xebb\xebb\xebb\xebb\xebb\xebb\xebb\xebb\xebb\xebd\xebd\xebd\xebd\xebd\xebd\xebb\xebb\xebd\xebd\xebd\xebd\xebd\xebd\xebd\xebd\xebd\xebd\xeb\x8b\xf4\x88\n\x88\n\x88\n\x88\n\x88\n\x87\n\x87\n\x87\n\x88\n\x88\n\x88\n\x88\n\x87\n\x87\n\x87\n\x87\n\x87\n\x87\n\x87\n\x87\n\x87\n\x87\n\x87\n\x87\n\x87\n\x87\n\x87\n\x88\n\a\n\xe6\b\xe6\b\xe6\b\xe6\b\xe4\b\xe4\b\xe4\b\xe4\b\xe4\b\xe4\b\xe6\b\xe4\b\xe4\b\xe4\b\xe4\b\xe4\b\xe4\b\xe4\b\xe4\b\xe4\b\xe4\b\xe4\b\xe4\b\xb2\b\xc7\a\xc7\a\xc7\a\xc5\a^\x06V\x03V\x03V\x03V\x03 \b\xde\r\xde\r\xde\r\xde\r\xde\r\xde\r\xde\r\xde\r\xde\r\xdf\r\xdc\r\xdc\r\xde\r\xde\r\xde\r\xde\r\xde\r\xde\r\xc9\x0e\xf1\x12\xf1\x12\xf1\x12\xf1\x12V\x131\x141\x141\x142\x142\x141\x141\x141\x141\x141\x141\x141\x141\x141\x141\x141\x141\x141\x14/\x14/\x14/\x141\x141\x14N\x10\xd9\xfe\xd9\xfe\xd9\xfe\xd9\xfeX\xfd%\xfa%\xfa%\xfa#\xfa%\xfa%\xfa%\xfa%\xfa#\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa\xea\xfc\xbc\t\xbc\t\xbc\t\xbe\tB\r\xf2\x14\xf2\x14\xf3\x14\xf2\x14\xf2\x14\xf2\x14\xf2\x14\xf2\x14\xf2\x14\xf2\x14\xf2\x14\xf2\x14\xf2\x14\xf2\x14\xf2\x14\xf2\x14\xf2\x14\xf2\x14\xf1\x14\xf1\x14\xf1\x14\xf1\x14\xf1\x14\xf1\x14\xf1\x14\xf1\x14\xf2\x14\xf2\x14\xf8\x14\t\x15\t\x15\t\x15\t\x15\t\x15\t\x15\b\x15\b\x15\b\x15\b\x15\b\x15\t\x15\t\x15\t\x15\t\x15\t\x15\t\x15\t\x15\t\x15\t\x15\b\x15\b\x15\t\x15\t\x15\t\x15\xf8\v\xd4\t\xd4\t\xcc\x00\xbf\xea\xc0\xea\xbf\xea\xbd\xea\xbd\xea\xbd\xea\xbd\xea\xbd\xea\xbd\xea\xbd\xea\xbd\xea\xbf\xea\xbf\xea\xbf\xea\xbf\xea\xbd\xea\xbf\xea\xbf\xea\xbd\xea\xbd\xea\xbd\xea\xbf\xea\xbf\xea\xbf\xea\xc0\xea\xc0\xea\xbf\xea\xbf\xea\xbf\xea\xbf\xea\xbf\xea\xbf\xea\xbf\xea\xce\xebZ\xedZ\xedZ\xedZ\xedZ\xedZ\xedZ\xedZ\xedZ\xedY\xedY\xedY\xedZ\xedZ\xedZ\xed[\xed[\xedZ\xedZ\xedZ\xedZ\xed[\xed[\xedZ\xedZ\xedZ\xedZ\xedZ\xed\v\xed\x8c\xec\x8c\xec\x8c\xec\x8c\xec\x9f\xeb\xc1\xea\xc1\xea\xc1\xea\xc0\xea\xc0\xea\xc0\xea\xc0\xea\xc1\xea\xc1\xea\xc1\xea\xc0\xea\xc0\xea\xc1\xea\xc1\xea\xc1\xea\xc1\xea\xc0\xea\xc0\xea\xc0\xea\xc0\xea\xc0\xea\xc0\xea\xc0\xea\xc0\xea\xc1\xea\xc1\xea\xc1\xea\xc1\xeaj\xed=\xf0=\xf0=\xf0=\xf0\x05\x00\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd1\t\xd1\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd1\t\xd1\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xd0\t\xce\t\xce\t\xce\t\xce\t\xd0\t\xd0\t\xce\t\xce\t\xce\t\xa5\xf3\xc1\xea\xc3\xea\xc3\xea\xc3\xea\xc3\xea\xc1\xea\xc3\xea\xc3\xea\xc3\xea\xc3\xea\xc3\xea\xc3\xea\xc3\xea\xc3\xea\xc4\xea\xc4\xea\xc3\xea\xc3\xea\xc3\xea\xc3\xea\xc3\xea\xc3\xea\xc3\xea\xc3\xea\xc3\xea\xc3\xea\xc3\xea\xc3\xea\xc0\xef+\xf2+\xf2,\xf2,\xf2\xd7\xf78\xf98\xf98\xf98\xf98\xf98\xf98\xf98\xf98\xf98\xf98\xf98\xf98\xf98\xf98\xf97\xf97\xf98\xf98\xf98\xf98\xf98\xf98\xf99\xf99\xf99\xf98\xf98\xf98\xf98\xf98\xf99\xf9D\xfa4\b\x19\n\x19\n\x19\ns\v5\x115\x115\x115\x115\x115\x115\x114\x114\x115\x115\x114\x114\x114\x114\x115\x114\x114\x114\x114\x114\x114\x114\x114\x114\x114\x114\x114\x113\x113\x114\x114\x114\x11\xe3\n\xa8\xf9\xa8\xf9\xa9\xf9\xa9\xf9\xa8\xf9\xa9\xf9\xa9\xf9\xa9\xf9\xa9\xf9\xa9\xf9\xa9\xf9\xa8\xf9\xa9\xf9\xa9\xf9\xa9\xf9\xa8\xf9\xa9\xf9\xa9\xf9\xa9\xf9\xa8\xf9\xa8\xf9\xa8\xf9\xa8\xf9\xa9\xf9\xa9\xf9\xa9\xf9\xa8\xf9\xa8\xf9\xa8\xf9\xa9\xf9\xa9\xf9\xab\xf9\xa9\xf9\xa9\xf9\xa9\xf9\xa9\xf9\xa9\xf9\xa9\xf9~\xf6\xdb\xf2\xdb\xf2\xdb\xf2\xdb\xf2v\xf20\xf20\xf20\xf20\xf20\xf2/\xf20\xf20\xf20\xf20\xf22\xf22\xf20\xf20\xf20\xf20\xf2/\xf2/\xf20\xf20\xf20\xf20\xf20\xf20\xf20\xf22\xf22\xf20\xf22\xf22\xf20\xf20\xf20\xf22\xf22\xf20\xf22\xf22\xf2m\n1\x110\x110\x110\x110\x110\x110\x110\x110\x110\x110\x111\x111\x110\x110\x110\x110\x110\x110\x110\x110\x110\x110\x110\x110\x110\x110\x110\x110\x11/\x11/\x110\x110\x110\x110\x110\x11/\x11/\x11/\x11/\x110\x110\x11/\x11/\x11/\x11/\x110\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11/\x11-\x11<\x0ee\ne\ne\nc\nc\nc\nc\n\x81\n\x90\r\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x18\x0e\x18\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x18\x0e\x18\x0e\x1a\x0e\x18\x0e\x18\x0e\x18\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x1a\x0e\x01\x0f]\x0f]\x0f]\x0fj\x0f\xdc\x11T\x12T\x12T\x12T\x12T\x12T\x12T\x12T\x12T\x12U\x12U\x12U\x12T\x12T\x12U\x12T\x12T\x12T\x12T\x12T\x12T\x12T\x12T\x12T\x12T\x12T\x12T\x12T\x12U\x12T\x12T\x12T\x12T\x12T\x12T\x12R\x12R\x12R\x12R\x12R\x12T\x12T\x12y\f@\xfe@\xfe@\xfe@\xfe@\xfe@\xfe?\xfe?\xfe?\xfe@\xfe@\xfe?\xfe@\xfe@\xfe@\xfe?\xfe?\xfe@\xfe@\xfe@\xfe@\xfe@\xfe?\xfe@\xfe@\xfe@\xfeA\xfeA\xfe@\xfe@\xfe@\xfe@\xfe@\xfe@\xfeA\xfeA\xfeA\xfeA\xfeA\xfeA\xfeA\xfeA\xfe@\xfe@\xfeA\xfeA\xfe@\xfe@\xfe\x85\r\xdf\x14\xdf\x14\xdf\x14\xdf\x14\xdd\x14\xdf\x14\xdf\x14\xdf\x14\xdd\x14\xdd\x14\xdd\x14\xdf\x14\xdf\x14\xdf\x14\xdf\x14\xdf\x14\xdf\x14\xdf\x14\xdf\x14\xdf\x14\xdf\x14\xdf\x14\xdf\x14\xdf\x14\xdf\x14\xdf\x14\xdf\x14\xdf\x14\xdf\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdc\x14\xdc\x14\xdc\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdd\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdd\x14\xdd\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdd\x14\xdd\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdc\x14\xdb\x14\xdb\x14\xdb\x14\xdb\x14\xdb\x14\xe6\x13\xad\x13\xad\x13\xad\x13\xad\x13\xad\x13\xad\x13\xad\x13\xad\x13\xac\x13\xac\x13\xac\x13\xac\x13\xac\x13\xad\x13\xad\x13\xad\x13\xae\x13\xad\x13\xad\x13\xad\x13\xac\x13\xac\x13\xad\x13\xad\x13\xad\x13\xac\x13\xac\x13\xac\x13\xac\x13\xac\x13\xac\x13\xad\x13\xad\x13\xad\x13\xac\x13\xac\x13\xad\x13\xad\x13\xad\x13\xac\x13\xac\x13\xac\x13\xac\x13\xad\x13\xad\x13\xac\x13\xac\x13\xac\x13\xac\x13\xac\x13\xad\x13\xac\x13\xac\x13\xac\x13\xac\x13\xac\x13\xac\x13\xda\x02\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf4\xf5\xf4\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf5\xf5\xf5\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xf6\xf5\xa5\xfe\x13\x13\x13\x13\x13\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x10\x13\x10\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x10\x13\x10\x13\x10\x13\x12\x13\x12\x13\x12\x13\x10\x13\x10\x13\x10\x13\x10\x13\x10\x13\x10\x13\x10\x13\x10\x13\x10\x13\x10\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x12\x13\x10\x13\x10\x13\x10\x13\x10\x13\x10\x13\x10\x13\x10\x13\x10\x13\x10\x13\x0f\x13\x0f\x13G\x13\xbb\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd2\x14\xd4\x14\xd5\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd2\x14\xd2\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd2\x14\xd2\x14\xd2\x14\xd2\x14\xd4\x14\xd2\x14\xd2\x14\xd2\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd4\x14\xd2\x14\xd2\x14\xd2\x14\xd2\x14\xd2\x14\xd2\x14\xd4\x14\xd2\x14\xd2\x14\xd2\x14\xb2\x13\xd5\xfc\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x03\xf9\x03\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x01\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x04\xf9\x04\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x04\xf9\x04\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x04\xf9\x04\xf9\x04\xf9\x04\xf9\x04\xf9\x04\xf9\x04\xf9\x04\xf9\x14\xf9X\xfa\x8e\xfa\x8e\xfa\x8e\xfa\xa4\xfa\x1e\xfb\"\xfb\"\xfb\"\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb\"\xfb\"\xfb\"\xfb\"\xfb#\xfb#\xfb\"\xfb\"\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb\"\xfb#\xfb#\xfb#\xfb#\xfb#\xfb\"\xfb\"\xfb\"\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb\"\xfb#\xfb%\xfb%\xfb%\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb\"\xfb\"\xfb%\xfb%\xfb%\xfb%\xfb%\xfb%\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb%\xfb\xd9\x00\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcd\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcd\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xca\x14\xca\x14\xca\x14\xca\x14\xca\x14\xca\x14\xca\x14\xca\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xcb\x14\xca\x14\xca\x14\xca\x14\xca\x14\xca\x14\xcb\x14\xca\x14\xca\x14\xca\x14\xc9\x14\xc9\x14\xca\x14\xca\x14\xca\x14\xca\x14\xca\x14\xca\x14\xca\x14\xca\x14\xca\x14\xca\x14\xca\x14\xc9\x14\xc9\x14\xca\x14\xca\x14\xca\x14\xe0\x10\x81\f\x81\f\x81\f\x80\f\x80\f\x81\f\x81\f\x81\f\x81\f\x81\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x81\f\x81\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x81\f\x81\f\x81\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x81\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f~\f~\f\x80\f~\f~\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f~\f~\f\x80\f~\f~\fE\x0e\xc5\x0e\xc3\x0e\xc3\x0e\v\x0f\xb9\x12A\x13@\x13@\x13@\x13@\x13A\x13@\x13@\x13A\x13A\x13A\x13@\x13A\x13A\x13@\x13@\x13@\x13@\x13A\x13A\x13@\x13@\x13@\x13@\x13A\x13@\x13@\x13@\x13A\x13@\x13?\x13?\x13?\x13@\x13@\x13@\x13?\x13?\x13?\x13@\x13@\x13@\x13@\x13@\x13@\x13@\x13@\x13@\x13?\x13?\x13@\x13@\x13?\x13?\x13?\x13?\x13?\x13@\x13@\x13@\x13@\x13?\x13?\x13?\x13?\x13@\x13@\x13?\x13?\x13?\x13?\x13?\x13?\x13?\x13?\x13?\x13?\x13?\x13=\x13=\x13?\x13?\x13?\x13=\x13=\x13?\x13?\x13?\x13?\x13?\x13?\x13=\x13=\x13=\x13=\x13?\x13?\x13?\x13?\x13?\x13?\x13=\x13=\x13=\x13=\x13=\x13.\x01\v\xf6\v\xf6\v\xf6\v\xf6\f\xf6\f\xf6\f\xf6\f\xf6\f\xf6\f\xf6\f\xf6\v\xf6\f\xf6\f\xf6\f\xf6\f\xf6\f\xf6\f\xf6\f\xf6\f\xf6\f\xf6\v\xf6\f\xf6\f\xf6\f\xf6\f\xf6\f\xf6\f\xf6\v\xf6\f\xf6\f\xf6\f\xf6\f\xf6\f\xf6\f\xf6\x0e\xf6\x0e\xf6\x0e\xf6\f\xf6\f\xf6\f\xf6\f\xf6\f\xf6\x0e\xf6\x0e\xf6\x0e\xf6\x0e\xf6\x0e\xf6w\t\x16\x0f\x17\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x14\x0f\x14\x0f\x16\x0f\x16\x0f\x16\x0f\x16\x0f\x14\x0f\x14\x0f\x16\x0f\x16\x0f\x16\x0f\x14\x0f\x14\x0f\x16\x0f\x16\x0f\x16\x0f\x14\x0f\x14\x0f\x14\x0f1\x10\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbf\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbf\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbc\x14\xbc\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbe\x14\xbc\x14\xbc\x14\xbc\x14\xbe\x14\xbe\x14\xbc\x14\xbc\x14\xbc\x14\xbe\x14\xbe\x14\xa7\x13,\x12,\x12,\x12,\x12,\x12,\x12,\x12,\x12,\x12,\x12,\x12,\x12,\x12,\x12,\x12,\x12,\x12,\x12,\x12,\x12,\x12,\x12*\x12*\x12*\x12*\x12,\x12,\x12,\x12*\x12*\x12,\x12,\x12,\x12*\x12*\x12*\x12*\x12*\x12*\x12*\x12*\x12*\x12*\x12*\x12*\x12*\x12*\x12*\x12*\x12*\x12*\x12)\x12\x81\x01\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xba\xf7\xba\xf7\xba\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbe\xf7\xbe\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbe\xf7\xbe\xf7\xbc\xf7\xd0\xf7u\xf8\x85\xf8\x85\xf8\x87\xf8\x8c\xf8\xa4\xf8\xa4\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa6\xf8\xa6\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa6\xf8\xa6\xf8\xa5\xf8\xa6\xf8\xa6\xf8\xa6\xf8\xa5\xf8\xa6\xf8\xa6\xf8\xa5\xf8\xa5\xf8\xa6\xf8\xa6\xf8\xa6\xf8\xa6\xf8\xa6\xf8\xa5\xf8\xa6\xf8\xa6\xf8\xa6\xf8\xa6\xf8\xa6\xf8\xa6\xf8\xa6\xf8\xa6\xf8\xa6\xf8\xa6\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa6\xf8\xa6\xf8\xa6\xf8\xa6\xf8L\x05\xb7\x14\xb7\x14\xb7\x14\xb7\x14\xb5\x14\xb5\x14\xb7\x14\xb7\x14\xb7\x14\xb7\x14\xb5\x14\xb5\x14\xb7\x14\xb7\x14\xb5\x14\xb7\x14\xb7\x14\xb5\x14\xb5\x14\xb5\x14\xb7\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb7\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb5\x14\xb4\x14\xb4\x14\xb4\x14\xb4\x14\xb4\x14\xb4\x14\xb5\x14\xb5\x14\xb4\x14\xb4\x14\xb4\x14\xb4\x14\xb4\x14\xb5\x14\xb4\x14\xb4\x14\xa2\x11\xca\x10\xca\x10\xca\x10\xca\x10\xca\x10\xcb\x10\xcb\x10\xca\x10\xca\x10\xca\x10\xca\x10\xca\x10\xca\x10\xca\x10\xca\x10\xca\x10\xc9\x10\xc9\x10\xca\x10\xca\x10\xca\x10\xca\x10\xca\x10\xca\x10\xca\x10\xca\x10\xca\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xca\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xca\x10\xc9\x10\xc9\x10\xc9\x10\xc7\x10\xc7\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10\xc9\x10D\n\xda\xfe\xda\xfe\xda\xfe\xda\xfe\xda\xfe\xda\xfe\xda\xfe\xda\xfe\xda\xfe\xdc\xfe\xdc\xfe\xda\xfe\xda\xfe\xda\xfe\xda\xfe\xda\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xda\xfe\xda\xfe\xda\xfe\xda\xfe\xda\xfe\xda\xfe\xda\xfe\xda\xfe\xda\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xda\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xda\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xda\xfe\xda\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xdc\xfe\xd8\xfe\xb4\xf7\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6 \xf6 \xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6 \xf6 \xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6 \xf6 \xf6\x1e\xf6\x1e\xf6 \xf6 \xf6 \xf6\x1e\xf6\x1e\xf6\x1e\xf6 \xf6 \xf6 \xf6 \xf6 \xf6 \xf6 \xf6 \xf6 \xf6 \xf6 \xf6 \xf6 \xf6 \xf6 \xf6 \xf6 \xf6 \xf6 \xf6!\xf6!\xf6!\xf6!\xf6!\xf6!\xf6!\xf6!\xf6!\xf6 \xf6 \xf6!\xf6!\xf6!\xf6 \xf6!\xf6!\xf6!\xf6!\xf6!\xf6!\xf6!\xf6\"\xf6\"\xf6!\xf6!\xf6!\xf6!\xf6!\xf6!\xf6!\xf6!\xf6\"\xf6\"\xf6\"\xf6!\xf6!\xf6!\xf6!\xf6!\xf6!\xf6!\xf6!\xf6!\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6!\xf6!\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6$\xf6$\xf6$\xf6\"\xf6\"\xf6\"\xf6\"\xf6\"\xf6$\xf6$\xf6$\xf6\"\xf6\"\xf6$\xf6$\xf6$\xf6$\xf6$\xf6$\xf6\"\xf6$\xf6$\xf6$\xf6$\xf6$\xf6$\xf6$\xf6$\xf6$\xf6$\xf6$\xf6$\xf6J\xfc\xee\x0e\xee\x0e\xec\x0e\xec\x0e\xee\x0e\xee\x0e\xee\x0e\xee\x0e\xee\x0e\xee\x0e\xee\x0e\xee\x0e\xee\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xee\x0e\xee\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xee\x0e\xee\x0e\xee\x0e\xee\x0e\xee\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xeb\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xeb\x0e\xeb\x0e\xeb\x0e\xec\x0e\xeb\x0e\xeb\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xec\x0e\xeb\x0e\xeb\x0e\xec\x0eN\x0e\xdd\xfa\x13\xf7\x12\xf7\x12\xf7\xfa\xf6:\xf6(\xf6(\xf6(\xf6(\xf6(\xf6(\xf6'\xf6(\xf6(\xf6(\xf6(\xf6(\xf6(\xf6(\xf6(\xf6(\xf6(\xf6(\xf6(\xf6(\xf6(\xf6(\xf6(\xf6(\xf6(\xf6(\xf6(\xf6)\xf6)\xf6(\xf6(\xf6(\xf6(\xf6)\xf6)\xf6(\xf6)\xf6)\xf6)\xf6)\xf6)\xf6)\xf6)\xf6)\xf6(\xf6)\xf6)\xf6)\xf6)\xf6)\xf6(\xf6)\xf6)\xf6)\xf6)\xf6)\xf6)\xf6)\xf6)\xf6)\xf6)\xf6)\xf6)\xf6)\xf6)\xf6)\xf6)\xf6+\xf6+\xf6+\xf6+\xf6)\xf6+\xf6+\xf6)\xf6)\xf6)\xf6)\xf6+\xf6+\xf6+\xf6)\xf6+\xf6+\xf6+\xf6+\xf6+\xf6+\xf6+\xf6+\xf6+\xf6+\xf6E\xf6P\xf6P\xf6P\xf6P\xf6\x8f\xf6\x9e\xf6\x9f\xf6\x9f\xf6\x9e\xf6\x9f\xf6\x9f\xf6\x9e\xf6\x9e\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9e\xf6\x9e\xf6\x9e\xf6\x9e\xf6\x9e\xf6\x9e\xf6\x9e\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9e\xf6\x9f\xf6\x9f\xf6\x9e\xf6\x9e\xf6\x9e\xf6\x9e\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\xa1\xf6\xa1\xf6\xa1\xf6\x9f\xf6\x9f\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\x9f\xf6\x9f\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa1\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa2\xf6\xa2\xf6.\t\x04\x11\x04\x11\x04\x11\x04\x11s\x12\xdf\x12\xdf\x12\xdf\x12\xdf\x12\xdf\x12\xdf\x12\xdd\x12\xdd\x12\xdf\x12\xdf\x12\xdf\x12\xdf\x12\xdd\x12\xdd\x12\xdd\x12\xdf\x12\xdf\x12\xdf\x12\xdf\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdf\x12\xdf\x12\xdf\x12\xdf\x12\xdf\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdc\x12\xdc\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdd\x12\xdc\x12\xdc\x12\xdc\x12\xdd\x12\xdc\x12\xdc\x12\xdc\x12\xdd\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xda\x12\xda\x12\xda\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xda\x12\xda\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xdc\x12\xda\x12\xda\x12\xda\x12\xda\x12\xda\x12\xda\x12\xda\x12\xda\x12\xdc\x12\xda\x12\xda\x12\xda\x12\xac\x12\x03\x02b\xfeb\xfeb\xfeb\xfeb\xfeb\xfeb\xfeb\xfeb\xfeb\xfeb\xfeb\xfeb\xfeb\xfed\xfed\xfed\xfeb\xfeb\xfed\xfed\xfed\xfed\xfed\xfed\xfed\xfeb\xfee\xfee\xfeb\xfeb\xfeb\xfed\xfed\xfed\xfed\xfed\xfeb\xfed\xfed\xfed\xfed\xfeb\xfed\xfed\xfeb\xfeb\xfeb\xfed\xfed\xfee\xfee\xfee\xfed\xfed\xfed\xfed\xfed\xfed\xfed\xfed\xfed\xfed\xfed\xfed\xfed\xfed\xfee\xfee\xfee\xfed\xfed\xfeb\xfed\xfed\xfed\xfed\xfed\xfed\xfed\xfed\xfed\xfee\xfee\xfee\xfed\xfed\xfed\xfed\xfee\xfee\xfed\xfed\xfed\xfee\xfee\xfee\xfee\xfed\xfed\xfed\xfed\xfed\xfee\xfee\xfed\xfed\xfed\xfee\xfee\xfee\xfee\xfed\xfee\xfee\xfee\xfee\xfee\xfee\xfed\xfee\xfee\xfee\xfee\xfee\xfee\xfee\xfee\xfee\xfee\xfee\xfee\xfee\xfed\xfee\xfee\xfem\xfc8\xf68\xf68\xf67\xf67\xf68\xf68\xf68\xf67\xf68\xf68\xf68\xf67\xf67\xf68\xf68\xf68\xf68\xf68\xf6:\xf6:\xf68\xf68\xf68\xf68\xf6:\xf6:\xf68\xf68\xf68\xf68\xf68\xf68\xf68\xf68\xf68\xf68\xf68\xf68\xf6:\xf6:\xf6:\xf68\xf68\xf68\xf6:\xf6:\xf68\xf68\xf68\xf68\xf6:\xf6:\xf6:\xf6:\xf6:\xf6:\xf6:\xf6:\xf68\xf68\xf6:\xf6:\xf6:\xf6:\xf6:\xf6:\xf6:\xf6:\xf6:\xf6:\xf6;\xf6;\xf6;\xf6:\xf6;\xf6;\xf6;\xf6;\xf6;\xf6:\xf6:\xf6:\xf6;\xf6;\xf6;\xf6;\xf6;\xf6;\xf6;\xf6:\xf6;\xf6;\xf6;\xf6;\xf6;\xf6;\xf6;\xf6;\xf6;\xf6;\xf6;\xf6;\xf6;\xf6=\xf6=\xf6=\xf6;\xf6=\xf6=\xf6;\xf6;\xf6;\xf6;\xf6;\xf6=\xf6=\xf6=\xf6=\xf6=\xf6;\xf6;\xf6;\xf6=\xf6=\xf6=\xf6=\xf6=\xf6=\xf6=\xf6=\xf6=\xf6=\xf6>\xf6>\xf6=\xf6=\xf6=\xf6=\xf6=\xf6=\xf6=\xf6=\xf6>\xf6>\xf6>\xf6>\xf6>\xf6=\xf6=\xf6=\xf6=\xf6=\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6\xba\x04\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb3\v\xb3\v\xb3\v\xb4\v\xb4\v\xb3\v\xb3\v\xb3\v\xb4\v\xb3\v\xb3\v\xb4\v\xb4\v\xb4\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb4\v\xb4\v\xb4\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb4\v\xb4\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb4\v\xb4\v\xb4\v\xb4\v\xb4\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb1\v\xb1\v\xb1\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb1\v\xb1\v\xb1\v\xb1\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb3\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb3\v\xb3\v\xb1\v\xb1\v\xb1\v\xb3\v\xb3\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb3\v\xb3\v\xb3\v\xb3\v\xb1\v\xb1\v\xb3\v\xb1\v\xb0\v\xb0\v\xb1\v\xb1\v\xb3\v\xb1\v\xb1\v\xb1\v\xb1\v\xb3\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb0\v\xb0\v\xb1\v\xb1\v\xb1\v\x0e\n\xac\b\xac\b\xac\b\xae\b\xd8\xfe5\xf85\xf85\xf85\xf85\xf85\xf85\xf85\xf85\xf87\xf85\xf85\xf85\xf85\xf85\xf87\xf87\xf87\xf85\xf85\xf85\xf85\xf85\xf85\xf85\xf85\xf85\xf87\xf87\xf87\xf87\xf87\xf87\xf87\xf87\xf87\xf87\xf87\xf85\xf85\xf85\xf85\xf85\xf85\xf87\xf87\xf87\xf85\xf85\xf87\xf87\xf87\xf87\xf87\xf85\xf87\xf87\xf87\xf87\xf88\xf87\xf87\xf87\xf87\xf88\xf87\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf87\xf88\xf88\xf88\xf88\xf88\xf88\xf87\xf87\xf87\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf89\xf89\xf89\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf89\xf89\xf89\xf89\xf88\xf89\xf89\xf89\xf89\xf89\xf88\xf88\xf88\xf88\xf88\xf89\xf89\xf89\xf89\xf89\xf89\xf89\xf89\xf89\xf89\xf89\xf89\xf89\xf89\xf89\xf89\xf89\xf89\xf89\xf8;\xf8;\xf89\xf89\xf89\xf89\xf8;\xf8;\xf8;\xf8;\xf89\xf89\xf89\xf89\xf89\xf8;\xf8;\xf8;\xf8;\xf89\xf89\xf89\xf89\xf8;\xf8;\xf8;\xf8;\xf8;\xf8;\xf8;\xf8;\xf8;\xf8;\xf8;\xf8;\xf8;\xf8;\xf8;\xf8<\xf8<\xf8;\xf8;\xf8;\xf8;\xf8<\xf8<\xf8<\xf8<\xf8<\xf8;\xf8;\xf8;\xf8<\xf8<\xf8;\xf8;\xf8;\xf8;\xf8;\xf8;\xf8;\xf8;\xf8;\xf8;\xf8;\xf8<\xf8<\xf8<\xf8<\xf8<\xf8;\xf8<\xf8<\xf8<\xf8<\xf8<\xf8<\xf8<\xf8<\xf8<\xf8<\xf8>\xf8>\xf8>\xf8<\xf8<\xf8<\xf8<\xf8<\xf8<\xf8<\xf8<\xf8<\xf8<\xf8>\xf8>\xf8>\xf8>\xf8>\xf8>\xf8>\xf8>\xf8>\xf8<\xf8<\xf8<\xf8<\xf8<\xf8>\xf8>\xf8>\xf8>\xf8>\xf8>\xf8>\xf8>\xf8>\xf8>\xf8<\xf9\xfa\xfd\x1a\xfe\x1a\xfe\x1a\xfe\xf5\x02\u007f\x14\u007f\x14\u007f\x14\u007f\x14~\x14~\x14~\x14\u007f\x14\u007f\x14~\x14~\x14~\x14~\x14~\x14}\x14}\x14}\x14~\x14~\x14}\x14}\x14~\x14~\x14~\x14~\x14~\x14~\x14~\x14~\x14~\x14~\x14~\x14~\x14~\x14}\x14}\x14}\x14}\x14~\x14~\x14~\x14~\x14~\x14~\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14}\x14{\x14}\x14}\x14}\x14{\x14{\x14}\x14}\x14{\x14{\x14{\x14}\x14{\x14{\x14{\x14}\x14{\x14{\x14{\x14}\x14}\x14{\x14{\x14{\x14{\x14{\x14{\x14{\x14{\x14{\x14{\x14{\x14{\x14{\x14z\x14z\x14z\x14{\x14{\x14{\x14{\x14{\x14z\x14z\x14z\x14{\x14z\x14z\x14z\x14z\x14{\x14z\x14z\x14{\x14{\x14z\x14z\x14{\x14{\x14z\x14z\x14z\x14z\x14z\x14z\x14z\x14y\x14y\x14z\x14z\x14z\x14z\x14z\x14z\x14z\x14z\x14y\x14y\x14z\x14y\x14y\x14y\x14z\x14y\x14y\x14y\x14y\x14y\x14y\x14y\x14y\x14y\x14y\x14y\x14y\x14z\x14z\x14z\x14w\x14w\x14y\x14w\x14w\x14y\x14y\x14y\x14y\x14y\x14w\x14w\x14y\x14y\x14y\x14y\x14w\x14w\x14y\x14y\x14w\x14w\x14y\x14y\x14y\x14v\x14v\x14w\x14y\x14w\x14w\x14w\x14y\x14y\x14w\x14w\x14w\x14w\x14w\x14w\x14w\x14w\x14w\x14w\x14y\x14y\x14w\x14w\x14v\x14v\x14v\x14w\x14v\x14v\x14v\x14w\x14w\x14w\x14w\x14w\x14w\x14w\x14t\x14t\x14v\x14v\x14v\x14w\x14v\x14v\x14w\x14w\x14w\x14w\x14w\x14v\x14v\x14v\x14v\x14w\x14v\x14v\x14v\x14w\x14v\x14v\x14t\x14t\x14t\x14v\x14t\x14t\x14t\x14t\x14t\x14t\x14v\x14v\x14v\x14v\x14v\x14v\x14t\x14t\x14t\x14v\x14v\x14t\x14t\x14t\x14v\x14v\x14t\x14t\x14t\x14t\x14t\x14t\x14t\x14t\x14t\x14t\x14s\x14s\x14s\x14t\x14t\x14v\x14t\x14t\x14t\x14t\x14t\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14t\x14t\x14t\x14t\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14t\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14s\x14r\x14r\x14r\x14r\x14r\x14r\x14r\x14s\x14s\x14s\x14r\x14r\x14r\x14r\x14r\x14\xa1\x10\v\x0f\v\x0f\f\x0f\f\x0f\t\x0f\t\x0f\v\x0f\v\x0f\v\x0f\v\x0f\v\x0f\v\x0f\v\x0f\v\x0f\v\x0f\v\x0f\v\x0f\v\x0f\v\x0f\v\x0f\v\x0f\v\x0f\v\x0f\v\x0f\v\x0f\t\x0f\t\x0f\v\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\v\x0f\v\x0f\v\x0f\v\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\b\x0f\b\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\t\x0f\b\x0f\b\x0f\t\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\b\x0f\a\x0f\a\x0f\a\x0f\a\x0f\x05\x0f\x05\x0f\a\x0f\a\x0f\a\x0f\x05\x0f\x05\x0f\x05\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\x05\x0f\x05\x0f\a\x0f\a\x0f\a\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x05\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x05\x0f\x05\x0f\x05\x0f\x04\x0f\x04\x0f\x05\x0f\x05\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x04\x0f\x04\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x04\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f\x02\x0f/\a0\xf10\xf10\xf1/\xf1\f\xf3\x87\xf7\x87\xf7\x87\xf7\x87\xf7\xdd\xfe)\x0e)\x0e)\x0e(\x0e\xda\x05\xc1\xf6\xc1\xf6\xc1\xf6\xbf\xf6\x8a\xf4\xff\xf0\xff\xf0\xff\xf0\xff\xf0\x02\xfb\x1a\t\x1c\t\x1c\t\x1c\t\xbd\v\x01\x0f\x00\x0f\x00\x0f\x00\x0f\xf3\x02\xa6\xf5\xa5\xf5\xa5\xf5\xa5\xf5\x98\x01N\rN\rN\rP\r7\x0e\x01\x0f\x01\x0f\x01\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\x00\x0f\xb8\x0e\x88\x0e\x88\x0e\x88\x0e\x88\x0e5\x0e\x02\x0e\x02\x0e\x02\x0e\x02\x0e\x88\v7\n7\n9\n9\n\b\xf9\x00\xf1\x00\xf1\xff\xf0\xff\xf0\x00\xf1\x00\xf1\x00\xf1\x00\xf1\x00\xf1\xaa\x05\xf2\f\xf2\f\xf2\f\xf2\fz\xf7\x02\xf1\x02\xf1\x00\xf1\x00\xf1\x8b\x06\v\f\v\f\f\f\x18\f\x14\x0e\x84\x0e\x84\x0e\x84\x0e\x1a\rU\xf5\x02\xf1\x02\xf1\x02\xf1\xea\xf1\x8c\xfb\xf6\xfc\xf6\xfc\xf6\xfc\xd6\xfe]\r\xfe\x0e\xfe\x0e\xfe\x0e\xf0\x0e\x9d\x0e\x96\x0e\x96\x0e\x96\x0e\xdb\t\b\xf2\x02\xf1\x02\xf1\x03\xf1F\xf2\xad\xf7\xba\xf7\xba\xf7\xba\xf7\xbb\xfc\xfd\x0e\xfd\x0e\xfd\x0e\xfd\x0e\xfe\x0e\xfd\x0e\xfd\x0e\xfd\x0e\xfd\x0e\xe6\x06\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x05\xf1\x05\xf1\x03\xf1\x03\xf14\xf1\x9d\xf1\x9d\xf1\x9d\xf1\x9d\xf1\xeb\xfb\xfd\x0e\xfd\x0e\xfd\x0e\xfd\x0e\x92\x0e\xe2\r\xe2\r\xe2\r\xe2\r\\\v\xa5\a\xa5\a\xa5\a\xa5\a\r\xfeX\xf1X\xf1X\xf1X\xf1\xe4\xfc\x9b\n\x9b\n\x9b\n\x9b\n\xf8\nZ\vZ\vZ\vZ\v\xe0\b}\x06}\x06}\x06}\x06 \nG\rG\rG\rG\r\xaf\vn\nn\nn\nm\n\x03\x02\x17\xfc\x17\xfc\x16\xfc\x16\xfc\xa5\a\xfa\x0e\xfa\x0e\xfa\x0e\xfa\x0e\xfb\x0e\xfb\x0e\xfb\x0e\xfa\x0e\xfa\x0e\xb8\xfb\xe9\xf1\xe8\xf1\xe9\xf1\xe9\xf1\xe6\x05\xfa\x0e\xfa\x0e\xfa\x0e\xfa\x0e\xfa\x0e\xfa\x0e\xf9\x0e\xf9\x0e\xf9\x0e\xfa\x0e\xf9\x0e\xf9\x0e\xfa\x0e\xfa\x0e\xf9\x0e\xf9\x0e\xfa\x0e\xf9\x0e\xf9\x0e\xf9\x0e\xf9\x0e\xf9\x0e\xfa\x0e\xec\x0e\xbf\bM\aM\aO\a\x9f\x06\xbc\xf4\x15\xf1\x15\xf1\x15\xf1-\xf1\x9c\xf2\xdb\xf2\xdb\xf2\xdb\xf2\xe0\xf4c\tY\fY\fY\f\x9e\f\xbb\x0e\xf9\x0e\xf7\x0e\xf7\x0eK\fT\xfb\xe4\xf9\xe4\xf9\xe5\xf9?\xf9\xbf\xf5\x8c\xf5\x8c\xf5\x8c\xf5\xca\xf4H\xf1/\xf1/\xf1/\xf1'\xf1\a\xf1\a\xf1\t\xf1\t\xf1n\xf3\xe3\xfb\xe3\xfb\xe3\xfb\xe3\xfb\x89\x00\xf7\x0e\xf7\x0e\xf7\x0e\xf7\x0e\f\t\xd7\xf8\xd7\xf8\xd7\xf8\xd7\xf8F\xf8\xe3\xf6\xe3\xf6\xe4\xf6\xe4\xf6\x1d\xf6j\xf4j\xf4j\xf4i\xf4\xc1\xf3r\xf2r\xf2r\xf2r\xf2\xf6\xfb\x01\r\x01\r\x01\r\x01\r\xbf\r\xf6\x0e\xf6\x0e\xf6\x0e\xf6\x0e'\x03\xa3\xf1\xa3\xf1\xa3\xf1\xa3\xf1u\xf17\xf17\xf17\xf16\xf1\xc0\xfdG\rG\rG\rG\r\x10\x0e\xf6\x0e\xf5\x0e\xf5\x0e\xf5\x0e\xcb\x06O\xfeO\xfeO\xfeO\xfe\xa2\xfb\x13\xf9\x13\xf9\x13\xf9\x14\xf9\xbd\x04\xf5\x0e\xf5\x0e\xf5\x0e\xf5\x0e\xf5\x0e\xf5\x0e\xf5\x0e\xf5\x0e\xf5\x0e\xf5\x0e\xf5\x0e\xf5\x0e\xf5\x0e\xf5\x0eF\r$\f$\f$\f#\f\xff\t\xae\b\xae\b\xae\b\xae\b\x97\xffo\xfaq\xfaq\xfaq\xfa\xf4\a\xf3\x0e\xf5\x0e\xf5\x0e\xf5\x0e\xa2\xfa\v\xf1\v\xf1\r\xf1\r\xf1\r\xf1\v\xf1\r\xf1\r\xf1\r\xf1\r\xf1\r\xf1\r\xf1\r\xf1\r\xf1\r\xf1\r\xf1\r\xf1\r\xf1\r\xf1\x0e\xf1\x0e\xf1\r\xf1\x0e\xf1\x0e\xf1{\a\xce\r\xce\r\xce\r\xce\r\xd0\xf6\r\xf1\r\xf1\r\xf1\r\xf1\r\xf1\r\xf1\x0e\xf1\x0e\xf1\r\xf1\r\xf1\r\xf1\x0e\xf1\x0e\xf1\x0e\xf1\x0e\xf1\x0e\xf1\x0e\xf1\x0e\xf17\xf1\xf6\xf2:\xf3:\xf3:\xf3\a\xf3H\xf1\x0e\xf1\x0e\xf1\x0e\xf1+\xf3`\x02\xfb\x03\xfb\x03\xfa\x03\x02\x05]\v\xe5\v\xe5\v\xe5\vG\f`\x0e\x80\x0e\x80\x0e~\x0e\x8e\t\xe8\xf1\x10\xf1\x10\xf1\x0e\xf1\x0e\xf1\x0e\xf1\x10\xf1\x10\xf1\x10\xf1~\xf6\xa1\v\xa1\v\xa1\v\xa1\v]\f\xf0\x0e\xf0\x0e\xf0\x0e\xf0\x0e\xf0\x0e\xef\x0e\xef\x0e\xf0\x0e\xf0\x0e7\a\x0e\xf1\x0e\xf1\x0e\xf1\x10\xf1\x10\xf1\x10\xf1\x10\xf1\x11\xf1\x11\xf1B\xf1\xba\xf1\xba\xf1\xba\xf1\xbb\xf1:\xf2O\xf3O\xf3O\xf3O\xf3\xeb\xf3)\xf5)\xf5)\xf5)\xf5\xda\xfb\x80\b\x80\b\x80\b\x80\b\x00\x00\x11\xf1\x11\xf1\x11\xf1\x11\xf1\x89\xfb\xa0\f\xa0\f\x9e\f\x9e\f\x9d\f\x9d\f\x9e\f\x9e\f\x9e\fZ\v\x8f\t\x8f\t\x8f\t\x8e\t\t\xff\x12\xf1\x12\xf1\x12\xf1\x11\xf1g\xfe\xee\x0e\xec\x0e\xec\x0e\xec\x0eM\x0e\x95\r\x95\r\x95\r\x95\r\xf0\xff\x12\xf1\x12\xf1\x12\xf1\x12\xf1f\xf2\xc1\xf3\xc1\xf3\xc1\xf3\xc1\xf3\x9d\xf3y\xf3y\xf3y\xf3y\xf3{\xf2\x93\xf1\x93\xf1\x93\xf1\x93\xf1N\xf1\x14\xf1\x14\xf1\x14\xf1\x14\xf1\xb6\x01\xee\x0e\xee\x0e\xee\x0e\xec\x0e\xd3\xfd\x12\xf1\x12\xf1\x12\xf1\x12\xf1\x8e\xf3J\xf5I\xf5I\xf5I\xf5\x8f\xf7\r\xf9\f\xf9\f\xf9\f\xf9\x91\x06\xeb\x0e\xec\x0e\xec\x0e\xec\x0e\t\xfc\x14\xf1\x14\xf1\x14\xf1\x14\xf12\x04\x9b\x0e\x9b\x0e\x9d\x0e\x9d\x0e\xba\rG\rF\rF\rF\r\xf4\f\xcc\f\xcc\f\xcc\f\xcc\f\xb5\xfc\x82\xf5\x82\xf5\x80\xf5\x80\xf5\x06\x05\x85\v\x85\v\x85\v\x85\v\x83\xf8\x15\xf1\x14\xf1\x15\xf1\x15\xf1\xdd\xf1%\xf2&\xf2&\xf2&\xf2\xa3\x06\x95\r\x95\r\x95\r\x95\rj\b\xc9\x06\xc9\x06\xcb\x06\xcb\x06V\xfcI\xf9J\xf9J\xf9J\xf9P\x04L\aL\aJ\aJ\af\r\xea\x0e\xea\x0e\xea\x0e\x9b\x0e\xd8\xf8\xca\xf3\xca\xf3\xcc\xf3\xc7\xf3(\xf3\x04\xf3\x03\xf3\x03\xf3 \xf3|\xf5\xf4\xf5\xf2\xf5\xf2\xf5\x01\xf7\x0e\b2\v3\v2\vE\vG\fs\fs\fs\f\xc6\n\xe0\xf8\"\xf6\"\xf6$\xf6$\xf6$\xf6\"\xf6$\xf6$\xf6\x99\xf8n\f\xdc\x0e\xdc\x0e\xdd\x0e\x8b\v\xa7\xf3\x16\xf1\x18\xf1\x18\xf1\x18\xf1\x18\xf1\x18\xf1\x18\xf1\x18\xf1\x18\xf1\x18\xf1\x18\xf1\x18\xf1\x18\xf1\x18\xf1\x18\xf1\x18\xf1\x18\xf1\x18\xf1n\xf5_\vs\fs\fr\f\xe6\vT\t?\t?\t?\t\xdf\x04\x80\xf1\x19\xf1\x18\xf1\x18\xf1B\xf2 \xf7)\xf7)\xf7)\xf7\xee\xf5\x18\xf1\x18\xf1\x19\xf1\x19\xf1\x19\xf1\x19\xf1\x19\xf1\x19\xf1\x19\xf1\x19\xf1\x19\xf1\x19\xf1\x19\xf1\x19\xf1B\xf4\x82\xfe\x82\xfe\x82\xfe\x82\xfe\x82\xfe\x82\xfe\x82\xfe\x82\xfe\x83\xfe\x14\xfb\x1b\xf1\x1b\xf1\x1b\xf1\x1b\xf1\x19\xf1\x1b\xf1\x1b\xf1\x1b\xf1\x1b\xf1'\xf1L\xf1L\xf1L\xf1J\xf1\x93\xf9*\x0e*\x0e*\x0e*\x0ec\r\x8c\v\x8c\v\x8c\v\x8c\v\x92\f\xe5\x0e\xe5\x0e\xe5\x0e\xe4\x0e\xe4\x0e\xe5\x0e\xe5\x0e\xe5\x0e\xe5\x0e\x12\x06\xc6\xf3\xc6\xf3\xc6\xf3\xc6\xf3\xd9\xfc\xe4\x0e\xe4\x0e\xe4\x0e\xe4\x0e\xe4\x0e\xe5\x0e\xe5\x0e\xe5\x0e\xe5\x0e\xe5\x0e\xe5\x0e\xe5\x0e\xe4\x0e\xe4\x0e\x1d\x04\x1c\xf1\x1c\xf1\x1c\xf1\x1c\xf1\x1c\xf1\x1c\xf1\x1c\xf1\x1c\xf1\x1c\xf1\x1c\xf1\x1c\xf1\x1c\xf1\x1f\xf1\x1d\xf1\x1c\xf1\x1d\xf1\x1d\xf1\x1c\xf1\x1c\xf1\xf6\xfa\xfb\t\xfb\t\xfb\t\xfb\t\xf6\v\xe4\x0e\xe4\x0e\xe4\x0e\xe3\x0e\xe3\x0e\xe3\x0e\xe3\x0e\xe3\x0e\xe3\x0e\xf6\fQ\nQ\nQ\nQ\n\x89\xff\x1d\xf1\x1d\xf1\x1d\xf1\x1d\xf1\x1d\xf1\x1d\xf1\x1f\xf1\x1f\xf1\x1f\xf1\t\xfb{\a|\a|\a|\a\x95\x03\xd8\xfe\xd9\xfe\xd9\xfe\xda\xfew\x02\xc1\x06\xc1\x06\xc1\x06\xc1\x06\x8e\t\xc5\f\xc5\f\xc5\f\xc5\f\f\v!\t \t \t \tc\v\xd7\r\xd7\r\xd7\r\xd8\rY\x0e\xe1\x0e\xe0\x0e\xe0\x0e\xe1\x0e\xe1\x0e\xe1\x0e\xe1\x0e\xe1\x0e\xe1\x0e\xe1\x0e\xe0\x0e\xe0\x0e\xe0\x0e\xe1\x0e\xe0\x0e\xe0\x0e\xe1\x0e\xe1\x0e\xe0\x0e\xa6\xff \xf1 \xf1 \xf1\x1f\xf1 \xf1 \xf1 \xf1 \xf1 \xf1 \xf1 \xf1 \xf1 \xf1 \xf1\xe2\x00\xe0\x0e\xe0\x0e\xe0\x0e\xe0\x0e\xe0\x0e\xe0\x0e\xe0\x0e\xe0\x0e\xe0\x0e\xe0\x0e\xe0\x0e\xe0\x0e\xe0\x0e\xe0\x0e\x9e\xfe \xf1!\xf1!\xf1!\xf1c\xf2i\xf3i\xf3i\xf3g\xf3\x10\x01\xeb\v\xeb\v\xeb\v\xeb\vl\xff\xaf\xf5\xb0\xf5\xb0\xf5\xb0\xf5\xaf\xf5\xb0\xf5\xb0\xf5\xb0\xf5\xb0\xf5\x17\x04\xdf\x0e\xdf\x0e\xdf\x0e\xdf\x0ek\r[\f\\\f\\\f\\\f\\\f\\\f\\\f\\\f\\\fK\xfd\xa0\xf2\xa0\xf2\xa0\xf2\xa0\xf2H\x03\xdf\x0e\xdd\x0e\xdd\x0e\xdd\x0e\xdd\x0e\xdd\x0e\xdd\x0e\xdd\x0e\xdd\x0eR\x00\x8c\xf6\x8d\xf6\x8d\xf6\x8d\xf63\xf4\xa4\xf2\xa5\xf2\xa5\xf2\xa5\xf2\xbb\xf1#\xf1#\xf1#\xf1#\xf1\x0e\xf3I\xf4J\xf4I\xf4I\xf4H\x02$\v$\v$\v$\vn\r\xdc\x0e\xdc\x0e\xdc\x0e\xdc\x0e\xdd\x0e\xdd\x0e\xdd\x0e\xdd\x0e\xdd\x0e\xf7\xfeP\xf5P\xf5Q\xf5Q\xf5\xb6\xf2$\xf1$\xf1$\xf1$\xf1o\x01\x16\v\x16\v\x15\v\x15\vr\v\xa9\v\xa9\v\xa9\v\xa9\v\x01\f6\f4\f4\f4\f\xcd\v\x92\v\x92\v\x92\v\x92\v\x99\v\x9e\v\x9e\v\x9e\v\x9e\v\xac\v\xb3\v\xb3\v\xb3\v\xb3\vG\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9b\f\x9a\f\x9a\f\v\xfd\x82\xf4\x82\xf4\x82\xf4\x82\xf4T\xf2&\xf1&\xf1&\xf1&\xf1&\xf1&\xf1&\xf1&\xf1&\xf1+\x04h\x0eg\x0eg\x0eg\x0e\xb3\x0e\xda\x0e\xd9\x0e\xd9\x0e\xd9\x0e\xd9\x0e\xd9\x0e\xd9\x0e\xd9\x0e\xd9\x0eq\xfb'\xf1'\xf1'\xf1'\xf1g\xf7\xb4\xfa\xb4\xfa\xb3\xfa\xb3\xfa\xe4\a\xd9\x0e\xd9\x0e\xd9\x0e\xd9\x0ec\xfb(\xf1(\xf1(\xf1(\xf1(\xf1'\xf1(\xf1(\xf1(\xf1\xa4\x04\xd9\x0e\xd9\x0e\xd9\x0e\xd9\x0e[\xfb(\xf1(\xf1(\xf1(\xf1'\xf1'\xf1'\xf1(\xf1(\xf1\xa5\x04\xd8\x0e\xd8\x0e\xd8\x0e\xd8\x0e\xd7\x0e\xd7\x0e\xd8\x0e\xd8\x0e\xd8\x0e\xd7\x0e\xd7\x0e\xd7\x0e\xd7\x0e\xd7\x0e\xba\t\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a\v\a%\f\xd8\x0e\xd7\x0e\xd7\x0e\xd7\x0e|\x0eK\x0eM\x0eM\x0eM\x0e\xab\x06\x9b\x02\x99\x02\x99\x02\x99\x02A\xf7)\xf1)\xf1)\xf1)\xf1)\xf1)\xf1+\xf1+\xf1+\xf1f\x04\xd5\x0e\xd5\x0e\xd7\x0e\xd7\x0e\xd7\x0e\x18\x06D\xfbC\xfbC\xfbC\xfbC\xfbX\xf9i\xf3i\xf3i\xf3i\xf3i\xf3\x92\xf4\x80\n\xd7\x0e\xd5\x0e\xd5\x0e\xd5\x0e\xd5\x0e\xde\xfb+\xf1+\xf1+\xf1+\xf1+\xf1+\xf1+\xf1+\xf1+\xf1+\xf1+\xf1+\xf1+\xf1+\xf1,\xf1,\xf1+\xf1\x80\xf1\x1e\xfa\xf3\xfb\xf3\xfb\xf3\xfb\xf2\xfb\xf2\xfb2\xf5,\xf1,\xf1,\xf1,\xf1,\xf1,\xf1,\xf1,\xf1,\xf1,\xf1+\xf1+\xf1,\xf1,\xf1,\xf1+\xf1,\xf1\xa8\xf1[\t\xd4\x0e\xd4\x0e\xd4\x0e\xd4\x0e\xd4\x0el\xfe\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xfd\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xce\xf4\xff\xf7\xff\xf7\xff\xf7\xff\xf7\x00\xf8\x00\xf8@\x02\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x044\xf9,\xf1,\xf1,\xf1-\xf1-\xf1-\xf1-\xf1-\xf1-\xf1-\xf1-\xf1}\xf5a\b\xd0\b\xd0\b\xd0\b\xd0\b\xd0\b\xd3\x06A\x06B\x06B\x06B\x06B\x063\xfe\x1e\xf8\x1e\xf8\x1e\xf8\x1e\xf8 \xf8 \xf8 \xf8 \xf8 \xf8 \xf8 \xf8\a\xf7|\xf10\xf1/\xf1/\xf1/\xf1/\xf1^\a\xb8\x0e\xb7\x0e\xb7\x0e\xb7\x0e\xb7\x0e\x9e\v\n\t\n\t\n\t\n\t\n\t\t\x03M\xf7M\xf7M\xf7M\xf7M\xf7\x80\xf6\x9d\xf10\xf10\xf10\xf10\xf10\xf1?\xf1E\xf1E\xf1E\xf1E\xf1E\xf1E\xf1E\xf1E\xf1E\xf1E\xf1E\xf1?\xf10\xf10\xf10\xf10\xf10\xf1\x05\xf4f\n5\r5\r7\r7\r7\r\x94\xfc+\xf5)\xf5)\xf5)\xf5)\xf5>\xf32\xf12\xf12\xf12\xf12\xf1k\xf6$\x04$\x04$\x04$\x04$\x04\x95\x04\xd0\t\xb1\n\xb1\n\xb1\n\xb1\n\xb1\n\xb1\n\xb3\n\xb3\n\xb3\n\xb3\n\xb1\n\x9c\xff\xf7\xf1\xf5\xf1\xf5\xf1\xf7\xf1\xf7\xf1R\xf7X\bX\bX\bX\bX\bX\bX\bX\bX\bX\bX\bX\b\xcc\v\xed\r\xed\r\xed\r\xed\r\xed\rI\x0e\xcd\x0e\xcd\x0e\xcd\x0e\xcd\x0e\xcd\x0e\xcd\x0e\xcd\x0e\xcd\x0e\xcd\x0e\xcd\x0e\xcd\x0e\xcd\x0e\xcd\x0e\xcd\x0e\xcd\x0e\xcd\x0e\xcd\x0e\xcc\x0e\xb1\xfd4\xf13\xf13\xf13\xf13\xf13\xf13\xf13\xf14\xf14\xf14\xf1H\xf5\xf4\t\x1d\v\x1d\v\x1d\v\x1d\v\x1d\vV\vj\vj\vk\vk\vk\vk\vj\vj\vj\vj\vk\vU\f@\x0e@\x0e@\x0e@\x0e@\x0eP\x0e\xbf\x0e\xcc\x0e\xcc\x0e\xca\x0e\xca\x0e\xca\x0e\xcc\x0e\xcc\x0e\xcc\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xcc\x0e\xcc\x0e\xcc\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xca\x0e7\b6\xf16\xf16\xf17\xf17\xf1}\xf1\x13\t\xca\x0e\xca\x0e\xca\x0e\xca\x0e\xc9\x0e\xc9\x0e\xc9\x0e\xc9\x0e\xc9\x0e\xc9\x0e\xc9\x0e\xc9\x0e\xc8\x0e\xc8\x0e\xc8\x0e\xc8\x0e\xc8\x0et\n\xc7\xf5\xdc\xf4\xdc\xf4\xdd\xf4\xdd\xf4\xdd\xf4g\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\xd4\xf5\v\xf6\v\xf6\v\xf6\n\xf6\n\xf6B\xf6\xb5\xf6\xb5\xf6\xb5\xf6\xb5\xf6\xb5\xf6G\xf7u\xfb\xf2\xfb\xf2\xfb\xf2\xfb\xf2\xfb\xf2\xfb<\xfd\xcf\xfd\xcf\xfd\xcf\xfd\xcf\xfd\xcf\xfd\xe5\x05\xc8\x0e\xc6\x0e\xc6\x0e\xc6\x0e\xc8\x0e\xa5\t.\xfb.\xfb.\xfb0\xfb0\xfb\xd3\xfa\xb5\xf4\x83\xf3\x84\xf3\x84\xf3\x84\xf3\x84\xf3\x13\xf2:\xf1:\xf18\xf18\xf18\xf18\xf18\xf1:\xf1:\xf1:\xf1:\xf1:\xf1:\xf1:\xf1:\xf1:\xf18\xf18\xf1$\b\xa6\x0e\xa6\x0e\xa8\x0e\xa8\x0e\xa8\x0eq\x0eG\x0eG\x0eG\x0eG\x0eG\x0e8\r=\v=\v=\v=\v=\v\xa8\b\xde\xf8k\xf7k\xf7k\xf7j\xf7j\xf7j\xf7k\xf7k\xf7j\xf7j\xf7k\xf7^\xf4;\xf1;\xf1;\xf1;\xf1;\xf1;\xf7\xec\x06\xec\x06\xed\x06\xec\x06\xec\x06\xed\x06\xed\x06\xed\x06\xec\x06\xec\x06\xec\x06\xec\x06\xee\v\xc3\x0e\xc5\x0e\xc5\x0e\xc5\x0e\xc5\x0e\xde\x04D\xf7F\xf7F\xf7F\xf7D\xf7\v\xf6>\xf1>\xf1=\xf1=\xf1=\xf1=\xf1S\b\xc3\x0e\xc3\x0e\xc3\x0e\xc3\x0e\xc5\x0e\xc3\x0e\xc3\x0e\xc3\x0e\xc3\x0e\xc5\x0e\xc2\x0e\xc2\x0e\xc3\x0e\xc3\x0e\xc3\x0e\xc3\x0e\xc2\x0eY\x0e\xcc\v\x8e\v\x8e\v\x8e\v\x8e\v\x8e\v>\n\xb0\t\xb0\t\xb0\t\xb0\t\xb0\t\xa1\n\xa0\v\xa0\v\xa0\v\xa1\v\xa1\v\xa1\v\xa1\v\xa1\v\xa1\v\xa1\v\xa1\v\xb6\n\x14\xfb\x00\xf8\x00\xf8\x00\xf8\x01\xf8\x01\xf8(\x05\x1a\r\x1a\r\x1a\r\x1a\r\x1a\r\xc5\r\xc1\x0e\xc1\x0e\xc1\x0e\xc1\x0e\xc2\x0ex\n\xe4\xf7n\xf7n\xf7n\xf7n\xf7n\xf7n\xf7n\xf7n\xf7n\xf7n\xf7l\xf7\x19\xf4?\xf1?\xf1?\xf1?\xf1?\xf1?\xf1?\xf1?\xf1?\xf1?\xf1>\xf1?\xf1?\xf1?\xf1?\xf1?\xf1?\xf1?\xf1\x1d\x05\xc1\x0e\xc1\x0e\xc1\x0e\xc1\x0e\xc1\x0e\xbf\x0e\xbf\x0e\xc1\x0e\xc1\x0e\xc1\x0e\xc1\x0e\x1f\r\xb3\a\xb3\a\xb5\a\xb5\a\xb5\a\xb3\a\xb3\a\xb3\a\xb5\a\xb5\a\xb5\a\xb5\aF\v\xd3\r\xd4\r\xd4\r\xd4\r\xd4\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xf2\r\xaf\x0e\xbf\x0e\xbe\x0e\xbe\x0e\xbf\x0e\xbf\x0e\x13\xfc\x8e\xf4\x8e\xf4\x8e\xf4\x8e\xf4\x8e\xf4\xf1\xf2B\xf1B\xf1B\xf1B\xf1A\xf1A\xf1B\xf1B\xf1B\xf1B\xf1A\xf1B\xf1B\xf1B\xf1B\xf1B\xf1C\xf1C\xf1C\xf1C\xf1C\xf1B\xf1B\xf1B\xf1M\xf1\\\xf1\\\xf1\\\xf1\\\xf1\\\xf1m\xf1\xb7\xf1\xba\xf1\xbb\xf1\xbb\xf1\xba\xf1\xba\xf1D\a\xbe\x0e\xbd\x0e\xbd\x0e\xbd\x0e\xbe\x0e\xbd\x0e\xbd\x0e\xbd\x0e\xbd\x0e\xbd\x0e\xbd\x0e\x11\x06C\xf1C\xf1C\xf1C\xf1C\xf1W\xf1A\xf2g\xf2g\xf2g\xf2g\xf2g\xf2\xa5\xf4\xe6\xf5\xe6\xf5\xe6\xf5\xe7\xf5\xe7\xf5\xf9\xf3E\xf1C\xf1C\xf1C\xf1C\xf1C\xf1C\xf1C\xf1E\xf1E\xf1C\xf1C\xf1\xb3\a\xbb\x0e\xbd\x0e\xbb\x0e\xbb\x0e\xbb\x0e\xbd\x0e\xbd\x0e\xbb\x0e\xbb\x0e\xbb\x0e\xbb\x0e\xbb\x0e\xbb\x0e\xbb\x0e\xbb\x0e\xbb\x0e\xbb\x0e\x1b\r\xe5\xfd\x9b\xfb\x9b\xfb\x9b\xfb\x9b\xfb\x9b\xfb\x9b\xfb\x9b\xfb\x9b\xfb\x9b\xfb\x9b\xfb\x9b\xfb\xb9\x03\x93\x0e\x95\x0e\x95\x0e\x95\x0e\x93\x0e\x93\x0e\x93\x0e\x93\x0e\x93\x0e\x92\x0e\x92\x0e\x92\x0e\xb2\x0e\xba\x0e\xba\x0e\xba\x0e\xba\x0e\xbb\x0e\xba\x0e\xba\x0e\xba\x0e\xba\x0e\xba\x0e\xba\x0e\x1e\x0e\xce\f\xce\f\xce\f\xcd\f\xcd\f\xf9\f\x80\x0e\xba\x0e\xba\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xba\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xb8\x0e)\xf8f\xf1g\xf1g\xf1g\xf1g\xf1g\xf1f\xf1g\xf1g\xf1g\xf1g\xf1_\xf1I\xf1I\xf1H\xf1I\xf1I\xf1I\xf1I\xf1I\xf1I\xf1I\xf1I\xf1I\xf1n\x04\xb8\x0e\xb8\x0e\xb8\x0e\xb8\x0e\xb7\x0e\x8b\x0eQ\x0eQ\x0eQ\x0eQ\x0eQ\x0e\xc6\r\x85\v|\v|\v|\vz\vz\v-\xfd\x9e\xf8\x9e\xf8\x9e\xf8\x9e\xf8\x9e\xf8\x9e\xf8\x9e\xf8\x9e\xf8\x9e\xf8\x9e\xf8\x9e\xf89\xff\xb6\x0e\xb6\x0e\xb6\x0e\xb7\x0e\xb7\x0e\xb7\x0e\xb7\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb4\x0e\xb4\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb6\x0e\xb4\x0e\xb4\x0e\xb4\x0e\xb4\x0e\xb4\x0e\xb4\x0e\xb4\x0e\xb4\x0e\xb6\x0e\xb4\x0e\xb4\x0e\xb4\x0e\xb4\x0e\xb6\x0e\xb4\x0e\xb4\x0e\x87\r\xfc\xf7\x96\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\xc0\xfd\xb4\x0e\xb4\x0e\xb4\x0e\xb3\x0e\xb3\x0e\xb3\x0e\xb3\x0e\xb4\x0e\xb4\x0e\xb4\x0e\xb4\x0e\xb4\x0e\xb4\x0e\xb4\x0e\xb3\x0e\xb3\x0e\xb3\x0e\xb3\x0e\xb3\x0e\xb4\x0e\xb3\x0e\xb3\x0e\xb3\x0e\xb4\x0e\xa3\aM\xf1M\xf1N\xf1N\xf1M\xf1M\xf1M\xf1M\xf1M\xf1M\xf1M\xf1M\xf1N\xf1N\xf1M\xf1M\xf1M\xf1M\xf1M\xf1M\xf1M\xf1M\xf1M\xf1M\xf1N\xf1N\xf1N\xf1M\xf1N\xf1N\xf1N\xf1N\xf1N\xf1N\xf1N\xf1N\xf1N\xf1\xe7\xfa\x9f\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9f\a\x9d\a\x9d\a\x9d\a\x9d\a\xf6\f\xb2\x0e\xb2\x0e\xb2\x0e\xb2\x0e\xb2\x0e\xb2\x0e\xb2\x0e\xb2\x0e\xb2\x0e\xb0\x0e\xb0\x0e\x86\b4\xf94\xf94\xf94\xf94\xf9\x03\xf9\x0e\xf6{\xf5{\xf5|\xf5|\xf5|\xf5\xe5\x04\xb0\x0e\xb0\x0e\xb0\x0e\xb0\x0e\xb0\x0e\xaf\x0e\xaf\x0e\xaf\x0e\xaf\x0e\xb0\x0e\xb0\x0eh\r7\x06\xa0\x05\x9f\x05\x9f\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\x9f\x055\xfcQ\xf1Q\xf1Q\xf1P\xf1P\xf1P\xf1P\xf1P\xf1Q\xf1Q\xf1Q\xf1Q\xf1\x17\bN\x0eN\x0eN\x0eN\x0eN\x0eP\x0eN\x0eN\x0eN\x0eN\x0eN\x0ek\x0e\xad\x0e\xad\x0e\xaf\x0e\xaf\x0e\xb0\x0e\xaf\x0e\xaf\x0e\xad\x0e\xad\x0e\xaf\x0e\xaf\x0e\xaf\x0e\xaf\x0e\xaf\x0e\xaf\x0e\xaf\x0e\xaf\x0e\xaf\x0e\xad\x0e\xad\x0e\xad\x0e\xad\x0e\xad\x0e\xad\x0e\f\n\xeb\xf2\x88\xf1\x88\xf1\x88\xf1\x88\xf1\x88\xf1\x8a\xf1\x8a\xf1\x8a\xf1\x8a\xf1\x8a\xf1\x88\xf1p\xf1T\xf1T\xf1T\xf1S\xf1S\xf1S\xf1S\xf1S\xf1T\xf1T\xf1T\xf1^\xf1\xa7\b\xac\x0e\xac\x0e\xac\x0e\xac\x0e\xac\x0e\xac\x0e\xad\x0e\xad\x0e\xad\x0e\xac\x0e\xac\x0e\xad\x0e\xac\x0e\xac\x0e\xac\x0e\xac\x0e\xac\x0e\xac\x0e\xac\x0e\xac\x0e\xac\x0e\xac\x0e\xac\x0e\xac\x0e\x91\xfd\xaf\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xaf\xf3\xa2\xf7\xc3\v\x0e\r\x0e\r\x0e\r\x0e\r\x0e\r\x0f\r\x0f\r\x0f\r\x0f\r\x0f\r\x0f\r\xa8\x03\x10\xf9\x10\xf9\x10\xf9\x11\xf9\x11\xf9\x10\xf9\x11\xf9\x11\xf9\x11\xf9\x11\xf9\x11\xf9\x11\xf92\bc\fb\fb\fb\fc\fc\fb\fb\fb\fb\fb\f\x12\r\xa9\x0e\xa9\x0e\xa9\x0e\xa9\x0e\xa9\x0e\xab\x0e\xab\x0e\xab\x0e\xab\x0e\xab\x0e\xab\x0e\xab\x0e\xa9\x0e\xa9\x0e\xa9\x0e\xa9\x0e\xa9\x0e\xab\x0e\xa9\x0e\xa9\x0e\xa9\x0e\xa9\x0e\xa9\x0e\xa9\x0e\xba\r\x1d\b\x95\a\x95\a\x95\a\x95\a\x95\a\x95\a\x95\a\x95\a\x95\a\x95\a\x95\a\xd2\x05\x98\x03\x98\x03\x98\x03\x98\x03\x98\x03\xf6\xffy\xf1X\xf1X\xf1X\xf1X\xf1X\xf1X\xf1X\xf1X\xf1X\xf1X\xf1X\xf1\x9f\xfc\x83\a\x83\a\x83\a\x83\a\x83\a\x83\a\x83\a\x83\a\x83\a\x83\a\x83\a\xfd\x06n\xf5Z\xf1X\xf1X\xf1X\xf1X\xf1X\xf1X\xf1X\xf1X\xf1X\xf1X\xf1\b\xfb\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa8\x0e\xa8\x0e\xa8\x0e\xa8\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa6\x0e\xa5\x0e\xa5\x0e8\xfaZ\xf1[\xf1[\xf1[\xf1[\xf1[\xf1[\xf1[\xf1[\xf1Z\xf1[\xf1[\xf1Z\xf1Z\xf1[\xf1[\xf1[\xf1[\xf1[\xf1[\xf1[\xf1[\xf1[\xf1\\\xf1<\xfb\xa4\x04\xa4\x04\xa4\x04\xa4\x04\xa5\x04P\a\xa5\x0e\xa5\x0e\xa5\x0e\xa5\x0e\xa5\x0e\xa5\x0e\xa5\x0e\xa4\x0e\xa4\x0e\xa5\x0e\xa5\x0e\xa5\x0e\x01\xfe\\\xf1[\xf1[\xf1\\\xf1\\\xf1\\\xf1\\\xf1\\\xf1\\\xf1\\\xf1\\\xf1I\xf3\x17\a\\\n\\\n\\\n\\\n\\\n\\\n\\\n\\\n\\\n\\\n\\\n\\\n[\n[\n[\n\\\n\\\n\xeb\nN\x0e\xa4\x0e\xa4\x0e\xa2\x0e\xa2\x0e\xa2\x0e\xa2\x0e\xa2\x0e\xa2\x0e\xa2\x0e\xa2\x0e\xa2\x0e\xe1\x05B\xfaB\xfaB\xfaB\xfaB\xfaB\xfaB\xfaB\xfaC\xfaC\xfaB\xfaB\xfaB\xfaB\xfaB\xfaB\xfaB\xfaB\xfa\xff\xf5^\xf1^\xf1^\xf1^\xf1^\xf1^\xf1^\xf1^\xf1^\xf1^\xf1^\xf1^\xf1\x17\b\xa1\x0e\xa1\x0e\xa1\x0e\xa1\x0e\xa1\x0e\xa2\x0e\xa1\x0e\xa1\x0e\xa1\x0e\xa1\x0e\xa2\x0e\xa1\x0e\xa1\x0e\xa1\x0e\xa1\x0e\xa1\x0e\xa1\x0e\xf8\r\xb4\xfd\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x14\xfa\x13\xfa\x13\xfa\x14\xfa\x14\xfa\xc3\xf7\xfc\xf2\xfc\xf2\xfc\xf2\xfd\xf2\xfd\xf2\xfd\xf2\xfd\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfd\xf2\xfd\xf2M\xf5\xb7\xf6\xb7\xf6\xb7\xf6\xb8\xf6\xb8\xf6\xb7\xf6\xb7\xf6\xb7\xf6\xb8\xf6\xb8\xf6\xb7\xf6o\xf7!\xfc\xa5\xfc\xa6\xfc\xa6\xfc\xa6\xfc\xa6\xfc\xa6\xfc\xa6\xfc\xa5\xfc\xa6\xfc\xa6\xfc\xa6\xfc\xa6\xfc\xa6\xfc\xa6\xfc\xa6\xfc\xa6\xfc\xa6\xfc\x83\xfe\x8f\a\x16\b\x16\b\x16\b\x16\b\x16\b\x16\b\x16\b\x15\b\x15\b\x15\b\x16\b\xcb\xfdb\xf1b\xf1b\xf1c\xf1c\xf1b\xf1b\xf1b\xf1b\xf1b\xf1b\xf1b\xf1\xb0\xf5,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7\x8e\xfa3\x053\x052\x052\x052\x052\x05\xbb\t\x01\v\x01\v\x01\v\x01\v\x01\v\x01\v\x01\v\x01\v\x01\v\x01\v\x01\v\x00\f\x9d\x0e\x9d\x0e\x9d\x0e\x9d\x0e\x9d\x0e\x9d\x0e\x9d\x0e\x9d\x0e\x9b\x0e\x9b\x0e\x9b\x0e\x9d\x0e\x9d\x0e\x9b\x0e\x9b\x0e\x9b\x0e\x9b\x0e\x9b\x0e\x9b\x0e\x9b\x0e\x9b\x0e\x9d\x0e\x9b\x0e\x9b\x0e\x9d\x0e\x9d\x0e\x9b\x0e\x9b\x0e\x9d\x0e\x9b\x0e\x9b\x0e\x02\x02~\xf9~\xf9~\xf9}\xf9~\xf9~\xf9~\xf9~\xf9~\xf9~\xf9~\xf9\xf2\xfa\xae\a\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9a\t\x9b\t\x9b\t\x9a\t\x9a\t\x9a\t{\tF\tF\tF\tF\tH\tA\t\x17\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x13\t\x12\t\x12\t\x12\t\x13\t\x98\t_\n_\n^\n^\n_\n_\n_\n_\n_\n_\n_\n_\nC\r\x9a\x0e\x9a\x0e\x9a\x0e\x9a\x0e\x99\x0e\x99\x0e\x99\x0e\x99\x0e\x99\x0e\x9a\x0e\x99\x0e\x99\x0e\x99\x0e\x99\x0e\x99\x0e\x99\x0e\x9a\x0e\x9a\x0e\xe0\xfd\x01\xf7\x00\xf7\x00\xf7\x00\xf7\x00\xf7\x00\xf7\x00\xf7\x00\xf7\x00\xf7\x00\xf7\x00\xf7\xb0\xf6p\xf5j\xf5j\xf5j\xf5j\xf5j\xf5{\xf2g\xf1g\xf1g\xf1g\xf1g\xf1g\xf1g\xf1g\xf1g\xf1g\xf1g\xf1>\xf6a\aa\aa\a_\a_\a_\aa\a_\a_\aa\aa\aa\aa\aa\a_\a_\a_\aa\a\x18\x02i\xf1i\xf1i\xf1j\xf1j\xf1j\xf1i\xf1j\xf1j\xf1i\xf1i\xf1i\xf1\x05\xf4w\xf6y\xf6y\xf6y\xf6y\xf65\xfc\x9d\f\x9d\f\x9d\f\x9d\f\x9d\f\x9d\f\x9d\f\x9d\f\x9d\f\x9d\f\x9d\f\x9d\f~\xfe$\xf2%\xf2%\xf2%\xf2%\xf2%\xf2$\xf2$\xf2$\xf2$\xf2%\xf2%\xf2%\xf2%\xf2%\xf2$\xf2$\xf2%\xf2\xc0\x01\x95\x0e\x95\x0e\x95\x0e\x95\x0e\x96\x0e\x96\x0e\x95\x0e\x95\x0e\x95\x0e\x95\x0e\x95\x0e\x95\x0e\x95\x0e\x93\x0e\x93\x0e\x95\x0e\x95\x0e\x95\x0e0\xfek\xf1k\xf1k\xf1k\xf1k\xf1k\xf1k\xf1k\xf1k\xf1k\xf1k\xf1k\xf1m\xf1m\xf1k\xf1k\xf1k\xf1k\xf1k\xf1k\xf1k\xf1k\xf1m\xf1m\xf1k\xf1k\xf1k\xf1k\xf1m\xf1m\xf1m\xf1m\xf1m\xf1m\xf1m\xf1k\xf1k\xf1m\xf1m\xf1m\xf1m\xf1m\xf1m\xf1m\xf1m\xf1m\xf1m\xf1m\xf1m\xf1A\xf3E\n\x93\x0e\x93\x0e\x93\x0e\x92\x0e\x92\x0e\x92\x0e\x93\x0e\x93\x0e\x93\x0e\x93\x0e\x93\x0e\x93\x0e\x92\x0e\x92\x0e\x92\x0e\x92\x0e\x92\x0eU\x0e\xa6\v.\v,\v+\v+\v+\v,\v.\v.\v.\v.\v.\v,\v,\v,\v.\v,\v,\vD\t\xf2\xf4n\xf1n\xf1n\xf1n\xf1n\xf1n\xf1n\xf1n\xf1n\xf1n\xf1n\xf1p\xf1p\xf1p\xf1n\xf1n\xf1n\xf1\xf5\xf2\x99\x02?\x05?\x05A\x05?\x05?\x05?\x05?\x05A\x05A\x05?\x05?\x05?\x05A\x05A\x05?\x05?\x05?\x05\xdb\x03\xbf\xf5_\xf3_\xf3_\xf3_\xf3_\xf3_\xf3_\xf3_\xf3_\xf3^\xf3_\xf3`\xf3`\xf3`\xf3_\xf3_\xf3_\xf3b\xf5\xf8\tv\rv\rv\rv\rv\r\x1f\x0e\x8f\x0e\x8f\x0e\x8f\x0e\x8f\x0e\x8f\x0e\x8e\x0e\x8e\x0e\x8e\x0e\x8f\x0e\x8f\x0e\x8f\x0e\x8e\x0e\x8e\x0e\x8e\x0e\x8e\x0e\x8e\x0e\x8e\x0e\x8e\x0e\x06\xff\x9d\xf4\x9d\xf4\x9f\xf4\x9f\xf4\x9d\xf4\x9d\xf4\x9d\xf4\x9d\xf4\x9f\xf4\x9f\xf4\x9d\xf4\x9d\xf4\x9d\xf4\x9d\xf4\x9d\xf4\x9d\xf4\x9d\xf4\x9d\xf4\x15\x01\xa1\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa0\t\xa1\t\xa1\t\xa1\t\x87\tL\b\x11\b\x0f\b\x0f\b\x11\b\x11\b\xa4\x05\xf0\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03\xf1\x03#\x03u\xf7\"\xf5\"\xf5!\xf5!\xf5\"\xf5\"\xf5\"\xf5\"\xf5\"\xf5\"\xf5\"\xf5\"\xf5\"\xf5\"\xf5\"\xf5\"\xf5\"\xf5\xf9\xf4\x13\xf2u\xf1u\xf1u\xf1u\xf1t\xf1u\xf1u\xf1t\xf1t\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1u\xf1t\xf1b\xf2S\t\x8b\x0e\x8b\x0e\x8b\x0e\x8b\x0e\x8b\x0e\x8b\x0e\x8b\x0e\x8b\x0e\x8b\x0e\x8a\x0e\x8a\x0e\x8b\x0e\x8b\x0e\x8b\x0e\x8b\x0e\x8b\x0e\x8a\x0e\x87\x0eG\x0e7\x0e7\x0e7\x0e7\x0e7\x0e7\x0e7\x0e7\x0e7\x0e7\x0e7\x0ep\x06u\xf1u\xf1v\xf1v\xf1v\xf1v\xf1v\xf1v\xf1v\xf1v\xf1v\xf1v\xf1v\xf1v\xf1x\xf1x\xf1x\xf1v\xf1u\xf1v\xf1v\xf1v\xf1x\xf1x\xf1x\xf1x\xf1x\xf1x\xf1x\xf1x\xf1x\xf1x\xf1x\xf1x\xf1x\xf1x\xf1x\xf1\x04\xf70\t0\t0\t/\t/\t/\t/\t/\t/\t/\t/\t/\t/\t/\t/\t/\t0\t/\t\xb5\x05\xe0\xf8\xe0\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xd1\xf7q\xf3Q\xf3S\xf3S\xf3S\xf3S\xf3S\xf3S\xf3Q\xf3S\xf3S\xf3S\xf3S\xf3S\xf3S\xf3S\xf3S\xf3S\xf3\xd2\xf7B\r\x87\x0e\x87\x0e\x87\x0e\x85\x0e\x85\x0e\x85\x0e\x87\x0e\x87\x0e\x87\x0e\x87\x0e\x85\x0e\x85\x0e\x87\x0e\x87\x0e\x85\x0e\x85\x0e\x87\x0e\xe7\f\xaf\x03\xd3\x02\xd3\x02\xd4\x02\xd6\x02\xd6\x02\xd6\x02\xd4\x02\xd4\x02\xd6\x02\xd6\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02\xd4\x02L\x03\xa7\x06\x16\a\x15\a\x15\a\x16\a\x16\a\x15\a\x15\a\x15\a\x15\a\x15\a\x15\a\x15\a\x15\a\x15\a\x15\a\x15\a\x15\a\xf7\x05\xf7\xfa\x1c\xf9\x1b\xf9\x1c\xf9\x1c\xf9\x1c\xf9\x1c\xf9\x1c\xf9\x1c\xf9\x1c\xf9\x1c\xf9\x1c\xf9\x1c\xf9\x1c\xf9\x1c\xf9\x1c\xf9\x1c\xf9\x1c\xf9\x11\xf9[\xf84\xf83\xf84\xf84\xf84\xf8\xee\xf7\xb7\xf7\xb7\xf7\xb7\xf7\xb6\xf7\xb6\xf7\xb7\xf7\xb7\xf7\xb7\xf7\xb7\xf7\xb7\xf7\xb7\xf7\xb6\xf7\xb6\xf7\xb7\xf7\xb7\xf7\xb6\xf7\xb6\xf7\xb6\xf7p\xf4}\xf1}\xf1}\xf1}\xf1}\xf1}\xf1\u007f\xf1\u007f\xf1\u007f\xf1\u007f\xf1\u007f\xf1\u007f\xf1}\xf1\u007f\xf1\u007f\xf1}\xf1}\xf1}\xf1\xde\xf1A\xf2A\xf2A\xf2A\xf2A\xf2A\xf2A\xf2A\xf2A\xf2B\xf2B\xf2B\xf2B\xf2B\xf2A\xf2A\xf2A\xf2A\xf2\x02\xff\x81\x0e\x81\x0e\x81\x0e\x81\x0e\x81\x0e\x81\x0e\x81\x0e\x80\x0e\x80\x0e\x81\x0e\x81\x0e\x81\x0e\x81\x0e\x80\x0e\x80\x0e\x81\x0e\x81\x0e\x81\x0e\x80\x0e\x80\x0e\x80\x0e\x80\x0e\x80\x0e\x80\x0e\x9a\n\xc5\xf3\u007f\xf1\u007f\xf1\u007f\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x80\xf1\x82\xf1\x82\xf1\x82\xf1\x82\xf1\x82\xf1\x80\xf1\x80\xf1\x82\xf1\x82\xf1\x82\xf1\x82\xf1\x82\xf1\x82\xf1\x80\xf1\x80\xf1\x80\xf1\x82\xf1\x9f\x01~\x0e~\x0e~\x0e~\x0e~\x0e~\x0e~\x0e~\x0e\x80\x0e~\x0e~\x0e~\x0e~\x0e~\x0e~\x0e~\x0e~\x0e~\x0e~\x0e~\x0e~\x0e~\x0e~\x0e}\x0e}\x0e}\x0e}\x0e}\x0e}\x0e}\x0e}\x0e}\x0e}\x0e}\x0e}\x0e}\x0e}\x0e}\x0e}\x0e~\x0e~\x0e~\x0e}\x0e\xf1\nz\xfb\xd3\xfa\xd3\xfa\xd3\xfa\xd3\xfa\xd3\xfa\xd3\xfa\xd3\xfa\xd3\xfa\xd3\xfa\xd3\xfa\xd3\xfa\xd3\xfa\xd5\xfa\xd5\xfa\xd5\xfa\xd3\xfa\xd3\xfa\xd3\xfa\xd3\xfa\xd3\xfa\xd3\xfa\xd3\xfa\xd3\xfa\xd3\xfa\xea\xf4\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf1\x84\xf18\xfa|\x0e|\x0e|\x0e|\x0e|\x0ez\x0ez\x0ez\x0ez\x0e|\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ey\x0ey\x0ey\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0ez\x0e\xca\xff)\xf9'\xf9'\xf9'\xf9'\xf9\x0e\xf6\x87\xf1\x87\xf1\x86\xf1\x86\xf1\x86\xf1\x87\xf1\x87\xf1\x87\xf1\x87\xf1\x87\xf1\x87\xf1\x87\xf1\x87\xf1\x87\xf1\x87\xf1\x88\xf1\x88\xf1\x87\xf1\x87\xf1\x87\xf1\x87\xf1\x87\xf1\x88\xf1\x88\xf1\x87\xf1\x87\xf1\x87\xf1\x88\xf1\x88\xf1\x88\xf1\x88\xf1\x87\xf1\x87\xf1\x87\xf1\x87\xf1\x87\xf1\x88\xf1\x88\xf1\x88\xf1\x88\xf1\x88\xf1\x88\xf1\x88\xf1\x88\xf1\x88\xf1\x87\xf1\x88\xf1\x88\xf1\x88\xf1\x88\xf1h\xfeE\vE\vE\vF\vF\vF\vF\vF\vF\vF\vF\vF\vF\vF\vF\vF\vF\vE\vE\vF\vF\vF\vE\vE\v\xbf\v@\x0ev\x0ev\x0ex\x0ev\x0ev\x0ev\x0ex\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0ev\x0eu\x0eu\x0ev\x0ev\x0ev\x0ev\x0eu\x0eu\x0eu\x0eu\x0e\xb4\xfd\x8b\xf1\x8a\xf1\x8a\xf1\x8a\xf1\x8b\xf1\x8b\xf1\x8b\xf1\x8b\xf1\x8a\xf1\x8a\xf1\x8a\xf1\x8b\xf1\x8d\xf1\x8b\xf1\x8b\xf1\x8b\xf1\x8b\xf1\x8b\xf1\x8b\xf1\x8b\xf1\x8b\xf1\x8b\xf1\x8b\xf1\x8b\xf1\xf3\xf1X\xf3X\xf3W\xf3X\xf3X\xf3X\xf3\xe1\x06u\x0eu\x0eu\x0eu\x0eu\x0es\x0es\x0eu\x0eu\x0eu\x0eu\x0es\x0es\x0es\x0es\x0es\x0es\x0es\x0e\xbb\x005\xf95\xf94\xf94\xf94\xf95\xf95\xf95\xf95\xf94\xf94\xf94\xf94\xf94\xf94\xf95\xf95\xf95\xf9\xdb\xf4\x8e\xf1\x8e\xf1\x8e\xf1\x8d\xf1\x8d\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x8e\xf1\x03\xf2\xc7\xf3\xce\xf3\xce\xf3\xce\xf3\xce\xf3\xce\xf3\xce\xf3\xce\xf3\xcd\xf3\xce\xf3\xce\xf3\xce\xf3\xce\xf3\xce\xf3\xce\xf3\xce\xf3\xce\xf3\xce\xf3\x87\xf6=\b_\n_\n^\n^\n^\nX\f\x93\r\x93\r\x93\r\x95\r\x93\r\x93\r\x95\r\x95\r\x93\r\x93\r\x93\r\x93\r\x93\r\x93\r\x93\r\x92\r\x92\r\x92\rZ\b\xc4\x03\xc5\x03\xc5\x03\xc5\x03\xc5\x03\xc5\x03\xc4\x03\xc4\x03\xc4\x03\xc5\x03\xc4\x03\xc4\x03\xc4\x03\xc4\x03\xc5\x03\xc5\x03\xc5\x03\xc4\x03\xc4\x03\xc5\x03\xc4\x03\xc4\x03\xc4\x03\xc4\x03<\x04\x98\x06\xc5\x06\xc5\x06\xc5\x06\xc7\x06\xc7\x06\xc5\x06\xc5\x06\xc5\x06\xc5\x06\xc5\x06\xc5\x06\xc5\x06\xc5\x06\xc5\x06\xc5\x06\xc5\x06\xc5\x06\x1a\a\xe7\n\x9e\v\xa0\v\x9e\v\x9e\v\x9e\v3\rn\x0en\x0en\x0en\x0eo\x0eo\x0en\x0en\x0en\x0en\x0en\x0en\x0en\x0en\x0en\x0en\x0en\x0eo\x0eC\x02\x99\xf4\x98\xf4\x98\xf4\x98\xf4\x98\xf4\x99\xf4\x99\xf4\x99\xf4\x99\xf4\x99\xf4\x99\xf4\x99\xf4\x98\xf4\x99\xf4\x99\xf4\x98\xf4\x98\xf4\x98\xf4\x99\xf4\x99\xf4\x99\xf4\x99\xf4\x99\xf4\x99\xf4\x8b\xf6a\bV\vW\vW\vW\vW\vW\vW\vW\vW\vW\vW\vV\vV\vV\vW\vW\vW\vW\vV\vV\vV\vV\vV\vV\vY\fq\rq\rq\rq\rr\rr\rr\rq\rq\rq\rq\rq\rq\rq\rq\rq\rq\rq\ro\ro\ro\ro\rq\rq\r\xf5\f\xbc\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x11\b\x11\b\x0f\b\x0f\b\x0f\b\x19\vj\x0ej\x0ej\x0ej\x0ek\x0ek\x0ek\x0ej\x0ej\x0ej\x0ej\x0ej\x0ej\x0ej\x0ej\x0ej\x0ej\x0ek\x0ej\x0ej\x0ej\x0ej\x0eh\x0eh\x0e\xbe\r4\a\x14\x06\x14\x06\x14\x06\x14\x06\x14\x06r\xfa\x96\xf1\x96\xf1\x96\xf1\x96\xf1\x98\xf1\x98\xf1\x98\xf1\x98\xf1\x98\xf1\x98\xf1\x98\xf1\x98\xf1\x98\xf1\x96\xf1\x98\xf1\x98\xf1\x98\xf1\x98\xf1\x98\xf1\x98\xf1\x98\xf1\x98\xf1\x98\xf1\x98\xf1[\xf6\xf1\fh\x0eh\x0eh\x0eg\x0eg\x0eg\x0eh\x0eh\x0eh\x0eh\x0eh\x0eh\x0eh\x0eh\x0eh\x0eg\x0eg\x0eg\x0eg\x0eg\x0eg\x0ef\x0ef\x0eg\x0eg\x0eg\x0eg\x0eg\x0eg\x0eg\x0eg\x0ef\x0ef\x0ef\x0eg\x0eg\x0ef\x0eg\x0eg\x0eg\x0eg\x0eg\x0eg\x0eg\x0ef\x0eg\x0eh\x0ef\x0ef\x0eg\x0eg\x0eg\x0eg\x0eg\x0eg\x0eg\x0e{\xffH\xf6H\xf6H\xf6H\xf6F\xf6F\xf6H\xf6H\xf6H\xf6H\xf6H\xf6H\xf6H\xf6H\xf6F\xf6F\xf6F\xf6F\xf6H\xf6H\xf6H\xf6H\xf6H\xf6H\xf6S\xf5\xa8\xf1\x9c\xf1\x9c\xf1\x9a\xf1\x9c\xf1\x9c\xf1\x9c\xf1\x9c\xf1\x9a\xf1\x9a\xf1\x9a\xf1\x9a\xf1\x9a\xf1\x9a\xf1\x9a\xf1\x9a\xf1\x9a\xf1\x9c\xf1\x9c\xf1\x9c\xf1\x9c\xf1\x9c\xf1\x9c\xf1\x9c\xf1\x9c\xf1\x9d\xf1\x9d\xf1\x9c\xf1\x9c\xf1\x9c\xf1\x9c\xf1\xfe\xf1\x01\xf3\x01\xf3\x01\xf3\x01\xf3\x01\xf3\x01\xf3\x01\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x01\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x01\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x01\xf3\x01\xf3\x01\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x03\xf3\x8d\xf2\x9d\xf1\x9d\xf1\x9d\xf1\x9d\xf1\x9d\xf1\x9d\xf1\x9d\xf1\x9d\xf1\x9d\xf1\x9d\xf1\x9d\xf1\x9d\xf1\x9d\xf1\x9d\xf1\x9d\xf1\x9d\xf1\x9e\xf1\x9e\xf1\x9e\xf1\x9e\xf1\x9e\xf1\x9e\xf1\x9e\xf1\x9e\xf1\x9e\xf1\x9e\xf1\x9e\xf1\x9e\xf1\x9e\xf1\x9e\xf1\x9e\xf1\xbb\xf2\u007f\xf4\u007f\xf4\u007f\xf4\u007f\xf4\u007f\xf4\u007f\xf4\u007f\xf4\u007f\xf4\u007f\xf4\u007f\xf4\u007f\xf4\x80\xf4\x80\xf4\x80\xf4\x80\xf4\x80\xf4\x80\xf4\u007f\xf4\u007f\xf4\u007f\xf4\u007f\xf4\x80\xf4\x80\xf4\u007f\xf4\u007f\xf4\x80\xf4\x80\xf4\x80\xf4\x80\xf4\x80\xf4\x80\xf4\xf7\xfe_\f`\f`\f`\f`\f`\f`\f`\f`\f`\f`\f`\f`\f_\f_\f_\f`\f_\f_\f`\f`\f_\f_\f_\f`\f`\f`\f`\f_\f_\f_\fV\r`\x0e`\x0e`\x0e_\x0e_\x0e_\x0e_\x0e_\x0e_\x0e_\x0e_\x0e_\x0e_\x0e_\x0e_\x0e`\x0e`\x0e`\x0e_\x0e_\x0e_\x0e]\x0e]\x0e_\x0e_\x0e_\x0e_\x0e_\x0e_\x0e_\x0e_\x0e\r\r\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd7\v\xd5\v\xd4\v\xd4\v\xd4\v\xd5\v\xd5\v\xd5\v\xd4\v\xd4\v\xd4\v\xd4\v\xd4\v\xd5\vx\f\\\x0e\\\x0e\\\x0e\\\x0e]\x0e]\x0e\\\x0e\\\x0e\\\x0e\\\x0e\\\x0e\\\x0e\\\x0e\\\x0e\\\x0e\\\x0e\\\x0e]\x0e\\\x0e\\\x0e\\\x0e\\\x0e\\\x0e[\x0e[\x0e\\\x0e[\x0e[\x0e[\x0e\\\x0e\\\x0e\xf6\n2\x022\x022\x022\x022\x022\x021\x021\x022\x022\x022\x022\x022\x022\x021\x021\x021\x022\x022\x022\x022\x022\x022\x022\x022\x021\x021\x022\x022\x022\x022\x022\x022\x022\x022\x022\x022\x022\x02c\x06\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9f\a\x9f\a\x9f\a\x9d\a\x9c\a\x9c\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\x9d\a\xdf\b:\n:\n:\n;\n;\n;\n;\n;\n;\n:\n:\n:\n:\n:\n:\n:\n;\n:\n:\n:\n:\n:\n:\n:\n:\n:\n;\n:\n:\n:\n:\n;\n;\n:\n:\n:\n:\n\xcc\x05\x9a\xf2\xa8\xf1\xa9\xf1\xa9\xf1\xa8\xf1\xa8\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa8\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xab\xf1\xab\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xa9\xf1\xab\xf1\xab\xf1\x84\xf3\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1c\xf7\x1c\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1e\xf7\x1e\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1e\xf7\x1e\xf7\xfd\xf6\xbd\xf2\xab\xf1\xab\xf1\xab\xf1\xab\xf1\xab\xf1\xac\xf1\xac\xf1\xac\xf1\xac\xf1\xac\xf1\xac\xf1\xac\xf1\xac\xf1\xab\xf1\xac\xf1\xac\xf1\xab\xf1\xab\xf1\xac\xf1\xae\xf1\xae\xf1\xae\xf1\xac\xf1\xac\xf1\xac\xf1\xae\xf1\xae\xf1\xae\xf1\xae\xf1\xae\xf1\xae\xf1\xae\xf1\xae\xf1\xae\xf1\xac\xf1\xac\xf1\xac\xf1\xac\xf1\xae\xf1\xae\xf1\xae\xf1\xae\xf1\xae\xf1S\xf2e\xf5\x9b\xf5\x9b\xf5\x9b\xf5\x9b\xf5\x9b\xf5\x17\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf5\xf7\xf5\xf7\xf5\xf7\xf5\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf8\xf7\xf8\xf7\xf8\xf7\xf6\xf7\xf5\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf6\xf7\xf8\xf7\xbe\xf6\xc9\xf3\xc9\xf3\xc9\xf3\xc9\xf3\xca\xf3\xca\xf32\xf2\xaf\xf1\xb0\xf1\xb0\xf1\xaf\xf1\xaf\xf1\xaf\xf1\xaf\xf1\xb0\xf1\xb0\xf1\xb0\xf1\xaf\xf1\xaf\xf1\xaf\xf1\xaf\xf1\xb0\xf1\xb0\xf1\xb0\xf1\xb0\xf1\xb0\xf1\xb0\xf1\xb0\xf1\xb0\xf1\xb0\xf1\xb0\xf1\xfa\xf8\xb8\f\xb8\f\xba\f\xb8\f\xb8\f\xb8\f\xb7\f\xb7\f\xb8\f\xb8\f\xb8\f\xb8\f\xb8\f\xba\f\xba\f\xba\f\xb8\f\xb8\f\xb7\f\xb7\f\xb7\f\xb7\f\xb8\f\xb8\f\xb8\f\xb8\f\xb8\f\xb8\f\xb8\f\xb7\f\xb7\f\x19\rP\x0eP\x0eN\x0eN\x0eN\x0eN\x0eN\x0eN\x0eN\x0eN\x0eN\x0eN\x0eN\x0eN\x0eN\x0eN\x0eN\x0eM\x0eM\x0eM\x0eN\x0eN\x0eN\x0eN\x0eM\x0eM\x0eN\x0eN\x0eN\x0eN\x0eM\x0e\x8e\b\xa1\xf20\xf20\xf20\xf20\xf20\xf20\xf20\xf20\xf20\xf20\xf20\xf20\xf20\xf20\xf20\xf20\xf20\xf20\xf20\xf22\xf22\xf20\xf20\xf22\xf22\xf22\xf22\xf22\xf22\xf20\xf2\x1d\xf2\xbb\xf1\xb5\xf1\xb5\xf1\xb3\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb3\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb3\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb3\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1\xb5\xf1|\xf5C\xf8C\xf8C\xf8D\xf8D\xf8D\xf8C\xf8D\xf8D\xf8C\xf8C\xf8C\xf8C\xf8D\xf8D\xf8D\xf8D\xf8C\xf8D\xf8D\xf8D\xf8D\xf8D\xf8D\xf8D\xf8D\xf8C\xf8C\xf8D\xf8D\xf8D\xf8\xcc\x03I\x0eI\x0eI\x0eI\x0eJ\x0eJ\x0eJ\x0eJ\x0eJ\x0eJ\x0eJ\x0eJ\x0eI\x0eI\x0eI\x0eI\x0eJ\x0eI\x0eI\x0eI\x0eI\x0eI\x0eI\x0eI\x0eI\x0eI\x0eG\x0eG\x0eI\x0eI\x0eI\x0eI\x0eI\x0eI\x0eI\x0eI\x0eI\x0eI\x0eI\x0eI\x0eI\x0eI\x0eI\x0eI\x0eG\x0eG\x0eG\x0eG\x0eG\x0eG\x0eG\x0eI\x0eI\x0eG\x0eG\x0eI\x0eI\x0eG\x0eG\x0eG\x0eG\x0eG\x0eG\x0eI\x0eG\x0eG\x0eG\x0eI\x0eG\x0eF\x0eF\x0eF\x0eG\x0eF\x0eF\x0eF\x0eF\x0eF\x0eF\x0eF\x0eG\x0eG\x0eG\x0eF\x0eF\x0eG\x0eF\x0eF\x0eF\x0eF\x0eF\x0eF\x0eF\x0eF\x0eG\x0eF\x0eF\x0eF\x0eF\x0eF\x0eF\x0eF\x0eF\x0eF\x0eE\x0eE\x0eE\x0eF\x0eF\x0eF\x0eE\x0eE\x0eF\x0eF\x0eF\x0eF\x0eF\x0eF\x0eF\x0eF\x0eF\x0eE\x0eE\x0eE\x0eE\x0eE\x0eE\x0eF\x0eE\x0eE\x0eE\x0eE\x0eE\x0eE\x0eE\x0eE\x0eE\x0eC\x0eC\x0eC\x0eE\x0eE\x0eE\x0eE\x0eE\x0eE\x0eE\x0eE\x0eE\x0eE\x0eE\x0eE\x0eE\x0e\x19\n\x1d\x06\x1d\x06\x1d\x06\x1d\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1d\x06\x1d\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\x1c\x06\xab\x06\n\v\xba\v\xba\v\xba\v\xba\v\xba\v\xb8\v\xb8\v\xb8\v\xb8\v\xb8\v\xba\v\xba\v\xba\v\xba\v\xba\v\xb8\v\xb8\v\xb8\v\xba\v\xba\v\xb8\v\xb8\v\xb8\v\xb8\v\xb8\v\xb8\v\xb8\v\xb8\v\xb8\v\xb8\v\xb8\v\xb8\v\xb8\v\xb8\v\xb8\v\xb8\v\xb8\v\xe2\f@\x0e@\x0e@\x0e@\x0eB\x0eB\x0eB\x0e@\x0e@\x0e@\x0e@\x0e@\x0eB\x0e@\x0e@\x0e@\x0e@\x0e@\x0e@\x0e@\x0e@\x0e@\x0e@\x0e@\x0e@\x0e@\x0e?\x0e?\x0e?\x0e?\x0e@\x0e@\x0e@\x0e@\x0e?\x0e?\x0e@\x0e?\x0e?\x0e?\x0e@\x0e@\x0e@\x0e@\x0e@\x0e@\x0e@\x0e@\x0e@\x0e?\x0e?\x0e?\x0e@\x0e?\x0e?\x0e?\x0e?\x0e?\x0e?\x0e?\x0e?\x0e@\x0e@\x0e?\x0e?\x0e?\x0e?\x0e?\x0e?\x0e?\x0e?\x0e>\x0e>\x0e>\x0e>\x0e>\x0e?\x0e>\x0e>\x0e>\x0e?\x0e>\x0e>\x0e>\x0e>\x0e>\x0e>\x0e>\x0e>\x0e>\x01\xba\xf3\xba\xf3\xba\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xba\xf3\xba\xf3\xba\xf3\xb9\xf3\xba\xf3\xba\xf3\xba\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xbb\xf3\xba\xf3\xba\xf3\xba\xf3\xbb\xf3\xbb\xf3\xbb\xf3\xba\xf3\xba\xf3\xb9\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xb9\xf3\xb9\xf3\xba\xf3\xc4\xf2\xc4\xf1\xc4\xf1\xc4\xf1\xc4\xf1\xc4\xf1\xc4\xf1\xc4\xf1\xc4\xf1\xc4\xf1\xc4\xf1\xc4\xf1\xc4\xf1\xc4\xf1\xc4\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc4\xf1\xc4\xf1\xc5\xf1\xc5\xf1\xc4\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc4\xf1\xc4\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc5\xf1\xc6\xf1\xdb\xf5\xf9\v;\x0e;\x0e;\x0e;\x0e;\x0e;\x0e;\x0e:\x0e:\x0e:\x0e:\x0e:\x0e:\x0e:\x0e:\x0e:\x0e:\x0e:\x0e:\x0e:\x0e:\x0e:\x0e:\x0e:\x0e8\x0e8\x0e:\x0e:\x0e:\x0e8\x0e8\x0e8\x0e:\x0e:\x0e:\x0e:\x0e:\x0e:\x0e:\x0e:\x0e:\x0e8\x0e8\x0e\t\x0e\xd9\f\xb0\f\xb0\f\xb1\f\xb1\f\xb1\f\xc0\xff\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x9a\xf5\x9a\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x99\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf5\x9a\xf58\xfaT\r5\x0e7\x0e7\x0e7\x0e7\x0e7\x0e7\x0e7\x0e7\x0e7\x0e7\x0e5\x0e5\x0e5\x0e5\x0e7\x0e7\x0e7\x0e7\x0e7\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e7\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e7\x0e7\x0e7\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e5\x0e\xe3\v2\n2\n2\n2\n2\n0\n0\n0\n2\n2\n2\n2\n2\n2\n0\n0\n0\n0\n0\n0\n0\n2\n2\n2\n2\n0\n0\n0\n0\n0\n2\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n0\n/\n/\n0\n0\n0\n/\n/\n0\n0\nG\xfb\xcd\xf1\xcd\xf1\xcd\xf1\xcd\xf1\xcd\xf1\xcd\xf1\xcd\xf1\xcd\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcd\xf1\xcd\xf1\xcd\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcd\xf1\xcd\xf1\xd0\xf1\xd0\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xd0\xf1\xd0\xf1\xcf\xf1\xcf\xf1\xcf\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xcf\xf1\xcf\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xcf\xf1\xd0\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd0\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd0\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd3\xf1\xd3\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd1\xf1\xd3\xf1\xd3\xf1\xd4\xf9-\x0e-\x0e-\x0e/\x0e/\x0e/\x0e/\x0e/\x0e/\x0e-\x0e-\x0e-\x0e-\x0e-\x0e-\x0e-\x0e-\x0e-\x0e/\x0e/\x0e/\x0e/\x0e-\x0e-\x0e-\x0e-\x0e,\x0e,\x0e,\x0e-\x0e-\x0e-\x0e-\x0e-\x0e-\x0e-\x0e-\x0e-\x0e-\x0e-\x0e-\x0e-\x0e,\x0e,\x0e,\x0e,\x0e-\x0e,\x0e,\x0e-\x0e-\x0e-\x0e,\x0e,\x0e,\x0e-\x0e-\x0e-\x0e-\x0e-\x0e,\x0e,\x0e,\x0e,\x0e,\x0e,\x0e,\x0e,\x0e,\x0e,\x0e\x16\v*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05(\x05(\x05(\x05(\x05(\x05(\x05(\x05*\x05(\x05(\x05(\x05(\x05(\x05(\x05(\x05(\x05*\x05*\x05*\x05(\x05(\x05(\x05*\x05(\x05(\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05*\x05(\x05(\x05*\x05(\x05(\x05(\x05(\x05(\x05(\x05(\x05(\x05(\x05(\x05(\x05*\x05A\x06\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x11\b\x11\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0e\b\x0e\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0e\b\x0e\b\x0f\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0f\b\x0e\b\x0e\b\x0f\b\x0f\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0f\b\x0f\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b?\b\xf8\f&\x0e&\x0e&\x0e&\x0e&\x0e&\x0e&\x0e&\x0e&\x0e&\x0e&\x0e&\x0e&\x0e&\x0e&\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e&\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e%\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e\"\x0e\"\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e#\x0e\xd7\r\xd9\nF\nE\nE\nE\nF\nF\nF\nF\nF\nF\nF\nF\nF\nF\nF\nF\nF\nF\nE\nD\nD\nE\nE\nE\nE\nE\nE\nF\nF\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nF\nF\nE\nE\nE\nE\nD\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nE\nD\nD\nE\nE\nE\nD\nD\nD\nD\nD\nD\nD\nD\nD\nD\nD\nD\nD\n\xbd\x00D\xf7B\xf7B\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7B\xf7B\xf7B\xf7B\xf7B\xf7B\xf7D\xf7D\xf7B\xf7B\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7B\xf7B\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7D\xf7\xdf\xf9B\xfcB\xfcB\xfcB\xfcB\xfch\xfd\xed\x02f\x03f\x03h\x03f\x03f\x03f\x03f\x03f\x03f\x03h\x03f\x03f\x03f\x03h\x03h\x03h\x03h\x03h\x03f\x03f\x03h\x03h\x03h\x03h\x03h\x03f\x03f\x03f\x03h\x03i\x03h\x03h\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03h\x03f\x03f\x03f\x03h\x03f\x03f\x03f\x03f\x03f\x03h\x03h\x03h\x03h\x03h\x03h\x03h\x03h\x03f\x03f\x03f\x03h\x03h\x03h\x03h\x03f\x03f\x03f\x03f\x03f\x03h\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03h\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03f\x03h\x03f\x03f\x03f\x03f\x03f\x03f\x03D\x05\x87\r\x17\x0e\x17\x0e\x17\x0e\x17\x0e\x17\x0e\x18\x0e\x17\x0e\x17\x0e\x17\x0e\x17\x0e\x17\x0e\x17\x0e\x17\x0e\x17\x0e\x16\x0e\x16\x0e\x16\x0e\x16\x0e\x17\x0e\x17\x0e\x17\x0e\x17\x0e\x17\x0e\x17\x0e\x17\x0e\x16\x0e\x16\x0e\x16\x0e\x16\x0e\x16\x0e\x16\x0e\x16\x0e\x16\x0e\x17\x0e\x17\x0e\x17\x0e\x16\x0e\x16\x0e\x16\x0e\x16\x0e\x16\x0e\x16\x0e\x17\x0e\x17\x0e\x16\x0e\x16\x0e\x17\x0e\x16\x0e\x16\x0e\x16\x0e\x16\x0e\x16\x0e\x16\x0e\x16\x0e\x14\x0e\x14\x0e\x14\x0e\x16\x0e\x16\x0e\x16\x0e\x16\x0e\x16\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x16\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x16\x0e\x16\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x14\x0e\x13\x0e\x13\x0e\x13\x0e\x13\x0e\x14\x0e\x14\x0e\x13\x0e\x13\x0e\x13\x0e\x13\x0e\x13\x0e\x13\x0e\x13\x0e\x13\x0e\x13\x0e\x14\x0e\x14\x0e\x13\x0e\x13\x0e\x13\x0e\x13\x0e\x13\x0e\x13\x0e\x13\x0e\x13\x0e\x13\x0e\x13\x0e\x13\x0e\x14\x0e\x14\x0e\x13\x0e\x13\x0e\x13\x0e&\xfe\xed\xf1\xed\xf1\xed\xf1\xed\xf1\xed\xf1\xed\xf1\xed\xf1\xed\xf1\xed\xf1\xed\xf1\xed\xf1\xed\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xee\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf0\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf0\xf1\xf0\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf0\xf1\xf0\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf3\xf1\xf3\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf1\xf1\xf1\xf1\xf1\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf12\xf6\xdc\v\r\x0e\r\x0e\r\x0e\r\x0e\r\x0e\f\x0e\f\x0e\r\x0e\r\x0e\r\x0e\r\x0e\r\x0e\r\x0e\r\x0e\r\x0e\r\x0e\f\x0e\f\x0e\v\x0e\v\x0e\f\x0e\f\x0e\f\x0e\f\x0e\f\x0e\f\x0e\v\x0e\v\x0e\v\x0e\f\x0e\f\x0e\f\x0e\v\x0e\v\x0e\v\x0e\f\x0e\f\x0e\f\x0e\f\x0e\f\x0e\f\x0e\f\x0e\f\x0e\f\x0e\f\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\t\x0e\t\x0e\v\x0e\v\x0e\t\x0e\t\x0e\v\x0e\v\x0e\v\x0e\v\x0e\v\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\v\x0e\v\x0e\v\x0e\v\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\v\x0e\v\x0e\b\x0e\b\x0e\t\x0e\v\x0e\v\x0e\v\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\t\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\t\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\a\x0e\a\x0e\b\x0e\b\x0e\b\x0e\b\x0e\t\x0e\b\x0e\b\x0e\b\x0e\a\x0e\a\x0e\a\x0e\b\x0e\a\x0e\a\x0e\a\x0e\a\x0e\a\x0e\b\x0e\b\x0e\b\x0e\b\x0e\a\x0e\a\x0e\a\x0e\a\x0e\a\x0e\a\x0e\a\x0e\a\x0e\a\x0e\a\x0e\a\x0e\a\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\a\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\a\x0e\a\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x04\x0e\x04\x0e\x05\x0e\x05\x0e\x05\x0e\x04\x0e\x04\x0e\x04\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x05\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x85\v\xf2\xf5\xfe\xf1\xfe\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfc\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xff\xf1\xff\xf1\xff\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xfe\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xfe\xf1\xfe\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\xff\xf1\x00\xf2\x00\xf2\x00\xf2\x00\xf2\x00\xf2\x00\xf2\x00\xf2\x00\xf2\x00\xf2\x00\xf2\x00\xf2\x13\xf3'\xf4'\xf4'\xf4'\xf4'\xf4%\xf4%\xf4%\xf4%\xf4%\xf4%\xf4'\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4'\xf4'\xf4'\xf4'\xf4'\xf4'\xf4'\xf4'\xf4(\xf4(\xf4(\xf4'\xf4'\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4(\xf4)\xf4)\xf4)\xf4)\xf4)\xf4)\xf4)\xf4)\xf4(\xf4(\xf4(\xf4(\xf4(\xf4\xfc\xf3\xd0\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa1\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa1\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa3\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa4\xf2Z\xf2\x05\xf2\x05\xf2\x05\xf2\x05\xf2\x05\xf2\x05\xf2\x05\xf2\x05\xf2\x05\xf2\x05\xf2\x05\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x05\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\a\xf2\a\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\a\xf2\a\xf2\a\xf2\a\xf2\x06\xf2\a\xf2\a\xf2\a\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\x06\xf2\a\xf2\a\xf2\a\xf2\a\xf2\x06\xf2\a\xf2\a\xf2\a\xf2\a\xf2\a\xf2\a\xf2\a\xf2\a\xf2\a\xf2\b\xf2\b\xf2\b\xf2\a\xf2\a\xf2\a\xf2\a\xf2\a\xf2\b\xf2\b\xf2\b\xf2\a\xf2\b\xf2\b\xf2\b\xf2\b\xf2\a\xf2\xa8\xf2\x1e\b\xf9\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf8\r\xf6\r\xf6\r\xf6\r\xf6\r\xf6\r\xf8\r\xf8\r\xf8\r\xf6\r\xf6\r\xf6\r\xf6\r\xf8\r\xf8\r\xf8\r\xf6\r\xf6\r\xf6\r\xf5\r\xf6\r\xf8\r\xf6\r\xf6\r\xf6\r\xf6\r\xf5\r\xf5\r\xf5\r\xf6\r\xf6\r\xf6\r\xf6\r\xf6\r\xf6\r\xf6\r\xf6\r\xf6\r\xf6\r\xf6\r\xf6\r\xf6\r\xf5\r\xf5\r\xf5\r\xf6\r\xf6\r\xf5\r\xf5\r\xf6\r\xf6\r\xf6\r\xf6\r\xf5\r\xf5\r\xf5\r\xf5\r\xf5\r\xf5\r\xf5\r\xf5\r\xf5\r\xf6\r\xf6\r\xf5\r\xf5\r\xf5\r\xf5\r\xf5\r\xf5\r\xf4\r\xf4\r\xf4\r\xf5\r\xf5\r\xf5\r\xf5\r\xf5\r\xf5\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf5\r\xf5\r\xf5\r\xf5\r\xf5\r\xf5\r\xf5\r\xf5\r\xf5\r\xf4\r\xf2\r\xf4\r\xf5\r\xf5\r\xf4\r\xf4\r\xf4\r\xf5\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf2\r\xf2\r\xf4\r\xf4\r\xf2\r\xf2\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf2\r\xf2\r\xf4\r\xf4\r\xf2\r\xf2\r\xf2\r\xf2\r\xf2\r\xf2\r\xf2\r\xf2\r\xf2\r\xf2\r\xf2\r\xf2\r\xf2\r\xf2\r\xf2\r\xf2\r\xf2\r\xf4\r\xf2\r\xf2\r\xf2\r\xf2\r+\x01\x0e\xf2\x0e\xf2\x0e\xf2\x0e\xf2\x0e\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0e\xf2\x0e\xf2\x0e\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x10\xf2\x10\xf2\x0f\xf2\x0f\xf2\x10\xf2\x10\xf2\x10\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x10\xf2\x10\xf2\x10\xf2\x10\xf2\x10\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x0f\xf2\x10\xf2\x10\xf2\x0f\xf2\x0f\xf2\x10\xf2\x12\xf2\x12\xf2\x12\xf2\x10\xf2\x10\xf2\x10\xf2\x10\xf2\x10\xf2\x10\xf2\x10\xf2\x12\xf2\x12\xf2\x10\xf2\x10\xf2\x10\xf2\x10\xf2\x10\xf2\x12\xf2\x12\xf2\x12\xf2\x10\xf2\x10\xf2\x10\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x10\xf2\x10\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x10\xf2\x10\xf2\x10\xf2\x10\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x12\xf2\x12\xf2\x12\xf2\x12\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x12\xf2\x12\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x15\xf2\x15\xf2\x15\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x13\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x15\xf2\x15\xf2\x15\xf2\x15\xf2\x16\xf2\x16\xf2\x16\xf2\x15\xf2\x16\xf2\x16\xf2\x15\xf2\x15\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x15\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x16\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x17\xf2\x19\xf2\x19\xf2\x19\xf2\x17\xf2\x17\xf2\x17\xf2\x19\xf2\x19\xf2\x19\xf2\x19\xf2\x19\xf2\x19\xf2\x19\xf2\x19\xf2\x19\xf2\x19\xf2\x19\xf2\x19\xf2\x19\xf2\x19\xf2\x19\xf2\x19\xf2\x19\xf2\x19\xf2\x17\xf2\x19\xf2\x1a\xf2\x1a\xf2\x19\xf2\x19\xf2\x17\xf2\x17\xf2\x19\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x19\xf2\x19\xf2\x19\xf2\x19\xf2\x1a\xf2\x1a\xf2\x19\xf2\x19\xf2\x19\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x19\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1a\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1a\xf2\x1a\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1d\xf2\x1d\xf2\x1d\xf2\x1b\xf2\x1d\xf2\x1d\xf2\x1d\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1b\xf2\x1d\xf2\x1d\xf2\x1d\xf2\x1b\xf2\x1d\xf2\x1d\xf2\x1d\xf2\x1d\xf2\x1d\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1d\xf2\x1d\xf2\x1d\xf2\x1d\xf2\x1d\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1d\xf2\x1d\xf2\x1d\xf2\x1d\xf2\x1d\xf2\x1d\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1d\xf2\x1d\xf2\x1d\xf2\x1d\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2 \xf2 \xf2 \xf2\x1e\xf2 \xf2 \xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2\x1e\xf2 \xf2 \xf2 \xf2 \xf2 \xf2 \xf2 \xf2 \xf2 \xf2 \xf2 \xf2\x1e\xf2\x1e\xf2\x1e\xf2 \xf2 \xf2 \xf2 \xf2 \xf2 \xf2 \xf2 \xf2^\x01\xdf\r\xdf\r\xdf\r\xe0\r\xdf\r\xdf\r\xdf\r\xe0\r\xe0\r\xe0\r\xe0\r\xe0\r\xe0\r\xe0\r\xe0\r\xe0\r\xdf\r\xdf\r\xdf\r\xdf\r\xdf\r\xe0\r\xe0\r\xe0\r\xe0\r\xe0\r\xdf\r\xdf\r\xdf\r\xe0\r\xe0\r\xdf\r\xdf\r\xdf\r\xdf\r\xdf\r\xdf\r\xdf\r\xdf\r\xdf\r\xdf\r\xdf\r\xdf\r\xdf\r\xdf\r\xe0\r\xdf\r\xdf\r\xdf\r\xdf\r\xdf\r\xdf\r\xdf\r\xdf\r\xdf\r\xde\r\xde\r\xde\r\xde\r\xde\r\xde\r\xde\r\xde\r\xde\r\xde\r\xdf\r\xdf\r\xdf\r\xdf\r\xde\r\xdc\r\xde\r\xde\r\xdc\r\xdc\r\xdc\r\xde\r\xde\r\xdc\r\xdc\r\xde\r\xde\r\xde\r\xde\r\xde\r\xde\r\xde\r\xde\r\xdc\r\xdc\r\xdc\r\xdc\r\xde\r\xdc\r\xdc\r\xdc\r\xde\r\xdc\r\xdc\r\xdb\r\xdb\r\xdc\r\xde\r\xdc\r\xdc\r\xdc\r\xde\r\xdc\r\xdc\r\xdc\r\xdc\r\xdb\r\xdb\r\xdb\r\xdb\r\xdb\r\xdc\r\xdc\r\xdc\r\xdc\r\xdc\r\xdc\r\xdc\r\xdc\r\xdb\r\xdb\r\xdb\r\xdb\r\xdb\r\xdb\r\xdb\r\xdb\r\xdb\r\xdb\r\xdb\r\xdb\r\xdb\r\xdb\r\xdb\r\xdb\r\xdc\r\xdb\r\xdb\r\xdc\r\xdc\r\xdb\r\xdb\r\xdb\r[\x02\x93\xf5\x92\xf5\x92\xf5\x92\xf5\x92\xf5\x92\xf5\x92\xf5\x92\xf5\x92\xf5\x92\xf5\x92\xf5\x92\xf5\x92\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x92\xf5\x92\xf5\x92\xf5\x92\xf5\x92\xf5\x92\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x94\xf5\x94\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x94\xf5\x94\xf5\x94\xf5\x93\xf5\x93\xf5\x93\xf5\x93\xf5\x94\xf5\x94\xf5\x96\xf5\x96\xf5\x96\xf5\x94\xf5\x93\xf5\x93\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x96\xf5\x96\xf5\x94\xf5\x96\xf5\x96\xf5\x96\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x96\xf5\x96\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x96\xf5\x96\xf5\x96\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x97\xf5\x97\xf5\x97\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x96\xf5\x97\xf5\x97\xf5\x97\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x97\xf5\x97\xf5\x97\xf5\x96\xf5\x97\xf5\x97\xf5\x97\xf5\x96\xf5\x96\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\xdf\x06\xd4\r\xd4\r\xd4\r\xd4\r\xd4\r\xd4\r\xd4\r\xd4\r\xd4\r\xd4\r\xd3\r\xd3\r\xd3\r\xd3\r\xd4\r\xd4\r\xd4\r\xd3\r\xd3\r\xd3\r\xd4\r\xd4\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd3\r\xd1\r\xd1\r\xd3\r\xd3\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd3\r\xd3\r\xd3\r\xd3\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd0\r\xd0\r\xd0\r\xd0\r\xd0\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd0\r\xd0\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd1\r\xd0\r\xd0\r\xd0\r\xd0\r\xd0\r\xd0\r\xd0\r\xd0\r\xd0\r\xce\r\xce\r\xce\r\xce\r\xd0\r\xd0\r\xd0\r\xd0\r\xd0\r\xd0\r\xd0\r\xd0\r\xd0\r\xd0\r\xce\r\xce\r\xce\r\xce\r\xce\r\xce\r\xce\r\xce\r\xce\r\xce\r\xd0\r\xd0\r\xce\r\xce\r\xce\r\xd0\r\xce\r\xce\r\xce\r\xce\r\xce\r\xce\r\xce\r\xce\r\xce\r\xce\r\xce\r\xcd\r\xcd\r\xce\r\xce\r\xce\r\xcd\r\xcd\r\xcd\r\xcd\r\xce\r\xce\r\xce\r\xce\r\xcd\r\xcd\r\xcd\r\xcd\r\xce\r\xcd\r\xcd\r\xcd\r\xce\r\xcd\r\xcc\r\xcc\r\xcc\r\xcd\r\xcd\r\xcd\r\xcd\r\xcd\r\xcd\r\xcd\r\xcd\r\xcd\r\xcd\r\xcd\r\xcd\r\xcd\r\xcd\r\xcd\r\xcd\r\xcd\r\xcd\r\xcc\r\xcc\r\xcc\r\xcd\r\xcc\r\xcc\r\xcc\r\xcd\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcc\r\xcd\r\xcc\r\xcc\r\xcc\r\xcc\r\xca\r\xca\r\xcc\r\xca\r\xca\r\xca\r\xca\r\xca\r\xca\r\xca\r\xca\r\xca\r\xcc\r\xcc\r\xcc\r\xcc\r\xca\r\xca\r\xcc\r\xcc\r\xca\r\xca\r\xca\r\xca\r\xca\r\xca\r\xca\r\xca\r\xca\r\xc9\r\xc9\r\xca\r\xca\r\xca\r\xca\r\xca\r\xca\r\xca\r\xca\r\xca\r\xca\r\xca\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc8\r\xc8\r\xc8\r\xc8\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc8\r\xc8\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc9\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc6\r\xc6\r\xc6\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc8\r\xc6\r\xc6\r\xc6\r\xc6\r\xc8\r\xc6\r\xc6\r\xc6\r\xc6\r\xc6\r\xc6\r\xc6\r\xc6\r\xc6\r\xc8\r\xc8\r\xc8\r\xc6\r\xc6\r\xc6\r\xc6\r\xc6\r\xc6\r\xc6\r\xc6\r\xc6\r\xc5\r\xc5\r\xc5\r\xc6\r\xc6\r\xc6\r\xc6\r\xc5\r\xc5\r\xc6\r\xc6\r\xc6\r\xc5\r\xc5\r\xc5\r\xc5\r\xc5\r\xc5\r\xc5\r\xc5\r\xc5\r\xc6\r\xc6\r\xc6\r\xc6\r\xc5\r\xc5\r\xc5\r\xc5\r\xc5\r\xc5\r\xc5\r\xc5\r\xc3\r\xc3\r\xc5\r\xc5\r\xc3\r\xc3\r\xc3\r\xc3\r\xc5\r\xc5\r\xc5\r\xc5\r\xc5\r\xc3\r\xc3\r\xc3\r\xc5\r\xc5\r\xc3\r\xc3\r\xc3\r\xc3\r\xc5\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc3\r\xc2\r\xc2\r\xc2\r\xc2\r\xc3\r\xc3\r\xc3\r\xc2\r\xc2\r\xc2\r\xc2\r\xc3\r\xc2\r\xc1\r\xc1\r\xc2\r\xc2\r\xc2\r\xc2\r\xc2\r\xc2\r\xc2\r\xc2\r\xc2\r\xc2\r\xc2\r\xc2\r\xc2\r\xc2\r\xc1\r\xc1\r\xc1\r\xc1\r\xc2\r\xc2\r\xc2\r\xc2\r\xc2\r\xc1\r\xc1\r\xc1\r\xc1\r\xc1\r\xc1\r\xc1\r\xc1\r\xc1\r\xc1\r\xc1\r\xc1\r\xc1\r\xc1\r\xc1\r\xc1\r\x1a\r|\nO\nO\nO\nO\nO\nO\nO\nO\nO\nO\nO\nO\nO\nO\nO\nM\nM\nM\nO\nO\nO\nO\nO\nM\nM\nM\nO\nM\nM\nM\nO\nO\nM\nM\nM\nM\nM\nM\nM\nM\nM\nM\nM\nM\nM\nM\nM\nO\nO\nO\nO\nM\nM\nM\nL\nL\nL\nM\nM\nM\nM\nM\nM\nM\nM\nM\nM\nM\nM\nM\nM\nM\nM\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nK\nK\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nL\nM\nL\nK\nK\nK\nL\nL\nL\nL\nK\nK\nK\nK\nK\nK\nK\nK\nK\nL\nK\nK\nK\nK\nL\nL\nK\nK\nK\nK\nK\nK\nK\nK\nK\nK\nK\nK\nK\nK\nK\nI\nI\nI\nI\nI\nI\nI\nI\nI\nI\nI\nK\nI\nI\nI\nK\nK\nK\nK\nK\nK\nI\nI\nI\nK\nI\nI\nI\nI\nI\nI\nK\nI\nI\nI\nK\nK\nI\nI\nI\nI\nI\nI\nI\nI\nI\n\xc6\tQ\xf9\x9d\xf4\x9d\xf4\x9d\xf4\x9d\xf4\x9f\xf4\x9f\xf4`\xf4\x9a\xf2H\xf2H\xf2H\xf2H\xf2H\xf2H\xf2'\xf7\xfc\v\xb8\r\xb8\r\xb7\r\xb7\r\xb7\r\xb8\r\xc8\x06g\xf2g\xf2g\xf2g\xf2g\xf2g\xf2g\xf29\xfb\x1f\r\x1f\r!\r\x1f\r\x1f\r\x1f\r\x1f\r%\f\xb7\n\xb7\n\xb7\n\xb7\n\xb7\n\xb7\n\xb7\n(\f\xb7\r\xb7\r\xb7\r\xb6\r\xb6\r\xb7\r\xb6\rt\xfeJ\xf2I\xf2I\xf2I\xf2J\xf2J\xf2J\xf2\xb1\xfdW\x04Y\x04Y\x04Y\x04Y\x04W\x04W\x04\xb7\b\x89\n\x89\n\x89\n\x8b\n\x8b\n\x8b\n+\n\x8a\xfc\x97\xf8\x97\xf8\x97\xf8\x97\xf8\x97\xf8\x99\xf8\x00\xf88\xf3L\xf2J\xf2L\xf2L\xf2L\xf2J\xf24\xf5P\x02\x91\x03\x91\x03\x91\x03\x91\x03\x91\x03\x91\x03e\xffJ\xf2J\xf2L\xf2L\xf2L\xf2L\xf2L\xf2\xee\xfa\xb4\r\xb4\r\xb6\r\xb6\r\xb4\r\xb4\r\xb4\r\xb4\r\xb4\r\xb4\r\xb4\r\xb4\r\xb3\r\xb3\r\xb3\r\x9e\v.\t.\t,\t,\t,\t,\t,\t\t\xfdW\xf2W\xf2W\xf2W\xf2U\xf2W\xf2W\xf2\x0f\xf5\xd7\xf6\xd5\xf6\xd7\xf6\xd7\xf6\xd7\xf6\xd7\xf6\xd7\xf6`\xf6(\xf6)\xf6)\xf6)\xf6(\xf6(\xf6(\xf6P\xf6\\\xf6\\\xf6\\\xf6\\\xf6\\\xf6\\\xf6\xb7\xf7j\t\xb3\r\xb3\r\xb3\r\xb3\r\xb3\r\xb3\r\xbe\nI\xf9\xb0\xf6\xb0\xf6\xaf\xf6\xaf\xf6\xaf\xf6\xaf\xf6;\xf7U\xf9s\xf9r\xf9r\xf9r\xf9r\xf9r\xf91\xf8\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc5\xf4\xc5\xf4\xc6\xf43\xfd\xb2\r\xb2\r\xb2\r\xb2\r\xb2\r\xb2\r\xb2\r!\n\xf0\x04\xf0\x04\xef\x04\xef\x04\xf0\x04\xf0\x04\xf0\x045\xfc\x96\xf2\x96\xf2\x98\xf2\x98\xf2\x96\xf2\x98\xf2\x98\xf2\xa9\x00q\fq\fq\fq\fq\fq\fq\fq\xfcP\xf2Q\xf2Q\xf2Q\xf2P\xf2Q\xf2Q\xf2\x10\x02u\tu\tu\tu\tu\tu\tu\t\x9e\f\xb0\r\xb0\r\xb0\r\xb0\r\xb0\r\xaf\r \f`\xf7Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2e\xf3\xef\xf9\xf3\xfa\xf3\xfa\xf3\xfa\xf3\xfa\xf5\xfa\xf5\xfaO\xf9\xc2\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2Q\xf2\x95\xf2S\xf3S\xf3S\xf3S\xf3S\xf3S\xf3Q\xf3\xd5\xfb\xad\r\xad\r\xad\r\xad\r\xaf\r\xaf\r\xad\rz\x03r\xf3r\xf3r\xf3q\xf3r\xf3r\xf3r\xf3\\\xff\xad\r\xad\r\xad\r\xad\r\xad\r\xad\r\xad\r\xad\r\xad\r\xad\r\xad\r\xad\r\xad\r\xad\r\xad\r\xb6\xfdS\xf2S\xf2S\xf2S\xf2S\xf2S\xf2S\xf2\v\x04\xad\r\xad\r\xad\r\xad\r\xac\r\xac\r\xac\r\xd5\tI\bJ\bJ\bI\bI\bJ\b\xe4\aA\xf7T\xf2T\xf2T\xf2T\xf2T\xf2T\xf2T\xf2T\xf2T\xf2T\xf2T\xf2T\xf2T\xf2T\xf28\xf5U\x04F\x06F\x06F\x06F\x06F\x06F\x06\xcf\aj\r\xab\r\xab\r\xac\r\xac\r\xab\r\xab\rH\x06W\xf2W\xf2U\xf2U\xf2U\xf2U\xf2U\xf2U\xf2U\xf2U\xf2U\xf2U\xf2U\xf2U\xf2U\xf2U\xf2U\xf2U\xf2U\xf2U\xf2U\xf2U\xf2U\xf2\xcb\xfe\xa9\r\xa9\r\xa9\r\xa9\r\xab\r\xa9\r\xa9\r\xdc\tP\x06P\x06P\x06P\x06P\x06P\x06P\x06_\t\x9b\v\x9b\v\x9a\v\x9a\v\x9b\v\x9b\v\x9b\vz\xfbW\xf2W\xf2W\xf2W\xf2W\xf2W\xf2W\xf2b\xf4B\xf5A\xf5A\xf5B\xf5B\xf5B\xf5I\xf5\xed\x03\x99\b\x99\b\x99\b\x99\b\x99\b\x98\b;\ac\xf6X\xf2X\xf2X\xf2X\xf2X\xf2X\xf2\xa4\xf5Q\n\xa8\r\xa8\r\xa8\r\xa8\r\xa8\r\xa8\r\x01\n\xa2\xfaY\xf9Y\xf9Y\xf9Y\xf9Y\xf9Y\xf9I\xf9\x10\xf9\x0f\xf9\x0f\xf9\x0f\xf9\x0f\xf9\x0f\xf9\x0f\xf9\xab\xfd\x98\b\x98\b\x98\b\x99\b\x99\b\x99\b\x99\b*\x05\xec\xfe\xec\xfe\xec\xfe\xec\xfe\xec\xfe\xec\xfe\xec\xfe\xc7\xfd%\xfc%\xfc&\xfc&\xfc&\xfc&\xfc&\xfc\x8c\xf7Z\xf2Z\xf2Z\xf2Z\xf2[\xf2[\xf2[\xf2\xbc\x00\xa5\r\xa5\r\xa5\r\xa5\r\xa5\r\xa6\r\xa6\r\xdb\fK\fK\fK\fK\fJ\fJ\fJ\f\xd2\xfeA\xf7B\xf7B\xf7B\xf7B\xf7B\xf7B\xf7@\x03{\b{\b{\b{\b{\b{\b{\b\xa9\v\xb6\f\xb4\f\xb4\f\xb4\f\xb4\f\xb4\f\\\vl\xf7\\\xf2\\\xf2\\\xf2\\\xf2\\\xf2\\\xf2\\\xf2\\\xf2[\xf2[\xf2\\\xf2\\\xf2\\\xf2\\\xf2_\xf2p\xf2q\xf2q\xf2q\xf2q\xf2p\xf2p\xf2\x83\xf2\xc4\xf2\xc5\xf2\xc5\xf2\xc5\xf2\xc5\xf2\xc5\xf2\xc6\xf2\x0e\xf7\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02\x98\x02V\xfd\\\xf2\\\xf2^\xf2^\xf2^\xf2^\xf2^\xf2J\xfc\xa2\f\xa2\f\xa2\f\xa2\f\xa2\f\xa2\f\xa2\f\x11\r\xa2\r\xa2\r\xa2\r\xa2\r\xa2\r\xa2\r\xa1\rw\x01\x84\xf4\x84\xf4\x84\xf4\x84\xf4\x86\xf4\x86\xf4\x86\xf4\xfe\x01\xa1\r\xa1\r\xa1\r\xa1\r\xa1\r\xa1\r\xa1\r\xa1\r\xa1\r\xa1\r\xa1\r\xa1\r\xa1\r\xa1\r\xa1\r\xc1\t\x98\a\x99\a\x99\a\x98\a\x98\a\x98\a\x98\a\a\x05\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03#\v\xa0\r\xa1\r\xa0\r\xa0\r\xa0\r\xa1\rn\r\xa8\t\xa3\b\xa3\b\xa3\b\xa3\b\xa3\b\xa3\bF\a\xe3\xfb\x92\xf9\x92\xf9\x92\xf9\x92\xf9\x92\xf9\x92\xf9\xda\xfb(\b\xd7\t\xd7\t\xd7\t\xd7\t\xd7\t\xd7\t^\x05\xa1\xf3`\xf2b\xf2b\xf2b\xf2`\xf2`\xf2\xe0\xf3\x96\xf8\x9a\xf8\x9b\xf8\x9b\xf8\x9a\xf8\x9a\xf8\x9a\xf8\xcd\xf6b\xf2b\xf2b\xf2b\xf2b\xf2b\xf2`\xf2\xb0\xf3=\xf6=\xf6=\xf6=\xf6=\xf6=\xf6=\xf6\xbe\xf4c\xf2c\xf2c\xf2b\xf2b\xf2b\xf2`\xf2U\xfc)\t)\t)\t)\t)\t)\t)\t!\xfec\xf2c\xf2c\xf2b\xf2c\xf2c\xf2c\xf2\xe3\x00\x9d\r\x9d\r\x9d\r\x9d\r\x9e\r\x9d\r\x9d\r\x9d\r\x9d\r\x9d\r\x9d\r\x9d\r\x9d\r\x9d\r\x9d\r\x9d\r\x9b\r\x9b\r\x9b\r\x9d\r\x9d\r\x9d\r\x9d\r\x9b\r\x9b\r\x9d\r\x9d\r\x9d\r\x9d\r\x9b\r\x9b\r\xa2\xfdu\xf7u\xf7u\xf7u\xf7u\xf7u\xf7\xba\xf7u\b\x9b\r\x9b\r\x9b\r\x9b\r\x9b\r\x9a\rC\f\xe7\xfa\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf6\x9f\xf60\xf6\xfd\xf2f\xf2f\xf2f\xf2f\xf2f\xf2e\xf2\x19\xf6\xd4\b2\v3\v2\v2\v2\v2\vK\n\xc9\x06\x8e\x06\x8f\x06\x8f\x06\x8f\x06\x8f\x06\x8f\x06\x90\x02\xf2\xf5\xdc\xf5\xdc\xf5\xdb\xf5\xdb\xf5\xdb\xf5\xdb\xf5\xcf\xfb\xd8\n\xd8\n\xd8\n\xd8\n\xd8\n\xd8\n\xd9\n\xbf\v\x9a\r\x9a\r\x9a\r\x9a\r\x9a\r\x9a\r\x9a\rF\n\xa7\x04\xa8\x04\xa8\x04\xa8\x04\xa8\x04\xa8\x04\xa8\x04\x1e\b\x01\r\x01\r\x01\r\x00\r\x00\r\x00\r\x00\r\xcf\x00g\xf2g\xf2f\xf2g\xf2g\xf2g\xf2g\xf2A\xff\n\f\n\f\n\f\n\f\n\f\n\f\n\f\x80\f\xe6\f\xe6\f\xe6\f\xe6\f\xe7\f\xe6\f\xe6\f\x00\r\x15\r\x16\r\x16\r\x16\r\x16\r\x16\r\x16\rg\r\x97\r\x96\r\x96\r\x97\r\x97\r\x97\r\x97\r\x17\t\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xa5\v\x97\r\x96\r\x96\r\x96\r\x97\r\x97\r\x97\r\x9e\xfc\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\x01\xf7\xb6\xf7\x18\x06\x0e\n\x0e\n\x0e\n\x0e\n\x0e\n\x0e\nT\n\xfd\f\x95\r\x96\r\x96\r\x96\r\x95\r\x95\rc\n\xe2\xf5j\xf2j\xf2k\xf2k\xf2k\xf2k\xf2j\xf2j\xf2k\xf2k\xf2j\xf2j\xf2k\xf2k\xf2\x1a\xf3\xbc\xf5\xe9\xf5\xe9\xf5\xe9\xf5\xe9\xf5\xe7\xf5\xe9\xf5\x16\xf5u\xf2k\xf2k\xf2k\xf2k\xf2k\xf2m\xf2m\xf2m\xf2m\xf2k\xf2m\xf2m\xf2m\xf2m\xf2m\xf2m\xf2m\xf2k\xf2m\xf2m\xf2m\xf2k\xf2k\xf2m\xf2m\xf2m\xf2m\xf2k\xf2k\xf2k\xf2\xc6\xfc\b\r\n\r\n\r\n\r\n\r\n\r\n\r\xb0\x01m\xf2m\xf2m\xf2m\xf2m\xf2m\xf2m\xf2\x02\xff\x92\r\x92\r\x93\r\x93\r\x93\r\x93\r\x93\r\x92\r\x92\r\x92\r\x92\r\x92\r\x92\r\x93\r\x93\r\x01\xffn\xf2n\xf2n\xf2n\xf2n\xf2n\xf2n\xf2\xfb\x01\x92\r\x92\r\x92\r\x92\r\x92\r\x92\r\x92\r\xd7\b\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xcc\x05\xce\n\x90\r\x90\r\x90\r\x92\r\x92\r\x90\r\x90\rf\n\xe9\b\xe9\b\xe9\b\xea\b\xe9\b\xe9\b\xe9\b\xd8\xfe\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd2\xfa\xd2\xfa\xdc\b\x8f\r\x90\r\x90\r\x90\r\x90\r\x90\r\x90\r\x90\r\x90\r\x90\r\x8f\r\x8f\r\x8f\r\x8f\r\x8f\r\x8f\r\x8f\r\x90\r\x90\r\x90\r\x8f\r\x8f\r\x00\v$\xf78\xf38\xf38\xf38\xf38\xf38\xf3\v\xf6S\x06\xd1\b\xd1\b\xd1\b\xd1\b\xd3\b\xd3\b(\x05I\xf4q\xf2q\xf2q\xf2q\xf2r\xf2r\xf2\x8f\xf6\\\x06g\ag\ag\ag\ag\ag\aa\x06\x02\x03\xef\x02\xef\x02\xef\x02\xef\x02\xef\x02\xef\x02\x98\x00\xfb\xf9\xfb\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xc7\xf7r\xf2r\xf2r\xf2r\xf2r\xf2r\xf2q\xf2\xe2\xf3\xe3\xf6\xe3\xf6\xe3\xf6\xe3\xf6\xe3\xf6\xe3\xf6\xe3\xf6P\xf5t\xf2t\xf2t\xf2t\xf2t\xf2t\xf2t\xf2\xbf\xfc\x19\r\x19\r\x19\r\x19\r\x19\r\x19\r\x19\r\xfc\x01r\xf2r\xf2t\xf2t\xf2r\xf2r\xf2t\xf2\xdc\xf3\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x8e\x00\x9a\f\x9a\f\x99\f\x99\f\x99\f\x99\f\x99\fl\nM\bM\bM\bM\bM\bM\bM\b\x9e\xff\x12\xf8\x12\xf8\x12\xf8\x12\xf8\x12\xf8\x11\xf8\x11\xf8^\x03\x19\f\x18\f\x18\f\x18\f\x18\f\x18\f\x18\f\xf2\f\x8a\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8a\r\x8a\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8b\r\x8a\r\x8a\r\x8a\r\x8a\r\x8b\r\x8b\r\x8b\r\x8b\r\xa2\f4\f3\f3\f3\f4\f3\f3\f#\r\x8a\r\x8a\r\x8a\r\x88\r\x88\r\x8a\r\x8a\r\xe3\n\xeb\t\xeb\t\xea\t\xea\t\xea\t\xea\t\xd1\t\xf3\xf9\xd8\xf4\xd8\xf4\xd8\xf4\xd8\xf4\xd8\xf4\xd8\xf4\x9d\xf54\b\x88\r\x88\r\x88\r\x88\r\x88\r\x88\r\x88\r\x88\r\x88\r\x88\r\x88\r\x88\r\x88\r\x88\rK\v\xe9\xf6x\xf2x\xf2x\xf2x\xf2y\xf2y\xf2x\xf2x\xf2x\xf2x\xf2y\xf2y\xf2y\xf2y\xf27\xf4\v\xfe\x85\xff\x83\xff\x83\xff\x83\xff\x85\xff\x85\xff\xbb\x01J\f\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\x87\r\xc9\a\x10\xf5\xa9\xf4\xa9\xf4\xa9\xf4\xa9\xf4\xa9\xf4\xa9\xf4\xa4\xf6m\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcv\x00\xf9\n\xf9\n\xf9\n\xf9\n\xf9\n\xf9\n\xf8\n\x8b\t;\x06;\x06;\x06:\x06:\x06:\x06;\x06\xf1\x00\xe0\xf5\xe0\xf5\xe0\xf5\xe0\xf5\xe0\xf5\xe0\xf5\xe0\xf5\f\xfe\x84\r\x84\r\x84\r\x84\r\x84\r\x84\r\x84\r\x84\r\x84\r\x84\r\x83\r\x83\r\x84\r\x84\r\x84\r\x84\r\x84\r\x84\r\x84\r\x84\r\x84\r\x84\r\x84\r\x84\r\x83\r\x83\r\x83\r\x84\r\x84\r\x84\r\x84\r\xd8\x01|\xf2|\xf2|\xf2|\xf2|\xf2|\xf2|\xf2\x9e\xfd\x16\v\x16\v\x15\v\x15\v\x16\v\x16\v\x16\vw\xff}\xf2}\xf2}\xf2}\xf2}\xf2}\xf2}\xf2\xc4\xfd_\t_\ta\t_\t_\t_\t_\t\xe4\xff\xdb\xf6\xdc\xf6\xdc\xf6\xdc\xf6\xdb\xf6\xdb\xf6\xdb\xf6\xaa\xf5\x9d\xf4\x9f\xf4\x9f\xf4\x9f\xf4\x9d\xf4\x9d\xf4\x9d\xf4q\xf3}\xf2\u007f\xf2\u007f\xf2\u007f\xf2\u007f\xf2\u007f\xf2\u007f\xf2\xc0\x01I\rI\rI\rI\rI\rI\rI\r{\al\x03l\x03l\x03l\x03l\x03l\x03l\x03\x89\t\x80\r\x80\r\x80\r\x81\r\x80\r\x80\r\x80\r\xd8\nA\tA\tA\tA\tA\tA\tA\tA\tA\tB\tB\tB\tB\tA\tA\t\xfa\xfb\x1a\xf5\x1a\xf5\x1a\xf5\x1a\xf5\x1a\xf5\x1a\xf5\x1a\xf5\x95\x05\x80\r\x80\r~\r~\r\x80\r~\r~\rM\vT\nT\nT\nT\nT\nS\nS\nw\x06\xe1\x04\xe1\x04\xe1\x04\xe1\x04\xdf\x04\xdf\x04\xdf\x04\xdc\xf9\xaa\xf5\xaa\xf5\xaa\xf5\xa9\xf5\xa9\xf5\xa9\xf5\xa9\xf5S\xf3\x82\xf2\x82\xf2\x82\xf2\x82\xf2\x82\xf2\x82\xf2\x8f\xf2~\xfe^\x02^\x02^\x02^\x02^\x02^\x02\x12\x02(\xf6\x83\xf2\x83\xf2\x83\xf2\x83\xf2\x83\xf2\x82\xf2c\xf3j\a&\r&\r&\r&\r(\r&\r*\rm\r}\r}\r}\r}\r}\r}\r}\r}\r}\r}\r}\r}\r|\r|\rx\rK\rB\rB\rC\rB\rB\rB\r\xe2\n\xca\xf6\x84\xf2\x84\xf2\x84\xf2\x84\xf2\x84\xf2\x84\xf2\x84\xf2\x84\xf2\x84\xf2\x84\xf2\x84\xf2\x84\xf2\x83\xf2\x83\xf2q\xf4\x16\x01Y\x03Y\x03Y\x03Y\x03Y\x03Z\x03\x8d\x04\xa8\v\xce\f\xce\f\xce\f\xce\f\xce\f\xce\f%\tf\xf5\x86\xf2\x86\xf2\x86\xf2\x86\xf2\x84\xf2\x86\xf2\x86\xf2\x86\xf2\x86\xf2\x86\xf2\x86\xf2\x86\xf2\x86\xf2\x86\xf2L\xf6\xc1\a\xc1\t\xc1\t\xc1\t\xc1\t\xc1\t\xc1\tg\n3\rz\rz\rz\rz\ry\ry\r\x80\bA\xf4\x87\xf2\x87\xf2\x87\xf2\x87\xf2\x87\xf2\x87\xf2\x87\xf2\x87\xf2\x86\xf2\x86\xf2\x86\xf2\x86\xf2\x87\xf2\x87\xf2\x87\xf2\x87\xf2\x87\xf2\x87\xf2\x87\xf2\x87\xf2\x87\xf2\x87\xf2U\xf8\x92\fy\ry\rx\rx\rx\rx\r\\\bQ\xf7\xc5\xf6\xc5\xf6\xc3\xf6\xc3\xf6\xc3\xf6\xc5\xf6\xbd\xfb\xa9\v\xf9\v\xf9\v\xf9\v\xf9\v\xf9\v\xfb\vm\v\xb8\t\xb6\t\xb6\t\xb6\t\xb6\t\xb6\t\xb6\t\xe7\b\x83\x06\x83\x06\x83\x06\x84\x06\x83\x06\x83\x06\x83\x06\xae\x06$\a$\a$\a$\a$\a$\a$\a\xef\x06_\x06_\x06_\x06a\x06_\x06_\x06_\x06\x0e\a\xd9\b\xd9\b\xd9\b\xd9\b\xd9\b\xd9\b\xd9\b\xee\b$\t$\t$\t$\t$\t$\t$\tI\n\x16\r\x16\r\x16\r\x16\r\x16\r\x16\r\x16\r\x15\b@\xfc@\xfc@\xfc@\xfc@\xfc@\xfc@\xfc\x94\xf9r\xf3r\xf3t\xf3t\xf3r\xf3r\xf3r\xf3+\xf3\x8b\xf2\x8b\xf2\x8b\xf2\x8b\xf2\x8b\xf2\x8b\xf2\x8b\xf2\t\xfbu\ru\ru\ru\ru\ru\ru\r\u007f\a\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe5\xfa\xe5\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\xe7\xfa\x9a\xff\x12\t\x12\t\x12\t\x12\t\x12\t\x12\t\x12\t\xc7\a8\x058\x059\x059\x059\x059\x058\x05j\x06\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\xc1\b\n\x01\x8e\xf2\x8e\xf2\x8e\xf2\x8e\xf2\x8e\xf2\x8e\xf2\x8e\xf2\xec\xfbI\rI\rI\rJ\rI\rI\rI\rV\rs\rs\rr\rr\rr\rr\rr\rr\rq\rq\rq\rr\rq\rq\rq\r\x93\f\x06\v\x06\v\x06\v\x06\v\x06\v\x06\v\x06\v\xe5\vq\rq\rq\rq\rq\rq\rq\rq\rq\rq\rq\rq\rq\rq\rq\ro\ro\ro\ro\rq\rq\rq\ro\rJ\x05\x1a\xf7\x1a\xf7\x1c\xf7\x1c\xf7\x1c\xf7\x1c\xf7\x1c\xf7y\xfc\xcb\x05\xcb\x05\xc9\x05\xc9\x05\xcb\x05\xcb\x05\xcb\x05\x95\bo\ro\ro\ro\ro\ro\rn\rn\ro\ro\ro\ro\ro\ro\ro\r\x9d\x03\x91\xf2\x91\xf2\x92\xf2\x92\xf2\x91\xf2\x91\xf2\x91\xf2\v\xfc\x85\f\x85\f\x85\f\x85\f\x85\f\x85\f\x85\f\x88\f\x8e\f\x8f\f\x8e\f\x8e\f\x8f\f\x8f\f\x8f\f\x92\f\x99\f\x99\f\x99\f\x97\f\x97\f\x97\f\x99\f\xda\x03i\xf4i\xf4i\xf4i\xf4i\xf4i\xf4i\xf4h\xfdn\rn\rn\rm\rm\rm\rm\rm\rm\rm\rm\rm\rn\rn\rn\r\x00\r9\f9\f9\f7\f7\f7\f7\f\x9c\x03\xcc\xf3\xcc\xf3\xcc\xf3\xcc\xf3\xcc\xf3\xcc\xf3\xca\xf3\xac\xf7\xe7\xfe\xe7\xfe\xe7\xfe\xe7\xfe\xe7\xfe\xe7\xfe\xe7\xfe\xea\x03k\rk\rm\rk\rk\rk\rk\r-\r\xb7\f\xb7\f\xb7\f\xb7\f\xb7\f\xb6\f\xb6\f;\n_\x05_\x05_\x05_\x05_\x05_\x05^\x05^\x05_\x05_\x05^\x05^\x05_\x05_\x05_\x05\x04\bj\rj\rj\rj\rj\rj\rj\r[\n\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf7\x03\xf7\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\x88\xfe\x96\xf2\x96\xf2\x96\xf2\x96\xf2\x95\xf2\x95\xf2\x96\xf2\xd5\xfag\rg\rg\rh\rj\rj\rj\rj\rh\rh\rh\rh\rj\rj\rh\rh\rh\rh\rh\rh\rh\rh\rh\r\xb2\x05\x98\xf2\x98\xf2\x98\xf2\x98\xf2\x98\xf2\x98\xf2\x98\xf2\x98\xf2\x98\xf2\x98\xf2\x98\xf2\x98\xf2\x98\xf2\x98\xf2\x98\xf2\xe9\xf9g\rg\rg\rg\rh\rh\rh\r\x8b\f(\n(\n)\n)\n)\n)\n)\nm\tO\aO\aO\aP\aP\aP\aP\a\xd3\bb\rg\rf\rf\rf\rf\rg\rg\rg\rg\rg\rg\rg\rf\rf\r7\a'\xf3\x99\xf2\x9a\xf2\x9a\xf2\x9a\xf2\x9a\xf2\x9a\xf2\x19\xf7I\x06\xe6\x06\xe5\x06\xe5\x06\xe5\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06}\a\xb7\t\xdc\t\xdc\t\xde\t\xde\t\xde\t\xde\t\x1c\aU\xfcv\xfbv\xfbv\xfbv\xfbv\xfbv\xfbv\xfbv\xfbv\xfbv\xfbv\xfbv\xfbv\xfbv\xfb\xa1\xfa\xf4\xf6\x8c\xf6\x8c\xf6\x8c\xf6\x8c\xf6\x8c\xf6\x8c\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6\x8d\xf6>\xf6\xa3\xf4g\xf4i\xf4i\xf4i\xf4i\xf4i\xf4\xc6\xf7w\nc\rc\rd\rd\rd\rd\rc\rc\rc\rc\rd\rd\rc\rc\rt\nk\xf6\x9d\xf2\x9d\xf2\x9d\xf2\x9d\xf2\x9d\xf2\x9d\xf2M\xf4R\x01\x05\x04\x03\x04\x03\x04\x03\x04\x05\x04\x05\x04\x05\x04\x05\x04\x05\x04\x03\x04\x03\x04\x03\x04\x05\x04\x05\x04\xae\x04\xad\vb\rc\rb\rb\rb\rb\r\x00\f\xa2\xf9\xd4\xf4\xd4\xf4\xd5\xf4\xd5\xf4\xd5\xf4\xd5\xf4\xd5\xf4\xd5\xf4\xd4\xf4\xd4\xf4\xd4\xf4\xd4\xf4\xd5\xf4\xd5\xf4\xdb\xf4X\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\xce\xf5\xab\ab\rb\rb\rb\rb\rb\rb\r`\r`\r`\r`\r`\r`\r`\r`\r`\r`\r`\r`\r`\r`\r`\r`\rS\xff\xb4\xf9\xb3\xf9\xb3\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xb4\xf9\xe2\xf4\xa1\xf2\xa0\xf2\xa0\xf2\xa0\xf2\xa0\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa3\xf2\xa3\xf2\xa1\xf2\xa3\xf2\xa3\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa1\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\xa3\xf2\x8c\x01\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xb1\v\xa8\f]\r]\r]\r]\r]\r]\r\\\r\\\r]\r_\r_\r_\r]\r]\r]\r]\r]\r]\r]\r]\r]\r]\r]\r\\\r\\\r]\r]\r]\r]\r\\\r\\\r\xfb\xff\xa3\xf2\xa3\xf2\xa3\xf2\xa4\xf2\xa4\xf2\xa4\xf2\xa4\xf2\x1b\xfb=\x04=\x04?\x04@\x04?\x04?\x04?\x04?\x04@\x04@\x04?\x04?\x04?\x04?\x04?\x04\x81\xfc\xa4\xf2\xa4\xf2\xa4\xf2\xa5\xf2\xa5\xf2\xa5\xf2\xa4\xf2\xa5\xf2\xa5\xf2\xa5\xf2\xa4\xf2\xa5\xf2\xa5\xf2\xa5\xf2\xa4\xf2\xd9\xfa;\a;\a;\a;\a;\a;\a;\a\xba\a\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b%\nY\rY\rY\r[\rY\rY\rY\r[\rY\rY\rY\rY\rY\rY\rY\r\x00\f\xac\b\xac\b\xac\b\xac\b\xac\b\xac\b\xab\b\xab\b\xac\b\xac\b\xac\b\xac\b\xac\b\xac\b\xac\b\xcc\tJ\rX\rX\rX\rX\rY\rX\r\x83\t_\xfc\xbf\xfb\xbd\xfb\xbf\xfb\xbf\xfb\xbf\xfb\xbd\xfb\xfb\xf93\xf3\xa8\xf2\xa8\xf2\xa8\xf2\xa8\xf2\xa8\xf2\xa8\xf2/\xf7\x1c\vX\rX\rX\rX\rX\rX\rV\rV\rV\rV\rX\rX\rX\rX\r\x01\r\xe7\n\x88\n\x88\n\x88\n\x88\n\x88\n\x88\n\x88\n\x88\n\x88\n\x88\n\x87\n\x87\n\x87\n\x88\n\xba\n\xd1\fV\rV\rU\rU\rU\rV\rV\rV\rU\rU\rV\rV\rV\rV\r\x11\r\r\x01C\xfdC\xfdC\xfdB\xfdB\xfdC\xfdC\xfdB\xfdC\xfdC\xfdC\xfdC\xfdB\xfdB\xfdB\xfd\a\xf7{\xf4{\xf4{\xf4{\xf4{\xf4|\xf4|\xf4|\xf4|\xf4{\xf4{\xf4{\xf4|\xf4|\xf4|\xf41\xf9\xa9\xfb\xa9\xfb\xa9\xfb\xa9\xfb\xaa\xfb\xaa\xfb\xaa\xfb\xa9\xfb\x90\xff\x15\x06\x15\x06\x15\x06\x15\x06\x15\x06\x15\x06\x15\x06\x15\x06E\x06\xcb\a\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1c\b\x1c\b\x1c\b\xa5\x06{\x05{\x05{\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05y\x05{\x05y\x05y\x05\x9b\xf7\xae\xf2\xae\xf2\xae\xf2\xae\xf2\xae\xf2\xae\xf2\xae\xf2\xae\xf2\xca\xfeT\rT\rR\rR\rQ\rQ\rQ\rR\rP\r?\r>\r?\r?\r?\r>\r>\r>\r>\rr\xfc\xae\xf2\xae\xf2\xae\xf2\xae\xf2\xaf\xf2\xaf\xf2\xaf\xf2\xaf\xf2\xaf\xf2\xaf\xf2\xaf\xf2\xaf\xf2\xae\xf2\xae\xf2\xae\xf2\xaf\xf2\xaf\xf2\xaf\xf2\xaf\xf2\xb0\xf2\xb0\xf2\xaf\xf2\xaf\xf2\xaf\xf2\xaf\xf2\xaf\xf2\xaf\xf2\xf4\xf5\xed\xf8\xeb\xf8\xeb\xf8\xeb\xf8\xed\xf8\xed\xf8\xed\xf8\xed\xf8\xc6\xfd\xf9\fP\rQ\rP\rP\rP\rQ\rP\rP\rP\rP\rP\rP\rP\rP\rP\rP\rQ\r\x9c\x02A\xf3A\xf3A\xf3A\xf3?\xf3?\xf3?\xf3A\xf3y\xf6\xe7\tP\rQ\rP\rP\rP\rP\rP\rP\rP\rP\rP\rP\rP\rN\rN\rN\rN\rN\rN\rN\rN\rN\rN\rN\rN\rN\rJ\r\xff\n;\n;\n;\n;\n;\n:\n:\n;\n;\n:\n:\n:\n;\n:\n:\n:\n:\n%\x06\x9c\xf4\xb2\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xb2\xf2\xb2\xf2\xb2\xf2\xb2\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xb3\xf2\xff\xf3\xd2\aK\rK\rM\rM\rM\rM\rM\rM\rM\rM\rM\rK\rK\rK\rK\rK\rK\rK\rK\rM\rK\rJ\rJ\rJ\rK\rK\rK\rM\vM\nL\nL\nL\nM\nL\nL\nL\n{\x01\xb5\xf2\xb5\xf2\xb5\xf2\xb5\xf2\xb5\xf2\xb5\xf2\xb5\xf2\xb5\xf2\xdc\xf4\xab\bJ\rJ\rJ\rJ\rJ\rJ\rJ\rJ\rJ\rJ\rJ\rJ\rJ\rJ\rJ\rJ\rJ\r\xe9\x06\x1d\xf3\xb7\xf2\xb6\xf2\xb6\xf2\xb6\xf2\xb6\xf2\xb6\xf2\xb6\xf2\xb6\xf2\xb6\xf2\xb6\xf2\xb6\xf2\xb6\xf2\xb6\xf2\xb6\xf2\xb6\xf2\xb6\xf2\xb6\xf2E\xfdI\rI\rI\rI\rJ\rJ\rI\rI\r\xb8\n\x16\xf8C\xf4C\xf4C\xf4C\xf4C\xf4B\xf4B\xf4B\xf4C\xf4C\xf4B\xf4B\xf4C\xf4C\xf4B\xf4B\xf4B\xf4\xb1\xfaI\rI\rI\rG\rG\rG\rG\rG\rG\rG\rG\rG\rG\rG\rG\rG\rG\rG\r\x8a\x05h\xfah\xfah\xfah\xfah\xfah\xfah\xfah\xfah\xfah\xfaj\xfaj\xfah\xfah\xfah\xfaj\xfaj\xfah\xfa\x1a\xf6\xba\xf2\xba\xf2\xba\xf2\xba\xf2\xb9\xf2\xb9\xf2\xb9\xf2\xba\xf2\xba\xf2\xb9\xf2\xb9\xf2\xb9\xf2\xba\xf2\xba\xf2\xba\xf2\xba\xf2\xba\xf2\xba\xf2\xba\xf2\xba\xf2\xba\xf2\xba\xf2\xba\xf2\xba\xf2\xbb\xf2\xbb\xf2\xba\xf2\x91\xf42\xf72\xf70\xf72\xf72\xf72\xf72\xf72\xf7N\xf94\a\xde\t\xde\t\xdf\t\xdf\t\xdf\t\xdf\t\xde\t\xde\t.\a\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\x10\x05\x0e\x05\x0e\x05\x10\x05\x0e\x05\x0e\x05\x0e\x05\x10\x05\x10\x05\x0e\x05\x0e\x05\x0e\x05\x0e\x05\xd4\nE\rE\rE\rE\rE\rE\rE\rE\rC\rC\rC\rC\rC\rC\rC\rE\rC\r\xfc\f\xfb\n\x90\n\x90\n\x90\n\x90\n\x90\n\x90\n\x90\n\x90\n\x90\n\x90\n\x8f\n\x8f\n\x90\n\x90\n\x90\n\x90\n\x90\n}\x05\xf8\xf5\xb3\xf5\xb3\xf5\xb3\xf5\xb3\xf5\xb3\xf5\xb4\xf5\xb4\xf5\xb3\xf5\xb4\xf5\xb4\xf5\xb3\xf5\xb3\xf5\xb3\xf5\xb3\xf5\xb3\xf5\xb3\xf5\xb3\xf5\xb8\xfeB\rB\rC\rB\rB\rB\rB\rB\rB\rB\rB\rB\rB\r@\r@\rB\rB\rB\r \f\x15\v\x15\v\x15\v\x16\v\x16\v\x16\v\x16\v\x16\v\x15\v\x15\v\x15\v\x15\v\x15\v\x15\v\x15\v\x15\v\x15\v\x15\v\xc0\xfb\xb9\xf3\xb9\xf3\xb9\xf3\xb7\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xeb\xf4\xe7\a@\r@\r@\r@\r@\r@\r@\r@\r?\r?\r?\r@\r@\r@\r@\r@\r@\r\x92\b/\xf5-\xf3-\xf3/\xf3/\xf3-\xf3-\xf3-\xf3-\xf3-\xf3-\xf3-\xf3-\xf3-\xf3-\xf3-\xf3-\xf3-\xf3~\xfa\xbe\n\xbe\n\xbe\n\xbe\n\xbe\n\xbe\n\xbe\n\xbe\n\xbe\n\xbe\n\xbe\n\xbe\n\xbe\n\xbe\n\xbe\n\xbe\n\xbe\n\xbe\n\xd7\v>\r>\r>\r>\r>\r>\r>\r>\r>\r>\r<\r<\r<\r<\r>\r<\r<\r<\r<\r<\r<\r<\r>\r>\r>\r>\r<\r3\r\x19\r\x19\r\x19\r\x19\r\x19\r\x19\r\x1a\r\x19\r\x19\r\x19\r\x1a\r\x19\r\x19\r\x19\r\x18\r\x18\r\x18\r\x19\r\x05\x03\xc4\xf2\xc4\xf2\xc4\xf2\xc4\xf2\xc4\xf2\xc4\xf2\xc4\xf2\xc4\xf2\xc4\xf2\xc4\xf2\xc4\xf2\xc4\xf2\xc5\xf2\xc5\xf2\xc5\xf2\xc5\xf2\xc5\xf2\xc5\xf2/\xf6\x87\xf9\x87\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x84\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9\x85\xf9^\x05o\fo\fo\fo\fo\fo\fo\fo\fo\fo\fo\fo\fn\fn\fn\fo\fo\fn\f\x19\v\xa5\n\xa5\n\xa5\n\xa4\n\xa4\n\xa5\n\xa5\n\xa5\n\xa4\n\xa4\n\xa4\n\xa4\n\xa5\n\xa5\n\xa5\n\xa5\n\xa4\n\xc2\a\n\xf6\xc6\xf2\xc6\xf2\xc6\xf2\xc6\xf2\xc8\xf2\xc8\xf2\xc6\xf2\xc6\xf2\xc6\xf2\xc6\xf2\xc6\xf2\xc6\xf2\xc8\xf2\xc8\xf2\xc8\xf2\xc8\xf2\xc8\xf23\xf3\x8a\xf4\x92\xf4\x92\xf4\x93\xf4\x93\xf4\x93\xf4\x92\xf4\x93\xf4\x93\xf4\x17\xf7[\xf8[\xf8[\xf8[\xf8[\xf8[\xf8[\xf8[\xf8\xa0\xff\x16\r\x16\r\x16\r\x18\r\x16\r\x16\r\x16\r\x16\r\x18\r.\r7\r7\r7\r7\r7\r7\r7\r7\r7\r7\r7\r7\r7\r7\r7\r7\r7\r7\r7\r7\r7\r7\r7\r7\r7\r5\r5\r\x90\xffg\xf5g\xf5g\xf5f\xf5f\xf5f\xf5f\xf5g\xf5g\xf5g\xf5g\xf5f\xf5f\xf5f\xf5f\xf5f\xf5f\xf5f\xf5\x9e\xf3\xcb\xf2\xcb\xf2\xcb\xf2\xcb\xf2\xcb\xf2\xcb\xf2\xcb\xf2\xcb\xf2\xcb\xf2\xcb\xf2\xcb\xf2\xcc\xf2\xcc\xf2\xcb\xf2\xcb\xf2\xcc\xf2\xcc\xf2\b\xf4\xa4\a4\r4\r5\r5\r4\r4\r4\r4\r4\r4\r4\r4\r4\r3\r3\r3\r4\r4\r4\r4\r4\r5\r5\r5\r5\r4\r3\r3\r3\r4\r4\r4\r3\r3\r3\r3\r3\r3\r3\r4\r4\r4\r3\r3\r4\r4\r4\r3\r3\r3\r3\r3\r3\r3\r3\r\xcd\x04\xbc\xf5\xbc\xf5\xbc\xf5\xbe\xf5\xbe\xf5\xbc\xf5\xbc\xf5\xbc\xf5\xbc\xf5\xbc\xf5\xbb\xf5\xbc\xf5\xbc\xf5\xbc\xf5\xbc\xf5\xbc\xf5\xbc\xf5\xbc\xf5(\xfd\xed\x05\xed\x05\xed\x05\xed\x05\xec\x05\xec\x05\xec\x05\xec\x05\xec\x05\xec\x05\xec\x05\xed\x05\xed\x05\xed\x05\xec\x05\xec\x05\xec\x05\xec\x05\xed\x05\xed\x05\xed\x05\xed\x05\xed\x05\xec\x05\xec\x05\xec\x05\xec\x05\x89\x01\x9a\xf3$\xf3$\xf3$\xf3$\xf3%\xf3%\xf3$\xf3$\xf3$\xf3$\xf3%\xf3%\xf3%\xf3$\xf3$\xf3$\xf3%\xf3*\xf9l\x05l\x05l\x05l\x05l\x05l\x05l\x05l\x05l\x05l\x05l\x05l\x05l\x05m\x05l\x05j\x05j\x05j\x05\x8e\xfd\xd2\xf2\xd2\xf2\xd0\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd0\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xd2\xf2\xa2\xf6\x82\x05\xe1\x06\xe1\x06\xe1\x06\xe1\x06\xdf\x06\xdf\x06\xe1\x06\xe1\x06\xdf\x06\xdf\x06\xe1\x06\xe1\x06\xe1\x06\xe1\x06\xe1\x06\xe1\x06\xe1\x06E\x01\xd3\xf2\xd3\xf2\xd2\xf2\xd3\xf2\xd3\xf2\xd3\xf2\xd3\xf2\xd3\xf2\xd3\xf2\xd3\xf2\xd3\xf2\xd3\xf2\xd3\xf2\xd3\xf2\xd3\xf2\xd3\xf2\xd3\xf2\xd3\xf2\x9f\xf9U\x05U\x05U\x05T\x05T\x05T\x05U\x05U\x05\xa5\x05s\v,\r,\r,\r,\r,\r,\r,\r,\r,\r,\r,\r,\r,\r,\r,\r,\r,\rG\f\x04\a\x12\x06\x12\x06\x12\x06\x12\x06\x11\x06\x11\x06\x12\x06\x12\x06\x12\x06\x12\x06\x12\x06\x12\x06\x12\x06\x12\x06\x12\x06\x12\x06\x12\x06\x9b\x02J\xf6r\xf5r\xf5r\xf5r\xf5r\xf5r\xf5r\xf5r\xf5\xd4\xf3\xd6\xf2\xd6\xf2\xd6\xf2\xd6\xf2\xd6\xf2\xd6\xf2\xd6\xf2\xd6\xf2\xd7\xf2\xd7\xf2\xd7\xf2\xd6\xf2\xd6\xf2\xd6\xf2\xd6\xf2\xd6\xf2\xd7\xf2\xd7\xf2~\x00S\x06S\x06S\x06S\x06S\x06S\x06S\x06Q\x06Q\x06S\x06S\x06Q\x06Q\x06S\x06S\x06S\x06T\x06S\x06S\x06S\x06S\x06S\x06S\x06S\x06S\x06S\x06Q\x06\xce\xfdM\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3\x0f\xf3\xd8\xf2\xd8\xf2\xd8\xf2\xd7\xf2\xd7\xf2\xd7\xf2\xd7\xf2\xd8\xf2\xd8\xf2\xd8\xf2\xd8\xf2\xda\xf2\xda\xf2\xda\xf2\xd8\xf2\xd8\xf2\xd8\xf2\xd8\xf2\xd8\xf2\xda\xf2\xda\xf2\xda\xf2\xda\xf2\xda\xf2\xda\xf2\xda\xf2\xda\xf2%\xf9\x97\n\x97\n\x96\n\x96\n\x96\n\x96\n\x96\n\x96\n\x96\n\x96\n\x96\n\x96\n\x96\n\x96\n\x96\n\x97\n\x97\n\x97\ns\v&\r&\r&\r&\r&\r&\r&\r&\r&\r&\r&\r&\r&\r&\r&\r&\r&\r%\r%\r%\r%\r%\r%\r&\r&\r&\r%\r\x1a\r\xb3\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9b\f\x9b\f\x9a\f\x9a\f\xdb\tD\xfb\xe6\xf8\xe6\xf8\xe6\xf8\xe6\xf8\xe6\xf8\xe4\xf8\xe4\xf8\xe4\xf8\xe4\xf8\xe6\xf8\xe6\xf8\xe6\xf8\xe4\xf8\xe4\xf8\xe4\xf8\xe6\xf8\xe6\xf8\xe3\xf7B\xf4\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xff\xf3\xff\xf3\xff\xf3\xfd\xf3\xff\xf3\xff\xf3\xff\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xc3\xfa\"\r\"\r\"\r\"\r\"\r\"\r\"\r\"\r\"\r\"\r#\r#\r#\r#\r\"\r\"\r\"\r\"\r\"\r\"\r\"\r\"\r\"\r#\r#\r\"\r\"\r\"\rJ\xff7\xfa8\xfa8\xfa8\xfa7\xfa8\xfa8\xfa7\xfa7\xfa7\xfa7\xfa7\xfa8\xfa8\xfa8\xfa8\xfa8\xfa\xec\xfa\xef\x04\xc1\a\xc1\a\xc1\a\xc1\a\xc1\a\xc1\a\xc1\a\xc1\a\xc1\a\xc1\a\xc1\a\xc0\a\xc0\a\xc1\a\xc1\a\xc0\a\xc0\a\xa4\x05o\xf7\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8d\xf4\x8d\xf4\x8d\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8b\xf4\x8d\xf4\x8d\xf4\x8d\xf4\x8b\xf4\x8d\xf4\x8d\xf4\x8d\xf4\x8d\xf4\x8d\xf4\x8d\xf4\xd4\xf5\xd1\xf6\xd0\xf6\xd0\xf6\xd0\xf6\xd0\xf6\xd0\xf6\xd0\xf6\xd0\xf6\xd1\xf6\xd1\xf6\xd1\xf6\xd0\xf6\xd1\xf6\xd1\xf6\xd1\xf6\xd0\xf6\xd0\xf6\xd0\xf6Z\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb0\xf7\xb0\xf7\xb0\xf7\xb0\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xbc\xf6\xba\xf4\xba\xf4\xba\xf4\xba\xf4\xba\xf4\xba\xf4\xba\xf4\xba\xf4\xba\xf4\xb8\xf4\xba\xf4\xba\xf4\xba\xf4\xba\xf4\xba\xf4\xba\xf4\xba\xf4\xb8\xf4\n\xf4\xe5\xf2\xe5\xf2\xe3\xf2\xe3\xf2\xe3\xf2\xe3\xf2\xe3\xf2\xe3\xf2\xe3\xf2\xe3\xf2\xe3\xf2\xe3\xf2\xe3\xf2\xe3\xf2\xe3\xf2\xe3\xf2\xe3\xf2\xe5\xf2\xe5\xf2\xe5\xf2\xe5\xf2\xe3\xf2\xe3\xf2\xe3\xf2\xe5\xf2\xe5\xf2\xe3\xf2\xd2\xf44\b\x1d\r\x1d\r\x1b\r\x1b\r\x1b\r\x1b\r\x1b\r\x1b\r\x1b\r\x1b\r\x1b\r\x1b\r\x1a\r\x1a\r\x1a\r\x1b\r\x1d\r\x1b\r\x1a\r\x1b\r\x1b\r\x1a\r\x1a\r\x1a\r\x1a\r\x1b\r\x1b\rP\x00\x05\xf4\x05\xf4\x05\xf4\a\xf4\a\xf4\a\xf4\a\xf4\a\xf4\a\xf4\a\xf4\x05\xf4\x04\xf4\x05\xf4\x05\xf4\x05\xf4\x05\xf4\x05\xf4\x05\xf4g\xf3\xe6\xf2\xe6\xf2\xe6\xf2\xe6\xf2\xe6\xf2\xe6\xf2\xe6\xf2\xe6\xf2\xe6\xf2\xe6\xf2\xe7\xf2\xe7\xf2\xe6\xf2\xe6\xf2\xe7\xf2\xe7\xf2\xe7\xf2\xe7\xf2\xe7\xf2\xe7\xf2\xe6\xf2\xe6\xf2\xe6\xf2\xe7\xf2\xe7\xf2\xe7\xf2\xe6\xf2\x95\xf3\xa6\xf5\xb3\xf5\xb3\xf5\xb4\xf5\xb4\xf5\xb4\xf5\xb3\xf5\xb3\xf5\xb3\xf5\xb3\xf5\xb3\xf5\xb4\xf5\xb4\xf5\xb4\xf5\xb3\xf5\xb3\xf5\xb3\xf5\xb3\xf5\xb3\xf5\xb4\xf5\xb4\xf5\xb3\xf5\xb3\xf5\xb3\xf5\xb4\xf5\xb4\xf5\xb4\xf5\xb4\xf5\xd3\x01\xdc\a\xdc\a\xdc\a\xdb\a\xdb\a\xdc\a\xdc\a\xdc\a\xdc\a\xdc\a\xdc\a\xdc\a\xdc\a\xdb\a\xdb\a\xdb\a\xdb\a\xdb\a\xdc\a\xdb\a\xdb\a\xdb\a\xdb\a\xdb\a\xdb\a\xdb\a\xdb\a\xb0\t\x18\r\x16\r\x16\r\x18\r\x16\r\x16\r\x16\r\x16\r\x16\r\x16\r\x16\r\x16\r\x16\r\x16\r\x16\r\x16\r\x16\r\x16\r\x16\r\x16\r\x16\r\x16\r\x16\r\x15\r\x15\r\x15\r\x16\rh\f;\xfb\xd0\xf5\xd0\xf5\xd0\xf5\xd1\xf5\xd1\xf5\xd0\xf5\xd0\xf5\xd1\xf5\xd1\xf5\xd1\xf5\xd1\xf5\xd1\xf5\xd1\xf5\xd1\xf5\xd1\xf5\xd1\xf5\xd0\xf5\xd1\xf5\xd1\xf5\xd1\xf5\xd1\xf5\xd0\xf5\xd0\xf5\xd0\xf5\xd0\xf5\xd0\xf5\xd0\xf5\x19\x00\x15\r\x15\r\x13\r\x13\r\x13\r\x13\r\x15\r\x15\r\x15\r\x13\r\x13\r\x15\r\x15\r\x15\r\x13\r\x13\r\x13\r\x13\r\x13\r\x15\r\x15\r\x15\r\x15\r\x13\r\x13\r\x13\r\x13\r\x1c\n\xd0\xf6\xee\xf2\xee\xf2\xed\xf2\xed\xf2\xed\xf2\xed\xf2\xed\xf2\xed\xf2\xed\xf2\xed\xf2\xed\xf2\xed\xf2\xed\xf2\xee\xf2\xee\xf2\xed\xf2\xed\xf2\xed\xf2\xed\xf2\xed\xf2\xed\xf2\xed\xf2\xed\xf2\xed\xf2\xee\xf2\xee\xf2\xee\xf2p\xff\f\v\f\v\f\v\f\v\f\v\f\v\f\v\f\v\f\v\f\v\f\v\f\v\v\v\f\v\f\v\f\v\f\v\f\v\f\v\f\v\f\v\f\v\f\v\f\v\f\v\v\v\v\v\xaa\x06\xdf\xf4\xee\xf2\xef\xf2\xef\xf2\xef\xf2\xef\xf2\xef\xf2\xef\xf2\xef\xf2\xef\xf2\xef\xf2\xee\xf2\xee\xf2\xef\xf2\xef\xf2\xef\xf2\xef\xf2\xef\xf2\xef\xf2\xef\xf2\xef\xf2\xf1\xf2\xf1\xf2\xf1\xf2\xef\xf2\xef\xf2\xef\xf2\xef\xf2\x99\xf5\x87\xf7\x87\xf7\x87\xf7\x87\xf7\x87\xf7\x87\xf7\x87\xf7\x85\xf7\x87\xf7\x87\xf7\x88\xf7\x88\xf7\x87\xf7\x87\xf7\x87\xf7\x85\xf7\x87\xf7\x87\xf7\x88\xf7\x88\xf7\x87\xf7\x87\xf7\x87\xf7\x87\xf7\x88\xf7\x88\xf7\x88\xf7\x87\xf7\x87\xf7\x87\xf7\x87\xf7\x88\xf7\x88\xf7\x88\xf7\x88\xf7\x87\xf7\x87\xf7R\xf8\xd4\xf8\xd4\xf8\xd4\xf8\xd4\xf8\xd4\xf8\xd4\xf8\xd4\xf8\xd5\xf8\xd5\xf8\xd5\xf8\xd4\xf8\xd5\xf8\xd5\xf8\xd5\xf8\xd5\xf8\xd5\xf8\xd5\xf8\xd5\xf8\xd5\xf8\xd5\xf8\xd5\xf8\xd5\xf8\xd4\xf8\xd5\xf8\xd5\xf8\xd5\xf8\xd5\xf8'\xf8?\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6>\xf6?\xf6?\xf6?\xf6>\xf6>\xf6>\xf6>\xf6>\xf6S\xf5\xf3\xf2\xf3\xf2\xf3\xf2\xf5\xf2\xf5\xf2\xf5\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf5\xf2\xf6\xf2\xf6\xf2\xf5\xf2\xf5\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf5\xf2\xf5\xf2\xf5\xf2\xf3\xf2\xf5\xf2\xf5\xf2\xf5\xf2\xf5\xf2\xf5\xf2\xf5\xf2 \x04\v\r\v\r\v\r\v\r\v\r\v\r\v\r\v\r\v\r\n\r\n\r\n\r\v\r\v\r\v\r\v\r\v\r\v\r\v\r\v\r\n\r\n\r\n\r\v\r\n\r\n\r\n\r\n\r\n\r\n\r\n\r\n\r\n\r\n\r\n\r\b\r\b\rg\f\x16\f\x16\f\x16\f\x16\f\x16\f\x18\f\x18\f\x16\f\x16\f\x16\f\x16\f\x18\f\x16\f\x16\f\x16\f\x16\f\x16\f\x16\f\x16\f\x16\f\x16\f\x16\f\x16\f\x15\f\x15\f\x15\f\x15\f\x15\f\x15\f\x15\f\x15\f\x15\f\x15\f\x16\f\x16\f\x16\f\x16\fc\xfb\xf8\xf2\xf8\xf2\xf9\xf2\xf9\xf2\xf8\xf2\xf8\xf2\xf8\xf2\xf8\xf2\xf8\xf2\xf8\xf2\xf8\xf2\xf8\xf2\xf8\xf2\xf8\xf2\xf9\xf2\xf9\xf2\xf8\xf2\xf8\xf2\xf8\xf2\xf8\xf2\xf9\xf2\xf9\xf2\xf9\xf2\xf9\xf2\xf9\xf2\xf9\xf2\xf8\xf2\xf9\xf2\xfa\xf2\xfa\xf2\xfa\xf2\xf9\xf2\xf9\xf2\xf9\xf2\xf8\xf2\xf8\xf2\xf8\xf2\xd8\x03\xa0\f\xa1\f\xa1\f\xa1\f\xa1\f\xa1\f\xa1\f\xa1\f\xa1\f\xa1\f\xa1\f\xa1\f\xa1\f\xa1\f\xa1\f\xa1\f\xa0\f\xa0\f\xa0\f\xa1\f\xa1\f\xa1\f\xa0\f\xa0\f\xa0\f\xa0\f\xa0\f\xa0\f\xa1\f\xa1\f\xa1\f\xa0\f\xa0\f\xa0\f\xa0\f\xa0\f\xa0\f\x1f\xfc\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfa\xf2\xfa\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfa\xf2\xfa\xf2\xfa\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xfc\xf2\xa6\xf9|\f\x90\f\x90\f\x90\f\x90\f\x90\f\x90\f\x92\f\x92\f\x90\f\x90\f\x90\f\x90\f\x90\f\x90\f\x92\f\x92\f\x92\f\x90\f\x90\f\x90\f\x90\f\x90\f\x90\f\x90\f\x90\f\x90\f\x90\f\x00\v\x00\n\xff\t\xff\t\xff\t\x00\n\xff\t\xff\t\xff\t\xff\t\xff\t\xff\t\xff\t\xff\t\xff\t\x00\n\x00\n\x00\n\x00\n\x00\n\xff\t\xff\t\xff\t\xfd\t\xfd\t\xff\t\xff\t\xff\t\xfa\x04\x0e\xf4\xff\xf2\xfd\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\xff\xf2\x00\xf3\x00\xf3\x00\xf3\xff\xf2\x00\xf3\x00\xf3\x00\xf3\x00\xf3\xff\xf2\xff\xf2\x86\x01\x00\r\x00\r\x00\r\x00\r\x00\r\x00\r\x00\r\x00\r\x00\r\x00\r\x00\r\x01\r\x01\r\x01\r\x00\r\x00\r\x01\r\x01\r\x01\r\x00\r\x00\r\x00\r\x00\r\x00\r\x00\r\x00\r\x00\r\xdc\f:\f$\f$\f$\f$\f$\f$\f$\f$\f$\f$\f$\f$\f$\f$\f$\f$\f$\f#\f#\f#\f#\f$\f$\f#\f#\f#\f#\f\x8e\f\xff\f\xff\f\xff\f\xff\f\xff\f\xff\f\xfd\f\xfd\f\xff\f\xff\f\xff\f\xfd\f\xfd\f\xfd\f\xff\f\xff\f\xff\f\xff\f\xfd\f\xfd\f\xfd\f\xff\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xee\f\xdc\f\xdc\f\xdb\f\xdb\f\xdb\f\xdb\f\xdb\f\xdc\f\xdb\f\xdb\f\xdb\f\xdc\f\xdc\f\xdb\f\xdb\f\xdb\f\xdb\f\xdb\f\xdb\f\xdb\f\xdb\f\xdb\f\xd9\f\xd9\f\xd9\f\xd9\f\xd9\fb\fL\x00m\xfcm\xfcm\xfcn\xfcn\xfcm\xfcm\xfcm\xfcm\xfcn\xfcn\xfcn\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcm\xfcn\xfcn\xfcm\xfcm\xfcm\xfc\xe2\x01$\f$\f$\f$\f$\f$\f$\f$\f$\f#\f#\f$\f$\f!\f!\f#\f#\f#\f#\f$\f$\f#\f#\f#\f#\f$\f$\f$\f#\f#\f#\f#\f#\f#\f#\f#\f#\f]\f\xfb\f\xfb\f\xf9\f\xf9\f\xf9\f\xfb\f\xfb\f\xfb\f\xfb\f\xfb\f\xf9\f\xf9\f\xf9\f\xf9\f\xf9\f\xf9\f\xf9\f\xf9\f\xf8\f\xf8\f\xf8\f\xf9\f\xf9\f\xf9\f\xf8\f\xf8\f\xf9\f\xf9\f:\v\x01\n\x01\n\x01\n\x03\n\x03\n\x03\n\x01\n\x01\n\x8d\x057\xf5\xc5\xf3\xc5\xf3\xc4\xf3\xc4\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3\xc5\xf3M\xf6\xc6\xf8\xc6\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc5\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xc6\xf8\xf9\xf8@\xf9@\xf9@\xf9@\xf9?\xf9?\xf9?\xf9?\xf9?\xf9?\xf9@\xf9@\xf9@\xf9@\xf9@\xf9@\xf9@\xf9@\xf9?\xf9@\xf9@\xf9@\xf9@\xf9@\xf9@\xf9@\xf9@\xf9@\xf9@\xf9@\xf9@\xf9@\xf9@\xf9@\xf9@\xf9@\xf9@\xf9\xa1\xf9o\xfao\xfan\xfan\xfan\xfan\xfan\xfao\xfao\xfan\xfao\xfao\xfan\xfan\xfan\xfan\xfan\xfan\xfan\xfao\xfao\xfao\xfao\xfao\xfao\xfan\xfao\xfao\xfao\xfao\xfan\xfan\xfao\xfao\xfao\xfao\xfao\xfa\x86\xfd\x0f\b\xcc\b\xca\b\xca\b\xca\b\xca\b\xca\b\xcc\b\xcc\b\xca\b\xca\b\xca\b\xca\b\xca\b\xc9\b\xc9\b\xc9\b\xca\b\xca\b\xca\b\xca\b\xca\b\xca\b\xca\b\xca\b\xca\b\xc9\b\xc9\b\xc9\b\xc9\b\xc9\b\xc9\b\xca\b\xc9\b\xc9\b\xc9\b\xca\b)\t\xcd\vc\fc\fc\fc\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\fb\f`\f`\f`\f\xc8\f\xf0\f\xf0\f\xf0\f\xf0\f\xf0\f\xf0\f\xf0\f\xf0\f\xf0\f\xf0\f\xf0\f\xee\f\xee\f\xee\f\xf0\f\xf0\f\xf0\f\xf0\f\xf0\f\xee\f\xee\f\xee\f\xee\f\xee\f\xee\f\xee\f\xee\f\xee\f\xee\f\xee\f\xed\f\xed\f\xee\f\xee\f\xee\f\xee\f\xee\f\xee\f\xee\f\xee\f\xee\f\xed\f\xed\f\xed\f\xed\f\xed\fb\bs\xf7\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd5\xf5\xd5\xf5\xd5\xf5\xd5\xf5\xd5\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd8\xf5\xd8\xf5\xd8\xf5\xd8\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd7\xf5\xd5\xf5\xb7\xf5\xb0\xf5\xb0\xf5\xb0\xf5\xb0\xf5\xb0\xf5\xaf\xf5\xaf\xf5\xaf\xf5\xad\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xac\xf5\xac\xf5\xaa\xf5\xaa\xf5\xa9\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xac\xf5\xac\xf5\xac\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xac\xf5C\xfbr\v\xc2\v\xc2\v\xc3\v\xc3\v\xc2\v\xc2\v\xc2\v\xc2\v\xc2\v\xc2\v\xc2\v\xc2\v\xc2\v\xc2\v\xc2\v\xc2\v\xc2\v\xc2\v\xc2\v\xc1\v\xc1\v\xc2\v\xc2\v\xc2\v\xc2\v\xc2\v\xc2\v\xc2\v\xc1\v\xc1\v\xc1\v\xc2\v\xc2\v\xc2\v\xc2\v\xc2\v\xc1\v\xc1\v\xc1\v\xc1\v\xc1\v\xc1\v\xc1\v\xc1\v\xc1\v\xc1\v\xf1\v2\f2\f2\f2\f2\f2\f2\f2\f2\f2\f2\f2\f2\f2\f2\f2\f2\f2\f0\f0\f0\f2\f0\f0\f0\f2\f2\f2\f2\f2\f0\f0\f0\f0\f0\f0\f2\f0\f/\f/\f0\f0\f0\f0\f0\f0\f0\f\x18\x00\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x03\xf8\x04\xf8\x05\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x03\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x05\xf8\x05\xf8\x05\xf8\x04\xf8\x04\xf8\x80\xf8\xc9\a\xe5\f\xe5\f\xe5\f\xe5\f\xe5\f\xe6\f\xe6\f\xe5\f\xe5\f\xe3\f\xe3\f\xe3\f\xe5\f\xe5\f\xe5\f\xe5\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe5\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe2\f\xe2\f\xe2\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe3\f\xe2\f\xe2\f\xe2\f\xe3\f\xe2\f\xe2\f\xe2\f\n\x01\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xad\xf4\xad\xf4\xad\xf4\xad\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xad\xf4\xad\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xb0\xf4\xb0\xf4\xb0\xf4\xb0\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xb0\xf4\xb0\xf4\xb0\xf4\xb0\xf4\xb0\xf4\xb0\xf4\xb0\xf4\xb0\xf4\xaf\xf4\xb0\xf4\xb0\xf4\xb0\xf4\xaf\xf4\xaf\xf4\xaf\xf4\xb0\xf4[\xf9\xd0\t0\v0\v/\v/\v/\v/\v/\v/\v0\v/\v/\v/\v/\v0\v0\v0\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v/\v.\v.\v.\v/\v/\v/\v.\v.\v.\v/\v/\v.\v.\v.\v/\v/\v/\v/\v/\v/\v\xc6\xfb\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3\"\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3$\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3'\xf3'\xf3%\xf3%\xf3%\xf3$\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3%\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3%\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3'\xf3m\xf5\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb4\xf7\xb4\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb6\xf7\xb6\xf7\xb6\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\f\a\xd7\f\xd7\f\xd7\f\xd7\f\xd7\f\xd7\f\xd7\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd7\f\xd7\f\xd7\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd5\f\xd4\f\xd4\f\xd4\f\xd4\f\xd4\f\xd5\f\xd5\f\xd4\f\xd4\f\xd4\f\xd4\f\xd4\f\xd4\f\xd4\f\xd4\f\xd5\f\xd4\f\xd4\f\xd4\f\xd4\f\xd4\f\xd4\f\xd4\f\xd4\f\xd4\f\xd4\f\xd3\f\xd3\f\xd3\f\xd4\f\xd4\f\xd4\f\xd3\f\xd3\f\xd4\f\xd4\f\xd4\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd4\f\xd4\f\xd4\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd1\f\xd1\f\xd1\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd4\f\xd4\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd3\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xd1\f\xbe\n\xd2\a\xd2\a\xd3\a\xd3\a\xd3\a\xd3\a\xd4\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd3\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd0\a\xd0\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd0\a\xd2\a\xd3\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd2\a\xd0\a\xd0\a\xd2\a\xd2\a\xb7\xfe;\xf99\xf99\xf99\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf99\xf99\xf99\xf99\xf99\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9;\xf9=\xf7q\xf6q\xf6q\xf6q\xf6q\xf6q\xf6q\xf6r\xf6r\xf6r\xf6r\xf6r\xf6r\xf6r\xf6r\xf6q\xf6r\xf6r\xf6r\xf6r\xf6r\xf6r\xf6r\xf6r\xf6r\xf6s\xf6s\xf6r\xf6r\xf6q\xf6q\xf6r\xf6s\xf6s\xf6s\xf6s\xf6r\xf6r\xf6r\xf6r\xf6r\xf6r\xf6\x82\xf66\xf7g\xf7g\xf7g\xf7g\xf7g\xf7g\xf7g\xf7g\xf7g\xf7g\xf7g\xf7h\xf7h\xf7g\xf7g\xf7h\xf7h\xf7h\xf7h\xf7h\xf7h\xf7h\xf7h\xf7h\xf7h\xf7h\xf7h\xf7g\xf7g\xf7g\xf7h\xf7h\xf7h\xf7g\xf7g\xf7g\xf7g\xf7g\xf7g\xf7g\xf7h\xf7h\xf7h\xf7h\xf7h\xf7h\xf7g\xf7g\xf7g\xf7h\xf7h\xf7h\xf7h\xf7\xbe\xfaP\n\xc8\f\xc8\f\xc8\f\xc8\f\xc9\f\xc8\f\xc8\f\xc6\f\xc6\f\xc8\f\xc8\f\xc8\f\xc8\f\xc8\f\xc8\f\xc8\f\xc8\f\xc8\f\xc8\f\xc8\f\xc8\f\xc8\f\xc6\f\xc6\f\xc8\f\xc8\f\xc6\f\xc6\f\xc8\f\xc8\f\xc6\f\xc6\f\xc6\f\xc6\f\xc6\f\xc6\f\xc6\f\xc6\f\xc6\f\xc6\f\xc6\f\xc6\f\xa3\a^\xf6\x0f\xf5\x0f\xf5\x0f\xf5\x0f\xf5\x0f\xf5\x0e\xf5\x0f\xf5\x0f\xf5\x0f\xf5\x0f\xf5\x0f\xf5\x10\xf5\x10\xf5\x10\xf5\x10\xf5\x0f\xf5\x10\xf5\x10\xf5\x0f\xf5\x10\xf5\x10\xf5\x10\xf5\x0f\xf5\x10\xf5\x10\xf5\x10\xf5\x10\xf5\x10\xf5\x10\xf5\x10\xf5\x10\xf5\x10\xf5\x10\xf5\x10\xf5\x10\xf5\x10\xf5\x10\xf5\x0f\xf5\x10\xf5\x10\xf5\x10\xf5\x10\xf5\x10\xf5\x12\xf5\x12\xf5\x12\xf5\x12\xf5\x12\xf5\x10\xf5\x10\xf5\x10\xf5\x10\xf5\x10\xf5\xae\xfb\xc3\f\xc3\f\xc3\f\xc3\f\xc3\f\xc2\f\xc2\f\xc5\f\xc5\f\xc3\f\xc3\f\xc3\f\xc3\f\xc3\f\xc3\f\xc2\f\xc2\f\xc3\f\xc3\f\xc3\f\xc3\f\xc2\f\xc2\f\xc2\f\xc2\f\xc2\f\xc2\f\xc3\f\xc2\f\xc2\f\xc2\f\xc3\f\xc2\f\xc2\f\xc2\f\xc3\f\xc3\f\xc3\f\xc3\f\xc2\f\xc2\f\xc2\f\xc3\f\xc3\f\xc2\f\xc2\f\xc2\f\xc2\f\xc2\f\xc2\f\xc2\f\xc2\f\xc2\f\xc2\f\xf0\t\"\x04\"\x04\"\x04#\x04#\x04\"\x04\"\x04#\x04#\x04\"\x04\"\x04#\x04#\x04#\x04#\x04#\x04\"\x04\"\x04\"\x04\"\x04\"\x04#\x04#\x04#\x04\"\x04\"\x04\"\x04#\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04\"\x04#\x04#\x04\"\x04\"\x04\"\x04\"\x04#\x04\"\x04\"\x04\"\x04#\x04\"\x04\"\x04\"\x04\xe9\x04J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06J\x06I\x06I\x06I\x06J\x06J\x06J\x06J\x06I\x06I\x06J\x06J\x06I\x06I\x06J\x06J\x06J\x06J\x06J\x06I\x06I\x06I\x06I\x06I\x06I\x06J\x06I\x06I\x06I\x06W\xff\f\xf4\f\xf4\v\xf4\v\xf4\v\xf4\v\xf4\v\xf4\v\xf4\v\xf4\v\xf4\f\xf4\f\xf4\f\xf4\f\xf4\f\xf4\f\xf4\f\xf4\f\xf4\f\xf4\f\xf4\v\xf4\v\xf4\v\xf4\v\xf4\v\xf4\v\xf4\f\xf4\f\xf4\f\xf4\f\xf4\f\xf4\v\xf4\v\xf4\f\xf4\f\xf4\f\xf4\f\xf4\v\xf4\v\xf4\f\xf4\f\xf4\f\xf4\f\xf4\f\xf4\f\xf4\f\xf4\f\xf4\f\xf4\f\xf4\x0e\xf4\x0e\xf4\x0e\xf4\x0e\xf4\x0e\xf4\xc1\xf3F\xf3F\xf3F\xf3F\xf3F\xf3F\xf3E\xf3E\xf3E\xf3E\xf3F\xf3F\xf3F\xf3F\xf3F\xf3F\xf3F\xf3F\xf3F\xf3F\xf3F\xf3F\xf3H\xf3H\xf3F\xf3F\xf3F\xf3F\xf3F\xf3F\xf3F\xf3F\xf3H\xf3H\xf3H\xf3H\xf3H\xf3H\xf3H\xf3H\xf3H\xf3H\xf3H\xf3F\xf3F\xf3F\xf3H\xf3H\xf3H\xf3H\xf3F\xf3F\xf3F\xf3H\xf3H\xf3H\xf3H\xf3H\xf3H\xf3H\xf3H\xf3F\xf3H\xf3H\xf3I\xf3\xda\xfb\xb6\n\xb6\n\xb6\n\xb6\n\xb6\n\xb6\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb6\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb6\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb3\n\xb3\n\xb3\n\xb3\n\xb3\n\xb3\n\xb4\n\xb4\n\xb4\n\xb4\n\xb4\n\xb3\n\xb3\n\xb3\n\xb3\n\xb3\n\xb3\n\xb3\n\xb3\n\xb3\n\xb3\n\xb4\n\n\x03J\xf3J\xf3J\xf3J\xf3J\xf3J\xf3J\xf3J\xf3J\xf3L\xf3L\xf3J\xf3J\xf3J\xf3J\xf3J\xf3L\xf3L\xf3J\xf3J\xf3J\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3L\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3L\xf3L\xf3L\xf3L\xf3L\xf3M\xf3M\xf3M\xf3M\xf3L\xf3L\xf3M\xf3M\xf3M\xf3M\xf3M\xf3O\xf3O\xf3O\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3M\xf3O\xf3O\xf3O\xf3M\xf3M\xf3M\xf3M\xf3O\xf3O\xf3O\xf3M\xf3M\xf3M\xf3M\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3O\xf3P\xf3P\xf3P\xf3P\xf3P\xf3P\xf3P\xf3O\xf3P\xf3P\xf3P\xf3P\xf3Q\xf3Q\xf3P\xf3O\xf3O\xf3P\xf3Q\xf3Q\xf3P\xf3P\xf3Q\xf3Q\xf3Q\xf3P\xf3P\xf3P\xf3P\xf3P\xf3P\xf3P\xf3P\xf3/\xf7\x16\t\xfd\v\xff\v\xfd\v\xfd\v\xfd\v\xfc\v\xfc\v\xfd\v\xfd\v\xfc\v\xfc\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfc\v\xfc\v\xfc\v\xfd\v\xfd\v\xff\v\xfd\v\xfc\v\xfd\v\xfd\v\xfc\v\xfc\v\xfc\v\xfc\v\xfd\v\xfd\v\xfd\v\xfc\v\xfc\v\xfc\v\xfc\v\xfc\v\xfc\v\xfc\v\xfc\v\xfc\v\xfc\v\xfc\v\xfc\v\xfc\v\xfc\v\xfc\v\xfb\v\xfb\v\xfb\v\xfc\v\xfb\v\xfb\v\xfb\v\xfb\v\xfb\v\xfb\v\xfb\v\xfc\v\xfc\v\xfc\v\xfb\v\xfb\v\xfb\v\xfc\v\xfb\v\xc9\v\xff\x06_\x05_\x05_\x05_\x05_\x05_\x05_\x05_\x05_\x05_\x05_\x05^\x05^\x05^\x05^\x05_\x05_\x05_\x05^\x05^\x05^\x05_\x05_\x05_\x05_\x05^\x05^\x05_\x05_\x05_\x05^\x05^\x05^\x05_\x05_\x05_\x05_\x05_\x05_\x05_\x05_\x05_\x05_\x05^\x05^\x05^\x05^\x05_\x05^\x05^\x05^\x05^\x05^\x05^\x05^\x05_\x05_\x05_\x05_\x05_\x05_\x05^\x05^\x05^\x05^\x05^\x05^\x05_\x05_\x05_\x05^\x05^\x05^\x05^\x05^\x05^\x05^\x05^\x05^\x05^\x05^\x05^\x05^\x05^\x05^\x05\\\x05\\\x05\f\xfbX\xf3X\xf3X\xf3X\xf3W\xf3W\xf3W\xf3X\xf3X\xf3X\xf3X\xf3W\xf3W\xf3X\xf3X\xf3W\xf3W\xf3X\xf3X\xf3W\xf3W\xf3X\xf3X\xf3X\xf3W\xf3X\xf3X\xf3X\xf3X\xf3X\xf3X\xf3X\xf3X\xf3X\xf3X\xf3X\xf3Z\xf3Z\xf3Z\xf3X\xf3X\xf3X\xf3X\xf3X\xf3Z\xf3Z\xf3Z\xf3Z\xf3X\xf3X\xf3X\xf3X\xf3X\xf3X\xf3Z\xf3Z\xf3Z\xf3Z\xf3Z\xf3Z\xf3Z\xf3Z\xf3Z\xf3Z\xf3X\xf3Z\xf3Z\xf3Z\xf3Z\xf3Z\xf3Z\xf3Z\xf3[\xf3[\xf3[\xf3[\xf3[\xf3[\xf3[\xf3[\xf3[\xf3[\xf3[\xf3[\xf3[\xf3[\xf3Z\xf3\xa0\xf4\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xf1\xf6\xf1\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xee\xf6\xee\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xef\xf6\xef\xf6\xef\xf6\xef\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf2\xf6\xf2\xf6\xf2\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf2\xf6\xf2\xf6\xf2\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf2\xf6\xf2\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xf2\xf6\xf2\xf6\xf2\xf6\xf2\xf6\xf2\xf6\xdb\xf6\xdf\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x93\xf5\x93\xf5\x93\xf5\x94\xf5\x94\xf5\x94\xf5\x93\xf5\x94\xf5\x94\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x94\xf5\x94\xf5\x94\xf5\x93\xf5\x94\xf5\x94\xf5\x96\xf5\x96\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x94\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x94\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x94\xf5\x94\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x96\xf5\x97\xf5\x97\xf5\x97\xf5\x99\xf5\x99\xf5\x96\xf5\x96\xf5\x96\xf5\x97\xf5\x99\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x96\xf5\x96\xf5\x97\xf5\x97\xf5\x97\xf5\x96\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\r\xff\x9d\f\x9d\f\x9d\f\x9d\f\x9d\f\x9d\f\x9d\f\x9d\f\x9d\f\x9e\f\x9d\f\x9d\f\x9d\f\x9d\f\x9b\f\x9b\f\x9d\f\x9d\f\x9b\f\x9b\f\x9b\f\x9d\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9d\f\x9d\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9b\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9b\f\x9b\f\x9a\f\x9a\f\x9b\f\x9a\f\x9a\f\x9a\f\x9a\f\x9b\f\x9a\f\x99\f\x99\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x9a\f\x99\f\x99\f\x99\f\x9a\f\x99\f\x99\f\x99\f\x9a\f\x9a\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f\x99\f0\xfci\xf3i\xf3i\xf3g\xf3g\xf3g\xf3i\xf3i\xf3i\xf3i\xf3i\xf3i\xf3i\xf3i\xf3i\xf3i\xf3i\xf3i\xf3i\xf3i\xf3i\xf3i\xf3i\xf3j\xf3j\xf3j\xf3j\xf3j\xf3j\xf3j\xf3i\xf3i\xf3i\xf3i\xf3i\xf3j\xf3j\xf3j\xf3j\xf3j\xf3i\xf3i\xf3i\xf3i\xf3j\xf3j\xf3j\xf3i\xf3i\xf3i\xf3j\xf3j\xf3j\xf3j\xf3j\xf3j\xf3j\xf3j\xf3k\xf3k\xf3j\xf3j\xf3j\xf3j\xf3j\xf3j\xf3j\xf3j\xf3j\xf3j\xf3j\xf3k\xf3k\xf3j\xf3j\xf3j\xf3j\xf3k\xf3k\xf3k\xf3k\xf3k\xf3k\xf3j\xf3j\xf3j\xf3j\xf3k\xf3k\xf3k\xf3k\xf3k\xf3k\xf3k\xf3k\xf3m\xf3m\xf3k\xf3k\xf3k\xf3k\xf3k\xf3k\xf3k\xf3k\xf3m\xf3m\xf3m\xf3k\xf3k\xf3k\xf3k\xf3m\xf3m\xf3m\xf3m\xf3m\xf3m\xf3k\xf3k\xf3k\xf3k\xf3m\xf3m\xf3m\xf3m\xf3m\xf3m\xf3m\xf3m\xf3m\xf3m\xf3m\xf3m\xf3m\xf3m\xf3m\xf3n\xf3n\xf3n\xf3m\xf3m\xf3m\xf3m\xf3m\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3m\xf3m\xf3n\xf3n\xf3m\xf3m\xf3n\xf3n\xf3n\xf3m\xf3m\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3n\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3n\xf3n\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3p\xf3q\xf3q\xf3q\xf3p\xf3p\xf3p\xf3p\xf3q\xf3q\xf3q\xf3q\xf3q\xf3q\xf3q\xf3q\xf3p\xf3p\xf3p\xf3p\xf3p\xf3q\xf3q\xf3q\xf3q\xf3q\xf3q\xf3q\xf3q\xf3q\xf3r\xf3r\xf3r\xf3r\xf3r\xf3r\xf3r\xf3r\xf3r\xf3q\xf3q\xf3r\xf3r\xf3r\xf3r\xf3r\xf3r\xf3r\xf3q\xf3q\xf3r\xf3r\xf3r\xf3r\xf3t\xf3t\xf3t\xf3r\xf3r\xf3r\xf3r\xf3r\xf3r\xf3r\xf3r\xf3t\xf3t\xf3t\xf3t\xf3r\xf3t\xf3t\xf3t\xf3t\xf3t\xf3t\xf3t\xf3t\xf3r\xf3t\xf3t\xf3t\xf3t\xf3t\xf3r\xf3r\xf3 \xf7q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9r\xf9r\xf9r\xf9r\xf9r\xf9r\xf9q\xf9r\xf9r\xf9r\xf9r\xf9r\xf9r\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9q\xf9r\xf9s\xf9s\xf9r\xf9q\xf9q\xf9r\xf9r\xf9r\xf9r\xf9r\xf9r\xf9r\xf9r\xf9q\xf9q\xf9q\xf9r\xf9r\xf9r\xf9r\xf9r\xf9q\xf9q\xf9r\xf9r\xf9r\xf9r\xf9r\xf9r\xf9r\xf9s\xf9s\xf9r\xf9r\xf9r\xf9r\xf9r\xf9r\xf9s\xf9s\xf9s\xf9r\xf9r\xf9r\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9r\xf9s\xf9s\xf9s\xf9r\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9u\xf9u\xf9u\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9u\xf9u\xf9s\xf9s\xf9u\xf9u\xf9u\xf9u\xf9u\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9s\xf9u\xf9u\xf9s\xf9s\xf9s\xf9a\xfc\xd7\a^\t_\t^\t^\t^\t^\t^\t^\t^\t\xb7\t\xfc\v\x84\f\x84\f\x84\f\x84\f\x85\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x84\f\x83\f\x83\f\x83\f\x83\f\x84\f\x84\f\x84\f\x83\f\x83\f\x83\f\x83\f\x83\f\x81\f\x81\f\x81\f\x83\f\x83\f\x83\f\x83\f\x81\f\x81\f\x81\f\x83\f\x83\f\x83\f\x83\f\x83\f\x83\f\x83\f\x83\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x80\f\x80\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x81\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x81\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f\x80\f~\f~\f~\f~\f~\f~\f~\f~\f~\f~\f\x80\f\x80\f~\f~\f~\f~\f~\f~\f~\f~\f~\f~\f~\f~\f~\f~\f~\f~\f}\f}\f~\f~\f~\f~\f~\f~\f\x80\f~\f~\f~\f}\f}\f}\f}\f~\f~\f~\f}\f}\f}\f~\f}\f}\f}\f}\f}\f}\f|\f|\f|\f}\f}\f}\f}\f}\f}\f}\f}\f|\fz\f|\f|\f|\f}\f}\f}\f|\f|\f|\f|\f|\f|\f|\f|\f|\f|\f}\f}\f}\f}\f|\f|\f|\f|\f|\f|\f|\f|\f|\f|\f|\f|\f|\f|\f|\fz\fz\fz\f|\f|\f|\f|\f|\f|\f|\f\x15\b\xe4\xfa%\xfa%\xfa#\xfa#\xfa#\xfa#\xfa#\xfa#\xfa#\xfa#\xfa#\xfa#\xfa%\xfa%\xfa%\xfa#\xfa%\xfa%\xfa%\xfa#\xfa%\xfa%\xfa%\xfa#\xfa#\xfa%\xfa%\xfa%\xfa%\xfa#\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa#\xfa#\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa%\xfa%\xfa%\xfa&\xfa&\xfa%\xfa%\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa%\xfa&\xfa&\xfa&\xfa%\xfa%\xfa%\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa%\xfa%\xfa%\xfa&\xfa&\xfa&\xfa%\xfa%\xfa%\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa%\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa(\xfa(\xfa(\xfa%\xfa%\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa&\xfa(\xfa(\xfa(\xfa&\xfa&\xfa&\xfa&\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa&\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa&\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa)\xfa)\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa&\xfa&\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa)\xfa)\xfa)\xfa(\xfa(\xfa(\xfa(\xfa(\xfa(\xfa)\xfa)\xfa)\xfa(\xfa(\xfa(\xfa(\xfa)\xfa)\xfa(\xfa(\xfa)\xfa)\xfa)\xfa)\xfa(\xfa(\xfa(\xfa)\xfa)\xfa)\xfa)\xfa)\xfa(\xfa(\xfa(\xfa)\xfa)\xfa)\xfa(\xfa)\xfa)\xfa)\xfa(\xfa(\xfa(\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa(\xfa(\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa(\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa*\xfa*\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa(\xfa(\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa*\xfa*\xfa*\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa)\xfa*\xfa*\xfa*\xfa*\xfa)\xfa)\xfa*\xfa*\xfa,\xfa,\xfa,\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa)\xfa)\xfa*\xfa*\xfa*\xfa)\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa*\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa*\xfa*\xfa*\xfa*\xfa*\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa,\xfa\xa8\xf5\x96\xf3\x96\xf3\x96\xf3\x95\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x98\xf3\x98\xf3\x98\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x96\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x99\xf3\x99\xf3\x99\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x99\xf3\x99\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x98\xf3\x98\xf3\x98\xf3\x98\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x99\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x99\xf3\x99\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x99\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9a\xf3\x9a\xf3\x9a\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9c\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9e\xf3\x9e\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9d\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9d\xf3\x9d\xf3\x9d\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9e\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9e\xf3\x9e\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa0\xf3\xa0\xf3\xa1\xf3\xa1\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa0\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa0\xf3\xa0\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa0\xf3\xa0\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa1\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa1\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa1\xf3\xa1\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa4\xf3\xa4\xf3\xa1\xf3\xa1\xf3\xa3\xf3\xa4\xf3\xa4\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa3\xf3\xa3\xf3\xa3\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa5\xf3\xa5\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa4\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa5\xf3\xa5\xf3\xa5\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa5\xf3\xa5\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa7\xf3\xa8\xf3\xa8\xf3\xa8\xf3\xa8\xf3\xaa\xf3\xaa\xf3\xaa\xf3\xa8\xf3\xa8\xf3\xa8\xf3\xa8\xf3\xa8\xf3\xa8\xf3\xa8\xf3\xaa\xf3\xaa\xf3\xaa\xf3\xa8\xf3\xa8\xf3\xa8\xf3\xaa\xf3\xaa\xf3\xaa\xf3\xa8\xf3\xa8\xf3\xa8\xf3\xa8\xf3\xaa\xf3\xaa\xf3\xaa\xf3\xa8\xf3\xaa\xf3\xaa\xf3\xaa\xf3\xa8\xf3\xa8\xf3\xa8\xf3\xaa\xf3\xaa\xf3\xaa\xf3\xaa\xf3\xa8\xf3\xaa\xf3\xaa\xf3\xaa\xf3\xaa\xf3\xaa\xf3\xaa\xf3\xaa\xf3\xaa\xf3\xaa\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xaa\xf3\xaa\xf3\xaa\xf3\xab\xf3\xab\xf3\xab\xf3\xaa\xf3\xab\xf3\xab\xf3\xab\xf3\xaa\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xab\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xab\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xab\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xac\xf3\xac\xf3\xac\xf3\xac\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xac\xf3\xac\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xae\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xae\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xaf\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xaf\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xaf\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb0\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb0\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb0\xf3\xb2\xf3\xb3\xf3\xb3\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb2\xf3\xb3\xf3\xb3\xf3\xb2\xf3\xb2\xf3\xb3\xf3\xb3\xf3\xb3\xf3\xb2\xf3\xb3\xf3\xb3\xf3\xb3\xf3\xb3\xf3\xb3\xf3\xb3\xf3\xb3\xf3\xb3\xf3\xb3\xf3\xb3\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb3\xf3\xb3\xf3\xb3\xf3\xb5\xf3\xb5\xf3\xb3\xf3\xb3\xf3\xb3\xf3\xb3\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb3\xf3\xb3\xf3\xb3\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb6\xf3\xb6\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb5\xf3\xb6\xf3\xb6\xf3\xb5\xf3\xb5\xf3\xb6\xf3\xb7\xf3\xb7\xf3\xb6\xf3\xb5\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb6\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb6\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb7\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xb7\xf3\xb7\xf3\xb7\xf3\xba\xf3\xba\xf3\xb9\xf3\xb9\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xb9\xf3\xb9\xf3\xb9\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xba\xf3\xbb\xf3\xbb\xf3\xbb\xf3\xbb\xf3\xbb\xf3\xbb\xf3\xbb\xf3\xbb\xf3\xba\xf3\xba\xf3\xbb\xf3\xbb\xf3\xbb\xf3\xdf\x02E\fE\fE\fE\fE\fE\fE\fE\fC\fC\fC\fE\fE\fE\fE\fE\fE\fE\fC\fC\fC\fE\fE\fE\f8\x03\xd8\xf7\xd8\xf7\xd8\xf7\xd8\xf7\xd8\xf7\xd9\xf7\xd9\xf7\xd9\xf7\xd9\xf7\xd8\xf7\xd8\xf7\xd8\xf7\x06\x05a\na\na\nb\na\na\na\na\na\na\n_\n|\n\xcc\n\xce\n\xce\n\xce\n\xce\n\xce\n\xcd\n\xcd\n\xcd\n\xce\n\xce\n\xce\n\x8f\xff\xac\xf5\xac\xf5\xad\xf5\xad\xf5\xad\xf5\xad\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xad\xf5\x9e\xf7\xb3\a@\fB\fB\fB\fB\fB\fB\fB\fB\fB\fB\f\xa7\x03\xbe\xf3\xbe\xf3\xc0\xf3\xc0\xf3\xc0\xf3\xc0\xf3\xbe\xf3\xbe\xf3\xbe\xf3\xbe\xf3\xc0\xf3\xc0\xf3\x15\x01m\tm\tm\tm\tm\tm\tm\tm\tm\tm\tm\tQ\x06\x01\xf9\xbe\xf6\xbe\xf6\xbe\xf6\xbe\xf6\xbe\xf6\xbc\xf6\xbc\xf6\xbc\xf6\xbc\xf6\xbe\xf6\xbe\xf6\xca\xff\xce\v\xce\v\xcd\v\xcd\v\xcd\v\xcd\v\xce\v\xcd\v\xcd\v\xcd\v\xce\v\xce\v\x1c\f?\f?\f?\f?\f?\f?\f?\f?\f>\f>\f>\f>\f>\f>\f>\f?\f?\f?\f?\f?\f?\f>\f>\f>\f>\f>\f>\f>\f>\f>\f>\f>\f>\f>\f>\f>\f\x11\fq\t\x91\b\x91\b\x91\b\x91\b\x91\b\x91\b\x91\b\x91\b\x91\b\x91\b\x91\b+\x02\xc2\xf3\xc2\xf3\xc2\xf3\xc4\xf3\xc4\xf3\xc2\xf3\xc2\xf3\xc2\xf3\xc2\xf3\xc2\xf3\xc2\xf3\xc2\xf3w\x00=\n=\n=\n=\n=\n=\n>\n>\n>\n>\n=\nI\n\x99\n\xad\n\xad\n\xad\n\xad\n\xad\n\xad\n\xad\n\xad\n\xad\n\xad\n\xad\n\xd7\x03\x15\xf8\x15\xf8\x15\xf8\x15\xf8\x15\xf8\x15\xf8\x13\xf8\x13\xf8\x13\xf8\x15\xf8\x15\xf8\x15\xf8\xbf\x01\x98\a\x96\a\x96\a\x96\a\x96\a\x96\a\x96\a\x96\a\x96\a\x96\a\x96\a\xb3\x04c\xf8H\xf6H\xf6F\xf6F\xf6H\xf6I\xf6I\xf6H\xf6H\xf6H\xf6F\xf6\xf4\xf5\x83\xf5\x83\xf5\x83\xf5\x82\xf5\x82\xf5\x82\xf5\x82\xf5\x82\xf5\x82\xf5\x82\xf5\x82\xf5\x82\xf5q\xf7`\xf8`\xf8`\xf8`\xf8`\xf8`\xf8`\xf8`\xf8a\xf8a\xf8a\xf8N\xfcB\t\x96\n\x96\n\x96\n\x96\n\x96\n\x96\n\x96\n\x96\n\x96\n\x96\n\x96\ns\x021\xf91\xf91\xf91\xf91\xf91\xf91\xf91\xf91\xf91\xf91\xf9&\xf9M\xf5\xc7\xf3\xc9\xf3\xc9\xf3\xc9\xf3\xc9\xf3\xc9\xf3\xc7\xf3\xc9\xf3\xc9\xf3\xc9\xf3\xc7\xf3\xc9\xf3\xc9\xf3\xc9\xf3\xc7\xf3\xc9\xf3\xc9\xf3\xc9\xf3\xc9\xf3\xc9\xf3\xc9\xf3\xc9\xf3\xc9\xf3\xc9\xf3\x1a\xff\x13\n\x13\n\x13\n\x13\n\x12\n\x12\n\x12\n\x13\n\x13\n\x13\n\x13\n\xd4\t\xf1\x05\x9c\x04\x9c\x04\x9c\x04\x9c\x04\x9c\x04\x9c\x04\x9c\x04\x9c\x04\x9c\x04\x9c\x04\x9c\x04O\x06g\ng\nf\nf\nf\ng\ng\ng\ng\ng\ng\ng\n{\x00\x0e\xf8\x0e\xf8\x0e\xf8\x0e\xf8\x0e\xf8\x0e\xf8\x0e\xf8\x0e\xf8\x0e\xf8\x0e\xf8\x0e\xf8\x94\xf9\xe9\a4\f4\f4\f4\f6\f6\f6\f6\f4\f4\f4\fY\x04\xca\xf3\xca\xf3\xcc\xf3\xcc\xf3\xcc\xf3\xcc\xf3\xcc\xf3\xcc\xf3\xcc\xf3\xcc\xf3\xcc\xf3\xcc\xf3/\xf4{\xf4{\xf4y\xf4{\xf4{\xf4{\xf4y\xf4y\xf4{\xf4{\xf4y\xf4y\xf4y\xf4\xdd\x003\f3\f3\f4\f3\f3\f3\f4\f4\f3\f3\f3\f3\f\x1a\v\xe9\t\xe9\t\xe9\t\xe9\t\xe9\t\xe9\t\xe9\t\xe9\t\xe9\t\xe9\t\xea\t\xe9\t\xe9\te\x00\xce\xf3\xce\xf3\xce\xf3\xce\xf3\xce\xf3\xce\xf3\xce\xf3\xce\xf3\xce\xf3\xcd\xf3\xce\xf3\xce\xf3\xce\xf3\x89\xf7\x8c\xfd\x8c\xfd\x8c\xfd\x8c\xfd\x8c\xfd\x8c\xfd\x8c\xfd\x8c\xfd\x8c\xfd\x8c\xfd\x8c\xfd\x8c\xfd\x8c\xfdK\xfa\xd0\xf3\xd0\xf3\xd0\xf3\xd0\xf3\xd0\xf3\xce\xf3\xce\xf3\xce\xf3\xce\xf3\xd0\xf3\xd0\xf3\xd0\xf3\xd0\xf3\xff\xf3t\xf4u\xf4u\xf4t\xf4t\xf4t\xf4u\xf4u\xf4u\xf4t\xf4t\xf4t\xf4r\xf4M\xf4\xd9\xf3\xd1\xf3\xd1\xf3\xd0\xf3\xd0\xf3\xd0\xf3\xd0\xf3\xd0\xf3\xd1\xf3\xd1\xf3\xd0\xf3\xd0\xf3\xd0\xf3\xca\xf6D\x02\xfa\x03\xfa\x03\xfa\x03\xfa\x03\xfa\x03\xfa\x03\xfa\x03\xf8\x03\xf8\x03\xfa\x03\xfa\x03\xf8\x03\r\x05\xe3\n/\f/\f/\f/\f/\f0\f0\f0\f0\f0\f/\f/\f\x9a\nv\xfcC\xf8C\xf8C\xf8D\xf8D\xf8D\xf8D\xf8D\xf8D\xf8D\xf8D\xf8D\xf8'\xf8\x00\xf5\xd2\xf3\xd2\xf3\xd2\xf3\xd2\xf3\xd2\xf3\xd2\xf3\xd2\xf3\xd2\xf3\xd2\xf3\xd2\xf3\xd2\xf3\xd2\xf3\xd2\xf3m\xf4\xb4\xf4\xb4\xf4\xb4\xf4\xb4\xf4\xb4\xf4\xb4\xf4\xb4\xf4\xb4\xf4\xb4\xf4\xb4\xf4\xb5\xf4\xb5\xf4\xb5\xf4\xf9\xf5\xbb\xf6\xba\xf6\xba\xf6\xbb\xf6\xbb\xf6\xbb\xf6\xba\xf6\xba\xf6\xbb\xf6\xbb\xf6\xbb\xf6\xba\xf6\xba\xf6\xe9\x02+\f+\f+\f,\f,\f,\f,\f,\f,\f,\f,\f+\f+\f\xaf\nD\tD\tD\tD\tD\tD\tD\tD\tD\tD\tD\tD\tD\ts\x01\xff\xf7\xff\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xff\xf7\xff\xf7\xff\xf7\xec\xff+\f+\f)\f)\f)\f+\f+\f+\f+\f+\f+\f+\f+\f\x12\x04\xd7\xf3\xd7\xf3\xd7\xf3\xd7\xf3\xd7\xf3\xd7\xf3\xd7\xf3\xd7\xf3\xd7\xf3\xd7\xf3\xd5\xf3\xd5\xf3\xd7\xf36\xf5\xcc\xf8\xe4\xf8\xe4\xf8\xe4\xf8\xe4\xf8\xe4\xf8\xe4\xf8\xe4\xf8\xe4\xf8\xe4\xf8\xe4\xf8\xe4\xf8\xe4\xf8\xec\xfc\x99\n)\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f(\f'\f'\f'\f'\f'\f'\f'\f'\f'\f'\f'\f'\f\xbb\v~\xfa\xd9\xf3\xd8\xf3\xd8\xf3\xd8\xf3\xd8\xf3\xd8\xf3\xd9\xf3\xd9\xf3\xd9\xf3\xd9\xf3\xd9\xf3\xd9\xf3\xd9\xf3\xe6\x03%\f%\f%\f%\f'\f'\f'\f'\f'\f'\f'\f'\f'\f\xab\x01\x84\xfa\x85\xfa\x85\xfa\x85\xfa\x85\xfa\x85\xfa\x85\xfa\x85\xfa\x85\xfa\x85\xfa\x85\xfa\x85\xfa\x85\xfa\x04\xf7\xdb\xf3\xdb\xf3\xdb\xf3\xdb\xf3\xdb\xf3\xdb\xf3\xdb\xf3\xdb\xf3\xdb\xf3\xdb\xf3\xdb\xf3\xdb\xf3\xdb\xf3\xf9\xfe$\f$\f$\f%\f%\f%\f%\f%\f%\f%\f$\f$\f$\f\xc9\t\x10\x06\x10\x06\x10\x06\x11\x06\x11\x06\x11\x06\x11\x06\x10\x06\x10\x06\x10\x06\x11\x06\x11\x06\x11\x06\xfd\a#\f#\f#\f$\f$\f$\f#\f#\f#\f$\f$\f$\f$\f#\f#\f#\f#\f#\f#\f#\f#\f#\f#\f#\f$\f$\f#\f#\f#\f#\f#\f#\f#\f#\f#\f#\f#\f!\f!\f#\f#\f\xff\v\x03\v\xbf\n\xbf\n\xbf\n\xbf\n\xc1\n\xc1\n\xc1\n\xc1\n\xc1\n\xc1\n\xc1\n\xc1\n\xc6\n~\v\xc3\v\xc3\v\xc3\v\xc3\v\xc3\v\xc3\v\xc3\v\xc3\v\xc3\v\xc3\v\xc3\v\xc3\v\xc3\v\x00\f \f \f \f \f \f \f \f \f!\f!\f!\f!\f!\f\xf9\xfd\xe0\xf3\xe0\xf3\xe0\xf3\xe0\xf3\xe0\xf3\xe0\xf3\xe0\xf3\xe0\xf3\xe0\xf3\xe0\xf3\xe0\xf3\xe0\xf3\xe0\xf3\xe0\xf3\xe2\xf3\xe2\xf3\xe2\xf3\xe0\xf3\xe0\xf3\xe0\xf3\xe0\xf3\xdf\xf3\xdf\xf3\xdf\xf3\xe0\xf3\xe0\xf3\xe0\xf3\xe0\xf3\xe2\xf3\xe2\xf3\xe2\xf3\xe0\xf3\xe0\xf3\xe2\xf3\xe2\xf3\xe2\xf3\xe2\xf3\xe0\xf3\xe0\xf3\xe0\xf3\xe0\xf3t\xf4\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x84\xf5\x84\xf5\x84\xf5\x84\xf5\x84\xf5\x84\xf5\x84\xf5\x84\xf5\x13\xf5\xed\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe2\xf3\xe2\xf3\xe2\xf3\xe2\xf3\xe2\xf3\xe2\xf3\xe2\xf3\xe2\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe2\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe4\xf3\xe4\xf3\xe3\xf3\xe3\xf3\xe2\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe3\xf3\xe4\xf3\xe4\xf3\xe4\xf3\xe4\xf3\xe4\xf3\xe4\xf3\xe4\xf3\xe4\xf3\xe4\xf3\xe4\xf3\xe4\xf3\xe4\xf3\xe4\xf3\xe4\xf3\xe4\xf3>\x03}\v}\v}\v~\v}\v}\v}\v~\v}\v}\v}\v}\v}\va\vM\vM\vM\vM\vM\vM\vM\vM\vM\vM\vM\vM\vM\vI\t\x1e\a\x1e\a\x1d\a\x1d\a\x1d\a\x1d\a\x1e\a\x1d\a\x1d\a\x1d\a\x1d\a\x1d\a\x1d\a\x8a\xff\xe6\xf3\xe6\xf3\xe7\xf3\xe7\xf3\xe7\xf3\xe6\xf3\xe6\xf3\xe7\xf3\xe7\xf3\xe7\xf3\xe7\xf3\xe7\xf3\xe7\xf3\xb1\xf5\xb7\xf9\xb7\xf9\xb7\xf9\xb7\xf9\xb7\xf9\xb6\xf9\xb7\xf9\xb7\xf9\xb7\xf9\xb7\xf9\xb7\xf9\xb7\xf9\xb7\xf9\x9a\xf9;\xf91\xf91\xf91\xf91\xf91\xf91\xf91\xf90\xf91\xf91\xf91\xf91\xf9\x80\xf8\xc3\xf4\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe2\xf4\xf0\x05\x01\f\x01\f\x01\f\x01\f\x01\f\x01\f\x00\f\x00\f\x01\f\x01\f\x01\f\x01\f\x01\f*\xfc\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xea\xf3\xea\xf3\xea\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xe8\xf3\xea\xf3\xea\xf3\xea\xf3\xea\xf3\xea\xf3\xea\xf3\xea\xf3A\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\xa1\xf4\x8b\xf9\x93\x01\x91\x01\x91\x01\x93\x01\x93\x01\x93\x01\x91\x01\x91\x01\x91\x01\x93\x01\x91\x01\x91\x01\x91\x01\x1f\xfe\x8b\xf5r\xf5q\xf5q\xf5q\xf5q\xf5q\xf5q\xf5r\xf5r\xf5r\xf5r\xf5r\xf5\xbc\xf9\xbe\t\x15\f\x15\f\x15\f\x15\f\x15\f\x13\f\x13\f\x13\f\x13\f\x13\f\x13\f\x15\f\xd4\t\xc1\xf8\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xed\xf3\xdc\xf5\xad\xf6\xac\xf6\xac\xf6\xac\xf6\xac\xf6\xac\xf6\xac\xf6\xac\xf6\xac\xf6\xac\xf6\xac\xf6\xac\xf6\xac\xf6\x9a\x03\x11\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f\x12\f:\x02\x9d\xf8\x9d\xf8\x9d\xf8\x9d\xf8\x9d\xf8\x9d\xf8\x9b\xf8\x9d\xf8\x9d\xf8\x9d\xf8\x9d\xf8\x9d\xf8\x9d\xf8\xb7\xf6\xef\xf3\xef\xf3\xef\xf3\xef\xf3\xef\xf3\xef\xf3\xef\xf3\xee\xf3\xee\xf3\xee\xf3\xef\xf3\xef\xf3\xef\xf3\x83\xf4\xd2\xf5\xd2\xf5\xd2\xf5\xd2\xf5\xd2\xf5\xd2\xf5\xd2\xf5\xd2\xf5\xd2\xf5\xd2\xf5\xd2\xf5\xd2\xf5\xd2\xf5T\xfa\f\n\x11\f\x11\f\x11\f\x11\f\x11\f\x11\f\x11\f\x11\f\x0f\f\x0f\f\x0f\f\x11\f\x0f\f\x0f\f\x0f\f\x0f\f\x0f\f\x0f\f\x0f\f\x0f\f\x0f\f\x0f\f\x0f\f\x0f\f\x0f\f\x0f\f\x0f\f\x1c\xfb\xf1\xf3\xf1\xf3\xf1\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf1\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf2\xf3\xf6\xfb5\x045\x043\x043\x045\x045\x045\x045\x045\x045\x045\x045\x045\x04\xd6\x06\x06\v\x06\v\x06\v\x06\v\x06\v\a\v\a\v\a\v\a\v\a\v\x06\v\x06\v\x06\v\x99\x04M\xf4\xf4\xf3\xf4\xf3\xf4\xf3\xf4\xf3\xf4\xf3\xf4\xf3\xf4\xf3\xf4\xf3\xf4\xf3\xf4\xf3\xf4\xf3\xf5\xf33\xf7\xa4\x04\xff\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x06\xff\x06\xff\x06\xb1\x06&\x03\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfa\x01\xfa\x01\x95\b\n\f\n\f\n\f\v\f\v\f\v\f\v\f\v\f\v\f\v\f\v\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\f\n\fe\x02\xce\xf5\xce\xf5\xd0\xf5\xd0\xf5\xd0\xf5\xd0\xf5\xd0\xf5\xd0\xf5\xd0\xf5\xd0\xf5\xd0\xf5\xd0\xf5\xd0\xf5\xf0\xfc\n\f\n\f\b\f\a\f\b\f\b\f\b\f\b\f\n\f\n\f\b\f\b\f\b\f\x0e\a\x10\xf6\xf6\xf3\xf6\xf3\xf8\xf3\xf8\xf3\xf8\xf3\xf8\xf3\xf8\xf3\xf8\xf3\xf8\xf3\xf8\xf3\xf8\xf3\xf6\xf3\xf8\xf3\xf8\xf3\xf8\xf3\xf8\xf3\xf8\xf3\xf8\xf3\xf8\xf3\xf8\xf3\xf8\xf3\xf8\xf3\xf9\xf3\xf9\xf3\xf9\xf3\xf8\xf3\xf8\xf3\x8c\xf8\xaf\xfa\xaf\xfa\xaf\xfa\xad\xfa\xaf\xfa\xaf\xfa\xaf\xfa\xaf\xfa\xaf\xfa\xaf\xfa\xaf\xfa\xaf\xfa\xaf\xfa\x10\x04H\vH\vH\vH\vH\vH\vH\vH\vH\vH\vH\vH\vH\v\x9d\v\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\x06\f\xc2\x05\xfd\xf8\xfd\xf8\xfc\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfd\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xfc\xf8\xf9\xfcg\n\x06\f\x04\f\x04\f\x04\f\x06\f\x06\f\x06\f\x04\f\x04\f\x04\f\x06\f\x04\f\xd3\vO\n\xdc\t\xdc\t\xdc\t\xde\t\xdc\t\xdc\t\xdc\t\xde\t\xde\t\xde\t\xdc\t\xdc\t\xdc\t\"\xfb\xfd\xf3\xfd\xf3\xfd\xf3\xfc\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf39\x01\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x03\f\x04\f\x04\f\xbb\x01\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3&\xfaA\bA\b?\b?\b?\b?\b?\b?\b?\b?\bA\bA\bA\b\xa1\x04X\xf6\xfd\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3\xff\xf3p\xf4K\xfaM\xfcM\xfcM\xfcM\xfcM\xfcM\xfcM\xfcM\xfcM\xfcM\xfcM\xfcM\xfcM\xfc\xc4\x05O\vM\vM\vM\vM\vM\vM\vO\vO\vM\vM\vO\vO\v\x95\xff\x01\xf4\x01\xf4\x01\xf4\x00\xf4\x00\xf4\x00\xf4\x01\xf4\x01\xf4\x01\xf4\x01\xf4\x00\xf4\x00\xf4\x00\xf4\x1c\xf7J\xfcJ\xfcK\xfcK\xfcK\xfcJ\xfcJ\xfcK\xfcK\xfcK\xfcK\xfcK\xfcJ\xfco\xfc\xda\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe1\xfc\xe3\xfc\xe3\xfc\xe3\xfc\xe3\xfc\x0f\xfc\xf4\xf6\xa5\xf5\xa5\xf5\xa5\xf5\xa5\xf5\xa5\xf5\xa5\xf5\xa5\xf5\xa4\xf5\xa4\xf5\xa4\xf5\xa5\xf5\xa5\xf5\xa5\xf5\x12\xf5\xcd\xf4\xcd\xf4\xcd\xf4\xcd\xf4\xcd\xf4\xcd\xf4\xce\xf4\xce\xf4\xce\xf4\xcd\xf4\xcd\xf4\xce\xf4\xce\xf4^\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4\x03\xf4!\xfe\xfb\v\xfb\v\xfb\v\xfb\v\xfc\v\xfc\v\xfb\v\xfb\v\xfb\v\xfc\v\xfc\v\xfc\v\xfc\v\x17\x054\xf4\x04\xf4\x04\xf4\x05\xf4\x05\xf4\x05\xf4\x04\xf4\x04\xf4\x04\xf4\x04\xf4\x04\xf4\x04\xf4\x04\xf4 \xf4\x9a\xf4\xb3\xf4\xb1\xf4\xb1\xf4\xb1\xf4\xb1\xf4\xb1\xf4\xb1\xf4\xb1\xf4\xb1\xf4\xb1\xf4\xb1\xf4\xb1\xf4\x13\xf5y\x05\xfb\v\xfb\v\xfb\v\xfb\v\xfb\v\xfb\v\xfc\v\xfc\v\xfb\v\xfb\v\xfb\v\xfb\v\xfb\v\x00\xfe\x05\xf4\x05\xf4\x05\xf4\x05\xf4\a\xf4\a\xf4\a\xf4\x05\xf4\x05\xf4\x05\xf4\a\xf4\a\xf4\a\xf4\x95\xf4D\xf5D\xf5B\xf5B\xf5B\xf5B\xf5B\xf5D\xf5D\xf5D\xf5D\xf5D\xf5D\xf5\x8c\xf7\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xb2\xfc\xc8\xfe\x91\a+\t+\t+\t+\t,\t+\t+\t+\t,\t,\t,\t+\t\xa0\b\x1c\xfa\x8e\xf4\x8e\xf4\x8e\xf4\x8e\xf4\x8e\xf4\x8e\xf4\x8d\xf4\x8e\xf4\x8e\xf4\x8e\xf4\x8e\xf4\x8e\xf4\x8e\xf4?\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf4\b\xf4\b\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf4\n\xf40\xf4\x87\xf4\x87\xf4\x86\xf4\x86\xf4\x86\xf4\x87\xf4\x88\xf4\x88\xf4\x87\xf4\x87\xf4\x87\xf4\x87\xf4\x87\xf4\x87\xf4\x87\xf4\x90\xf6 \xf8 \xf8\x1e\xf8 \xf8 \xf8 \xf8 \xf8 \xf8 \xf8!\xf8!\xf8 \xf8 \xf8 \xf8\xef\xf7\xd8\xf6\x93\xf6\x94\xf6\x94\xf6\x94\xf6\x93\xf6\x93\xf6\x93\xf6\x93\xf6\x93\xf6\x93\xf6\x93\xf6\x93\xf6\x93\xf6\x93\xf6\xf8\xf7.\xfa.\xfa.\xfa-\xfa-\xfa.\xfa.\xfa.\xfa.\xfa.\xfa.\xfa.\xfa.\xfa.\xfa.\xfa\x9d\xf9K\xf9K\xf9K\xf9K\xf9M\xf9M\xf9M\xf9M\xf9M\xf9M\xf9K\xf9K\xf9K\xf9K\xf9T\xf8\xee\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4w\xf4H\xf4\x0f\xf4\x0f\xf4\x0e\xf4\x0f\xf4\x0f\xf4\x0f\xf4\x0e\xf4\x0e\xf4\x0e\xf4\x0e\xf4\x0e\xf4\x0e\xf4\x0e\xf4\x0e\xf4?\xf43\x00\x0e\x05\x0e\x05\x0e\x05\x10\x05\x10\x05\x10\x05\x10\x05\x10\x05\x10\x05\x10\x05\x10\x05\x10\x05\x10\x05\x10\x05\x86\x00\x92\xf4\x0f\xf4\x0f\xf4\x0f\xf4\x0f\xf4\x0f\xf4\x0f\xf4\x0f\xf4\x10\xf4\x10\xf4\x0f\xf4\x0f\xf4\x10\xf4\x10\xf4\x10\xf4\x0f\xf4\x0f\xf4\x0f\xf4\x10\xf4\x10\xf4\x10\xf4\x10\xf4\x10\xf4\x10\xf4\x10\xf4\x0f\xf4\x10\xf4\x10\xf4\x10\xf4\x10\xf4\x12\xf4\x12\xf4\x12\xf4\x10\xf4\x10\xf4\x10\xf4\x10\xf4\x10\xf4\x10\xf4\x10\xf4\x10\xf4\x10\xf4\x10\xf4\x10\xf4\x10\xf4\x10\xf4\x10\xf4\x12\xf4\x12\xf4\x12\xf4\x12\xf4\x12\xf4\x12\xf4\x10\xf4\x10\xf4\x12\xf4\x12\xf4\x12\xf4\x12\xf4\x12\xf4\x10\xf4\x10\xf4\xab\x00\x16\n\x16\n\x17\n\x17\n\x16\n\x16\n\x16\n\x16\n\x16\n\x16\n\x16\n\x16\n\x16\n\x17\nE\b\x89\xfd\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xcf\xfa\xcf\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\x18\xfa\xde\xf8\xde\xf8\xde\xf8\xde\xf8\xde\xf8\xde\xf8\xde\xf8\xde\xf8\xde\xf8\xde\xf8\xde\xf8\xde\xf8\xde\xf8\xde\xf8\xde\xf8N\x03\xdc\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xde\t\xdc\t\xdc\t\xdc\t\xdc\t\x84\t\xf9\a\xab\a\xab\a\xab\a\xab\a\xab\a\xab\a\xab\a\xab\a\xab\a\xab\a\xab\a\xac\a\xac\a\xac\a\x06\x01,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7,\xf7+\xf7+\xf7-\xf5\x16\xf4\x16\xf4\x16\xf4\x16\xf4\x16\xf4\x16\xf4\x16\xf4\x15\xf4\x15\xf4\x16\xf4\x16\xf4\x16\xf4\x16\xf4\x16\xf4\x05\xf5\x8b\xf8\x18\xf9\x18\xf9\x18\xf9\x18\xf9\x18\xf9\x18\xf9\x18\xf9\x18\xf9\x18\xf9\x18\xf9\x18\xf9\x18\xf9\x1a\xf9\x1a\xf94\xf7\xa6\xf4\xa6\xf4\xa5\xf4\xa6\xf4\xa6\xf4\xa6\xf4\xa5\xf4\xa6\xf4\xa6\xf4\xa6\xf4\xa6\xf4\xa6\xf4\xa6\xf4\xa6\xf4\xa6\xf4I\x01\x95\a\x94\a\x94\a\x94\a\x95\a\x95\a\x95\a\x95\a\x95\a\x94\a\x94\a\x94\a\x94\a\x94\a\xb2\x03^\xf6\x96\xf4\x96\xf4\x96\xf4\x96\xf4\x96\xf4\x98\xf4\x98\xf4\x96\xf4\x96\xf4\x96\xf4\x96\xf4\x96\xf4\x96\xf4\x96\xf4\xe5\xfe\xe6\v\xe6\v\xe6\v\xe7\v\xe7\v\xe7\v\xe7\v\xe9\v\xe9\v\xe7\v\xe7\v\xe7\v\xe7\v\xe7\v\xe7\v\xe6\v\xe6\v\xe6\v\xe6\v\xe6\v\xe6\v\xe6\v\xe6\v\xe6\v\xe6\v\xe6\v\xe6\v\xe6\v\xe6\v\xe6\v\xda\x06%\xf6\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1a\xf4\x1b\xf4\x1b\xf4\xbb\xfe\xe5\v\xe5\v\xe5\v\xe6\v\xe6\v\xe6\v\xe5\v\xe5\v\xe5\v\xe5\v\xe5\v\xe5\v\xe6\v\xe5\v\xe5\v\xbb\xfb\x1b\xf4\x1b\xf4\x1b\xf4\x1b\xf4\x1b\xf4\x1b\xf4\x1b\xf4\x1b\xf4\x1b\xf4\x1b\xf4\x1b\xf4\x1b\xf4\x1b\xf4\x1b\xf4f\xf4[\xf5y\xf5y\xf5y\xf5y\xf5y\xf5y\xf5y\xf5{\xf5{\xf5{\xf5{\xf5{\xf5y\xf5y\xf5\xe0\xf4\x1d\xf4\x1d\xf4\x1e\xf4\x1e\xf4\x1e\xf4\x1d\xf4\x1d\xf4\x1d\xf4\x1d\xf4\x1d\xf4\x1d\xf4\x1d\xf4\x1d\xf4\x1d\xf4\x1d\xf4}\x01\x13\b\x13\b\x13\b\x15\b\x13\b\x13\b\x13\b\x13\b\x12\b\x12\b\x12\b\x13\b\x13\b\x13\b#\x04\"\xf6\x1d\xf4\x1e\xf4\x1e\xf4\x1e\xf4\x1e\xf4\x1e\xf4\x1e\xf4\x1e\xf4\x1e\xf4\x1e\xf4\x1e\xf4\x1e\xf4\x1e\xf4\x1e\xf47\xfe\xe0\v\xe0\v\xe0\v\xe2\v\xe2\v\xe2\v\xe2\v\xe2\v\xe2\v\xe0\v\xe0\v\xe0\v\xe2\v\xe0\v\xe0\vv\xfd\xb8\xf5\xb8\xf5\xb8\xf5\xb7\xf5\xb7\xf5\xb7\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb8\xf5\xb7\xf5\xb7\xf5\xb7\xf5\xb7\xf5\xc9\xf5\x0e\xf6\x19\xf6\x19\xf6\x19\xf6\x19\xf6\x19\xf6\x17\xf6\x17\xf6\x17\xf6\x19\xf6\x19\xf6\x19\xf6\x17\xf6\x17\xf6\x17\xf6\xa4\xf7\xfa\xf9\xfa\xf9\xfb\xf9\xfb\xf9\xfb\xf9\xfb\xf9\xfb\xf9\xfa\xf9\xfa\xf9\xfa\xf9\xfa\xf9\xfa\xf9\xfa\xf9\xfa\xf9\xfa\xf9Z\xf6\"\xf4\"\xf4\"\xf4!\xf4!\xf4!\xf4!\xf4!\xf4!\xf4!\xf4!\xf4!\xf4!\xf4!\xf4\xdc\xf6j\x04w\aw\aw\aw\aw\aw\aw\aw\aw\aw\aw\aw\aw\ax\a\xf5\a\xd3\b\xd3\b\xd3\b\xd4\b\xd4\b\xd4\b\xd3\b\xd3\b\xd3\b\xd3\b\xd3\b\xd4\b\xd4\b\xd4\b\xd4\b\xd1\xfc\"\xf4\"\xf4$\xf4$\xf4$\xf4$\xf4$\xf4\"\xf4\"\xf4$\xf4$\xf4$\xf4\"\xf4\"\xf4$\xf4$\xf4$\xf4$\xf4$\xf4$\xf4$\xf4$\xf4$\xf4$\xf4$\xf4$\xf4$\xf4$\xf4$\xf4$\xf4$\xf4%\xf4%\xf4%\xf4%\xf4%\xf4%\xf4%\xf4%\xf4%\xf4$\xf4$\xf4$\xf4$\xf4$\xf4$\xf4\xac\xf5\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\a\xf7\b\xf7\n\xf7\n\xf7\n\xf7\b\xf7\xf5\xf7\x87\x06\xd5\v\xd8\v\xd8\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd5\v\xd4\v\xd4\v\xd4\v\xd4\v\xd3\v\xd3\v\xd3\v\xd3\v\xd3\v\xd3\v\xd1\v\xd1\v\xd1\v\xd1\v\xd1\v\xd1\v\xd1\v\xd1\v\xd0\v\xd0\v\xd7\x01\x9d\xf6\x9d\xf6\x9d\xf6\x9d\xf6\x9d\xf6\x9e\xf6\x9e\xf6\x9d\xf6\x9d\xf6\x9e\xf6\x9e\xf6\x9e\xf6\x9e\xf6\x9e\xf6\x9e\xf6J\xf5\xaa\xf4\xaa\xf4\xaa\xf4\xaa\xf4\xac\xf4\xac\xf4\xac\xf4\xac\xf4\xac\xf4\xac\xf4\xac\xf4\xad\xf4\xad\xf4\xad\xf4\xe3\xf5U\xfa\x06\xfb\x06\xfb\x06\xfb\x06\xfb\x05\xfb\x05\xfb\x05\xfb\x05\xfb\x05\xfb\x06\xfb\b\xfb\b\xfb\b\xfb\b\xfb\xb4\x01\xc1\v\xc1\v\xc1\v\xc1\v\xc1\v\xbe\v\xbe\v\xbf\v\xbf\v\xbe\v\xbe\v\xbe\v\xbe\v\xbe\v\xbe\v\xce\b\xe8\x06\xe8\x06\xe8\x06\xe6\x06\xe5\x06\xe5\x06\xe5\x06\xe6\x06\xe6\x06\xe5\x06\xe5\x06\xe6\x06\xe6\x06\xe5\x06p\a\xd1\n\xb8\v\xb7\v\xb7\v\xb7\v\xb7\v\xb7\v\xb6\v\xb4\v\xb4\v\xb4\v\xb6\v\xb4\v\xb4\v\xb4\v@\x04M\xf4M\xf4M\xf4M\xf4M\xf4M\xf4M\xf4M\xf4O\xf4O\xf4O\xf4O\xf4P\xf4P\xf4P\xf4P\xf4P\xf4P\xf4Q\xf4S\xf4S\xf4S\xf4S\xf4S\xf4S\xf4S\xf4T\xf4T\xf4T\xf4T\xf4\xed\xf4B\x05\xab\v\xab\v\xab\v\xab\v\xa9\v\xa8\v\xa8\v\xa8\v\xa8\v\xa8\v\xa8\v\xa8\v\xa8\v\xa8\v\xa8\v\xa8\v\xa6\v\xa5\v\xa5\v\xa5\v\xa5\v\xa4\v\xa4\v\xa4\v\xa4\v\xa4\v\xa4\v\xa4\v\xa4\v\xa4\v\xad\x01_\xf4_\xf4_\xf4_\xf4_\xf4_\xf4_\xf4`\xf4`\xf4`\xf4b\xf4b\xf4b\xf4c\xf4c\xf4T\xf6z\xf7z\xf7z\xf7z\xf7|\xf7|\xf7|\xf7z\xf7z\xf7|\xf7}\xf7}\xf7}\xf7}\xf7\x06\xfa\x19\b\x9a\v\x99\v\x99\v\x99\v\x97\v\x97\v\x97\v\x97\v\x97\v\x97\v\x96\v\x96\v\x96\v\x96\v\x18\x04k\xf4m\xf4m\xf4m\xf4m\xf4k\xf4m\xf4n\xf4n\xf4m\xf4n\xf4n\xf4p\xf4p\xf4p\xf4p\xf4q\xf4q\xf4q\xf4q\xf4q\xf4q\xf4q\xf4q\xf4q\xf4r\xf4r\xf4r\xf4r\xf4t\xf4\xc6\xf4K\x04\xa4\n\xa2\n\xa2\n\xa2\n\xa2\n\xa2\n\xa2\n\xa1\n\xa0\n\xa1\n\xa1\n\xa0\n\xa0\n\xa0\n\x03\x06\x85\xf6y\xf4y\xf4y\xf4y\xf4y\xf4y\xf4y\xf4y\xf4{\xf4|\xf4|\xf4|\xf4|\xf4|\xf4\xb6\xfd\x81\v\x81\v\x81\v\x83\v\x83\v\x80\v\x80\v\x80\v\x81\v\x80\v~\v~\v~\v\x80\v\x80\v\xe4\xfd\x83\xf4\x83\xf4\x83\xf4\x83\xf4\x83\xf4\x83\xf4\x84\xf4\x84\xf4\x84\xf4\x84\xf4\x84\xf4\x84\xf4\x86\xf4\x86\xf4\a\xf6\xc0\x02\xb7\x06\xb6\x06\xb5\x06\xb5\x06\xb5\x06\xb5\x06\xb5\x06\xb6\x06\xb5\x06\xb5\x06\xb5\x06\xb5\x06\xb5\x06\xb5\x06\x1c\x02P\xf6\xd2\xf5\xd2\xf5\xd2\xf5\xd2\xf5\xd2\xf5\xd2\xf5\xd2\xf5\xd4\xf5\xd4\xf5\xd4\xf5\xd4\xf5\xd4\xf5\xd5\xf5\xd5\xf5A\xf5\x91\xf4\x91\xf4\x91\xf4\x91\xf4\x91\xf4\x91\xf4\x91\xf4\x91\xf4\x91\xf4\x91\xf4\x92\xf4\x92\xf4\x92\xf4\x93\xf4\x93\xf4\x93\xf4\x93\xf4\x95\xf4\x95\xf4\x95\xf4\x93\xf4\x93\xf4\x95\xf4\x95\xf4\x95\xf4\x96\xf4\x96\xf4\x96\xf4\x98\xf4\x98\xf4\xc2\xf4\xa8\xf5\xdd\xf5\xdf\xf5\xdf\xf5\xe0\xf5\xe0\xf5\xdf\xf5\xdf\xf5\xe0\xf5\xe0\xf5\xe0\xf5\xe0\xf5\xe0\xf5\xe0\xf5\xe0\xf5{\xf5\x9d\xf4\x9d\xf4\x9d\xf4\x9d\xf4\x9d\xf4\x9f\xf4\x9f\xf4\x9f\xf4\x9f\xf4\x9f\xf4\xa0\xf4\xa0\xf4\xa0\xf4\xa0\xf4\xa0\xf4\x02\x00]\v]\v_\v_\v_\v]\v]\v\\\v\\\v\\\v\\\v\\\v\\\v\\\v[\v.\x02\xee\xfd\xee\xfd\xee\xfd\xec\xfd\xee\xfd\xee\xfd\xee\xfd\xee\xfd\xee\xfd\xee\xfd\xee\xfd\xee\xfd\xee\xfd\xee\xfd\a\x00\xf5\b\xb3\n\xb3\n\xb3\n\xb1\n\xb1\n\xb1\n\xb1\n\xb0\n\xb0\n\xb0\n\xb1\n\xb0\n\xb0\n\xb0\n\xe7\nQ\vQ\vQ\vP\vP\vP\vP\vP\vO\vO\vP\vP\vP\vO\vO\vO\vM\vM\vM\vM\vL\vL\vL\vM\vM\vL\vK\vK\vK\vL\vK\vI\vI\vI\vI\vH\vF\vF\vF\vF\vF\vH\vH\vF\vE\vE\vb\a0\xf8\x82\xf5\x82\xf5\x82\xf5\x82\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x83\xf5\x84\xf5\x85\xf5\x85\xf5?\xf5\xc0\xf4\xc0\xf4\xc0\xf4\xc0\xf4\xc0\xf4\xc0\xf4\xc2\xf4\xc2\xf4\xc2\xf4\xc2\xf4\xc2\xf4\xc2\xf4\xc2\xf4\xc3\xf4\xc3\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc6\xf4\xc7\xf4\xc7\xf4\xc7\xf4\xc7\xf4\xc7\xf4\xc7\xf4\xc7\xf4\xc7\xf4\xc9\xf4\xc9\xf4\xc9\xf4\xc9\xf4\xc9\xf4\xca\xf4\xca\xf4\xcc\xf4\xcc\xf4\xcc\xf4\xcc\xf4\xcc\xf4\xcc\xf4\xcc\xf4\xcc\xf4\x12\xf5)\xf6\\\xf6\\\xf6\\\xf6\\\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6\\\xf6^\xf6^\xf6_\xf6_\xf6/\xf7\x91\x04\x8d\t\x8d\t\x8d\t\x8d\t\x8e\t\x8e\t\x8e\t\x8d\t\x8b\t\x8d\t\x8d\t\x8b\t\x8b\t\x8b\t\x8b\t\x8b\t\x85\n(\v(\v(\v(\v(\v(\v(\v'\v'\v'\v'\v'\v'\v'\v$\v$\v$\v$\v$\v#\v#\v#\v#\v#\v#\v#\v#\v#\v#\v!\v!\v!\v!\v \v \v \v\x1e\v\x1e\v\x1e\v\x1e\v\x1e\v\x1e\v\x1d\v\x1d\v\x1d\v\x1d\v\x1d\v\x1c\v\x1c\v\x1c\v\xa3\x06$\xf7\xe4\xf4\xe6\xf4\xe6\xf4\xe6\xf4\xe6\xf4\xe7\xf4\xe7\xf4\xe7\xf4\xe8\xf4\xe8\xf4\xe8\xf4\xe8\xf4\xe8\xf4\xe8\xf4\xe8\xf4=\xf6\xdf\x04\xe6\t\xe7\t\xe7\t\xe6\t\xe6\t\xe6\t\xe4\t\xe4\t\xe4\t\xe3\t\xe3\t\xe4\t\xe4\t\xe4\t\xe3\t\xe3\tL\t\xef\b\xef\b\xef\b\xee\b\xee\b\xee\b\xee\b\xee\b\xee\b\xee\b\xed\b\xeb\b\xeb\b\xeb\b\xeb\b\xeb\b\xd3\x03R\xfeR\xfeR\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfeS\xfe*\xfb\xf9\xf4\xf9\xf4\xf9\xf4\xf9\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfa\xf4\xfc\xf4\xfc\xf4\xfc\xf4\xfd\xf4\xfd\xf4\xfd\xf4\xff\xf4\xff\xf4\xff\xf4\xff\xf4\x00\xf5\x00\xf5\x00\xf5\xff\xf4\x00\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x01\xf5\x1a\xf6m\x05\xfd\n\xfd\n\xfd\n\xfc\n\xfc\n\xfc\n\xfd\n\xfd\n\xfc\n\xfb\n\xfb\n\xfb\n\xfb\n\xfb\n\xfb\n\xfb\n\"\xfe\x96\xf5\x96\xf5\x96\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x97\xf5\x97\xf55\xfdb\x06b\x06b\x06b\x06b\x06a\x06a\x06b\x06b\x06b\x06a\x06a\x06a\x06a\x06a\x06b\x06r\x05O\x03O\x03O\x03O\x03O\x03O\x03O\x03O\x03O\x03O\x03O\x03N\x03N\x03O\x03O\x03O\x03\x1d\x016\xf7\x16\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\x17\xf5\x19\xf5\x19\xf5\x19\xf5\x19\xf5\x19\xf5\x19\xf5\x19\xf5\"\xf5\x18\x00\xd3\x04\xd3\x04\xd3\x04\xd3\x04\xd3\x04\xd3\x04\xd4\x04\xd4\x04\xd4\x04\xd4\x04\xd4\x04\xd3\x04\xd2\x04\xd2\x04\xd2\x04\xd2\x04\xcd\xfe\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xca\xf9\xca\xf9\xca\xf9\xca\xf9\xca\xf9\xca\xf9\xcc\xf9\xcc\xf9\xcc\xf9\xa9\xfb\x90\xfe\x90\xfe\x8f\xfe\x90\xfe\x90\xfe\x90\xfe\x90\xfe\x90\xfe\x90\xfe\x91\xfe\x91\xfe\x91\xfe\x90\xfe\x91\xfe\x91\xfe\x91\xfet\x01\x03\n\xd7\n\xd7\n\xd7\n\xd7\n\xd5\n\xd5\n\xd5\n\xd5\n\xd4\n\xd4\n\xd4\n\xd4\n\xd4\n\xd4\n\xd4\n\x80\t\xe3\xfd#\xfa#\xfa#\xfa%\xfa%\xfa%\xfa%\xfa&\xfa&\xfa&\xfa%\xfa%\xfa%\xfa%\xfa%\xfa%\xfa\x17\xf72\xf53\xf53\xf53\xf54\xf54\xf54\xf53\xf53\xf54\xf54\xf54\xf54\xf56\xf56\xf56\xf5\xc2\xf6\x99\xf8\x99\xf8\x99\xf8\x99\xf8\x99\xf8\x97\xf8\x99\xf8\x99\xf8\x99\xf8\x9a\xf8\x9a\xf8\x9a\xf8\x9a\xf8\x9a\xf8\x9a\xf8\x9a\xf8\xba\xf7\xa8\xf5\xa2\xf5\xa4\xf5\xa4\xf5\xa4\xf5\xa4\xf5\xa4\xf5\xa4\xf5\xa5\xf5\xa5\xf5\xa5\xf5\xa5\xf5\xa5\xf5\xa6\xf5\xa6\xf5\xa6\xf5\xc2\xf5S\xf6u\xf6s\xf6s\xf6u\xf6u\xf6v\xf6v\xf6v\xf6v\xf6v\xf6w\xf6w\xf6w\xf6v\xf6w\xf6w\xf6\xa8\xf8\xbe\xf9\xbe\xf9\xbe\xf9\xbf\xf9\xc1\xf9\xc1\xf9\xbf\xf9\xbf\xf9\xbf\xf9\xbf\xf9\xbf\xf9\xc1\xf9\xc1\xf9\xc1\xf9\xc1\xf9\xc1\xf9\xc1\xf9\xc2\xf9\xc2\xf9\xc2\xf9\xc2\xf9\xc2\xf9\xc2\xf9\xc2\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9\xc3\xf9D\xf8O\xf5P\xf5P\xf5P\xf5P\xf5P\xf5Q\xf5Q\xf5Q\xf5Q\xf5Q\xf5S\xf5S\xf5S\xf5S\xf5S\xf5u\xf5\x01\xf6\x1c\xf6\x1a\xf6\x1a\xf6\x1c\xf6\x1d\xf6\x1d\xf6\x1d\xf6\x1d\xf6\x1d\xf6\x1d\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6\x1e\xf6_\x04\xa8\n\xa6\n\xa6\n\xa6\n\xa6\n\xa6\n\xa6\n\xa5\n\xa5\n\xa5\n\xa5\n\xa5\n\xa5\n\xa4\n\xa2\n\xa2\n\xa4\n\xa2\n\xa2\n\xa2\n\xa2\n\xa1\n\xa1\n\xa1\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xa0\n\xf5\x03\xb0\xf7\xb0\xf7\xb0\xf7\xb0\xf7\xb0\xf7\xb0\xf7\xb1\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb6\xf7\xb6\xf7\xb4\xf7\xb4\xf7\xb6\xf7\xb6\xf7\xb6\xf7\xb7\xf7\xb7\xf7\xb7\xf7\xb6\xf7\xb7\xf7\xb6\xf7\x1d\xf6l\xf5m\xf5m\xf5n\xf5n\xf5n\xf5m\xf5n\xf5n\xf5n\xf5n\xf5p\xf5p\xf5p\xf5q\xf5q\xf5\x0e\xf8n\xfan\xfan\xfal\xfal\xfal\xfal\xfan\xfan\xfan\xfao\xfao\xfao\xfao\xfao\xfao\xfa\xb7\xf8u\xf5u\xf5w\xf5w\xf5w\xf5x\xf5x\xf5x\xf5w\xf5x\xf5x\xf5x\xf5x\xf5y\xf5y\xf5y\xf5{\xf5{\xf5{\xf5{\xf5{\xf5|\xf5|\xf5|\xf5|\xf5|\xf5}\xf5}\xf5}\xf5}\xf5}\xf5}\xf5}\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\u007f\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x80\xf5\x82\xf5\x82\xf5\x82\xf5\x82\xf5\x82\xf5\x83\xf5\x83\xf5q\xf8X\xfbX\xfbX\xfbX\xfbX\xfbY\xfbY\xfbX\xfbX\xfbX\xfbY\xfbY\xfbY\xfbY\xfbY\xfbY\xfbo\xff\x1e\b\x1e\b\x1e\b\x1e\b\x1e\b\x1e\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1d\b\x1c\b\x1c\b\x1c\b\x1d\b\x1d\b\x1c\b\x1a\b\x1a\b\x1a\b\x1a\b\x1a\b\x1a\b\x1a\b\x19\b\x19\b\x19\b\x19\b\x19\b\xf7\xfc\xd7\xf6\xd7\xf6\xd8\xf6\xd8\xf6\xd8\xf6\xd8\xf6\xd8\xf6\xd8\xf6\xd8\xf6\xd9\xf6\xd9\xf6\xd9\xf6\xd9\xf6\xd8\xf6\xd9\xf6\xdb\xf6H\xf6\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x97\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x99\xf5\x9a\xf5\x9b\xf5\x9a\xf5\x9b\xf5<\xfb\xac\te\ne\nc\nc\nc\nc\nc\nc\nc\nc\nb\na\na\na\na\na\na\n_\n_\n_\n_\n_\n_\n^\n^\n^\n^\n^\n^\n\\\n\\\n\\\n\\\n\\\n[\n[\n[\n[\n[\n[\nZ\nX\nX\nX\nX\nX\nX\nX\nX\nX\n5\x02\xa9\xf5\xa9\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xaa\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xac\xf5\xad\xf5\xad\xf5\xad\xf5\xaf\xf5\xaf\xf5\xaf\xf5\xaf\xf5\xaf\xf5\xb0\xf5\xb0\xf5\xb0\xf5\xb0\xf5\xb1\xf5\xb1\xf5\xb1\xf5\xb0\xf5\xb1\xf5\xb1\xf5\xb3\xf5\xb3\xf5\xc7\xf5/\x01!\x06!\x06!\x06!\x06!\x06!\x06!\x06 \x06 \x06 \x06 \x06 \x06 \x06 \x06 \x06 \x067\bH\nH\nH\nF\nF\nF\nH\nH\nH\nF\nE\nE\nE\nE\nE\nD\nD\nD\nD\nD\nB\nB\nB\nB\nB\nB\nA\nA\nA\nA\nA\nA\n?\nA\t\xcf\x02\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\t\xfe\x16\xfc\x16\xfc\x17\xfc\x17\xfc\x16\xfc\x16\xfc\x17\xfc\x17\xfc\x17\xfc\x17\xfc\x17\xfc\x17\xfc\x17\xfc\x17\xfc\x17\xfc\x17\xfc\x17\xfc\x17\xfc\x17\xfc\x17\xfc\x17\xfc\x17\xfc\x18\xfc\x18\xfc\x18\xfc\x18\xfc\x18\xfc\x18\xfc\x18\xfc\x18\xfc\x18\xfc\x18\xfc\x18\xfcU\xfb\xa1\xf83\xf83\xf83\xf83\xf84\xf84\xf84\xf84\xf84\xf84\xf84\xf85\xf85\xf85\xf85\xf85\xf85\xf85\xf87\xf87\xf87\xf88\xf88\xf88\xf88\xf88\xf88\xf88\xf89\xf89\xf89\xf88\xf88\xf8\xc1\xf7>\xf7>\xf7>\xf7>\xf7>\xf7>\xf7>\xf7?\xf7?\xf7?\xf7?\xf7?\xf7?\xf7A\xf7A\xf7A\xf7\xd0\xf78\xf9I\xf9I\xf9I\xf9I\xf9I\xf9J\xf9J\xf9J\xf9I\xf9I\xf9J\xf9J\xf9J\xf9J\xf9J\xf9X\xfa^\x053\t2\t2\t2\t2\t2\t0\t0\t0\t0\t/\t/\t/\t/\t.\t.\t.\t.\t.\t.\t,\t,\t,\t,\t,\t,\t,\t+\t+\t+\t+\t+\t+\t\xc1\x04*\xfc*\xfc*\xfc,\xfc,\xfc-\xfc-\xfc-\xfc,\xfc,\xfc,\xfc-\xfc-\xfc,\xfc,\xfc,\xfc\xef\xfd\x8f\a\x0f\n\x0f\n\x0f\n\x0e\n\x0e\n\x0e\n\x0e\n\x0e\n\x0e\n\x0e\n\f\n\f\n\f\n\f\n\f\n\f\n\v\n\v\n\v\n\n\n\n\n\b\n\b\n\b\n\b\n\b\n\b\n\a\n\a\n\a\n\a\n\a\n\a\n\x06\n\x06\n\x06\n\x06\n\x06\n\x06\n\x04\n\x04\n\x04\n\x04\n\x04\n\x03\n\x03\n\x03\n\x03\n\x03\n\x03\n\x03\n\x03\n\x01\n\x01\n\x01\n\x9b\x02x\xfex\xfex\xfex\xfex\xfex\xfex\xfex\xfex\xfew\xfew\xfew\xfew\xfew\xfew\xfew\xfew\xfe\xce\xff\xc7\a\xfb\t\xfb\t\xfb\t\xfb\t\xf9\t\xf9\t\xf8\t\xf8\t\xf8\t\xf8\t\xf8\t\xf8\t\xf6\t\xf6\t\xf8\t\xf8\t\xf8\t\xbf\t9\t4\t4\t3\t3\t3\t3\t3\t4\t3\t2\t2\t2\t2\t2\t2\t2\t2\t0\t0\t0\t0\t0\t0\t0\t/\t/\t/\t/\t.\t,\t,\t/\t/\t.\t,\t,\t\xa1\t\xeb\t\xeb\t\xeb\t\xeb\t\xea\t\xea\t\xeb\t\xea\t\xea\t\xe9\t\xe9\t\xe9\t\xea\t\xe9\t\xe7\t\xe7\t\xe7\t\x8a\tX\x06L\x05L\x05L\x05L\x05L\x05L\x05L\x05L\x05L\x05L\x05J\x05I\x05I\x05J\x05J\x05J\x05J\x05\"\x06\x92\b\xce\b\xcd\b\xcc\b\xcc\b\xcc\b\xcd\b\xcd\b\xcc\b\xcc\b\xcc\b\xca\b\xca\b\xca\b\xca\b\xca\b\xc9\b\xc9\b\xc9\b\xc9\b\xc9\b\xc9\b\xc7\b\xc7\b\xc7\b\xc9\b\xc9\b\xc7\b\xc7\b\xc6\b\xc6\b\xc6\b\xc6\b\xc6\b\xc6\b\xc6\b\xc6\bv\xfe)\xf6)\xf6+\xf6+\xf6+\xf6+\xf6+\xf6+\xf6+\xf6,\xf6,\xf6,\xf6,\xf6,\xf6,\xf6,\xf6-\xf6\x82\xf6\x1b\x04\xd1\t\xd1\t\xd1\t\xd1\t\xd1\t\xd1\t\xd1\t\xd0\t\xd0\t\xd0\t\xd0\t\xce\t\xce\t\xce\t\xce\t\xce\t\xce\t\xcd\t\xcc\t\xcc\t\xcd\t\xcd\t\xcd\t\xcc\t\xca\t\xca\t\xcc\t\xca\t\xca\t\xca\t\xca\t\xca\t\xc9\t\xc9\t\xc9\t\xc9\t\xb1\aJ\x03J\x03J\x03J\x03J\x03J\x03K\x03K\x03K\x03K\x03K\x03J\x03J\x03J\x03J\x03J\x03J\x03J\x03!\xfd=\xf6=\xf6=\xf6>\xf6>\xf6?\xf6?\xf6?\xf6?\xf6?\xf6A\xf6A\xf6A\xf6A\xf6A\xf6A\xf6B\xf6B\xf6B\xf6B\xf6D\xf6D\xf6D\xf6D\xf6D\xf6D\xf6E\xf6F\xf6F\xf6E\xf6E\xf6E\xf6E\xf6F\xf6F\xf6F\xf6F\xf6F\xf6F\xf6H\xf6I\xf6I\xf6I\xf6I\xf6H\xf6I\xf6J\xf6J\xf6I\xf6I\xf6I\xf6J\xf6L\xf6L\xf6M\xf6\x99\xfa\x03\b\xb4\t\xb3\t\xb3\t\xb3\t\xb3\t\xb3\t\xb3\t\xb1\t\xb1\t\xb1\t\xb1\t\xb0\t\xb0\t\xb0\t\xaf\t\xaf\t\xaf\t\xb0\t\xaf\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xad\t\xac\t\xac\t\xac\t\xad\t\xac\t\xab\t\xab\t\xab\t\xab\t\xab\t\xe1\xffW\xf6W\xf6W\xf6W\xf6W\xf6W\xf6X\xf6Z\xf6Z\xf6X\xf6X\xf6X\xf6Z\xf6[\xf6[\xf6[\xf6Z\xf6Z\xf6\\\xf6\\\xf6\\\xf6\\\xf6^\xf6^\xf6^\xf6^\xf6^\xf6^\xf6_\xf6_\xf6_\xf6_\xf6_\xf6_\xf6_\xf6_\xf6\xd7\xf6F\xfaU\xfbT\xfbT\xfbT\xfbU\xfbU\xfbU\xfbU\xfbU\xfbU\xfbU\xfbV\xfbV\xfbV\xfbV\xfbV\xfbV\xfb\xb7\xfa\xd7\xf8\xa1\xf8\xa1\xf8\xa1\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa1\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa2\xf8\xa4\xf8\xa4\xf8\xa4\xf8\xa4\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa4\xf8\xa4\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa5\xf8\xa6\xf8\xa6\xf8\xa8\xf8\xa8\xf8\xa8\xf8\xa6\xf8\xa6\xf8\x8b\xf7n\xf6n\xf6q\xf6q\xf6q\xf6q\xf6q\xf6r\xf6r\xf6r\xf6r\xf6r\xf6r\xf6r\xf6r\xf6s\xf6u\xf6u\xf6u\xf6u\xf6u\xf6u\xf6v\xf6v\xf6v\xf6v\xf6w\xf6w\xf6w\xf6w\xf6w\xf6w\xf6w\xf6w\xf6w\xf6y\xf6\x85\xf6\xf5\xf6\x1c\xf7\x1c\xf7\x1c\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7 \xf7\xd3\xfa\x84\a\x80\t\x80\t\x80\t\x80\t\x80\t\x80\t\x80\t\u007f\t\u007f\t\u007f\t\u007f\t\u007f\t\u007f\t~\t|\t|\t|\t|\t{\t{\t{\t|\t|\t|\t{\ty\ty\ty\ty\ty\ty\ty\ty\ty\ty\ty\t\xdd\x00\x89\xf6\x89\xf6\x89\xf6\x89\xf6\x89\xf6\x8b\xf6\x8b\xf6\x8b\xf6\x8b\xf6\x8b\xf6\x8c\xf6\x8c\xf6\x8c\xf6\x8c\xf6\x8c\xf6\x8c\xf6\x8c\xf6\x8c\xf6\x8d\xf6\x8f\xf6\x8f\xf6\x8f\xf6\x8f\xf6\x8f\xf6\x8f\xf6\x8f\xf6\x8f\xf6\x8f\xf6\x90\xf6\x90\xf6\x90\xf6\x93\xf6\x93\xf6\x92\xf6\x92\xf6\x92\xf6\x96\xf6_\xf7\xb7\xf7\xb8\xf7\xb8\xf7\xb8\xf7\xb8\xf7\xb8\xf7\xb8\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbb\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbc\xf7\xbe\xf7\xbe\xf7\xbe\xf7\xbe\xf7\xbe\xf7\xbe\xf7\xbf\xf7\x89\xfb\xfa\x05\xe1\x06\xe1\x06\xe1\x06\xe1\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xde\x06\xde\x06\xde\x06\xde\x06\xdd\x06\xdd\x06\xdd\x06\xdd\x06\xdb\x06\xdb\x06\xdb\x06\xdd\x06\xdd\x06\xdd\x06\xdd\x06\xdd\x06\xdb\x06\xdb\x06\xdb\x06\xdb\x06\xda\x06\xda\x06\xda\x06\x0f\bZ\tZ\tX\tX\tX\tW\tW\tW\tW\tW\tW\tW\tV\tV\tV\tT\tT\tT\tT\tT\tT\tS\tS\tQ\tQ\tQ\tQ\tQ\tQ\tQ\tQ\tQ\tP\tP\tP\tP\t\xf6\b?\xfdR\xf8R\xf8R\xf8T\xf8T\xf8T\xf8T\xf8U\xf8U\xf8U\xf8U\xf8U\xf8V\xf8V\xf8V\xf8V\xf8V\xf8V\xf8V\xf8X\xf8X\xf8X\xf8X\xf8V\xf8V\xf8X\xf8Y\xf8Y\xf8Y\xf8Y\xf8Y\xf8Y\xf8Y\xf8Y\xf8Y\xf8[\xf8V\xfc\xb9\a\xde\b\xdc\b\xdc\b\xdc\b\xdc\b\xdc\b\xdc\b\xdc\b\xdc\b\xdc\b\xdb\b\xdb\b\xdb\b\xdb\b\xdb\b\xdb\b\xd9\b\xd8\b\xd7\b\xd7\b\xd7\b\xd8\b\xd8\b\xd8\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd7\b\xd5\b\xd5\b\xd5\b\xd5\b\xd5\b\xd5\b\x0e\x02\xf0\xf9\xf0\xf9\xf2\xf9\xf3\xf9\xf3\xf9\xf3\xf9\xf2\xf9\xf2\xf9\xf2\xf9\xf2\xf9\xf3\xf9\xf3\xf9\xf3\xf9\xf3\xf9\xf3\xf9\xf3\xf9\xf3\xf9\xf3\xf9\xf3\xf9\xf4\xf9\xf4\xf9\xf4\xf9\xf6\xf9\xf6\xf9\xf6\xf9\xf6\xf9\xf6\xf9\xf6\xf9\xf6\xf9\xf7\xf9\xf7\xf9\xf7\xf9\xf7\xf9\xf7\xf9\xf7\xf9\xf7\xf9\xf7\xf9\x87\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xcf\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd1\xfa\xd2\xfaa\xfb\x8d\x00V\x02U\x02U\x02U\x02U\x02U\x02U\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02R\x02R\x02R\x02R\x02\v\x04m\b\xae\b\xae\b\xac\b\xac\b\xac\b\xab\b\xab\b\xab\b\xac\b\xac\b\xab\b\xab\b\xab\b\xaa\b\xaa\b\xaa\b\xab\b\xaa\b\xa5\ah\xfb\xe4\xf6\xe4\xf6\xe4\xf6\xe6\xf6\xe6\xf6\xe6\xf6\xe6\xf6\xe6\xf6\xe6\xf6\xe6\xf6\xe6\xf6\xe6\xf6\xe6\xf6\xe7\xf6\xe9\xf6\xe9\xf6\xe9\xf6\xe7\xf6\xe7\xf6\xe9\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xea\xf6\xeb\xf6\xeb\xf6\xeb\xf6\xeb\xf6\xed\xf6\xed\xf6\xed\xf6\xed\xf6\xed\xf6\xed\xf6\xee\xf6\xee\xf6y\xfc\xb3\b\xb3\b\xb2\b\xb2\b\xb2\b\xb1\b\xb1\b\xb1\b\xb1\b\xb1\b\xb1\b\xaf\b\xaf\b\xaf\b\xaf\b\xaf\b\xaf\b\xaf\b\xae\b\xb6\b\xf6\b\f\t\v\t\v\t\v\t\v\t\v\t\v\t\n\t\n\t\b\t\b\t\b\t\b\t\n\t\b\t\b\t\a\t\a\t\a\t\a\t\x06\t\x06\t\x06\t\x06\t\x06\t\x06\t\x06\t\x04\t\x04\t\x04\t\x04\t\x04\t\x04\t\x03\t\x03\t\x03\t\x03\t\x03\t\x03\t\x96\x04\xd6\xfb\xd6\xfb\xd6\xfb\xd6\xfb\xd6\xfb\xd6\xfb\xd8\xfb\xd8\xfb\xd8\xfb\xd8\xfb\xd8\xfb\xd8\xfb\xd6\xfb\xd6\xfb\xd8\xfb\xd8\xfb\xd8\xfb\xd8\xfb\xd8\xfb\xd9\xfb\xd9\xfb\xd9\xfb\xd9\xfb\xd9\xfb\xd9\xfb\xd9\xfb\xd9\xfb\xd9\xfb\xd9\xfb\xd9\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xda\xfb\xf4\x00\xcb\x04\xcb\x04\xcb\x04\xc9\x04\xc9\x04\xc9\x04\xc9\x04\xcb\x04\xcb\x04\xcb\x04\xcb\x04\xcb\x04\xc9\x04\xc9\x04\xc9\x04\xc8\x04\xc8\x04\xc8\x04\xc9\x04k\x00\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x03\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x04\xf8\x05\xf8\x05\xf8\x05\xf8\x05\xf8\a\xf8\a\xf8\a\xf8\a\xf8\b\xf8\b\xf8\b\xf8\b\xf8\b\xf8\b\xf8\n\xf8\n\xf8\n\xf8\n\xf8\n\xf8\n\xf8\n\xf8\n\xf8\n\xf8\n\xf8\v\xf8\v\xf8\v\xf8\v\xf8\v\xf8\x82\xf7\x1a\xf7\x1a\xf7\x1a\xf7\x1c\xf7\x1c\xf7\x1c\xf7\x1c\xf7\x1c\xf7\x1c\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1d\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7\x1e\xf7 \xf7 \xf7!\xf7!\xf7!\xf7!\xf7!\xf7!\xf7\"\xf7\"\xf7\"\xf7!\xf7\"\xf7\"\xf7$\xf7$\xf7$\xf7$\xf7$\xf7d\xf74\xf9\xc6\xf9\xc7\xf9\xc7\xf9\xc7\xf9\xc7\xf9\xc7\xf9\xc7\xf9\xc7\xf9\xc7\xf9\xc7\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xc9\xf9\xca\xf9\xca\xf9\xca\xf9\xca\xf9\xca\xf9\xca\xf9\xca\xf9\xca\xf9\xca\xf9\xca\xf9\xcc\xf9\xcc\xf9\xcc\xf9\xcc\xf9\xcc\xf9\xcc\xf9\xcd\xf9\xcd\xf9\xcd\xf9\xcd\xf9\x03\xf97\xf70\xf70\xf70\xf70\xf70\xf70\xf70\xf72\xf72\xf72\xf72\xf72\xf72\xf73\xf74\xf74\xf74\xf73\xf74\xf74\xf76\xf76\xf76\xf76\xf76\xf76\xf77\xf77\xf77\xf77\xf77\xf77\xf77\xf79\xf79\xf79\xf79\xf7:\xf7:\xf7N\xfd>\x03>\x03@\x03>\x03>\x03>\x03>\x03=\x03=\x03=\x03>\x03>\x03>\x03=\x03=\x03=\x03=\x03=\x03=\x03R\x04/\af\af\af\af\af\af\af\ae\ae\ae\af\ae\ae\ae\ae\ae\ae\ae\ae\a\xa8\a\xc7\a\xc7\a\xc7\a\xc7\a\xc5\a\xc5\a\xc5\a\xc5\a\xc5\a\xc5\a\xc4\a\xc4\a\xc4\a\xc4\a\xc2\a\xc2\a\xc2\a\xc2\a\xc2\a\x84\x05\xc7\x02\xc7\x02\xc7\x02\xc5\x02\xc5\x02\xc5\x02\xc5\x02\xc5\x02\xc5\x02\xc5\x02\xc5\x02\xc5\x02\xc5\x02\xc5\x02\xc5\x02\xc5\x02\xc5\x02\xc5\x02\xc5\x02\xc5\x02\xc5\x02\xc4\x02\xc4\x02\xc4\x02\xc4\x02\xc4\x02\xc2\x02\xc2\x02\xc2\x02\xc4\x02\xc4\x02\xc4\x02\xc4\x02\xc4\x02\xc4\x02\xc4\x02\xc2\x02\xc2\x02\xc4\x02\xc4\x02r\x06\x96\b\x96\b\x96\b\x98\b\x96\b\x96\b\x96\b\x96\b\x96\b\x95\b\x95\b\x95\b\x95\b\x95\b\x95\b\x94\b\x94\b\x94\b\x94\b\x92\b\x92\b\x92\b\x91\b\x91\b\x91\b\x91\b\x91\b\x91\b\x91\b\x8f\b\x8f\b\x8f\b\x8f\b\x8f\b\x8e\b\x8e\b\x8f\b\x8f\b\x8e\b\xa5\x06\xd3\xfb\xca\xf8\xca\xf8\xca\xf8\xca\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcc\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xcd\xf8\xce\xf8\xce\xf8\b\xf8f\xf7f\xf7d\xf7d\xf7f\xf7f\xf7g\xf7g\xf7g\xf7g\xf7g\xf7g\xf7h\xf7h\xf7h\xf7h\xf7h\xf7h\xf7h\xf7j\xf7j\xf7k\xf7k\xf7k\xf7k\xf7k\xf7k\xf7k\xf7k\xf7l\xf7l\xf7l\xf7l\xf7l\xf7l\xf7n\xf7n\xf7o\xf7o\xf7\xe0\xf7\xa1\x03\x8f\b\x8f\b\x8f\b\x8f\b\x8e\b\x8e\b\x8e\b\x8e\b\x8e\b\x8e\b\x8f\b\x8f\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8d\b\x8b\b\x8a\b\x8a\b\x8a\b\x8a\b\x8a\b\x8a\b\x8a\b\x8a\b\x89\b\x89\b\x87\b\x87\b\x87\b\x87\b\x87\b\x87\b\x87\b\x87\b\x87\bm\x05\xba\xf9z\xf7z\xf7z\xf7z\xf7|\xf7|\xf7|\xf7|\xf7|\xf7|\xf7}\xf7}\xf7}\xf7~\xf7~\xf7~\xf7~\xf7~\xf7~\xf7~\xf7\x80\xf7\x80\xf7\x80\xf7\x80\xf7\x80\xf7\x80\xf7\x81\xf7\x81\xf7\x81\xf7\x81\xf7\x82\xf7\x82\xf7\x82\xf7\x82\xf7\x82\xf7\x82\xf7\x84\xf7\x84\xf7\x84\xf7!\xfd|\b|\b{\by\by\by\b{\b{\by\bx\bx\bx\bx\bx\bx\bx\bx\bw\bw\bw\bw\bu\bu\bu\bu\bu\bu\bu\bt\bt\bt\bu\bu\bt\br\br\br\br\br\br\b\xa2\x00\xe0\xf7\xe0\xf7\xe2\xf7\xe2\xf7\xe2\xf7\xe2\xf7\xe2\xf7\xe2\xf7\xe2\xf7\xe2\xf7\xe2\xf7\xe2\xf7\xe3\xf7\xe4\xf7\xe4\xf7\xe4\xf7\xe4\xf7\xe4\xf7\xe4\xf7\xe6\xf7\xe6\xf7\xe6\xf7\xe7\xf7\xe7\xf7\xe7\xf7\xe7\xf7\xe7\xf7\xe7\xf7\xe7\xf7\xe7\xf7\xe7\xf7\xe7\xf7\xe9\xf7\xe9\xf7\xe9\xf7\xe9\xf7\xe9\xf7\xe9\xf7\xea\xf7\xea\xf7\xeb\xf7\xed\xf7\xed\xf7\xed\xf7\xeb\xf7\xeb\xf7\xeb\xf7\xeb\xf7\xed\xf7\xed\xf7\xed\xf7\xed\xf7\xed\xf7\xee\xf7\xee\xf7\xee\xf7\xee\xf7\xee\xf7\xee\xf7\xee\xf7J\xfda\ba\ba\ba\ba\ba\b_\b^\b^\b^\b^\b^\b^\b^\b\\\b\\\b\\\b^\b^\b\\\b\\\b[\b[\b[\b[\b[\b[\bZ\bZ\bZ\bZ\bX\bX\bX\bX\bW\bW\bW\bW\bW\b\xde\x00\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xaa\xf7\xac\xf7\xac\xf7\xac\xf7\xac\xf7\xac\xf7\xac\xf7\xad\xf7\xad\xf7\xac\xf7\xac\xf7\xad\xf7\xad\xf7\xad\xf7\xaf\xf7\xaf\xf7\xaf\xf7\xaf\xf7\xb0\xf7\xb0\xf7\xb0\xf7\xb1\xf7\xb1\xf7\xb1\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb1\xf7\xb3\xf7\xb3\xf7\xb3\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb4\xf7\xb6\xf7\xb6\xf7\xb6\xf7\xb6\xf7\xb6\xf7\xb7\xf7\xb7\xf7\xb7\xf7\xb7\xf7\xb7\xf7\xb7\xf7\xb8\xf7\xb8\xf7\xb8\xf7\xb8\xf7\xb8\xf7\xb8\xf7\xba\xf7\xba\xf7\xba\xf7\xba\xf7\xf9\xf7\u007f\x02\x16\a\x15\a\x15\a\x15\a\x16\a\x16\a\x15\a\x15\a\x15\a\x15\a\x13\a\x13\a\x13\a\x13\a\x13\a\x12\a\x12\a\x12\a\x12\a\x12\a\x12\a\x12\a\x11\a\x11\a\x11\a\x11\a\x11\a\x11\a\x11\a\x0f\a\x0f\a\x0f\a\x0e\a\x0e\a\x0e\a\x0f\a\x0e\a\x0e\a\x0e\a\x0e\a\x0e\a\x0e\a\xc5\x04\xd1\xfb\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xf9\xfa\xf9\xfa\xf9\xfb\xf9\xfb\xf9\xfb\xf9\xfb\xf9\xfb\xf9\xfa\xf9\xfa\xf9\xfa\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfb\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfd\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\xfe\xf9\x00\xfa\x00\xfa\xfe\xf9\xfe\xf9\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x00\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x01\xfa\x02\xfa\x02\xfa\x04\xfa\x04\xfa\x04\xfa\x02\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x04\xfa\x05\xfa\x05\xfa\x05\xfa\x05\xfa\xbf\xf9T\xf9T\xf9T\xf9T\xf9T\xf9T\xf9T\xf9T\xf9U\xf9U\xf9U\xf9U\xf9U\xf9U\xf9V\xf9V\xf9V\xf9V\xf9X\xf9X\xf9X\xf9X\xf9Y\xf9Y\xf9Y\xf9Y\xf9Y\xf9Y\xf9Y\xf9Y\xf9Y\xf9[\xf9[\xf9[\xf9[\xf9[\xf9[\xf9[\xf9\\\xf9\\\xf9\\\xf9]\xf9]\xf9]\xf9\\\xf9\\\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9]\xf9_\xf9_\xf9_\xf9_\xf9_\xf9_\xf9_\xf9`\xf9`\xf9`\xf9a\xf9a\xf9'\x02\x17\b\x17\b\x17\b\x17\b\x16\b\x16\b\x16\b\x16\b\x16\b\x16\b\x15\b\x15\b\x15\b\x15\b\x15\b\x13\b\x13\b\x13\b\x13\b\x13\b\x13\b\x12\b\x12\b\x12\b\x12\b\x11\b\x11\b\x11\b\x11\b\x11\b\x11\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0f\b\x0e\b\x0e\b\x0e\b\x0e\b\x0e\b\f\b\f\b\f\b\v\b\v\b\v\b\f\b\f\b\v\b\v\b\v\b\n\b\n\b\n\b\n\b\b\b\b\b\b\b\b\b\b\b\b\b\a\b\xb5\ab\x05\xa1\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\xa0\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9f\x04\x9d\x04\x9d\x04\x9d\x04\x9d\x04\x9d\x04\x9d\x04\x9d\x04\x9d\x04\x9d\x04\x9d\x04\x9c\x04\x9c\x04\x9c\x04\x9c\x04\x9c\x04\x9c\x04\x9c\x04\x9a\x04\x9a\x04\x9a\x04\x9a\x04\x9a\x04\x9a\x04\x9a\x04\x9a\x04\x9a\x04\x9a\x04\x9a\x04\x99\x04\x99\x04\x99\x04\x99\x04\x99\x04\x99\x04\x99\x04\x98\x04\x98\x04\x98\x04\x98\x04\x98\x04\x98\x04\x98\x04a\x01\xcc\xf8\v\xf8\v\xf8\v\xf8\v\xf8\f\xf8\f\xf8\f\xf8\f\xf8\f\xf8\f\xf8\f\xf8\f\xf8\f\xf8\f\xf8\x0e\xf8\x0e\xf8\x0e\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x0f\xf8\x11\xf8\x12\xf8\x12\xf8\x12\xf8\x12\xf8\x12\xf8\x12\xf8\x12\xf8\x12\xf8\x12\xf8\x12\xf8\x13\xf8\x13\xf8\x13\xf8\x13\xf8\x13\xf8\x15\xf8\x15\xf8\x15\xf8\x15\xf8\x16\xf8\x16\xf8\x16\xf8\x16\xf8\x16\xf8\x16\xf8\x16\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x17\xf8\x19\xf8\x19\xf8\x19\xf8\x19\xf8\x19\xf8\x19\xf8\x1a\xf8\x1a\xf8\x1a\xf8\x1a\xf8\x1a\xf8\x1c\xf8\x1c\xf8\x1c\xf8\x1d\xf8\x1d\xf8\x1d\xf8\x1d\xf8\x1d\xf8\x1d\xf8\x1e\xf8\x1e\xf8\x1e\xf8 \xf8 \xf8 \xf8 \xf8 \xf8 \xf8 \xf8 \xf8 \xf8!\xf8!\xf8!\xf8!\xf8!\xf8!\xf8\"\xf8\"\xf8\"\xf8\"\xf8\"\xf8\"\xf8$\xf8$\xf8$\xf8$\xf8$\xf8%\xf8%\xf8%\xf8%\xf8%\xf8%\xf8&\xf8&\xf8&\xf8'\xf8'\xf8'\xf8'\xf8'\xf8'\xf8)\xf8)\xf8)\xf8)\xf8)\xf8)\xf8*\xf8*\xf8*\xf8)\xf8*\xf8,\xf8C\xf8V\xf8V\xf8V\xf8X\xf8Y\xf8Y\xf8Y\xf8Y\xf8Y\xf8Y\xf8Y\xf8Y\xf8Y\xf8[\xf8[\xf8[\xf8[\xf8[\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8\\\xf8]\xf8]\xf8_\xf8_\xf8_\xf8_\xf8_\xf8_\xf8_\xf8_\xf8_\xf8_\xf8`\xf8`\xf8`\xf8`\xf8`\xf8`\xf8a\xf8a\xf8a\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8c\xf8d\xf8d\xf8d\xf8d\xf8f\xf8f\xf8f\xf8f\xf8f\xf8f\xf8f\xf8f\xf8g\xf8g\xf8g\xf8g\xf8h\xf8h\xf8h\xf8j\xf8j\xf8j\xf8h\xf8j\xf8j\xf8k\xf8k\xf8k\xf8j\xf8k\xf8k\xf8k\xf8k\xf8k\xf8Q\xf8B\xf8B\xf8B\xf8C\xf8C\xf8C\xf8D\xf8D\xf8D\xf8D\xf8D\xf8D\xf8D\xf8F\xf8F\xf8F\xf8F\xf8F\xf8F\xf8F\xf8G\xf8G\xf8G\xf8I\xf8I\xf8I\xf8I\xf8I\xf8I\xf8J\xf8J\xf8J\xf8J\xf8J\xf8K\xf8K\xf8K\xf8K\xf8M\xf8M\xf8M\xf8M\xf8N\xf8N\xf8N\xf8N\xf8N\xf8N\xf8N\xf8N\xf8O\xf8O\xf8O\xf8O\xf8O\xf8O\xf8Q\xf8Q\xf8Q\xf8Q\xf8Q\xf8R\xf8R\xf8T\xf8T\xf8T\xf8R\xf8T\xf8T\xf8T\xf8T\xf8T\xf8T\xf8U\xf8U\xf8U\xf8U\xf8U\xf8U\xf8V\xf8V\xf8V\xf8V\xf8V\xf8V\xf8X\xf8\xbc\xf8~\xfc\xd8\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd8\xfd\xd8\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd8\xfd\xd8\xfd\xd8\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd8\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xf2\xff\x1d\x06\xec\x06\xec\x06\xec\x06\xec\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xe9\x06\xe9\x06\xe9\x06\xe9\x06\xe9\x06\xe9\x06\xe9\x06\xe8\x06\xe8\x06\xe8\x06\xe8\x06\xe8\x06\xe8\x06\xe6\x06\xe6\x06\xe6\x06\xe6\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe5\x06\xe4\x06\xe4\x06\xe4\x06\xe4\x06\xe4\x06\xe4\x06\xe4\x06\xe4\x06\xe4\x06\xe2\x06\xe2\x06\xe2\x06\xe2\x06\xe2\x06\xe1\x06\xe1\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xdf\x06\xde\x06\xdd\x06\xdd\x06\xdd\x06\xdd\x06\xdd\x06\xdd\x06\xdd\x06\xdd\x06\xdb\x06\xdb\x06\xdb\x06\xdb\x06\xdb\x06\xda\x06\xda\x06\xda\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd9\x06\xd7\x06\xd7\x06\xd7\x06\xd7\x06\xdf\x06\xf3\x06\xf3\x06\xf1\x06\xf1\x06\xf1\x06\xf0\x06\xf0\x06\xf0\x06\xf0\x06\xf0\x06\xf0\x06\xf0\x06\xf0\x06\xf0\x06\xf0\x06\xef\x06\xef\x06\xef\x06\xef\x06\xef\x06\xef\x06\xed\x06\xed\x06\xed\x06\xec\x06\xec\x06\xec\x06\xed\x06\xec\x06\xec\x06\xec\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xea\x06\xe9\x06\xe9\x06\xe9\x06\xe9\x06\xe9\x06\xe8\x06\xe8\x06\xe8\x06\xe8\x06J\x02\x1f\xfb\x1f\xfb\x1f\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb\"\xfb\"\xfb\"\xfb\"\xfb\"\xfb\"\xfb\"\xfb\"\xfb\"\xfb#\xfb#\xfb#\xfb#\xfb%\xfb%\xfb%\xfb#\xfb#\xfb%\xfb%\xfb%\xfb%\xfb%\xfb%\xfb%\xfb%\xfb%\xfb%\xfb%\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb&\xfb(\xfb(\xfb(\xfb(\xfb)\xfb)\xfb)\xfb)\xfb)\xfb)\xfb)\xfb)\xfb)\xfb*\xfb*\xfb*\xfb*\xfb)\xfb)\xfb*\xfb\xf2\xfaa\xf9\xdf\xf8\xdf\xf8\xdf\xf8\xe0\xf8\xe0\xf8\xe0\xf8\xe0\xf8\xe0\xf8\xe0\xf8\xe0\xf8\xe2\xf8\xe2\xf8\xe2\xf8\xe2\xf8\xe2\xf8\xe2\xf8\xe3\xf8\xe3\xf8\xe3\xf8\xe3\xf8\xe3\xf8\xe3\xf8\xe4\xf8\xe4\xf8\xe4\xf8\xe6\xf8\xe6\xf8\xe6\xf8\xe6\xf8\xe6\xf8\xe6\xf8\xe6\xf8\xe6\xf8\xe6\xf8\xe7\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe7\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xe9\xf8\xea\xf8\x96\xfa<\x04\x16\a\x16\a\x16\a\x15\a\x15\a\x15\a\x15\a\x15\a\x15\a\x13\a\x13\a\x13\a\x12\a\x12\a\x12\a\x12\a\x12\a\x12\a\x12\a\x11\a\x11\a\x11\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0e\a\x0e\a\x0e\a\x0e\a\x0e\a\x0e\a\x0e\a\f\a\f\a\f\a\f\a\f\a\f\a\v\a\v\a\v\a\v\a\n\a\n\a\n\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\b\a\a\a\a\a\a\a\a\a\a\a\a\a\x06\a\x06\a\x06\a\x06\a\x06\a\x06\a\xb2\x06S\x06S\x06T\x06S\x06S\x06S\x06S\x06S\x06S\x06S\x06S\x06Q\x06Q\x06Q\x06Q\x06Q\x06P\x06P\x06P\x06P\x06P\x06P\x06P\x06P\x06O\x06O\x06O\x06M\x06M\x06M\x06M\x06M\x06M\x06M\x06M\x06M\x06L\x06L\x06L\x06L\x06L\x06J\x06J\x06J\x06J\x06J\x06\n\x00\xd0\xf8\xd0\xf8\xd0\xf8\xd0\xf8\xd0\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd1\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd2\xf8\xd4\xf8\xd4\xf8\xd4\xf8\xd4\xf8\xd4\xf8\xd4\xf8\xd5\xf8\xd5\xf8\xd5\xf8\xd7\xf8\xd7\xf8\xd7\xf8\xd7\xf8\xd7\xf8\xd7\xf8\xd7\xf8\xd7\xf8\xd7\xf8\xd8\xf8\xd8\xf8\xd8\xf8\xd8\xf8\xd8\xf8\xd8\xf8\xd9\xf8\xd9\xf8\xd9\xf8\xd9\xf8\xdb\xf8\xdb\xf8\xdc\xf8\xdc\xf8\xdc\xf8\xdc\xf8\xdc\xf8\xdc\xf8\xdc\xf8\xde\xf8\xde\xf8\xde\xf8\xde\xf8\xde\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xdf\xf8\xe0\xf8?\xfa\xef\x03\x1e\a\x1e\a\x1e\a\x1e\a\x1e\a\x1e\a\x1d\a\x1d\a\x1d\a\x1d\a\x1d\a\x1d\a\x1c\a\x1c\a\x1c\a\x1c\a\x1c\a\x1a\a\x1a\a\x1a\a\x1a\a\x1a\a\x19\a\x19\a\x19\a\x19\a\x17\a\x17\a\x17\a\x17\a\x17\a\x17\a\x16\a\x16\a\x16\a\x16\a\x16\a\x16\a\x16\a\x16\a\x16\a\x15\a\x15\a\x15\a\x15\a\x13\a\x13\a\x12\a\x12\a\x12\a\x12\a\x12\a\x12\a\x12\a\x11\a\x11\a\x11\a\x11\a\x11\a\x11\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0f\a\x0e\a\x0e\a\f\a\f\a\f\a\f\a\f\a\f\a\f\a\v\a\v\a\v\a\v\a\v\a\v\a\n\a\n\a\n\a\n\a\n\a\n\a\b\a\b\a\b\a\b\a\a\a\a\a\a\a\x06\a\x06\a\x06\a\x06\a\x06\a\x06\a\x06\a\x06\a\x04\a\x04\a\x04\a\x04\a\x04\a\x04\a\x03\a\x03\a\x03\a\x03\a\x03\a\x01\a\x01\a\x01\a'\x06\xa3\xfc\x00\xf9\x00\xf9\x00\xf9\x00\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x01\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x03\xf9\x04\xf9\x04\xf9\x04\xf9\x04\xf9\x04\xf9\x04\xf9\x04\xf9\x05\xf9\x05\xf9\x06\xf9\x06\xf9\x06\xf9\x06\xf9\x06\xf9\x06\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\b\xf9\t\xf9\t\xf9\t\xf9\t\xf9\t\xf9\t\xf9\v\xf9\v\xf9\v\xf9\v\xf9\v\xf9\v\xf9\f\xf9\f\xf9\f\xf9\f\xf9\f\xf9\f\xf9\r\xf9\r\xf9\r\xf9\r\xf9\x0f\xf9\x0f\xf9\x0f\xf9\r\xf9\r\xf9\x10\xf9\x10\xf9\x10\xf9\x10\xf9\x10\xf9\x10\xf9\x11\xf9\x11\xf9\x1c\xf9\"\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x93\xfa\x93\xfa\x93\xfa\x94\xfa\x94\xfa\x94\xfa\x93\xfa\x93\xfa\x93\xfa\x94\xfa\x94\xfa\x94\xfa\x94\xfa\x94\xfa\x94\xfa\x94\xfa\x96\xfa\x97\xfa\x97\xfa\x97\xfa\x97\xfa\x97\xfa\x97\xfa\x97\xfa\x97\xfa\x97\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x9a\xfa\x9a\xfa\x9a\xfa\x9a\xfa\x9a\xfa\x9a\xfa\x9a\xfa\x9a\xfa\x9b\xfa\x9b\xfa\x9d\xfa\x9d\xfa\x9d\xfa\x9d\xfa\x9d\xfa\x9d\xfa\x9d\xfa\x9d\xfa\x9d\xfa\x9d\xfa\x9d\xfa\x9e\xfa\x9e\xfa\x9e\xfa\x9e\xfa\x9e\xfa\x9e\xfa\x9f\xfa\x9f\xfa\x9f\xfa\x9f\xfa\x9f\xfa\x9f\xfa\xec\xfa\x14\xfb\x14\xfb\x14\xfb\x14\xfb\x14\xfb\x14\xfb\x14\xfb\x16\xfb\x16\xfb\x16\xfb\x16\xfb\x16\xfb\x16\xfb\x16\xfb\x16\xfb\x16\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x17\xfb\x18\xfb\x18\xfb\x18\xfb\x18\xfb\x18\xfb\x18\xfb\x18\xfb\x1a\xfb\x1a\xfb\x1a\xfb\x1a\xfb\x1b\xfb\x1b\xfb\x1b\xfb\x1b\xfb\x1b\xfb\x1b\xfb\x1b\xfb\x1b\xfb\x1b\xfb\x1c\xfb\x1c\xfb\x1c\xfb\x1b\xfb\x1b\xfb\x1c\xfb\x1c\xfb\x1c\xfb\x1c\xfb\x1e\xfb\x1e\xfb\x1e\xfb\x1e\xfb\x1f\xfb\x1f\xfb\x1f\xfb\x1e\xfb\x1e\xfb\x1f\xfb\x1f\xfb\x1f\xfb\x1f\xfb\x1f\xfb\x1f\xfb!\xfb!\xfb!\xfb!\xfb!\xfb!\xfb\"\xfb\"\xfb\"\xfb\"\xfb\"\xfb\"\xfb\"\xfb\"\xfb\"\xfb\"\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb#\xfb%\xfb%\xfb%\xfb#\xfb#\xfb%\xfb%\xfb%\xfb*\xfb.\xfb.\xfb.\xfb0\xfb0\xfb0\xfb0\xfb0\xfb0\xfb1\xfb1\xfb1\xfb0\xfb1\xfb1\xfb1\xfb1\xfb1\xfb1\xfb3\xfb3\xfb3\xfb3\xfb3\xfb3\xfb3\xfb3\xfb3\xfb4\xfb4\xfb4\xfb4\xfb4\xfb4\xfb4\xfb4\xfb4\xfb5\xfb5\xfb5\xfb7\xfb7\xfb7\xfb5\xfb5\xfb5\xfb7\xfb7\xfb7\xfb7\xfb8\xfb8\xfb8\xfb8\xfb8\xfb8\xfb8\xfb8\xfb9\xfb9\xfb9\xfb8\xfb9\xfb9\xfb9\xfb9\xfb9\xfb9\xfb9\xfb;\xfb;\xfb;\xfb;\xfb;\xfb\x1b\x00\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xaf\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xae\x06\xac\x06\xac\x06\xac\x06\xac\x06\xac\x06\xac\x06\xab\x06\xab\x06\xab\x06\xab\x06\xab\x06\xab\x06\xaa\x06\xaa\x06\xaa\x06\xaa\x06\xa8\x06\xa8\x06\xa8\x06\xa8\x06\xa8\x06\xa7\x06\xa7\x06\xa7\x06\xa7\x06\xa7\x06\xa7\x06\xa5\x06\xa5\x06\xa5\x06\xa5\x06\xa5\x06\xa5\x06\xa5\x06\xa4\x06\xa4\x06\xa4\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa3\x06\xa1\x06\xa1\x06\xa1\x06\xa1\x06\xa1\x06\xa1\x06\xa0\x06\xa0\x06\xa0\x06\xa0\x06\xa0\x06\xa0\x06\xa0\x06\xa0\x06\xa0\x06\x9f\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9d\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9c\x06\x9a\x06\x9a\x06\x9a\x06\x9c\x06\x9c\x06\x9a\x06\x99\x06\x99\x06\x9a\x06\x9a\x06\x9a\x06\x99\x06\x99\x06\x99\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x98\x06\x96\x06\x96\x06\x96\x06\x95\x06\x95\x06\x95\x06\x95\x06\x95\x06\x95\x06\x95\x06\x95\x06\x94\x06\x94\x06\x94\x06\x94\x06\x94\x06\x94\x06\x94\x06\x94\x06\x92\x06\x92\x06\x92\x06\x91\x06\x8f\x06\x8f\x06\x8f\x06\x91\x06\x91\x06\x8f\x06\x8f\x06\x8f\x06\x8f\x06\x8f\x06\x8f\x06\x8e\x06\x8e\x06\x8e\x06\x8e\x06\x8e\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8d\x06\x8b\x06\x8a\x06\x8a\x06\x8a\x06\x8a\x06\x8a\x06\x8a\x06\x89\x06\x89\x06\x89\x06\x89\x06\x89\x06\x89\x06\x89\x06\x89\x06\x87\x06\x87\x06\x87\x06\x87\x06\x87\x06\x86\x06\x86\x06\x84\x06\x84\x06\x84\x06\x86\x06\x84\x06\x84\x06\x84\x06\x83\x06\x83\x06\x83\x06\x83\x06\x83\x06\x83\x06\x82\x06\x82\x06\x82\x06\x82\x06\x82\x06\x82\x06\x82\x06\x82\x06\x80\x06\x80\x06\x80\x06\x80\x06\u007f\x06\u007f\x06\u007f\x06\u007f\x06\u007f\x06\u007f\x06[\x03Z\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfeZ\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe[\xfe]\xfe]\xfe]\xfe]\xfe]\xfe]\xfe]\xfe]\xfe]\xfe]\xfe^\xfe^\xfe^\xfe]\xfe]\xfe^\xfe^\xfe]\xfe]\xfe]\xfe]\xfe]\xfe]\xfe]\xfe]\xfe^\xfe^\xfe^\xfe^\xfe^\xfe^\xfe^\xfe^\xfe^\xfe^\xfe^\xfe^\xfe^\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfe`\xfea\xfea\xfea\xfe\xae\xff\x19\x05c\x06c\x06c\x06c\x06b\x06b\x06b\x06b\x06b\x06b\x06a\x06a\x06a\x06a\x06a\x06a\x06_\x06_\x06_\x06_\x06_\x06_\x06_\x06^\x06^\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06\\\x06[\x06[\x06[\x06[\x06Z\x06Z\x06Z\x06Z\x06Z\x06Z\x06X\x06X\x06X\x06X\x06X\x06X\x06X\x06W\x06W\x06U\x06U\x06U\x06U\x06U\x06T\x06T\x06T\x06T\x06T\x06T\x06T\x06T\x06S\x06S\x06S\x06S\x06S\x06S\x06S\x06S\x06S\x06Q\x06Q\x06Q\x06P\x06P\x06P\x06P\x06P\x06P\x06P\x06O\x06O\x06O\x06O\x06O\x06O\x06M\x06M\x06M\x06L\x06L\x06L\x06L\x06L\x06L\x06L\x06L\x06L\x06J\x06J\x06\x14\x06\xe9\x05\xe9\x05\xe9\x05\xea\x05\xea\x05\xe9\x05\xe9\x05\xe9\x05\xe9\x05\xe9\x05\xe8\x05\xe8\x05\xe8\x05\xe8\x05\xe8\x05\xe8\x05\xe8\x05\xe8\x05\xe6\x05\xe6\x05\xe6\x05\xe5\x05\xe5\x05\xe5\x05\xe5\x05\xe5\x05\xe5\x05\xe4\x05\xe4\x05\xe4\x05\xe4\x05\xe4\x05\xe4\x05\xe4\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe2\x05\xe1\x05\xe1\x05\xe1\x05\xe1\x05\xdf\x05\xdf\x05\xdf\x05\xdf\x05\xdf\x05\xdf\x05\xdf\x05\xdf\x05\xde\x05\xde\x05\xde\x05\xde\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdd\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xdb\x05\xda\x05\xda\x05\xda\x05\xda\x05\xda\x05\xda\x05\xda\x05\xda\x05\xd8\x05\xd8\x05\xd7\x05\xd7\x05\xd7\x05\xd7\x05\xd7\x05\xd7\x05\xd6\x05\xd6\x05\xd6\x05\xd6\x05\xd6\x05\xd6\x05\xd4\x05\xd4\x05\xd4\x05\xd4\x05\xd4\x05\xd4\x05\xd4\x05\xd4\x05\xd3\x05\xd3\x05\xd3\x05\xd3\x05\xd2\x05\xd2\x05\xd2\x05\xd2\x05\xd2\x05\xd0\x05\xd0\x05\xd0\x05\xd0\x05\xd0\x05\xd0\x05\xd0\x05\xcf\x05\xcf\x05\xcf\x05\xcf\x05\xcf\x05\xcf\x05\xcd\x05\xcd\x05\xcd\x05\xcf\x05\xcd\x05\xcd\x05\xcd\x05\xcc\x05\xcc\x05\xe8\xff\xd7\xf9\xd7\xf9\xd7\xf9\xd8\xf9\xd8\xf9\xd8\xf9\xd8\xf9\xd8\xf9\xd8\xf9\xd9\xf9\xd9\xf9\xd9\xf9\xd9\xf9\xd9\xf9\xd9\xf9\xd9\xf9\xd9\xf9\xdb\xf9\xdb\xf9\xdc\xf9\xdc\xf9\xdc\xf9\xdc\xf9\xdc\xf9\xdc\xf9\xde\xf9\xde\xf9\xde\xf9\xde\xf9\xde\xf9\xde\xf9\xde\xf9\xde\xf9\xdf\xf9\xdf\xf9\xdf\xf9\xdf\xf9\xe0\xf9\xe0\xf9\xe0\xf9\xe0\xf9\xe0\xf9\xe0\xf9\xe0\xf9\xe0\xf9\xe2\xf9\xe2\xf9\xe2\xf9\xe2\xf9\xe3\xf9\xe3\xf9\xe3\xf9\xe3\xf9\xe3\xf9\xe3\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe4\xf9\xe5\xf9\xe5\xf9\xe5\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe7\xf9\xe8\xf9\xe8\xf9\xe8\xf9\xe8\xf9\xe8\xf9\xe9\xf9\xe9\xf9\xe9\xf9\xe8\xf9\xe9\xf9\xe9\xf9\xe9\xf9\xeb\xf9\xec\xf9\xec\xf9\xeb\xf9\xeb\xf9\xec\xf9\xec\xf9\xec\xf9\xec\xf9\xee\xf9\xee\xf9\xee\xf9\xee\xf9\xee\xf9\xee\xf9\xee\xf9\xee\xf9\xee\xf9\xef\xf9\xef\xf9\xef\xf9\xef\xf9\xf0\xf9\xf0\xf9\xf0\xf9\xf0\xf9\xf0\xf9\xf0\xf9\xf2\xf9\xf2\xf9\xf2\xf9\xf2\xf9\xf2\xf9\xf2\xf9\xf3\xf9\xf3\xf9\xf3\xf9\xf3\xf9\xf3\xf9\xf3\xf9\xf4\xf9\xf4\xf9\xf4\xf9\xf4\xf9\xf4\xf9\xf4\xf9\xf4\xf9\xf4\xf9\xf6\xf9\xf6\xf9\xf6\xf9\xf6\xf9\xf6\xf9\xf7\xf9\xf7\xf9\x94\xfa\x87\xfb\x87\xfb\x87\xfb\x88\xfb\x88\xfb\x88\xfb\x87\xfb\x87\xfb\x88\xfb\x88\xfb\x88\xfb\x88\xfb\x89\xfb\x89\xfb\x89\xfb\x89\xfb\x89\xfb\x89\xfb\x89\xfb\x89\xfb\x89\xfb\x8b\xfb\x8b\xfb\x8b\xfb\x8b\xfb\x8b\xfb\x8b\xfb\x8b\xfb\x8b\xfb\x8c\xfb\x8c\xfb\x8c\xfb\x8c\xfb\x8c\xfb\x8c\xfb\x8c\xfb\x8e\xfb\x8e\xfb\x8e\xfb\x8e\xfb\x8e\xfb\x8e\xfb\x8e\xfb\x8e\xfb\x8e\xfb\x8f\xfb\x8f\xfb\x8f\xfb\x8f\xfb\x8f\xfb\x8f\xfb\x8f\xfb\x8f\xfb\x8f\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x90\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x92\xfb\x93\xfb\x93\xfb\x93\xfb\x93\xfb\x93\xfb\x93\xfb\x93\xfb\x93\xfb\x93\xfb\x93\xfb\x94\xfb\x94\xfb\x94\xfb\x94\xfb\x94\xfb\x94\xfb\x94\xfb\x96\xfb\x96\xfb\x96\xfb\x96\xfb\x96\xfb\x97\xfb\x97\xfb\x97\xfb\x96\xfb\x97\xfb\x97\xfb\x97\xfb\x97\xfb\x97\xfb\x97\xfb\x97\xfb\x97\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x99\xfb\x9a\xfb\x9a\xfb\x9a\xfb\x9a\xfb\x9b\xfb\x9b\xfb\x9b\xfb\x9a\xfb\x9b\xfb\x9b\xfb\x9b\xfb\x9b\xfb\x9b\xfb\x9d\xfb\x9d\xfb\x9d\xfb\x9d\xfb\x9d\xfb\x9d\xfb\x9d\xfb\x9e\xfb\x9e\xfb\x9e\xfb\x9e\xfb\x9e\xfb\x9e\xfb\x9e\xfb\x9e\xfb\x9e\xfb\x9e\xfb\x9e\xfb\xa0\xfb\xa0\xfb\xa0\xfb\xa0\xfb\xa1\xfb\xa1\xfb\xa1\xfb\xa1\xfb\xa1\xfb\xa1\xfb\xa1\xfb\xa1\xfb\xa1\xfb\xa2\xfb\xa2\xfb\xa2\xfb\xa2\xfb\xa2\xfb\xa2\xfb\xa2\xfb\xa2\xfb\xa2\xfb\xa3\xfb\xa3\xfb\xa3\xfb\xa3\xfb\xa3\xfb\xa3\xfb*\xfb\x8f\xfa\x8f\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x90\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x92\xfa\x93\xfa\x93\xfa\x93\xfa\x93\xfa\x93\xfa\x93\xfa\x94\xfa\x94\xfa\x94\xfa\x94\xfa\x94\xfa\x96\xfa\x96\xfa\x96\xfa\x96\xfa\x96\xfa\x97\xfa\x97\xfa\x97\xfa\x99\xfa\x99\xfa\x99\xfa\x97\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x99\xfa\x9a\xfa\x9a\xfa\x9a\xfa\x9a\xfa\x9a\xfa\x9a\xfa\x9b\xfa\x9b\xfa\x9b\xfa\x9b\xfa\x9b\xfa\x9d\xfa\x9d\xfa\x9d\xfa\x9e\xfa\x9e\xfa\x9e\xfa\x9d\xfa\x9e\xfa\x9e\xfa\x9e\xfa\x9e\xfa\x9e\xfa\x9e\xfa\x9e\xfa\x9f\xfa\x9f\xfa\x9f\xfa\x9f\xfa\x9f\xfa\x9f\xfa\xa1\xfa\xa1\xfa\xa1\xfa\xa1\xfa\xa1\xfa\xa2\xfa\xa2\xfa\xa2\xfa\xa2\xfa\xa2\xfa\xa2\xfa\xa2\xfa\xa4\xfa\xa4\xfa\xa4\xfa\xa4\xfa\xa4\xfa\xa4\xfa\xa5\xfa\xa5\xfa\xa5\xfa\xa5\xfa\xa5\xfa\xa5\xfa\xa6\xfa\xa6\xfa\xa6\xfa\xa6\xfa\xa6\xfa\xa6\xfa\xa6\xfa\xa6\xfa\xa8\xfa\xa8\xfa\xa8\xfa\xa8\xfa\xa8\xfa\xa8\xfa\xa8\xfa\xa9\xfa\xa9\xfa\xa9\xfa\xab\xfa\xab\xfa\xab\xfa\xab\xfa\xab\xfa\xab\xfa\xab\xfa\xab\xfa\xab\xfa\xac\xfa\xac\xfa\xac\xfa\xac\xfa\xad\xfa\xad\xfa\xad\xfa\xad\xfa\xad\xfa\xad\xfa\xaf\xfa\xaf\xfa\xaf\xfa\xaf\xfa\xaf\xfa\xaf\xfa\xb0\xfa\xb0\xfa\xb0\xfa\xb0\xfa\xb0\xfa\xb0\xfa\xb0\xfa\xb0\xfa\xb0\xfa\xb1\xfa\xb1\xfa\xb1\xfa\xb1\xfa\xb1\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb3\xfa\xb4\xfa\xb4\xfa\xb4\xfa\xb4\xfa\xb4\xfa\xb4\xfa\xb6\xfa\xb6\xfa\xb6\xfa\xb6\xfa\xb6\xfa\xb6\xfa\xb6\xfa\xb6\xfa\xb7\xfa\xb7\xfa\xb7\xfa\xb7\xfa\xb7\xfa\xb7\xfa\xb7\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xb8\xfa\xba\xfa\xba\xfa\xba\xfa\xba\xfa\xba\xfa\xba\xfa\xba\xfah\xfd\xc0\x04\xa3\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa1\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\xa0\x05\x9f\x05\x9f\x05\x9f\x05\x9f\x05\x9d\x05\x9d\x05\x9f\x05\x9f\x05\x9f\x05\x9d\x05\x9d\x05\x9d\x05\x9c\x05\x9c\x05\x9c\x05\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x9a\x05\x99\x05\x99\x05\x99\x05\x99\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x98\x05\x96\x05\x96\x05\x96\x05\x96\x05\x96\x05\x96\x05\x96\x05\x96\x05\x95\x05\x94\x05\x94\x05\x94\x05\x94\x05\x94\x05\x94\x05\x94\x05\x94\x05\x94\x05\x92\x05\x92\x05\x92\x05\x91\x05\x91\x05\x91\x05\x91\x05\x91\x05\x91\x05\x91\x05\x8f\x05\x8f\x05\x8f\x05\x8f\x05\x8f\x05\x8f\x05\x8e\x05\x8e\x05\x8e\x05\x8e\x05\x8d\x05\x8d\x05\x8d\x05\x8d\x05\x8d\x05\x8d\x05\x8d\x05\x8d\x05\x8d\x05\x8d\x05\x8b\x05\x8b\x05\x8b\x05\x8a\x05\x8a\x05\x8a\x05\x8a\x05\x8a\x05\x8a\x05\x8a\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x88\x05\x87\x05\x87\x05\x87\x05\x86\x05\x86\x05\x86\x05\x86\x05\x86\x05\x86\x05\x86\x05\x86\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x84\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x83\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x82\x05\x80\x05\x80\x05\x80\x05\u007f\x05\u007f\x05\u007f\x05\u007f\x05\u007f\x05}\x05}\x05}\x05}\x05}\x05}\x05}\x05}\x05}\x05|\x05|\x05|\x05|\x05|\x05{\x05{\x05{\x05{\x05{\x05{\x05{\x05{\x05y\x05y\x05y\x05x\x05x\x05x\x05x\x05x\x05x\x05w\x05w\x05w\x05w\x05u\x05u\x05u\x05u\x05u\x05u\x05u\x05u\x05u\x05t\x05t\x05t\x05t\x05t\x05t\x05r\x05r\x05r\x05q\x05q\x05q\x05q\x05q\x05q\x05q\x05q\x05p\x05p\x05p\x05p\x05p\x05p\x05n\x05n\x05n\x05n\x05m\x05m\x05m\x05m\x05m\x05m\x05m\x05m\x05m\x05l\x05l\x05l\x05p\x05w\x05w\x05w\x05w\x05w\x05w\x05u\x05u\x05u\x05u\x05u\x05u\x05t\x05t\x05t\x05t\x05t\x05r\x05r\x05r\x05r\x05r\x05r\x05r\x05r\x05r\x05q\x05q\x05q\x05p\x05p\x05p\x05p\x05n\x05n\x05n\x05n\x05n\x05n\x05m\x05m\x05m\x05m\x05m\x05m\x05m\x05l\x05l\x05l\x05l\x05l\x05l\x05l\x05l\x05j\x05j\x05j\x05j\x05i\x05i\x05i\x05g\x05g\x05g\x05g\x05g\x05g\x05g\x05f\x05f\x05f\x05f\x05f\x05f\x05f\x05f\x05f\x05f\x05e\x05e\x05e\x05e\x05c\x05c\x05c\x05c\x05c\x05b\x05b\x05b\x05b\x05b\x05b\x05a\x05a\x05a\x05a\x05a\x05a\x05_\x05_\x05_\x05_\x05_\x05^\x05^\x05^\x05^\x05^\x05^\x05^\x05^\x05\\\x05\\\x05\\\x05\\\x05[\x05[\x05[\x05[\x05[\x05[\x05[\x05[\x05Z\x05Z\x05Z\x05Z\x05X\x05X\x05X\x05X\x05X\x05X\x05X\x05X\x05W\x05W\x05W\x05U\x05U\x05U\x05U\x05U\x05U\x05T\x05T\x05T\x05T\x05T\x05T\x05S\x05S\x05S\x05S\x05S\x05S\x05S\x05Q\x05Q\x05Q\x05P\x05P\x05P\x05P\x05P\x05P\x05P\x05O\x05O\x05O\x05O\x05O\x05O\x05O\x05O\x05M\x05M\x05M\x05M\x05M\x05M\x05L\x05J\x05J\x05J\x05J\x05J\x05J\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05I\x05H\x05H\x05H\x05H\x05H\x05H\x05F\x05F\x05F\x05F\x05F\x05F\x05F\x05E\x05D\x05D\x05D\x05D\x05D\x05D\x05D\x05D\x05B\x05B\x05B\x05A\x05A\x05A\x05B\x05B\x05A\x05A\x05A\x05A\x05?\x05?\x05?\x05?\x05?\x05?\x05>\x05>\x05>\x05>\x05>\x05=\x05=\x05=\x05=\x05=\x05<\x05<\x05<\x05<\x05<\x05<\x05<\x05<\x05:\x05:\x05:\x05:\x05:\x05:\x059\x059\x059\x059\x058\x058\x058\x058\x058\x058\x058\x058\x056\x056\x056\x055\x055\x055\x055\x055\x055\x055\x053\x053\x053\x053\x053\x053\x053\x053\x052\x052\x052\x052\x052\x052\x051\x051\x051\x051\x051\x05/\x05/\x05.\x05.\x05.\x05.\x05.\x05.\x05.\x05.\x05.\x05-\x05-\x05-\x05-\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05+\x05*\x05*\x05*\x05(\x05(\x05(\x05(\x05(\x05(\x05(\x05(\x05'\x05'\x05'\x05'\x05'\x05'\x05&\x05&\x05&\x05&\x05&\x05&\x05$\x05$\x05$\x05$\x05$\x05$\x05$\x05#\x05#\x05#\x05#\x05#\x05\"\x05\"\x05\"\x05\"\x05\"\x05\"\x05\x1c\x04\x83\x03\x83\x03\x82\x03\x82\x03\x82\x03\x82\x03\x82\x03\x82\x03\x82\x03\x82\x03\x82\x03\x82\x03\x80\x03\x80\x03\x80\x03\x80\x03\x80\x03\x80\x03\x80\x03\u007f\x03\u007f\x03\u007f\x03\u007f\x03\u007f\x03\u007f\x03\u007f\x03\u007f\x03\u007f\x03\u007f\x03\u007f\x03~\x03~\x03~\x03~\x03~\x03~\x03~\x03~\x03~\x03~\x03~\x03~\x03}\x03}\x03}\x03}\x03{\x03{\x03{\x03{\x03{\x03{\x03{\x03{\x03{\x03{\x03{\x03{\x03{\x03{\x03z\x03z\x03z\x03z\x03z\x03z\x03x\x03x\x03x\x03x\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03w\x03v\x03v\x03v\x03v\x03v\x03t\x03t\x03t\x03t\x03t\x03s\x03s\x03t\x03s\x03s\x03s\x03s\x03s\x03s\x03s\x03s\x03s\x03r\x03r\x03r\x03r\x03r\x03r\x03r\x03r\x03r\x03r\x03r\x03p\x03p\x03p\x03p\x03p\x03p\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03o\x03m\x03m\x03m\x03m\x03m\x03m\x03m\x03m\x03m\x03l\x03l\x03l\x03l\x03l\x03l\x03l\x03l\x03l\x03l\x03k\x03k\x03k\x03k\x03k\x03k\x03k\x03k\x03i\x03i\x03i\x03i\x03i\x03i\x03i\x03i\x03i\x03h\x03h\x03h\x03h\x03h\x03h\x03h\x03h\x03h\x03h\x03h\x03h\x03f\x03f\x03f\x03f\x03e\x03e\x03e\x03e\x03e\x03e\x03e\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03d\x03b\x03b\x03b\x03b\x03b\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03a\x03`\x03`\x03`\x03`\x03`\x03`\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03[\x03[\x03[\x03[\x03[\x03[\x03[\x03[\x03[\x03Z\x03Z\x03Z\x03Z\x03Z\x03Z\x03Z\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03W\x03W\x03W\x03W\x03W\x03W\x03W\x03W\x03W\x03V\x03V\x03V\x03V\x03V\x03V\x03V\x03V\x03V\x03V\x03U\x03U\x03U\x03U\x03U\x03U\x03U\x03U\x03U\x03S\x03S\x03S\x03S\x03S\x03S\x03R\x03R\x03R\x03R\x03R\x03R\x03R\x03R\x03R\x03P\x03P\x03P\x03P\x03P\x03P\x03P\x03P\x03P\x03O\x03O\x03O\x03O\x03O\x03O\x03O\x03O\x03O\x03O\x03N\x03N\x03N\x03N\x03N\x03N\x03N\x03N\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03K\x03K\x03K\x03K\x03J\x03J\x03J\x03J\x03J\x03J\x03J\x03J\x03J\x03H\x03H\x03H\x03H\x03H\x03H\x03H\x03H\x03H\x03H\x03H\x03G\x03G\x03G\x03G\x03G\x03G\x03G\x03G\x03G\x03E\x03E\x03E\x03E\x03E\x03E\x03D\x03D\x03D\x03D\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03C\x03A\x03A\x03A\x03A\x03A\x03A\x03A\x03@\x03@\x03@\x03@\x03@\x03@\x03@\x03>\x03>\x03>\x03>\x03>\x03>\x03=\x03=\x03=\x03>\x03>\x03=\x03=\x03=\x03=\x03=\x03=\x03=\x03=\x03=\x03=\x03<\x03<\x03<\x03<\x03<\x03<\x03<\x03<\x03<\x03:\x03:\x03:\x03:\x03:\x039\x039\x039\x039\x039\x039\x039\x039\x039\x039\x039\x039\x039\x038\x038\x038\x038\x038\x038\x038\x038\x038\x038\x038\x036\x036\x036\x036\x035\x035\x035\x035\x035\x035\x035\x035\x035\x035\x035\x033\x033\x033\x033\x033\x033\x033\x033\x033\x032\x032\x032\x032\x032\x032\x032\x031\x031\x031\x031\x031\x031\x031\x031\x03/\x03/\x03/\x03/\x03/\x03/\x03/\x03/\x03/\x03.\x03.\x03.\x03.\x03.\x03.\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03-\x03+\x03+\x03+\x03+\x03+\x03+\x03*\x03*\x03*\x03+\x03+\x03*\x03*\x03*\x03*\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03(\x03'\x03'\x03'\x03(\x03'\x03'\x03'\x03'\x03&\x03&\x03&\x03&\x03&\x03&\x03&\x03&\x03&\x03&\x03&\x03&\x03$\x03$\x03$\x03#\x03#\x03#\x03#\x03#\x03#\x03#\x03#\x03#\x03#\x03#\x03\"\x03\"\x03\"\x03\"\x03\"\x03\"\x03\"\x03\"\x03\"\x03 \x03 \x03 \x03 \x03 \x03 \x03 \x03 \x03 \x03\x1f\x03\x1f\x03\x1f\x03 \x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1f\x03\x1d\x03\x1d\x03\x1d\x03\x1d\x03\x1d\x03\x1d\x03\x1d\x03\x1d\x03\x1d\x03\x1c\x03\x1c\x03\x1c\x03\x1c\x03\x1c\x03\x1c\x03\x1c\x03\x1c\x03\x1c\x03\x1b\x03\x1b\x03\x1b\x03\x1b\x03\x1b\x03\x1b\x03\x1b\x03\x1b\x03\x1b\x03\x19\x03\x19\x03\x19\x03\x19\x03\x19\x03\x19\x03\x19\x03\x19\x03\x18\x03\x18\x03\x18\x03\x18\x03\x16\x03\x16\x03\x16\x03\x16\x03\x16\x03\x16\x03\x16\x03\x16\x03\x16\x03\x16\x03\x16\x03\x15\x03\x15\x03\x15\x03\x16\x03\x15\x03\x15\x03\x15\x03\x15\x03\x15\x03\x15\x03\x15\x03\x15\x03\x15\x03\x14\x03\x14\x03\x14\x03\x14\x03\x14\x03\x12\x03\x12\x03\x12\x03\x12\x03\x12\x03\x12\x03\x12\x03\x12\x03\x12\x03\x12\x03\x11\x03\x11\x03\x11\x03\x11\x03\x11\x03\x11\x03\x11\x03\x11\x03\x11\x03\x11\x03\x11\x03\x11\x03\x10\x03\x10\x03\x10\x03\x10\x03\x10\x03\x10\x03\x10\x03\x10\x03\x10\x03\x0e\x03\x0e\x03\x0e\x03\x0e\x03\x0e\x03\x0e\x03\r\x03\r\x03\r\x03\r\x03\r\x03\r\x03\r\x03\r\x03\v\x03\v\x03\v\x03\v\x03\v\x03\v\x03\v\x03\v\x03\v\x03\v\x03\n\x03\n\x03\n\x03\n\x03\n\x03\n\x03\n\x03\n\x03\n\x03\n\x03\n\x03\t\x03\t\x03\t\x03\t\x03\a\x03\a\x03\a\x03\a\x03\a\x03\a\x03\a\x03\a\x03\a\x03\a\x03\a\x03\a\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x06\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x05\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xfe\x02\xfc\x02\xfc\x02\xfc\x02\xfe\x02\xfe\x02\xfc\x02\xfc\x02\xfc\x02\xfc\x02\xfc\x02\xfc\x02\xfc\x02\xfc\x02\xfc\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xfa\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf8\x02\xf7\x02\xf7\x02\xf7\x02\xf7\x02\xf7\x02\xf7\x02\xf7\x02\xf7\x02\xf7\x02\xf5\x02\xf5\x02\xf5\x02\xf7\x02\xf7\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf5\x02\xf4\x02\xf4\x02\xf4\x02\xf4\x02\xf4\x02\xf4\x02\xf4\x02\xf4\x02\xf4\x02\xf3\x02\xf3\x02\xf3\x02\xf3\x02\xf3\x02\xf3\x02\xf3\x02\xf1\x02\xf1\x02\xf1\x02\xf1\x02\xf1\x02\xf1\x02\xf1\x02\xf1\x02\xf0\x02\xf0\x02\xf0\x02\xf1\x02\xf0\x02\xf0\x02\xf0\x02\xf0\x02\xf0\x02\xf0\x02\xf0\x02\xf0\x02\xef\x02\xef\x02\xef\x02\xef\x02\xef\x02\xef\x02\xef\x02\xef\x02\xef\x02\xed\x02\xed\x02\xed\x02\xed\x02\xed\x02\xed\x02\xed\x02\xed\x02\xed\x02\xec\x02\xec\x02\xec\x02\xec\x02\xec\x02\xec\x02\xec\x02\xec\x02\xec\x02\xea\x02\xea\x02\xea\x02\xea\x02\xea\x02\xea\x02\xea\x02\xea\x02\xea\x02\xea\x02\xe9\x02\xe9\x02\xe9\x02\xe9\x02\xe9\x02\xe9\x02\xe9\x02\xe9\x02\xe9\x02\xe8\x02\xe8\x02\xe8\x02\xe8\x02\xe8\x02\xe8\x02L\x03/\x04<\x04<\x04<\x04<\x04:\x04:\x04:\x04:\x04:\x04:\x049\x049\x049\x049\x049\x049\x048\x048\x048\x048\x048\x048\x048\x048\x046\x046\x046\x046\x046\x046\x045\x045\x045\x045\x045\x045\x04\xda\xff0\xfd0\xfd0\xfd0\xfd0\xfd0\xfd1\xfd1\xfd1\xfd0\xfd0\xfd1\xfd1\xfd1\xfd1\xfd1\xfd1\xfd1\xfd3\xfd3\xfd3\xfd3\xfd3\xfd3\xfd3\xfd3\xfd3\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd4\xfd\x1a\xff\xbb\x03+\x04+\x04+\x04*\x04*\x04*\x04*\x04*\x04*\x04(\x04(\x04(\x04(\x04(\x04(\x04(\x04'\x04'\x04'\x04'\x04'\x04'\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04&\x04$\x04$\x04$\x04$\x04$\x04$\x04S\xff\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xde\xfb\xe0\xfb\xe0\xfb\xe0\xfb\xe0\xfb\xe0\xfb\xe0\xfb\xe0\xfb\xe0\xfb\xe1\xfb\xe1\xfb\xe1\xfb\xe1\xfb\xe1\xfb\xe1\xfb\xe3\xfb\xe3\xfb\xe3\xfb\xe3\xfb\xe3\xfb\xe3\xfb\xe3\xfb\xe4\xfb\xe4\xfb\xe4\xfb\xe4\xfb\xe4\xfb\xe5\xfb\xe5\xfb\xe5\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe7\xfb\xe8\xfb\xe8\xfb\xe8\xfb\xe8\xfb\xe8\xfb\xe8\xfb\xe9\xfb\xe9\xfb\xe9\xfb\xe9\xfb\xe9\xfb\xe9\xfb\xeb\xfb\xeb\xfb\xeb\xfb\xeb\xfb\xeb\xfb\xeb\xfb\xeb\xfb\xeb\xfb\xeb\xfb\xec\xfb\xec\xfb\xec\xfb\xec\xfb\xec\xfb\xee\xfb\xee\xfb\xee\xfb'\x00\x11\x04\x11\x04\x11\x04\x11\x04\x11\x04\x11\x04\x11\x04\x11\x04\x10\x04\x10\x04\x10\x04\x10\x04\x10\x04\x10\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\x0e\x04\r\x04\r\x04\r\x04\r\x04\r\x04\r\x04\r\x04\v\x04\v\x04\v\x04\v\x04\v\x04\v\x04\n\x04\n\x04\n\x04\n\x04\n\x04/\x00\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf7\xfb\xf9\xfb\xf9\xfb\xf9\xfb\xf9\xfb\xf9\xfb\xf9\xfb\xfa\xfb\xfa\xfb\xfa\xfb\xfa\xfb\xfa\xfb\xfa\xfb\xfa\xfb\xfb\xfb\xfb\xfb\xfb\xfb\xfb\xfb\xfb\xfb\xfb\xfb\xfb\xfb\xfb\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfd\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xfe\xfb\xe8\xfc!\xfe!\xfe!\xfe!\xfe!\xfe!\xfe!\xfe!\xfe!\xfe\"\xfe\"\xfe\"\xfe\"\xfe\"\xfe\"\xfe\"\xfe\"\xfe\"\xfe\"\xfe\"\xfe\"\xfe\"\xfe\"\xfe\"\xfe\"\xfe\"\xfe#\xfe#\xfe#\xfe#\xfe#\xfe#\xfe#\xfe#\xfe#\xfe#\xfe#\xfe#\xfe\x94\xfd\x9f\xfc\x9f\xfc\x9f\xfc\x9f\xfc\x9f\xfc\xa0\xfc\xa0\xfc\xa0\xfc\xa0\xfc\xa0\xfc\xa0\xfc\xa2\xfc\xa2\xfc\xa2\xfc\xa2\xfc\xa2\xfc\xa2\xfc\xa2\xfc\xa2\xfc\xa2\xfc\xa3\xfc\xa3\xfc\xa3\xfc\xa3\xfc\xa3\xfc\xa3\xfc\xa3\xfc\xa3\xfc\xa5\xfc\xa5\xfc\xa5\xfc\xa5\xfc\xa5\xfc\xa5\xfc\xa5\xfc\xa6\xfc\xa6\xfc\xa6\xfc\xe8\xfe\xbe\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xef\x03\xed\x03\xed\x03\xed\x03\xed\x03\xed\x03\xec\x03\xec\x03\xec\x03\xec\x03\xec\x03\xec\x03\xea\x03\xea\x03\xea\x03\xea\x03\xea\x03\xea\x03\xea\x03\xe9\x03\xe9\x03\xe9\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe8\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe6\x03\xe5\x03\xe5\x03\xe5\x03\xe5\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe3\x03\xe2\x03\xe2\x03\xe2\x03\xe2\x03\xe2\x03\xe2\x03\xe2\x03\xe2\x03\xe1\x03\xe1\x03\xe1\x03\xe1\x03\xe1\x03\xe1\x03\xdf\x03\xdf\x03\xdf\x03\xdf\x03\xdf\x03\xdf\x03\xde\x03\xde\x03\xde\x03~\x00\b\xfd\b\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\v\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\f\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfdi\xfd\x95\x00\xd6\x01\xd6\x01\xd6\x01\xd6\x01\xd6\x01\xd6\x01\xd6\x01\xd6\x01\xd6\x01\xd6\x01\xd6\x01\xd6\x01\xd6\x01\xd6\x01\xd6\x01\xd4\x01\xd4\x01\xd4\x01\xd4\x01\xd4\x01\xd4\x01\xd4\x01\xd4\x01\xd4\x01\xd3\x01\xd3\x01\xd3\x01\xd4\x01\xd4\x01\xd4\x01\xd4\x01\xd4\x01\xd4\x01\xd3\x01\xd3\x01\xd3\x01\xd3\x01\xd3\x01\xd3\x01f\x02\xa0\x03\xab\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa7\x03\xa5\x03\xa5\x03\xa5\x03\xa5\x03\xa5\x03\xa4\x03\xa4\x03\xa4\x03\xa4\x03\xa4\x03\xa4\x03\xa3\x03\xa3\x03\xa3\x03\x9e\xff?\xfc?\xfc?\xfc?\xfc?\xfc?\xfc?\xfc?\xfc?\xfc@\xfc@\xfc@\xfc@\xfc@\xfcB\xfcB\xfcB\xfcB\xfcB\xfcB\xfcB\xfcB\xfcB\xfcC\xfcC\xfcC\xfcC\xfcE\xfcE\xfcE\xfcE\xfcE\xfcE\xfcE\xfcE\xfcE\xfcF\xfcF\xfcF\xfc\x04\xfd\xb1\x01S\x03R\x03R\x03R\x03R\x03R\x03R\x03P\x03P\x03P\x03P\x03P\x03P\x03P\x03P\x03P\x03O\x03O\x03O\x03O\x03O\x03O\x03O\x03O\x03N\x03N\x03N\x03N\x03N\x03N\x03N\x03L\x03L\x03L\x03L\x03L\x03L\x03L\x03K\x03K\x03K\x03\x8d\x03\xaf\x03\xaf\x03\xaf\x03\xaf\x03\xaf\x03\xaf\x03\xaf\x03\xaf\x03\xae\x03\xae\x03\xae\x03\xae\x03\xae\x03\xae\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xac\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xab\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xaa\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03\xa8\x03t\x00\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x8a\xfd\x8a\xfd\x8a\xfd\x8a\xfd\x8a\xfd\x8a\xfd\x8a\xfd\x8a\xfd\x8c\xfd\x8c\xfd\x8c\xfd\x8c\xfd\x8c\xfd\x8c\xfd\xe5\xff\x9d\x03\x9d\x03\x9d\x03\x9c\x03\x9c\x03\x9c\x03\x9c\x03\x9c\x03\x9c\x03\x9c\x03\x9c\x03\x9a\x03\x9a\x03\x9a\x03\x9a\x03\x9a\x03\x9a\x03\x99\x03\x99\x03\x99\x03\x99\x03\x99\x03\x99\x03\x98\x03\x98\x03\x98\x03\x98\x03\x98\x03\x98\x03\x98\x03\x98\x03\x96\x03\x96\x03\x96\x03\x96\x03\x96\x03\x96\x03\x95\x03\x95\x03\x95\x03\x95\x03\xe8\x01\\\xfd\xb6\xfc\xb6\xfc\xb6\xfc\xb8\xfc\xb8\xfc\xb8\xfc\xb8\xfc\xb8\xfc\xb8\xfc\xb8\xfc\xb8\xfc\xb8\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbc\xfc\xbc\xfc\xbc\xfc\xbc\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc?\xfd\xd1\x00#\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02 \x02 \x02 \x02 \x02 \x02 \x02 \x02 \x02 \x02 \x02 \x02 \x02 \x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1d\x02\x1d\x02\x1d\x02\x1d\x02\x1d\x02\x1d\x02\x1d\x02\x1d\x02\x1d\x02z\x02f\x03\x80\x03\x80\x03\x80\x03\x80\x03\x80\x03\x80\x03\x80\x03\u007f\x03\u007f\x03\u007f\x03\u007f\x03\u007f\x03~\x03~\x03~\x03~\x03~\x03~\x03~\x03}\x03}\x03}\x03}\x03}\x03}\x03{\x03{\x03{\x03{\x03{\x03{\x03{\x03{\x03{\x03z\x03z\x03z\x03z\x03z\x03x\x03x\x03x\x03-\x01\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x02\xfe\x02\xfe\x02\xfe\x02\xfe\x02\xfe\x02\xfe\x02\xfe\x02\xfe\x02\xfe\x02\xfe\x02\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x04\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x05\xfe\x06\xfe\x06\xfe\x06\xfe3\xfd\x91\xfc\x91\xfc\x93\xfc\x93\xfc\x93\xfc\x93\xfc\x94\xfc\x94\xfc\x94\xfc\x94\xfc\x94\xfc\x94\xfc\x94\xfc\x94\xfc\x95\xfc\x95\xfc\x95\xfc\x95\xfc\x95\xfc\x95\xfc\x95\xfc\x97\xfc\x97\xfc\x97\xfc\x97\xfc\x97\xfc\x97\xfc\x98\xfc\x98\xfc\x98\xfc\x98\xfc\x98\xfc\x98\xfc\x98\xfc\x98\xfc\x9a\xfc\x9a\xfc\x9a\xfc\x9a\xfc\x9a\xfc\x9a\xfc\x9b\xfc\xf0\xfck\x01`\x03`\x03`\x03`\x03`\x03`\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03^\x03]\x03]\x03]\x03]\x03]\x03]\x03]\x03[\x03[\x03[\x03[\x03[\x03[\x03[\x03[\x03Z\x03Z\x03Z\x03Z\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03Y\x03W\x03W\x03-\x03\x03\x03\x03\x03\x03\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x02\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xff\x02\xfe\x02\xfe\x02\xfe\x02\xfe\x02\xfe\x02\xfe\x02\xfe\x02\xfe\x02\xfe\x02\xfc\x02\xfc\x02\xfc\x02\xfc\x02\xfc\x02\xfc\x02\xfc\x02\xfb\x02\xfb\x02\xfb\x02\xfb\x02\xf3\x01\xa3\xffz\xffz\xffz\xffz\xffz\xffz\xffz\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff{\xff}\xff}\xff}\xff}\xff}\xff}\xffJ\xffw\xfd\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xb9\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbb\xfc\xbc\xfc\xbc\xfc\xbc\xfc\xbc\xfc\xbc\xfc\xbc\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbd\xfc\xbf\xfc\xbf\xfc\xbf\xfc\xbf\xfc\xbf\xfc\xbf\xfc\xbf\xfc\xc0\xfc\xc0\xfc\xc0\xfc\xc0\xfc\xc0\xfc\xc0\xfc\xc2\xfc\xc2\xfc\xc2\xfc\xc2\xfc\xc2\xfcJ\xfd\xd1\xfd\xd1\xfd\xd2\xfd\xd2\xfd\xd2\xfd\xd2\xfd\xd2\xfd\xd2\xfd\xd2\xfd\xd2\xfd\xd2\xfd\xd3\xfd\xd3\xfd\xd3\xfd\xd3\xfd\xd3\xfd\xd3\xfd\xd3\xfd\xd3\xfd\xd3\xfd\xd3\xfd\xd5\xfd\xd5\xfd\xd5\xfd\xd5\xfd\xd5\xfd\xd5\xfd\xd5\xfd\xd5\xfd\xd5\xfd\xd6\xfd\xd6\xfd\xd6\xfd\xd6\xfd\xd6\xfd\xd6\xfd\xd6\xfd\xd6\xfd\xd6\xfd\xd6\xfd\xd6\xfd\xd8\xfd\xd8\xfd\xd8\xfd\xd8\xfd\xd8\xfd\xa7\xfd\x83\xfd\x83\xfd\x83\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x85\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x86\xfd\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x88\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x89\xfd\x8a\xfd\x8a\xfd\x8a\xfd\x8a\xfd\x8a\xfd\x8a\xfd\x8a\xfd,\xfd\xf9\xfc\xf9\xfc\xfa\xfc\xfa\xfc\xfa\xfc\xfa\xfc\xfa\xfc\xfa\xfc\xfa\xfc\xfb\xfc\xfb\xfc\xfb\xfc\xfb\xfc\xfb\xfc\xfb\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfd\xfc\xfe\xfc\xfe\xfc\xfe\xfc\xfe\xfc\xfe\xfc\xfe\xfc\xfe\xfc\xfe\xfc\xfe\xfc\xfe\xfc\x00\xfd\x00\xfd\x00\xfd\x00\xfd\x00\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x02\xfdI\xfdP\x00\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x9b\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x99\x01\x98\x01\x98\x01\x98\x01\x98\x01\x98\x01\x98\x01\x98\x01\x98\x01\x98\x01\x97\x01\x97\x01$\x02\xdf\x02\xdf\x02\xde\x02\xde\x02\xde\x02\xde\x02\xde\x02\xde\x02\xde\x02\xdd\x02\xdd\x02\xdd\x02\xdd\x02\xdd\x02\xdd\x02\xdd\x02\xdd\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xd8\x02\xd8\x02\xd8\x02\xd8\x02\xd8\x02\xd8\x02\xd8\x02\xd8\x02\xd8\x02\xd7\x02\xd7\x02\xd7\x02\xd7\x02\xd7\x02\xd7\x02\"\x02\xbc\xfe\xb0\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb1\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb2\xfd\xb4\xfd\xb4\xfd\xb4\xfd\xb4\xfd\xb4\xfd\xb4\xfd\xb4\xfd\xb4\xfd\xb4\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb5\xfd\xb6\xfd\xb6\xfd\xb6\xfd\xb6\xfd\xb6\xfd\xb6\xfd\xb6\xfd\xb6\xfd\xb6\xfd\xb6\xfd\xb8\xfd\xb8\xfd_\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x01\xfd\x02\xfd\x02\xfd\x02\xfd\x02\xfd\x04\xfd\x04\xfd\x04\xfd\x04\xfd\x04\xfd\x04\xfd\x04\xfd\x04\xfd\x04\xfd\x05\xfd\x05\xfd\x05\xfd\x05\xfd\x05\xfd\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x06\xfd\x06\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\b\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\t\xfd\v\xfd\v\xfd\v\xfd\v\xfd\f\xfd\f\xfd\f\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\r\xfd\x0f\xfd\x0f\xfd\x0f\xfd\x0f\xfd\x0f\xfd\x0f\xfd\x0f\xfd\x0f\xfd\x10\xfd\x10\xfd\x10\xfd\x10\xfd\x10\xfd\x10\xfd\x11\xfd\x11\xfd\x11\xfd\x11\xfd\x11\xfd\x11\xfd\x11\xfd\x11\xfd\x11\xfd\x13\xfd\x13\xfd\x13\xfd\x13\xfd\x13\xfd\x14\xfd\x14\xfd\x14\xfd\x14\xfd\x14\xfd\x14\xfd\x8d\xff#\x02#\x02#\x02#\x02#\x02#\x02#\x02#\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02 \x02 \x02 \x02 \x02 \x02 \x02 \x02 \x02 \x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1d\x02\x1d\x02\x1d\x02\x1d\x02\x1d\x02\x1d\x02\x1d\x02\x1d\x02\x1d\x02\x1c\x02\x1c\x02w\x02\xdf\x02\xdf\x02\xdf\x02\xdf\x02\xdf\x02\xdf\x02\xde\x02\xde\x02\xde\x02\xde\x02\xde\x02\xde\x02\xdd\x02\xdd\x02\xdd\x02\xdd\x02\xdd\x02\xdd\x02\xdd\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xdb\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xda\x02\xd8\x02\xd8\x02\xd8\x02\xd8\x02\xd8\x02\xd8\x02\xd8\x02\xd8\x02\xd8\x02\xd8\x02\xd8\x02\xd7\x02\xd7\x02\xd7\x02\xd7\x02\xd6\x02\xd6\x02\xd6\x02E\x02\x8d\xfe0\xfd0\xfd0\xfd0\xfd0\xfd0\xfd1\xfd1\xfd1\xfd1\xfd1\xfd1\xfd3\xfd3\xfd3\xfd3\xfd3\xfd3\xfd3\xfd3\xfd4\xfd4\xfd4\xfd4\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd5\xfd7\xfd7\xfd7\xfd7\xfd7\xfd7\xfd8\xfd8\xfd8\xfd8\xfd8\xfd8\xfd9\xfd9\xfd9\xfd9\xfd9\xfdU\xff\xc9\x02\xc9\x02\xc9\x02\xc9\x02\xc9\x02\xc9\x02\xc9\x02\xc9\x02\xc8\x02\xc8\x02\xc8\x02\xc8\x02\xc8\x02\xc7\x02\xc7\x02\xc7\x02\xc7\x02\xc7\x02\xc7\x02\xc7\x02\xc7\x02\xc7\x02\xc5\x02\xc5\x02\xc5\x02\xc5\x02\xc4\x02\xc4\x02\xc4\x02\xc4\x02\xc4\x02\xc4\x02\xc4\x02\xc4\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc2\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xc0\x02\xc0\x02\xc0\x02\xc0\x02\xc0\x02\x15\x01x\xfdB\xfdB\xfdB\xfdB\xfdB\xfdB\xfdC\xfdC\xfdC\xfdC\xfdC\xfdC\xfdE\xfdE\xfdE\xfdE\xfdE\xfdE\xfdF\xfdF\xfdF\xfdF\xfdF\xfdF\xfdF\xfdF\xfdF\xfdG\xfdG\xfdG\xfdG\xfdI\xfdI\xfdI\xfdI\xfdI\xfdI\xfdI\xfdI\xfdJ\xfdJ\xfdJ\xfdJ\xfdJ\xfdJ\xfdJ\xfdJ\xfdK\xfdK\xfdK\xfdK\xfdw\xfe\x05\x02\xb3\x02\xb3\x02\xb3\x02\xb2\x02\xb2\x02\xb2\x02\xb2\x02\xb2\x02\xb2\x02\xb2\x02\xb0\x02\xb0\x02\xb0\x02\xb0\x02\xb0\x02\xb0\x02\xb0\x02\xb0\x02\xaf\x02\xaf\x02\xaf\x02\xaf\x02\xaf\x02\xaf\x02\xae\x02\xae\x02\xae\x02\xae\x02\xae\x02\xae\x02\xae\x02\xae\x02\xac\x02\xac\x02\xac\x02\xac\x02\xac\x02\xac\x02\xac\x02\xab\x02\xab\x02\xab\x02\xab\x02\xab\x02\xaa\x02\xaa\x02\xaa\x02\xaa\x02\xaa\x02\xaa\x02\xaa\x02\r\x02\x9b\xfel\xfdl\xfdl\xfdl\xfdl\xfdl\xfdm\xfdm\xfdm\xfdm\xfdm\xfdm\xfdo\xfdo\xfdo\xfdo\xfdo\xfdo\xfdp\xfdp\xfdp\xfdp\xfdp\xfdp\xfdp\xfdp\xfdp\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfdr\xfds\xfds\xfds\xfds\xfdt\xfdt\xfdt\xfdt\xfdt\xfdt\xfdt\xfdt\xfdv\xfdv\xfdv\xfdv\xfdv\xfdv\xfd\xb8\xfd>\xfeA\xfeA\xfeA\xfeA\xfeC\xfeC\xfeC\xfeC\xfeC\xfeC\xfeC\xfeC\xfeC\xfeD\xfeD\xfeD\xfeD\xfeD\xfeD\xfeD\xfeD\xfeD\xfeD\xfeD\xfeD\xfeE\xfeE\xfeE\xfeE\xfeE\xfeE\xfeE\xfeE\xfeE\xfeE\xfeE\xfeG\xfeG\xfeG\xfeG\xfeG\xfeG\xfeG\xfeG\xfeG\xfeH\xfeH\xfeH\xfeH\xfeH\xfeH\xfeH\xfeH\xfef\x00m\x02m\x02l\x02l\x02l\x02l\x02l\x02l\x02l\x02k\x02k\x02k\x02k\x02k\x02k\x02k\x02k\x02i\x02i\x02i\x02i\x02i\x02i\x02i\x02i\x02h\x02h\x02h\x02h\x02h\x02h\x02h\x02f\x02f\x02f\x02f\x02f\x02f\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02e\x02d\x02d\x02d\x02d\x02d\x02d\x02b\x02b\x02b\x02o\x02\x86\x02\x86\x02\x86\x02\x86\x02\x85\x02\x85\x02\x85\x02\x85\x02\x83\x02\x83\x02\x83\x02\x83\x02\x83\x02\x83\x02\x83\x02\x83\x02\x82\x02\x82\x02\x82\x02\x82\x02\x82\x02\x82\x02\x81\x02\x81\x02\x81\x02\x81\x02\x81\x02\x81\x02\x81\x02\u007f\x02\u007f\x02\u007f\x02\u007f\x02\u007f\x02\u007f\x02~\x02~\x02~\x02~\x02~\x02~\x02~\x02~\x02~\x02~\x02}\x02}\x02}\x02}\x02{\x02{\x02{\x02{\x02{\x02{\x02\xaa\x01\x8f\xfe\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xbf\xfd\xc0\xfd\xc0\xfd\xc0\xfd\xc0\xfd\xc0\xfd\xc2\xfd\xc2\xfd\xc2\xfd\xc2\xfd\xc2\xfd\xc2\xfd\xc2\xfd\xc2\xfd\xc2\xfd\xc3\xfd\xc3\xfd\xc3\xfd\xc3\xfd\xc3\xfd\xc3\xfd\xc3\xfd\xc3\xfd\xc3\xfd\xc4\xfd\xc4\xfd\xc4\xfd\xc4\xfd\xc4\xfd\xc4\xfd\xc4\xfd\xc4\xfd\xc6\xfd\xc6\xfd\xc6\xfd\xc6\xfd\xc6\xfd\xc6\xfd\xc6\xfd\xc7\xfd\xc7\xfd\xc7\xfd\xc7\xfd\xc7\xfd\xc7\xfd\xc7\xfd\xc7\xfd\xc8\xfd\xc8\xfd\xc8\xfd\xcd\xfd\xd6\xfd\xd6\xfd\xd6\xfd\xd6\xfd\xd6\xfd\xd8\xfd\xd8\xfd\xd8\xfd\xd8\xfd\xd8\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xd9\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xda\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdc\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xdd\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xde\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xe0\xfd\xb2\xffa\x02a\x02a\x02a\x02a\x02a\x02a\x02a\x02`\x02`\x02`\x02`\x02`\x02`\x02`\x02`\x02^\x02^\x02^\x02^\x02^\x02^\x02^\x02]\x02]\x02]\x02]\x02]\x02[\x02[\x02[\x02[\x02[\x02[\x02[\x02[\x02[\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Z\x02Y\x02Y\x02Y\x02Y\x02Y\x02Y\x02W\x02W\x02W\x02W\x02W\x02W\x02V\x02V\x02V\x02U\x02U\x02U\x02U\x02U\x02U\x02U\x02U\x02U\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02S\x02R\x02R\x02R\x02R\x02R\x02R\x02P\x02P\x02P\x02P\x02P\x02P\x02P\x02O\x02O\x02O\x02O\x02O\x02O\x02N\x02N\x02N\x02N\x02N\x02N\x02N\x02N\x02L\x02L\x02L\x02L\x02L\x02L\x02K\x02K\x02K\x02K\x02K\x02K\x02J\x02J\x02J\x02\x9b\x01l\xff\xf2\xfe\xf2\xfe\xf2\xfe\xf2\xfe\xf2\xfe\xf2\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf3\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf5\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf6\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xf7\xfe\xb0\xfe\xe7\xfd\xc4\xfd\xc4\xfd\xc4\xfd\xc4\xfd\xc4\xfd\xc4\xfd\xc4\xfd\xc6\xfd\xc6\xfd\xc6\xfd\xc6\xfd\xc6\xfd\xc6\xfd\xc7\xfd\xc7\xfd\xc7\xfd\xc7\xfd\xc7\xfd\xc7\xfd\xc8\xfd\xc8\xfd\xc8\xfd\xc8\xfd\xc8\xfd\xc8\xfd\xc8\xfd\xc8\xfd\xc8\xfd\xc8\xfd\xca\xfd\xca\xfd\xca\xfd\xca\xfd\xcb\xfd\xcb\xfd\xcb\xfd\xcb\xfd\xcb\xfd\xcb\xfd\xcb\xfd\xcb\xfd\xcb\xfd\xcd\xfd\xcd\xfd\xcd\xfd\xcd\xfd\xcd\xfd\xce\xfd\xce\xfd\xce\xfd\xce\xfd\xce\xfd\xce\xfd\xce\xfd\xce\xfd\xcf\xfd\xcf\xfd\xcf\xfd\xcf\xfd\xcf\xfd\xcf\xfd\xb8\xff1\x011\x011\x011\x011\x011\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01*\x01*\x01*\x01*\x01*\x01\xad\x01\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02 \x02 \x02 \x02 \x02 \x02 \x02 \x02 \x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1f\x02\x1d\x02\x1d\x02\x1d\x02\x1d\x02\x1d\x02\x1d\x02\x1c\x02\x1c\x02\x1c\x02\x1c\x02\x1c\x02\x1c\x02\x1c\x02\x1c\x02\x1c\x02\x1b\x02\x1b\x02\x1b\x02\x1b\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x18\x02\x18\x02\x18\x02\x18\x02\x18\x02\x18\x02\x18\x02\x18\x02\x18\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x18\x00\xeb\xfd\xeb\xfd\xeb\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xec\xfd\xee\xfd\xee\xfd\xee\xfd\xee\xfd\xee\xfd\xee\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xef\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf0\xfd\xf2\xfd\xf2\xfd\xf2\xfd\xf2\xfd\xf2\xfd\xf2\xfd\xf2\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf3\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf5\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf6\xfd\xf7\xfd\xf7\xfd\xf7\xfd\xf7\xfd:\xfe\xe1\x00\a\x02\a\x02\a\x02\a\x02\a\x02\x06\x02\x06\x02\x06\x02\x06\x02\x06\x02\x06\x02\x06\x02\x05\x02\x05\x02\x05\x02\x05\x02\x05\x02\x05\x02\x05\x02\x05\x02\x03\x02\x03\x02\x03\x02\x03\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xff\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfe\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfc\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01\xfb\x01w\x00r\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffr\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xff\xb9\xffU\x01\xec\x01\xec\x01\xec\x01\xec\x01\xea\x01\xea\x01\xea\x01\xea\x01\xea\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe9\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe8\x01\xe6\x01\xe6\x01\xe6\x01\xe6\x01\xe6\x01\xe6\x01\xe5\x01\xe5\x01\xe5\x01\xe5\x01\xe5\x01\xe5\x01\xe5\x01\xe5\x01\xe3\x01\xe3\x01\xe3\x01\xe3\x01\xe3\x01\xe3\x01\xe2\x01\xe2\x01\xe2\x01\xe2\x01\xe2\x01\xe2\x01\xe2\x01\xe1\x01\xe1\x01\xe1\x01\xe1\x01\xe1\x01\xe1\x01\xe1\x01\xe1\x01\xe1\x01\xe1\x01\xdf\x01\xdf\x01\xdf\x01\xdf\x01\xde\x01\xde\x01N\x01\xde\x00\xde\x00\xdd\x00\xdd\x00\xdd\x00\xdd\x00\xdd\x00\xdd\x00\xdd\x00\xdd\x00\xdd\x00\xdd\x00\xdd\x00\xdd\x00\xdd\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xda\x00\xda\x00\xda\x00\xda\x00\xda\x00\xda\x00\xda\x00\xda\x00\xda\x00\xda\x00\xda\x00\xda\x00\xda\x00\xda\x00\xd9\x00\xd9\x00\xd9\x00\xd9\x00\xd9\x00\xd9\x00\xd9\x00\xd9\x00\xd9\x00\xd9\x00\xd9\x00\xd9\x00\xd9\x00\xd9\x00\xd9\x00\xd9\x00\xd9\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00\xd8\x00w\x01\xcf\x01\xcf\x01\xcf\x01\xcf\x01\xcf\x01\xcd\x01\xcd\x01\xcd\x01\xcd\x01\xcd\x01\xcd\x01\xcd\x01\xcc\x01\xcc\x01\xcc\x01\xcc\x01\xcc\x01\xcc\x01\xcb\x01\xcb\x01\xcb\x01\xcb\x01\xcb\x01\xcb\x01\xcb\x01\xcb\x01\xc9\x01\xc9\x01\xc9\x01\xc9\x01\xc9\x01\xc9\x01\xc9\x01\xc9\x01\xc8\x01\xc8\x01\xc8\x01\xc8\x01\xc8\x01\xc8\x01\xc6\x01\xc6\x01\xc6\x01\xc6\x01\xc6\x01\xc6\x01\xc6\x01\xc5\x01\xc5\x01\xc5\x01\xc5\x01\xc5\x01\xc5\x01\xc5\x01\xc5\x01\xc4\x01\xc4\x01\xc4\x01\xc4\x01\xc4\x01\xc4\x01\xc2\x01\xc2\x01\xc2\x01\xc2\x01\xc2\x01\xc2\x01\xc2\x01\xc2\x01\xc1\x01\xc1\x01\xc1\x01A\x01e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00e\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00d\x00b\x00b\x00b\x00d\x00b\x00b\x00b\x00b\x00b\x00b\x00b\x00b\x00b\x00b\x00b\x00b\x00b\x00b\x00\xd1\x00\x91\x01\x91\x01\x91\x01\x91\x01\x91\x01\x91\x01\x91\x01\x90\x01\x90\x01\x90\x01\x90\x01\x90\x01\x90\x01\x90\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8e\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8c\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x8a\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x89\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x88\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x86\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\x85\x01\xda\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00~\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00}\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00{\x00z\x00z\x00z\x00z\x00z\x00z\x00z\x00z\x00z\x00\xa0\x00\x1c\x015\x015\x015\x015\x015\x015\x015\x015\x015\x013\x013\x013\x013\x013\x013\x013\x013\x013\x012\x012\x012\x012\x012\x012\x012\x012\x012\x011\x011\x011\x011\x011\x011\x011\x011\x011\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01/\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01.\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01-\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01*\x01*\x01*\x01*\x01\a\x01K\xff~\xfe~\xfe~\xfe~\xfe~\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\u007f\xfe\x81\xfe\x81\xfe\x81\xfe\x81\xfe\x81\xfe\x81\xfe\x81\xfe\x82\xfe\x82\xfe\x82\xfe\x82\xfe\x82\xfe\x82\xfe\x82\xfe\x82\xfe\x83\xfe\x83\xfe\x83\xfe\x83\xfe\x83\xfe\x83\xfe\x83\xfe\x83\xfe\x85\xfe\x85\xfe\x85\xfe\x85\xfe\x85\xfe\x85\xfe\x86\xfe\x86\xfe\x86\xfe\x86\xfe\x86\xfe\x86\xfe\x88\xfe\x88\xfe\x88\xfe\x88\xfe\x88\xfe\x88\xfe\x88\xfe\x88\xfe\x89\xfe\x89\xfe\x89\xfe\x89\xfe\x89\xfe\x89\xfe\x89\xfe\x8a\xfe\x8a\xfe\x8a\xfe\x8a\xfe\x8a\xfe\x8a\xfe\x8a\xfe\x8a\xfe\x8c\xfe\x8c\xfe\x8c\xfe\x8c\xfe\x8c\xfe\x8c\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8d\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x8f\xfe\x90\xfe\x90\xfe\x90\xfe\x90\xfe\x90\xfe\x90\xfe\x90\xfe\x91\xfe\x91\xfe\x91\xfe\x91\xfe\x91\xfe\x91\xfe\x91\xfe\x93\xfe\x93\xfe\x93\xfe\x93\xfe\x93\xfe\x93\xfe\x93\xfe\x93\xfe\x94\xfe\x94\xfe\x94\xfe\x94\xfe\x94\xfe\x94\xfe\x95\xfe\x95\xfe\x95\xfe\x95\xfe\x95\xfe\x95\xfe\x95\xfe\x95\xfe\x95\xfe\x97\xfe\x97\xfe\x97\xfe\x97\xfe\x97\xfe\x97\xfe\x98\xfe\x98\xfe\x98\xfe\x98\xfe\x98\xfe\x98\xfe\x9a\xfe\x9a\xfe\x9a\xfe\x9a\xfe\x9a\xfe\x9a\xfe\x9a\xfe\x9a\xfe\x9b\xfe\x9b\xfe\x9b\xfe\x9b\xfe\x9b\xfe\x9b\xfe\x9b\xfe\x9b\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9c\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9e\xfe\x9f\xfe\x9f\xfe\x9f\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa0\xfe\xa2\xfe\xa2\xfe\xa2\xfe\xa2\xfe\xa2\xfe\xa2\xfe\xa3\xfe\xa3\xfe\xa3\xfe\xa3\xfe\xa3\xfe\xa3\xfe\xa3\xfe\xa3\xfe\xa3\xfe\xa5\xfe\xa5\xfe\xa5\xfe\xa5\xfe\xa5\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa6\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa7\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xa9\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xaa\xfe\xab\xfe\xab\xfe\xab\xfe\xab\xfe\xab\xfe\xab\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xad\xfe\xae\xfe\xae\xfe\xae\xfe\xae\xfe\xae\xfe\xae\xfe\xae\xfe\xae\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb0\xfe\xb1\xfe\xb1\xfe\xb1\xfe\xbf\xfe\x05\xff\x1e\xff\x1e\xff\x1e\xff\x1e\xff\x1e\xff\x1e\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff!\xff!\xff!\xff!\xff!\xff!\xff!\xff!\xff!\xff!\xff\"\xff\"\xff\"\xff\"\xff\"\xff\"\xff\"\xff\"\xff\"\xff\"\xff\"\xff\"\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff$\xff&\xff&\xff&\xff&\xff&\xff&\xff&\xff&\xff&\xff&\xff&\xff'\xff'\xff'\xff'\xff'\xff'\xff'\xff'\xff'\xff'\xff(\xff(\xff(\xff(\xff(\xff(\xff(\xff(\xff(\xff(\xff(\xff\f\xff\xca\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc4\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc6\xfe\xc7\xfe\xc7\xfe\xc7\xfe\xc7\xfe\xc7\xfe\xc7\xfe\xc7\xfe\xc7\xfe\xc8\xfe\xc8\xfe\xc8\xfe\xc8\xfe\xc8\xfe\xc8\xfe\xc8\xfe\xca\xfe\xca\xfe\xca\xfe\xca\xfe\xca\xfe\xca\xfe\xcb\xfe\xcb\xfe\xcb\xfe\xcb\xfe\xcb\xfe\xcb\xfe\xcb\xfe\xcb\xfe\xcd\xfe\xcd\xfe\xcd\xfe\xcd\xfe\xcd\xfe\xcd\xfe\xcd\xfe\xcd\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xce\xfe\xcf\xfe\xcf\xfe\xcf\xfe\xcf\xfe\xcf\xfe\xcf\xfe\xcf\xfe\xcf\xfe\xd1\xfe\xd1\xfe\xd1\xfe\xd1\xfe\xd1\xfe\xd1\xfe\xd2\xfe\xd2\xfe\xd2\xfe\xd2\xfe\xd2\xfe\xd2\xfe\xd2\xfe\xd2\xfe\xd2\xfe\xd3\xfe\xd3\xfe\xd3\xfe\xd3\xfe\xd3\xfe\xd3\xfe\xd5\xfe\xd5\xfe\xd5\xfe\xd5\xfe\xd5\xfe\xd5\xfe\xd5\xfe\xd5\xfe\xd6\xfe\xd6\xfe\xd6\xfe\xb8\xff\x19\x01\x19\x01\x19\x01\x19\x01\x19\x01\x19\x01\x19\x01\x18\x01\x18\x01\x18\x01\x18\x01\x18\x01\x18\x01\x18\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x16\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x10\x01\x10\x01\x10\x01\x10\x01\x10\x01\x10\x01\x10\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\r\x01\r\x01\r\x01\r\x01\r\x01\r\x01\v\x01\v\x01\v\x01\v\x01\v\x01\v\x01\v\x01\v\x01\v\x01\v\x01\n\x01\n\x01\n\x01\n\x01\n\x01\n\x01\n\x01\t\x01\t\x01\t\x01\t\x01\t\x01\t\x01\t\x01\t\x01\a\x01\a\x01\x10\x01\x15\x01\x15\x01\x15\x01\x15\x01\x15\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x14\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x11\x01\x10\x01\x10\x01\x10\x01\x10\x01\x10\x01\x10\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\x0e\x01\r\x01\r\x01\r\x01\r\x01\r\x01\r\x01\v\x01\v\x01\v\x01\v\x01\v\x01\v\x01\v\x01\n\x01\n\x01\n\x01\n\x01\n\x01\n\x01\n\x01\n\x01\t\x01\t\x01\t\x01\t\x01\t\x01\t\x01\t\x01\t\x01\a\x01\a\x01\a\x01\a\x01\a\x01\a\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x06\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x05\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x03\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\x02\x01\xe8\x00\xd3\x00\xd3\x00\xd3\x00\xd3\x00\xd3\x00\xd3\x00\xd3\x00\xd3\x00\xd2\x00\xd2\x00\xd2\x00\xd2\x00\xd2\x00\xd2\x00\xd2\x00\xd2\x00\xd2\x00\xd1\x00\xd1\x00\xd1\x00\xd1\x00\xd1\x00\xd1\x00\xd1\x00\xd1\x00\xd1\x00\xcf\x00\xcf\x00\xcf\x00\xcf\x00\xcf\x00\xcf\x00\xcf\x00\xcf\x00\xce\x00\xce\x00\xce\x00\xce\x00\xce\x00\xce\x00\xce\x00\xce\x00\xce\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xca\x00\xca\x00\xca\x00\xca\x00\xca\x00\xca\x00\xca\x00\xca\x00\xc8\x00\xc8\x00\xc8\x00\xc8\x00\xc8\x00\xc8\x00\xc8\x00\xc8\x00\xc8\x00\xc7\x00\xc7\x00\xc7\x00\xc7\x00\xc7\x00\xc7\x00\xc7\x00\xc6\x00\xc6\x00\xc6\x00\xc6\x00\xc6\x00\xc6\x00\xc6\x00\xc6\x00\xc6\x00\xc6\x00\xc6\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc7\xff\x16\xff\x16\xff\x16\xff\x16\xff\x16\xff\x16\xff\x16\xff\x16\xff\x17\xff\x17\xff\x17\xff\x17\xff\x17\xff\x17\xff\x17\xff\x17\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x18\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1a\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1b\xff\x1d\xff\x1d\xff\x1d\xff\x1d\xff\x1d\xff\x1d\xff\x1d\xff\x1d\xff\x1e\xff\x1e\xff\x1e\xff\x1e\xff\x1e\xff\x1e\xff\x1e\xff\x1e\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff\x1f\xff!\xff!\xff!\xff!\xff!\xff!\xff\"\xff\"\xff\"\xff\"\xff\"\xff\"\xff\"\xff\"\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff#\xff$\xff$\xff$\xff$\xff$\xff$\xff&\xff&\xff&\xff&\xff&\xff&\xff&\xff&\xff'\xff'\xff'\xff'\xff'\xff'\xff'\xff(\xff(\xff(\xff(\xff(\xff(\xff(\xff(\xff*\xff*\xff*\xff*\xff*\xff*\xff`\xffq\x00\xd5\x00\xd5\x00\xd5\x00\xd5\x00\xd3\x00\xd3\x00\xd3\x00\xd3\x00\xd3\x00\xd3\x00\xd3\x00\xd3\x00\xd2\x00\xd2\x00\xd2\x00\xd2\x00\xd2\x00\xd2\x00\xd1\x00\xd1\x00\xd1\x00\xd1\x00\xd1\x00\xd1\x00\xd1\x00\xd1\x00\xcf\x00\xcf\x00\xcf\x00\xcf\x00\xcf\x00\xcf\x00\xcf\x00\xcf\x00\xce\x00\xce\x00\xce\x00\xce\x00\xce\x00\xce\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcc\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xca\x00\xca\x00\xca\x00\xca\x00\xca\x00\xca\x00\xca\x00\xca\x00\xc8\x00\xc8\x00\xc8\x00\xc8\x00\xc8\x00\xc8\x00\xc8\x00\xc8\x00\xc7\x00\xc7\x00\xc7\x00\xc7\x00\xc7\x00\xc7\x00\xc6\x00\xc6\x00\xc6\x00\xc6\x00\xc6\x00\xc6\x00\xc6\x00\xc6\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc4\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc3\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc1\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xc0\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbf\x00\xbd\x00\xbd\x00\xbd\x00\xb4\x00\xaa\x00\xaa\x00\xaa\x00\xaa\x00\xaa\x00\xa9\x00\xa9\x00\xa9\x00\xa9\x00\xa9\x00\xa9\x00\xa7\x00\xa7\x00\xa7\x00\xa7\x00\xa7\x00\xa7\x00\xa7\x00\xa7\x00\xa7\x00\xa6\x00\xa6\x00\xa6\x00\xa6\x00\xa6\x00\xa6\x00\xa6\x00\xa6\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa3\x00\xa2\x00\xa2\x00\xa2\x00\xa2\x00\xa2\x00\xa2\x00\xa2\x00\xa2\x00\xa0\x00\xa0\x00\xa0\x00\xa0\x00\xa0\x00\xa0\x00\xa0\x00\xa0\x00\xa0\x00\x9f\x00\x9f\x00\x9f\x00\x9f\x00\x9f\x00\x9f\x00\x9f\x00\x9f\x00\x9e\x00\x9e\x00\x9e\x00\x9e\x00\x9e\x00\x9e\x00\x9e\x00\x9e\x00\x9c\x00\x9c\x00\x9c\x00\x9c\x00\x9c\x00\x9c\x00\x9c\x00\x9c\x00\x9c\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x9b\x00\x99\x00\x99\x00\x99\x00\x99\x00\x99\x00\x99\x00\x99\x00\x98\x00\x98\x00\x98\x00\x98\x00\x98\x00\x98\x00\x98\x00\x98\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x97\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x95\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\x94\x00\xfd\xff]\xff]\xff]\xff^\xff^\xff^\xff^\xff^\xff^\xff^\xff`\xff`\xff`\xff`\xff`\xff`\xff`\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffa\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffb\xffd\xffd\xffd\xffd\xffd\xffd\xffe\xffe\xffe\xffe\xffe\xffe\xffe\xffe\xffg\xffg\xffg\xffg\xffg\xffg\xffg\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffh\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffi\xffk\xffk\xffk\xffk\xffk\xffk\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffl\xffm\xffm\xffm\xffm\xffm\xffm\xffm\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffo\xffp\xffp\xffp\xffp\xffp\xffp\xffp\xffp\xffr\xffr\xffr\xffr\xffr\xffr\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xffs\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xfft\xffv\xffv\xffv\xffv\xffv\xffv\xffv\xffw\xffw\xffw\xffw\xffw\xffw\xffw\xffx\xffx\xffx\xffx\xff\x8c\xff\xbf\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xc8\xff\xca\xff\xca\xff\xca\xff\xca\xff\xca\xff\xca\xff\xca\xff\xca\xff\xca\xff\xca\xff\xca\xff\xca\xff\xca\xff\xca\xff\xca\xff\xca\xff\xca\xff\xcb\xff\xcb\xff\xcb\xff\xcb\xff\xcb\xff\xcb\xff\xcb\xff\xcb\xff\xcb\xff\xcb\xff\xcb\xff\xcb\xff\xcb\xff\xcb\xff\xcb\xff\xcb\xff\xcb\xff\xcd\xff\xcd\xff\xcd\xff\xcd\xff\xcd\xff\xcd\xff\xcd\xff\xcd\xff\xcd\xff\xcd\xff\xcd\xff\xcd\xff\xcd\xff\xcd\xff\xcd\xff\xcd\xff\xcd\xff\xcd\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xce\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xcf\xff\xd1\xff\xd1\xff\xd1\xff\xd1\xff\xd1\xff\xd1\xff\xd1\xff\xd1\xff\xd1\xff\xd1\xff\xd1\xff\xd1\xff\xd1\xff\xd1\xff\xd1\xff\xd1\xff\xd1\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd2\xff\xd3\xff\xd3\xff\xd3\xff\xd3\xff\xd3\xff\xd3\xff\xd3\xff\xd3\xff\xd3\xff\xd3\xff\xd3\xff\xd3\xff\xd3\xff\xd3\xff\xd3\xff\xd3\xff\xd3\xff\xd3\xff\xd5\xff\xd5\xff\xd5\xff\xd5\xff\xd5\xff\xd5\xff\xd5\xff\xd5\xff\xd5\xff\xd5\xff\x15\x00V\x00V\x00V\x00U\x00U\x00U\x00U\x00U\x00U\x00U\x00U\x00U\x00U\x00S\x00S\x00S\x00S\x00S\x00S\x00S\x00S\x00S\x00R\x00R\x00R\x00R\x00R\x00R\x00R\x00R\x00R\x00P\x00P\x00P\x00P\x00P\x00P\x00P\x00P\x00O\x00O\x00O\x00O\x00O\x00O\x00O\x00O\x00O\x00N\x00N\x00N\x00N\x00N\x00N\x00L\x00L\x00L\x00L\x00L\x00K\x00K\x00K\x00K\x00K\x00I\x00I\x00I\x00I\x00H\x00H\x00H\x00H\x00H\x00G\x00G\x00G\x00G\x00G\x00E\x00E\x00E\x00E\x00D\x00D\x00D\x00D\x00D\x00C\x00C\x00C\x00C\x00C\x00A\x00A\x00A\x00A\x00@\x00@\x00@\x00@\x00@\x00>\x00>\x00>\x00>\x00>\x00>\x00=\x00=\x00=\x00=\x00=\x00<\x00<\x00<\x00<\x00:\x00:\x00:\x00:\x00:\x00:\x00:\x009\x009\x009\x009\x008\x008\x008\x008\x008\x006\x006\x006\x006\x006\x006\x005\x005\x005\x005\x005\x005\x003\x003\x003\x003\x003\x002\x002\x002\x002\x002\x002\x001\x001\x001\x001\x001\x00/\x00/\x00/\x00/\x00/\x00/\x00.\x00.\x00.\x00.\x00.\x00.\x00-\x00-\x00-\x00-\x00-\x00-\x00+\x00+\x00+\x00+\x00+\x00*\x00*\x00*\x00*\x00*\x00'\x00$\x00$\x00$\x00$\x00$\x00$\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00 \x00 \x00 \x00 \x00 \x00 \x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1f\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1c\x00\x1c\x00\x1c\x00\x1c\x00\x1c\x00\x1c\x00\x1c\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x1b\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x18\x00\x18\x00\x18\x00\x18\x00\x18\x00\x18\x00\x18\x00\x18\x00\x18\x00\x16\x00\x16\x00\x16\x00\x16\x00\x16\x00\x16\x00\x16\x00\x16\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x14\x00\x14\x00\x14\x00\x14\x00\x14\x00\x14\x00\x14\x00\x14\x00\x14\x00\x14\x00\x12\x00\x12\x00\x12\x00\x12\x00\x12\x00\x12\x00\x12\x00\x12\x00\x12\x00\x11\x00\x11\x00\x11\x00\x11\x00\x11\x00\x11\x00\x11\x00\x11\x00\x11\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\x0e\x00\r\x00\r\x00\r\x00\r\x00\r\x00\r\x00\r\x00\r\x00\r\x00\r\x00\r\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\v\x00\n\x00\n\x00\n\x00\n\x00\n\x00\n\x00\n\x00\n\x00\n\x00\n\x00\n\x00\n\x00\n\x00\t\x00\t\x00\t\x00\t\x00\t\x00\t\x00\t\x00\t\x00\t\x00\t\x00\t\x00\t\x00\a\x00\a\x00\a\x00\a\x00\a\x00\a\x00\a\x00\a\x00\a\x00\a\x00\a\x00\a\x00\a\x00\a\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x06\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x05\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x03\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00LIST@\x00\x00\x00INFOISFT\x15\x00\x00\x00Sony Sound Forge 7.0\x00\x00IENG\x02\x00\x00\x00 \x00ICRD\v\x00\x00\x002015-01-21\x00\x02")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package main
-
-var explodeSample = []byte("RIFFv\xa8\x00\x00WAVEfmt \x10\x00\x00\x00\x01\x00\x01\x00D\xac\x00\x00\x88X\x01\x00\x02\x00\x10\x00dataR\xa8\x00\x00\x00\x00\xf8\xff\x99\xe8\x9d\xe8Q Q 2\xe0.\xe0\xe7\xf3\xdf\xf3\xdf\xec\xdf\xec\x93\xe7\x97\xe7p\x1ap\x1a\x15,\r,\xa5ϩ\xcfP\x1bP\x1b\x8d܍ܶޮ\xde<\xcbE\xcb}0}0`0d0\x1b! !\xd9\xd5\xd5\xd5[!V!O\xe2T\xe2G\xd6K\xd6\xdb\xe7\xd3\xe79&5&P\xe0H\xe0R\xf7V\xf7s\x19{\x19\xc2\xe7\xbd\xe7H Q \xb9\x18\xc1\x18\xb2 \xa9 \xc7\xea\xc3\xea\xf7\xda\xf7ڦ\xe8\xa6\xe8\xee\xe1\xea\xe1\xad'\xa9'f\xeaf\xea\xe6\xce\xdaΜ,\xa9,\xa6˦\xcbo\xcbs\xcb\xf5\n\xe8\n\x9fΧ\xceP\xecC\xec\x82֏֛\xe2\x93\xe2\xee\x1c\xe6\x1c\xe7\xd1\xf0\xd1G\"L\"T.P.H'C'\b\xcf\f\xcf{\xd9\u007f\xd9\xc0&\xbc&\x99\xe8\x99\xe8\x99\r\x9d\rQ\x14Q\x14g\xe6c\xe6\f\xe7\x03\xe7^\xd7f\u05cf3\x8b3\xd4/\xd4/\xbeϺ\xcf@\xf4D\xf4\xe2!\xd9!\"\xd9+\xd9\xd8\x19\xcc\x199\x15=\x15\u007f%{%\x03\xd8\xff\xd7{\xe3{\xe3q0}0\xab!\xb3!\xb2/\xaa/\xf98\xfd8Y\xe5H\xe5\xb7\x17\xb7\x17#\x198\x19\x90\x14\u007f\x14\x9b\x1a\x9f\x1a\xb0\x1a\xb0\x1a\xf3\x10\xef\x10\b\xd6\x03\xd6\xc3\x1e\xbf\x1e\xed\xe5\xf9\xe5\xeb&\xe6&\xc3 \xba \xfd\xd1\x05ҕםה\x1b\x94\x1b_\xce_\xceH'H'\xff2\xfb2\xd5\x15\xd1\x15V\xf7Z\xf7\xe2\xe4\xe7\xe4\xa1٩\xd9\xc5\xd3\xcd\xd3i\"i\"\xdb\xca\xd3\xca\x1d\xe0\x19\xe0E\xf2E\xf2\xaeˮ\xcb\b\xca\x00ʶ\b\xb6\bP'T't&t&\x8c/\x88/\xe1\xd2\xe5\xd2\x0e\xdc\x12\xdc\xe5\xd2\xe9\xd2\x1a6\x1e6\xdb\xd1\xd7\xd1Y,],\a\xe4\xfe\xe3p2p2_\xc9W\xc9\xd8\xd4\xd8\xd4\xf6\xcb\xf6\xcb[&c&\xb8\x10\xab\x10}\u0381Η)\x82)\xb5\f\xbd\f\xa2\xed\x9e\xed\xe1\xed\xe5\xed3\n*\n(\xe6,\xe6\xa8ذ\xd8\xc8\x1c\xc0\x1c\xf6\xde\xf6ޓۏ\xdb$\xe4$\xe4\xae\r\xb7\r\xd6\xd3\xd6\xd3\xca\xec\xcf\xecO\xeeW\xee\xa7\x10\xaf\x10\x941\x871\xf2*\xf6*&\n\x19\nm5m5A\xdaF\xda\xfc$\xfc$'\xde\"\xde\xce\x1d\xd3\x1d\r\xd4\xfc\xd3\xde\x1a\xde\x1a\x8c*\x99*\x12\xe3\t\xe3\xc2\xe5\xc2\xe5h\xdfl\xdf1$6$\xa9ϭ\xcf\xdf\xe7\xd3\xe7\xa3؛\xd8\xce\xd1\xd7\xd1\xee\xd0\xfb\xd0\xd5&\xcd&\x85-\x80-\xab\xe1\xa2\xe1\"\xcf.ϴ$\xb9$\xa1*\x99*\x86؆\xd8D\x14@\x14!$\x1c$F\xdaN\xdae\fi\fq\x1fy\x1f\u007f\xcf\u007f\xcf\xe6\xc9\xe2\xc9k\xd7b\u05cd\x12\x88\x125\x1a9\x1a\x11\xe0\b\xe0\x86$\x8e$0!\x1b!\xab-\xb3-\xd1\xd0\xc4Ќ\x14\x94\x14\x8e0\x9b0\x93)\x86)\x80܍\xdc7\x16;\x165\x109\x10\xf7\xd5\xfb\xd5A\xe6A\xe6\xa11\x9d1\xd3'\xd7'\xca\"\xbe\"`0d0d\xe2q\xe2\x05\x14\xfc\x13\xf0\f\xf0\fk\xd5t\xd5\xc15\xc559\x1a9\x1a\x81\x1f\x86\x1fX\xd6X֩ϩ\xcfW\xd8[\xd8g\xc9c\xc9\xcf3\xca3\x04\xe0\x04\xe0\x11\x1b\r\x1bj n s\x1es\x1e\x06\xdf\x06\xdfy\xeey\xee\xb0)\xb5)\x8c͐\xcd\x15\x1b\x11\x1b*\xd6.\xd6\xc7\x1b\xbe\x1b\xe0\x1b\xed\x1b\xd1\xe1\xc8\xe1,\xed4\xed|\xcbo\xcb63?3e\xcfn\xcf?\n?\n\x85\xe6\x96\xe6\xa3ӊ\xd3l!\x81!\xc1Ӭ\xd3'\xcb'\xcb\x1c\xee%\xee\xea(\xe6(\xeb\x0e\xeb\x0e\x10\xee\v\xee@%<%\xb5\xf3\xb0\xf3N!_!\xb6\xe5\xb1\xe5\x04\xea\r\xea\xef\xc9\xe2ɰǰ\xc7\xde\xd8\xde\xd8\xd7\xe5\xd7\xe5\xa64\xa24\u007f\xea{\xea$\xc9,Ɂ7}7\x12\xdc\xfdۇʏ\xcaL1L1\xd7\xd6\xdb\xd6e\xe0X\xe0\xf7\x17\x03\x18\xfd'\xf9'\xe1\x19\xe1\x19-\xcc\x1c\xcco\xd0o\xd0i\xf3q\xf3\xad\x1b\xad\x1b\x83\x0f\u007f\x0f!\xe2!\xe2~\xdb~\xdb\x02\xeb\xfe\xea\x01\xcd\xf8\xcc50:0r\xcfz\xcf#\xd0\x1b\xd0\x0f\x1f\x14\x1fa\xc8nȼӼ\xd3!3\x1d3?\xd6?\xd6\x0e4\x0e4\x1a/\x1a/|\x01|\x01Q\xf9V\xf9B\x13B\x13\xa1%\x9d%\xec3\xec3Q/^/\xd0%\xd8%\x81!}!&3!3\x14\xcc\x1c̱\xe5\xad\xe5^\xd2^\xd2\xd6\xe4\xda\xe4\xc7\xea\xcb\xea\xbaϵ\xcf\x027\xfa6@\r@\rO\xe4F\xe4\",&,\xb7ܮ\xdc\xe6&\xeb&\xae\u05ee\xd7\x0f\x1a\x0f\x1az.~.(!(!k\xd0g\xd0T,Y,\xa2#\x95#\xc23\xc63\x80/\x80/\x99\x19\x99\x19\x9e\xe1\x95\xe1\xfe\xd2\x03\xd3\xf4\xfa\xfc\xfa\x9e#\x99#\x04\xdb\f\xdb\x05\r\xfd\f\xce\f\xca\f\x92\xf5\x9b\xf5\r6\x166\xae\x14\xae\x14\x97\x1f\x86\x1f\xa7\t\xa3\tV\xe1Z\xe1-\xd1)\xd1K0O0\\5W5w*{*\xd7\x1d\xd7\x1d\x9a\v\x9a\v\x1e*+*\xc10\xb40M6Y6_\x1cZ\x1c\x85\x1au\x1aa\x16r\x16<\xe88\xe8$\xce$\xceC\xd1C\xd1J\x1cN\x1cx#s#\xe8\xec\xf0\xec\xda\xd3\xde\xd3\x056\x006\xc3*\xc3*\xd8\x19\xd8\x19v,n,\x1f\xe6(\xe6\x8c\r\x88\r\xb6\x19\xaa\x19V![!0\xe10\xe1\x96ڍ\xda\x17\xd5\x1b\xd5\xdf.\xe8.r\xd4j\xd4K\xd6P\xd6\xca\xe7\xc6\xe7c\xc9gɮҦ\xd2$\xdf1ߢ/\x99/\xa1\xea\xa1\xea\x9aɚɰ5\xb05d$\\$[\xe9`\xe9s\x12s\x12]\xcfX\xcf<\xd2Iҽ\xf8\xc2\xf8\x0e#\x16#\xbd0\xb40+/4/>\xf32\xf39\xc9A\xc9\xe5\xcd\xe9\xcd\xee\xd0\xf7\xd0|\x06t\x06_\xe6R\xe6A\xe1E\xe1A\xcbAˆ̆\xcc\xed\xd4\xedԁ\xeb}\xeb\xff\xd7\xff\xd7\xf2\xe1\xee\xe1J\x15N\x15v\x1dz\x1d.\x05.\x05~ۂ\xdb?\xe0;\xe0\xce\xe2\xd2\xe2D\x14@\x14D%M%D%3%\xb9\xf3\xbd\xf3\xc3\x1e\xbb\x1e_+_+\x04\xea\r\xea\x11\xea\r\xeak\x17k\x17\xd5(\xd5(\xee/\xf2/\xff(\x03)\x03)\f)\xbf4\xbb4\x0f\x1f\x0f\x1fn\xcfz\xcf8\xe3/\xe3\xbf\xef\xbf\xef.\xf8!\xf8%\xf8%\xf8\xb0̹\xcc~̭̂ʠ\xca\xea\xcb\xea\xcb(\xce(Λ5\x9b5\xa45\xa85\xfb\xd0\xea\xd0*\xca2\xcaJ\xc9J\xc9c\x15c\x15V\xe8E\xe8\x8eӒ\xd3 \xce \xce \xce$\xce\xd9\xcb\xdd˨̨\xcc\xfa\xcd\x02\xce\x0e\xed\x01\xedP\x05L\x05\xbc\xf5\xc9\xf5\xc9\xf5\xc9\xf5\x99י\xd7\x01\xe3\xfd\xe2\x0f\t\x0f\tQ\x1eQ\x1e\r \t Y%]%U%M%\xbf/\xc3/\xc8/\xc3/\xb6\xf4\xc3\xf4\x01\xf4\xfd\xf3\xc7\xef\xd8\xef\xa2\xe1\x95\xe1\x14\xcc\x18\xcc!\xd6%\xd6\xd4\x03\xd0\x03_\x15c\x15,!,!\xfa\x1e\xfa\x1e\xcd\x1a\xc9\x1a&'&'\xf64\xff4X'T'\r\xcd\x11\xcd\v\xce\v\xce\n\xd5\xfe\xd4\xfe\xd4\x02\xd5&\xea7\xea@\xea;\xea!)!)))%)\a$\v$\a$\x03$|\xf9\x80\xf9\xac\xe2\xac\xe2\xe3\xe9\xe7\xe9:.?.?.2.b\xcb^\xcbV\xcbg\xcb\xe2!\xe2!\x8e0\x860Z-_-M\xefM\xef\xf9\xe7\xfd\xe7xځ\xda@\xd9;\xd9+\xd7'\xd7r\xean\xea%)-)\xa2\r\x99\r\x8fϋ\xcf\xe7\xd1\xec\xd1\xed\xd9\xe9\xd9\xfa\xd9\xf1\xd9c\xdac\xda[\xdal\xda\xfb!\xfb!\xff!\xfb!\x14\xf3\x18\xf3\x1d\xf3\f\xf3K\xd8O\xd8S\xd8[ت\xe8\xa6\xe8\xa6\xe8\xa2\xe8l\x15p\x15q\x1fl\x1f\x8f\x18\x8f\x18\x93їѠћ\xd1H6H6M6Q6U\x14Q\x14\xe3\xf8\xe3\xf8\x9b\xee\x9f\xee\xe6\xd5\xe6\xd5\xdd\xd5\xd9մ5\xac5\xb05\xb95q$q$\xc3\u05ff\u05fb\u05f7\xd7\x1c&\x1c&(&1&\x8c%\x94%\x88#\x88#\x88#\x84#W\xd8S\xd8W\xd8S\xd8`\xd8[\xd8B+F+>+O+\xac\x18\xb5\x18\xf4\xd6\xec\xd6\xec\xd6\xe3\xd6Y\xdef\xde^\xe1R\xe1R\xe1V\xe1M\xd2Q\xd2U\xd2^\xd2Y\xd2U\xd2d$`$S$W$`$\\$\xec\xe2\xec\xe2\xf8\xe2\t\xe3\r\xe3\xf8\xe2\x9a\xfc\x96\xfc\x9a\xfc\xa3\xfc\xaf\xfc\x9e\xfc\x99ווויי\xd7\xe8\xe5\xed\xe5\xe1\xe8\xe9\xe8\xee\xe8\xdd\xe8:$G$\xc15\xc15\xc15\xca5\xb95\xb45H Q U Y a ] \xa0\xec\x98\xec\x8f\xec\x93\xec\xf4\xee\xf4\xee:\x13B\x13G\x13>\x13\x81\xd5|Ս՞՞Ֆ\xd54\x1e/\x1e+\x1e4\x1e\x90\xea\x87\xea\xda\xce\xde\xce\xde\xce\xd6\xce\xd1\v\xd5\vA\x17A\x17=\x17=\x177\xd9;\xd9;\xd97\xd9;\xd9H\xd9\xce\xd8\xce\xd8\xd2\xd8\xc5\xd8\xc5\xd8\xc1\xd8\xcc\xe8\xc8\xe8\xd4\xe8\xdd\xe8\xd8\xe8\xd4\xe8\xd0\xcd\xcb\xcd\xd8\xcd\xd4\xcd\xcb\xcd\xc7ͥ1\xb21\xb61\xad1\x981\x941\xe1\xd7\xfa\xd7\x03\xd8\xfa\xd7\x03\xd8\xfa\xd7]\xcdf\xcdb\xcd]\xcdb\xcdj\xcd\xff\xd7\xff\xd7\xcb\xd9\xcf\xd9\xcbپٰش\xd8\x05\xd7\x0e\xd7\x1a\xd7\x1a\xd7\x1a\xd7#\xd70\xd0#\xd0\x1b\xd0#\xd0#\xd0#\xd0q\xccq\xcce\xcav\xca~\xca~ʃ\xcaz\xcaz3r3i3i3v3v3?3G3\xf51\xf51\xe91\xe91\xe41\xe41~\"v\"|\xed|\xedx\xed\x84\xed\x8d\xed\x88\xed\xae\xed\xc4\xed\xcc\xed\xb7\xed\xb3\xed\xc8\xed\xd0\xed\xc4\xed\x85ڍ\xdaX\xd6P\xd6C\xd6C\xd6K\xd6\\\xd6\x02\xe6\xf9\xe5\xe5#\xea#\xe1#\xee#\xf2#\xee#\xea#\xf2#* \" \x1a \r \x1a \x1e \x1a \x1e \xef\x1f\xf4\x1f\xe3\x1f\xde\x1f\xde\x1f\xde\x1f\xe3\x1f\xe7\x1f\xda\x1f\xe3\x1f3\xe3/\xe3+\xe3+\xe3/\xe33\xe33\xe33\xe3\xf1\xde\xe9\xde\xfc\xd8\xf8\xd8\xf8\xd8\x04\xd9\x00\xd9\xf8\xd8\xf4\xd8\x00\xd9\xe4\xdb\xdf\xdbg\xe1k\xe1g\xe1R\xe1V\xe1k\xe1o\xe1o\xe1o\xe8o\xe8:.2.).%.!.\x1d.\x1d.%.!.\x19.\x98\"\x98\"\x94 \x90 \x90 \x90 \x9d \x98 \x90 \x98 \xa1 \x9d \xa8\xe7\xa4\xe7\xa8\xe7\x9c\xe7\xa0\xe7\xa4\xe7\xa4\xe7\xac\xe7\xa8\xe7\xa4\xe7\xa4\xe7\xa8\xe7\x8c҄ҀҀҙҙҌҌ҄\xd2{\xd2{Ҁ\xd2\xe1*\xdc*\xdc*\xdc*\xdc*\xe5*\xed*\xed*\x85Ӂ\xd3u\xd3u\xd3u\xd3}ӅӅ\xd3$\xe6\x1b\xe6\n\xe6\x17\xe6$\xe6,\xe6$\xe6\x1b\xe6T\xcaX\xca\\\xcai\xcae\xcai\xcae\xcaeʂۇ\xdb\x0f\xe4\x0f\xe4\v\xe4\v\xe4\v\xe4\x0f\xe48\xe8/\xe8/ / 3 7 ; 7 7 / \xf6\xf4\a\xf5\v\xf5\x0f\xf5\a\xf5\xfe\xf4\x02\xf5\x0f\xf5\x0f\xf5\x02\xf5\x13+\x13+\x13+\x0f+\a+\x02+\v+\x18+)\x13\x1c\x13\xe6\xd0\xe6\xd0\xee\xd0\xf2\xd0\xf2\xd0\xee\xd0\xe6\xd0\xe6Т\x12\x9e\x12E4E4E4E4A4E4I4A4\xde0\xda0q$q$y$~$u$y$\x82$~$q$h$N2V2R2N2J2[2c2_2J2F2\f)\f)0\x1c4\x1c=\x1cE\x1c=\x1c9\x1cA\x1cE\x1c=\x1cA\x1cU\xeaY\xea\xc1\xe2\xb5\xe2\xb9\xe2\xbd\xe2\xbd\xe2\xc1\xe2\xb9\xe2\xc1\xe2\xb5\xe2\xb9\xe2t!x!P.T.X.P.C.?.?.G.?.C.G.?.\x93\xe7\x93\xe7\x8b\xe7\x86\xe7\x8b\xe7\x86\xe7\x8f\xe7\x8f\xe7\x86\xe7\x86\xe7\x9c\xe7\x97\xe7Z#R#\x145\v5\a5\x035\a5\a5\a5\xff4\xfb4\xf64\xf24\xfb4b*b*\xa2צתת\u05f7ציצת\u05eeײ\u05f7\u05f7\u05f7\xd7x\xebx\xeb\xcd\xf0\xc5\xf0\xcd\xf0\xc9\xf0\xc9\xf0\xd1\xf0\xda\xf0\xda\xf0\xd1\xf0\xd1\xf0\xd6\xf0\xd1\xf0\xd1\xf0\xd1\xf0Y\xd4]\xd4\xd1\xce\xcd\xce\xc9\xce\xc9\xce\xcd\xce\xcd\xce\xc9\xce\xc5\xce\xd1\xce\xc5\xce\xc5\xce\xc5\xce\xc9\xce\xc9\xce\xcd\xce\xc9\u038b.\x86.\x82.\x86.\x8b.\x82.\x86.\x93.\x9c.\xa0.\x97.\x8b.\x86.~.\x82.\x82.\x86.\x86.\xa8)\xa8)\v$\v$\x03$\x10$\x14$\a$\a$\a$\xff#\x03$\v$\x03$\x03$\xff#\xfb#\xf2#\xf2#\xf2#_!g!\x14\xee\x14\xee\x18\xee%\xee%\xee%\xee\x10\xee\x03\xee\x10\xee!\xee\x1c\xee\x1c\xee\x1c\xee!\xee!\xee\x14\xee\x18\xee\x18\xee\x10\xee\x14\xee%\xee)\xeel\xd8h\xd8\xc1ӸӸӰӼ\xd3\xc9\xd3\xd6\xd3\xd6\xd3\xcd\xd3\xc5\xd3\xcd\xd3\xd2\xd3\xda\xd3\xd2\xd3\xc5\xd3\xc1\xd3\xd2\xd3\xd2\xd3\xd2\xd3\xd2\xd3\xd6\xd3\xd2\xd3\xd6\xd3\xda\xd3\x1b\xda$\xdax\xdck\xdcg\xdco\xdco\xdcg\xdcg\xdcs\xdcs܄܍܀\xdc|\xdc|\xdcs\xdcg\xdco\xdcx\xdcs\xdc|\xdcx܀\xdcx\xdco\xdcs܀\xdc|\xdcxܐ\x14\x94\x14\xca$\xc5$\xc5$\xd6$\xda$\xd2$\xd6$\xd2$\xc1$\xb9$\xac$\xb9$\xc5$\xca$\xd2$\xce$\xca$\xc5$\xc5$\xc5$\xc1$\xb9$\xb0$\xb0$\xb4$\xb4$\xbd$\xc1$\xbd$\xb9$\xb4$\xa8$\xac$\xb4$\xf8)\xf4)D1H1L1U1H1H17171317131@1;1;1;1*1*131/1&1\"1&13131317171@171*1*1*1\"1&13131*1\x1e1\x1a1&131&1\x1a1\x1a1*1&1\xae*\xae*z\xe2\x86\xe2O\xccK\xccK\xccK\xccO\xccX\xccO\xccS\xccO\xccO\xccX\xccX\xcc\\\xcc`\xccd\xccX\xccS\xccK\xccO\xccS\xcc\\\xccX\xccS\xccS\xccX\xcc\\\xccS\xccK\xccS\xccS\xcc\\\xcch\xcch\xccd\xcc\\\xccS\xcc\\\xccd\xccd\xcc`\xccd\xccq\xccq\xcch\xccd\xccd\xcch\xccd\xccd\xccd\xccm\xcch\xccq\xccm\xccq\xccy\xcc~\xccu\xccq\xccm\xccq\xccq\xccq̂̊\xcc~\xccu\xccy\xccy\xccq\xcc~̂\xcc~̂̎̊\xccy\xccu\xccy\xcc~\xcc\x19\xcf\x15϶\xd4\xc2ԏۏ\xdb[\xe4S\xe4Q\xeaL\xeaH\xeaD\xeaQ\xeaY\xeaL\xeaU\xeaY\xeaQ\xeaH\xeaH\xeaQ\xeaU\xea]\xeaa\xeaY\xeaQ\xeaU\xea]\xea]\xea]\xeaf\xeaa\xeaY\xeaQ\xeaU\xeaQ\xeaQ\xeaL\xeaL\xea]\xeaf\xeaf\xeaf\xeaf\xeaa\xeaj\xeaj\xea]\xeaY\xeaf\xeaj\xean\xeaj\xeaf\xeaf\xeaf\xea]\xeaQ\xea]\xeaf\xeaj\xeaa\xeaf\xeaf\xeaa\xea]\xeaf\xeaa\xean\xeaa\xeaa\xea]\xeaf\xear\xeaj\xeaf\xeaj\xean\xear\xean\xeaj\xeaj\xeaf\xeaj\xeaw\xea{\xear\xeaa\xea]\xeaa\xeaj\xeaj\xear\xear\xean\xeaf\xea]\xeaa\xeaj\xear\xear\xea\u007f\xea\x83\xea{\xear\xeaf\xean\xear\xean\xeaa\xeaw\xea{\xear\xeaa\xean\xea{\xeaw\xear\xeaw\xea\u007f\xeaw\xean\xean\xeaw\xea{\xea{\xea{\xea\u007f\xear\xean\xean\xea{\xeaw\xear\xea{\xea{\xea\u007f\xea\x83\xea\x83\xea\u007f\xea{\xea{\xea\x83\xea\x8c\xea\u007f\xea\u007f\xea\u007f\xea{\xea\u007f\xea\x87\xea\x96\xe4\x8a\xe4\xdf\xdb\xe4\xdb=\xd5=ՔϏ\xcf\t\xcd\x01\xcd\xfc\xcc\xfc\xcc\t\xcd\r\xcd\r\xcd\r\xcd\x16\xcd\x16\xcd\x16\xcd\x16\xcd\r\xcd\x11\xcd\x1e\xcd\x1e\xcd\x11\xcd\t\xcd\t\xcd\x16\xcd\x1e\xcd\x11\xcd\x11\xcd\"\xcd/\xcd+\xcd\"\xcd\x1e\xcd\"\xcd+\xcd+\xcd'\xcd/\xcd'\xcd/\xcd<\xcd@\xcd3\xcd\x1a\xcd\x11\xcd'\xcd/\xcd/\xcd+\xcd/\xcd/\xcd3\xcd+\xcd7\xcd@\xcd<\xcd/\xcd/\xcd/\xcd7\xcd/\xcd3\xcd<\xcd7\xcd@\xcdD\xcd@\xcdD\xcd@\xcdH\xcdM\xcdQ\xcdM\xcd<\xcd3\xcd<\xcdH\xcdQ\xcdD\xcdD\xcdQ\xcdM\xcdD\xcd@\xcdH\xcdD\xcdD\xcdQ\xcdH\xcdQ\xcdQ\xcdQ\xcdU\xcdQ\xcdM\xcdQ\xcdY\xcdQ\xcdU\xcdH\xcd@\xcdQ\xcdj\xcdn\xcdb\xcd]\xcdQ\xcdY\xcdf\xcdj\xcds\xcdn\xcdb\xcd]\xcdY\xcdZ\x17Z\x17\x030\a0\x030\a0\v0\xfe/\xfe/\v0\x0f0\a0\x0f0\x140\a0\x030\xfe/\xfe/\xfe/\xfe/\x030\x030\x030\a0\x030\xfe/\x030\x030\xfa/\xf2/\xf2/\xfa/\xfa/\xf2/\xe5/\xe5/\xe5/\xe9/\xf2/\xee/\xe1/\xdd/\xdd/\xe9/\xee/\xee/\xe9/\xe5/\xe1/\xe9/\xdd/\xdd/\xd8/\xd0/\xd0/\xd8/\xd4/\xd8/\xd8/\xdd/\xe9/\xf2/\xe9/\xe1/\xd8/\xd4/\xd4/\xd8/\xcc/\xd0/\xd0/\xd4/\xd8/\xdd/\xd8/\xe1/\xd0/\xd0/\xc0(\xaf(\x91#\xa2#\xa2#\x9e#\x9e#\x95#\x91#\x95#\x99#\x99#\x8d#\x91#\x95#\x99#\x91#\x80#\x84#\x84#\x88#\x91#\x95#\x91#\x8d#\x8d#\x88#\x95#\x8d#\x91#\x95#\x95#\x88#\x84#\x88#\x91#\x91#\x91#\x88#\x91#\x95#\x88#x#x#\x84#\x84#\x84#\x88#|#|#\x80#|#\x80#x#s#\x84#\x84#|#\x84#|#k#o#x#|#|#|#o#k#\xd2\xdd\xce\xdd\xc1\xdd\xc5\xdd\xd2\xdd\xdf\xdd\xe7\xdd\xe3\xdd\xd6\xdd\xda\xdd\xce\xdd\xca\xdd\xd2\xdd\xdf\xdd\xda\xdd\xdf\xdd\xd2\xdd\xce\xdd\xd2\xdd\xd6\xdd\xd6\xdd\xce\xdd\xd2\xdd\xd6\xdd\xd6\xdd\xd6\xdd\xe3\xdd\xeb\xdd\xe7\xdd\xd6\xdd\xdf\xdd\xe7\xdd\xe7\xdd\xeb\xdd\xe3\xdd3\xde<\xdeQ\xde\u007f\xdef\xde]\xde{ރވރ\xde{\xde\u007f\xdeb\xde]\xdej\xdeQ\xdeQ\xdeU\xde@\xde/\xde3\xde7\xde@\xdeQ\xdeQ\xdeH\xdeH\xde\b\xd6\xff\xd5\b\xd6\b\xd6\b\xd6\x03\xd6\xf3\xd5\xf7\xd5\xfb\xd5\xe2\xd5\xdd\xd5\xe6\xd5\xe6\xd5\xe2\xd5\xe6\xd5\xdd\xd5\xd9\xd5\xd9\xd5\xd9\xd5\xdd\xd5\xcd\xd5\xc8\xd5\xe2\xd5\xf3\xd5\xe2\xd5\xd1\xd5\xd5\xd5\xe2\xd5\xe2\xd5\xe2\xd5\xe2\xd5\xe6\xd5\xea\xd5\xd9\xd5\xe2\xd5\xe2\xd5\xd5\xd5\xd5\xd5\xe6\xd5\xd9\xd5\xd5\xd5\xcd\xd5\xc8\xd5\xc4\xd5\xd1\xd5\xd9\xd5\xdd\xd5\xee\xd5\xee\xd5\xe2\xd5\xd5\xd5\xc8\xd5\xd1\xd5\xd5\xd5\xdd\xd5\xd9\xd5\xdd\xd5\xe6\xd5l\xdfp\xdf\x01\xef\xf8\xee\xf4\xee\xfc\xee\x05\xef\xfc\xee\xf8\xee\xf0\xee\xf0\xee\xfc\xee\x01\xef\xf8\xee\xf8\xee\x01\xef\xfc\xee\xf8\xee\x01\xef\xfc\xee\xf8\xee\xf8\xee\xf0\xee\xd6\xee\xda\xee\xda\xee\xda\xf0\xe2\xf0\xde\xf0S\xf0W\xf0_\xf0.\xf1.\xf1.\xf1\u007f\xf1z\xf1r\xf1z\xf1\x83\xf1v\xf1\xeb\xf0\xf3\xf0\xf7\xf0\x81\xf0\x89\xf0\x81\xf0\v\xf0\x17\xf0\x0f\xf0\xf2\xed\xfb\xed\x03\xee\xca\xee\xc1\xee\xc5\xeen\xefw\xef\xa0\"z\"v\"v\"\xff!\xff!\b\"\xe6!\xde!\xd9!\xe6!\xe6!\xe6!\b\"\f\"\b\"*\"!\"\x1d\"&\".\"2\"\x15\"\x04\"\xf7!\xef!\xfb!\b\"\xc0!\xb8!\xb8!\xcf \xc3 \xc3 w \x83 \x83 \xa1 \xa1 \xa5 \x06!\n!\xfe [![!R!x!\x81!\x8d!\x89!\x89!\x81!x!l!t!\x85!\x01(\xfd'\x81+\x8e+\x8e+\x9b+\x9b+\x92+\x9b+\x96+\xa3+\xa7+\xa7+\xab+\xa7+\x9b+\xa3+\xa7+\xab+\xb4+\xbc+\xb0+\xa7+\xa7+\xa3+\x9f+\x90*\x9d*\xaa*\xcf'\xcf'\xd3'm)`)X)Q*M*D*<*D*@*#*'*'*Q*I*D*\xa1*\xa1*\x99*\xed*\xf6*\xf2*`)\\)X)W$S$I\xd0\xe9\xd2\xe9\xd2\xed\xd2f\xd2j\xd2o\xd2\\\xd1T\xd1O\xd16\xd1>\xd1GѓћѠ\xd1\x12\xd2\x1a\xd2\x16\xd2\t\xd2\r\xd2\r\xd2\xd7\xd1\xd2\xd1\xdb\xd1\xce\xd1\xd2\xd1\xce\xd1\xd7\xd1\xd2\xd1\xd7\xd1\xec\xd1\xe7\xd1\xdb\xd1T\xcf]\xcfa\xcfX\xd1X\xd1\\\xd1)\xd1)\xd1:\xd1u\xd1q\xd1m\xd1\x12\xd2\x16\xd2\x1e\xd2D\xd2@\xd2<\xd2-\xce(\xce \xce\x06\xd0\xd4\xef\xd8\xef\x93\xee\x8e\xee\x93\xeeG\xee>\xee:\xee\t\xef\t\xef\x16\xef\x17\xf0\x17\xf0\v\xf0c\xf0_\xf0c\xf0\xa5\xef\xaa\xef\x99\xef\xba\xef\xc3\xef\xc7\xefc\xf0c\xf0c\xf0^\xf4j\xf4o\xf4@\xf4/\xf43\xf4\x1d\xf3)\xf3!\xf31\xee)\xee\x18\xee>\xeeG\xeeO\xee\x9d\xef\x94\xef\x90\xef\x9f\xf0\x9f\xf0\x96\xf0\xb4\xf0\xab\xf0\xa7\xf0\x92\xf0\x9f\xf0\x89\xf0/\xef/\xef+\xef\x88\xef\x88\xef1\xd8\r\xd9\x11\xd9\"١٘٘\xd9\xf5\xdb\xf0\xdb\xdb\xdbT\xdbX\xdbe\xdbR\xdaR\xda[ډ\xda}\xdax\xda\x00\xdb\f\xdb\x04\xdb\x00\xdb\x00\xdb\x00ۧ߯ߴ\xdf1\xdf-\xdf1\xdfB\xdd:\xdd:\xddg\xdcs\xdckܷܻܿ\xdc`\xd8d\xd8`\xd8\xea\xda\xea\xda\xea\xda,\xda5\xda0\xda\xcf\xdb\xd3\xdb\xc6۾۾۾\xdbP\xdbT\xdbLۊ0\x970\x8a0e,e,j,\xd8*\xe5*\xe5*E-=-J-\t/\xf4.\xfd.\x9c.\x97.\x97.\\.`.K.p-|-t-\xf2(\xf2(\xf7(((\x1f(#(v,\u007f,{,\x85-\x80-\x80-\xc4-\xcd-\xc4-).).2.\xca.\xc2.\xbd.s*o*f*\xd6+\xde+\xe7+\xe2-\xee-\xdd-\xd4/\xd4/\xe1/\xec3\xf03\xec3\xee/\xe5/\xe5\xef\x99\xed\x9e\xed\x99\xed\xcb\xea\xcf\xea\xc3\xea\xb3\xeb\xbc\xeb\xc4\xebL\xecC\xec6\xecW\xeb[\xebc\xeb\xa5\xea\xa1\xea\xa5\xea\xc3\xea\xbe\xea\xb6\xea\xc3\xea\xc3\xea\xba\xeaw\xea\x83\xea\x83\xea\xfe\xef\x06\xf0\xf6\xef\xf8\xee\x01\xef\xf8\xeee\xeci\xecr\xec\x13\xeb\x02\xeb\xfa\xeat\xebt\xebp\xeb\xa5\xe5\xa9\xe5\xb6\xe5\x19\xe7\x19\xe7\x14\xe7\x9d\xe8\xaa\xe8\xae\xe8M\xe8@\xe8@\xe8&\xec.\xec.\xec\xfc\xe9\xf4\xe9\x04\xea\xe9\xea\x8a+u+W&_&c&\x03)\xf2(\xf7(\xec)\xf4)\xf4)\x06(\xf9'\x01(t(t(t(\x86)~)u)`)\\)\\)\xef\x1f\xef\x1f\xf4\x1f\xce$\xd2$\xb9$\xc1$\xce$\xd2$\x16#\x1b#\x1f#\xe7$\xf0$\xf0$\xda&\xd5&\xde&\xe6&\xde&\xe2&S&[&J&7%3%3%\xf9\"\x01#\x05#\xd8%\xcb%\xd8%\xc02\xd12\xc92\xca)\xce)\xce)\x91/\x8c/\xca\xfd\x8d\xfe\x96\xeb\x8d\xeb\x91\xe8\x91\xe8\x99\xe8\xc6\xe7\xc6\xe7\xc6\xe7\x91\xe8\x95\xe8\x95\xe8\x05\xe8\x01\xe8\x01\xe8\x1d\xe7\x1d\xe7*\xe7\x10\xe7\x03\xe7\xf7\xe6\xe6\xe6\xf7\xe6\x03\xe7\xab\xe6\xa2\xe6\x9e\xe6c\xe6c\xe6Z\xe6J\xe6N\xe6_\xe6E\xe64\xe60\xe63\xe3<\xe33\xe3\xb2\xe3\xae\xe3\xae\xe3\xb4\xe4\xac\xe4\xb0\xe4\x81\xe4\x85\xe4\x8e\xe4}\xe4}\xe4\x81\xe4\xde\xe4\xda\xe4\xd2\xe4~\xe7\x82\xe7\x8b\xe7\xee\xe6\xee\xe6\xee\xe6+\xe8/\xe8'\xe8\xec\xe2\xf0\xe2\xf4\xe2\xf2\xe3\x9d\xef\xa1\xefa\x16a\x16X\x16,\x174\x17,\x17K\x18C\x18K\x18\xf9\x1b\xf9\x1b\x02\x1c(\x1c$\x1c\x1f\x1c\xb7\x1c\xb3\x1c\xc4\x1c\xb6\x1b\xa5\x1b\x9c\x1b\x15\x1b\x1e\x1b*\x1bD\x1b;\x1b3\x1be\x16r\x16r\x16G\x18C\x18C\x18(\x17\x1f\x17\x12\x17\xe0\x16\xe4\x16\xe4\x16\f\x18\a\x18\xf7\x17\x03\x18\x10\x18\x10\x18\x03\x18\xff\x17\xfb\x17m\x18`\x18\\\x18\x86\x18\x86\x18\x82\x18\x9b\x18\xa0\x18\x9b\x18\xca\x18\xc6\x18\xce\x18\xe3\x18\xdb\x18\xdb\x18\xf0\x18\xe7\x18\xe3\x18\x01\x19\x01\x19\t\x19\xb6\x1b.';'O0K0:0\x140\x140\v0\x12/\x0e/\t/\x01/\x01/\xf0.\xbd.\xc2.\xc6.`.e.\\.:.G.G.%.!.\x10.\xe2-\xea-\xe6-\xcd-\xd5-\xdd-\xc0-\xb7-\xab-\x91-\x89-\x8d-\x80-\x80-\x80-|-|-k-R-N-R-R-V-R-9-9-A-E-E-=-,-(-$-(-$-\x1f-\x13-\x0e-\x13-\x17-\x13-\x1b-\n-\x06-\x0e-\x13-\x06-\n-\x0e-\x0e-\x13-\x02-\x98 \x94 \x8c \x94 \x98 \x90 \x94 \x94 \x90 \x90 \x90 \x90 \x94 \x94 r j n r \x87 \x87 { w { w \u007f \u007f \u007f \x87 \x8c \x83 \u007f \x87 \x83 { r n f n w \x83 \x83 n a n \u007f w n w j ] ] j f n j f n w j f a a f f ] ] ] U U Y Y ] ] U D L Q U a Y U L T.K.?.?.C.K.P.P.P.X.\\.\\.K.O+S+S+\x17-\x13-\x0e-\xca)\xc6)\xca)\x15,\x19,\x11,\xde+\xde+\xeb+\xef+\xda+\xcd+\xb6,\xbe,\xba,\x8b,\x8b,\x8b,\xe0,\xed,\xed,(-,-4-)0 0)0\xe70\xe70\xe70t2p2p2\x8c1\x8c1\x8c1\xe70\xe70\xe70\xdc*\xd8*\xd4*\x01*\x01*\x05*\xfb(\xfb(\xfb(\xdd(\xd9(\xd9(\xfe*\a+\a+b*j*^*[+_+d+\xc9+\xc5+\xbc+\xd6+\xda+\xd6+],],Y,\x02\xd5\x06\xd5\x0e\xd5N\xd5R\xd5VՍՍՑ\xd5a\xe0e\xe0a\xe0\xfa\xd9\xf1\xd9\xfa\xd9\xe0\xde\xe0\xde\xe5ީ\xe0\xb1\xe0\xb1\xe0\xdc\xde\xdc\xde\xe0\xde{كك\xd9V\xdcI\xdcI\xdc\xd8\xd7\xe5\xd7\xe9וב\u05cc\u05c9ՖՉ\xd5\xc3\xd7\xd0\xd7\xd4\xd7\xc6\xca\xca\xca\xd7\xca\x01\xd2\x01\xd2\xf4\xd1w\xcd{͈\xcd\xeb\xc7\xe3\xc7\xd6\xc7h\xd3yӁ\xd3\x11\xcd\t\xcd\r̓χσ\xcf\xdc\xd9\xcf\xd9\xe4\xd9\x16\xd2\t\xd2\tҧկշ\xd5\xfe\xd4\xfe\xd4\n\xd5g\xdac\xda_\xdaq\xd8u\xd8}ؾ\xd4\xcb\xd4\xc7\xd4\xd4\xd2\xd0\xd2\xdc\xd2\xd2\xd1\xce\xd1\xdb\xd1\xe1\xd7\xdd\xd7\xe5\xd7p\xceh\xcec\xce\x03\xd1\aѽ\x1f\xd0\x1e\xc8\x1e\xd8\x1e\xde\x1f\xd2\x1f\xce\x1f\xda\x1f\xd6\x1f\xce\x1f/\x1e+\x1e\x1f\x1e\xa4\x1d\xac\x1d\xa8\x1dq\x1dv\x1d\x82\x1d\xac\x1f\xac\x1f\xa8\x1f\xa2\x1c\x9e\x1c\xa2\x1c/ * * \x1f\x1e\x1f\x1e\x1f\x1eH H L \xe0 \xe9 \xe4 ,!$!(!?\"C\";\"6\"2\"2\"\x105\x145\a5\xfe1\x022\x062!5%5\x105\x185!5\x1c5\x1c5\x145\x1c5)5%5\x1c5\xc44\xc84\xbf4\x1c5!515929292N2A2F2\x140\x180\x140P.T.K.\x91-\xa2-\xa7-\xda+\xda+\xde+[+[+[+M*D*<*\xa4)\xa8)\xa0)\f)\f)\f)\x80(\x84(\x80(\x12(\x17(\x12(\xa9'\xa5'\xec\xf8\x97\xf8\x93\xf8\x93\xf8K\xf8T\xf8T\xf8\f\xf8\x03\xf8\b\xf8\b\xf6\b\xf6\b\xf6\xd6\xf3\xd2\xf3\xd2\xf3\x8c\xf4\x8c\xf4\x80\xf45\xf55\xf51\xf5\x84\xf4\x88\xf4\x84\xf43\xef3\xef3\xef-\xf3:\xf3:\xf3\x12\xf2#\xf2\x1f\xf2!\xf3\x1d\xf3-\xf3N\xf0B\xf0B\xf0\xaf\xf2\xb3\xf2\xaf\xf2\xda\xee\xdf\xee\xdf\xee\xc9\xf0\xc9\xf0\xc5\xf0\xdc\xef\xe5\xef\x02\xf0\xaf\xf2\x9a\xf2\x84\xf2\x9c\xf1\x9c\xf1\xb1\xf1\v\xee\xf6\xed\xe1\xed\x9c\xf1\xa9\xf1\xad\xf1\xd6\xf0\xda\xf0\xd1\xf04\xf24\xf2,\xf2\xaf\xf2\xbb\xf2\xb3\xf2\x1d\xf3\x1d\xf3\x14\xf3\x9e\xed\xa2\xed\xaa\xed\xc7\xef\xba\xef\xb6\xef\x9f\xee\xac\xee\xb0\xee\xf5\xf1\xf1\xf1\xf9\xf1\xad\xf1\xb1\xf1\xad\xf1R\xeb[\xeb[\xeb\xa9\xf1\xa5\xf1\x9c\xf1\\\xeeW\xeeW\xee4\xf2A\xf2E\xf2\xd5\xf2\xcc\xf2\xc4\xf2\xb0\xf3\xb0\xf3\xb5\xf3\x88\xf4\x88\xf4\x84\xf4\r\xf4\x12\xf4\x12\xf41\xf51\xf5)\xf5\xb2\xf4\xbb\xf4\xcc\xf4\x8a\xf5u\x1ap\x1a\x02!\x02!\x02!G\x1dG\x1dG\x1d\v\x1f\v\x1f\x0f\x1f\x8f\x1d\x8f\x1d\x82\x1d\xb9\x1d\xc2\x1d\xc2\x1d\xd5!\xd1!\xd5!E\x1e8\x1e@\x1e\xde\x1f\xeb\x1f\xf8\x1f\t\x1e\x01\x1e\xfd\x1dw\x1ek\x1ew\x1e\x9c\x1d\xa0\x1d\xa0\x1d\x9c\x1d\x97\x1d\x97\x1d!\x1d!\x1d6\x1d\f\x1d\b\x1d\x03\x1d\xfe\x14\xf6\x14\xf1\x14\r\x0f\x15\x0f\x1a\x0f%\x13\x1c\x13G\xee\t\xef\x11\xef\x11\xefw\xefn\xefs\xefv\xf1v\xf1v\xf1{\xe5{\xe5\x83\xe5\"\xef\"\xef'\xef\xa9\xea\x98\xea\x9d\xea\xe0\xef\xd8\xef\xcb\xef\xad\xec\xb9\xec\xb5\xec\x81\xf0}\xf0\x81\xf0\xc7\xef\xc7\xef\xcb\xef\x9c\xf1\x9c\xf1\xa0\xf1\xcb\xf1\xbe\xf1\xb5\xf1\x1b\xf2\x1b\xf2(\xf2`\xfaS\xfaS\xfa\x89\xf7|\xf7\x81\xf7\xcc\xf9\xd4\xf9\xe1\xf9(\xf7\x1b\xf7\x0e\xf7\xf0\xf8\xec\xf8\xf4\xf8\xee\xf7\xee\xf7\xd9\xf7\xae\xfe\xb3\xfe\xbb\xfe\x8e\xfa\x97\xfa\x9f\xfaR\xfcN\xfcJ\xfc\xfa\xf9\xfa\xf9\x03\xfa\xa8\xfa\xac\xfa\xa8\xfak\xf9s\xf9w\xf9\x95\xf9\xa2\xf9\xaa\xf9\xe8\xf8\xe3\xf8\xf9\xf8\xd7\xf8\xd3\xf8\xce\xf8e\xf8`\xf8`\xf8G\xf8K\xf8C\xf8\x03\xf8\f\xf8\f\xf8\xd9\xf7\xd5\xf7\xd9\xf7\xa2\xf7\xaf\xf7\xbc\xf7\x91\xf7\x91\xf7\x9a\xf7x\xf7x\xf7t\xf7R\xf7Z\xf7c\xf7R\xf7Z\xf7N\xf79\xf7A\xf7A\xf70\xf74\xf7,\xf7\x17\xf7\x1f\xf7$\xf7\x02\xf7\xfe\xf6\n\xf7\x0e\xf7\x02\xf7\xf1\xf6\xed\xf6\xfe\xf6\xfe\xf6\xf1\xf6\xf1\xf6\xf1\xf6\xed\xf6\xe8\xf6\xe0\xf6\xdc\xf6\xe0\xf6\xe4\xf6\xe4\xf6\xdc\xf6\xdc\xf6\xdc\xf6\xe0\xf6\xe4\xf6\xd3\xf6\xd7\xf6\xe8\xf6\xe8\xf6\xe0\xf6\xdc\xf6\xd3\xf6\xd3\xf6\xd3\xf6\xd7\xf6\xe0\xf6\xe4\xf6\xdc\xf6\xdc\xf6\xd7\xf6\xd3\xf6\xd3\xf6\xdc\xf6\xe4\xf6\xdc\xf6\xcf\xf6\xc7\xf6\xd3\xf6\xd3\xf6\xd3\xf6\xd3\xf6\xd7\xf6\xe0\xf6\xd3\xf6\xcf\xf6\xd3\xf6\xd7\xf6\xd7\xf6\xd3\xf6\xcb\xf6\xbe\xf6\xba\xf6\xcb\xf6\xcf\xf6\xcb\xf6\xcf\xf6\xd3\xf6\xcb\xf6\xcb\xf6\xcf\xf6\xd7\xf6\xd3\xf6\xcb\xf6\xcf\xf6\xd7\xf6\xd3\xf6\xd7\xf6\xcb\xf6\xcb\xf6\xcf\xf6\xc2\xf6\xcb\xf6\xd3\xf6\xd7\xf6\xe0\xf6\xe0\xf6\xd3\xf6\xc2\xf6\xba\xf6\xbe\xf6\xcb\xf6\xd3\xf6\xcf\xf6\xd3\xf6\xcf\xf6\xcf\xf6\xd7\xf6\xe4\xf6\xe4\xf6\xd3\xf6\xcf\xf6\xd3\xf6\xcf\xf6\xcb\xf6\xcb\xf6\xd3\xf6\xd3\xf6\xd7\xf6\xdc\xf60\xf2,\xf24\xf2x\xf2g\xf2k\xf2!\xf3\x1d\xf3!\xf3\x8f\xf3\x8f\xf3\x93\xf3\r\xf4\x1a\xf4\x1a\xf4o\xf4o\xf4f\xf4\xb2\xf4\xb2\xf4\xbb\xf4D\xf4I\xf4D\xf4\x8c\b\x84\b\x84\b\xdd\x06\xe1\x06\xe6\x06S\x04N\x04F\x04\xe7\x02\xda\x02\xda\x02\x12\x01\x1b\x01\x1b\x01\xf4\xff\xe7\xff\xf0\xff\x99\xfe\x91\xfe\x84\xfe\xe4\xfd\xf0\xfd\xe8\xfdn\nr\nv\n\xc5\x02\xca\x02\xca\x02h\tl\tu\t\x03\x02\x03\x02\xfb\x01\xed\x03\xe9\x03\xe5\x03;\x00C\x00C\x00G\x00C\x00X\x00V\xfeV\xfe^\xfe\xe8\xfd\xdf\xfd\xdb\xfd\xb8\xfc\xb8\xfc\xb8\xfc \xfc\x1b\xfc$\xfcf\xfbf\xfbf\xfb\xd6\xfa\xda\xfa\xe7\xfa`\xfaW\xfaK\xfa\xd0\xf9\xe1\xf9\xe9\xf9\x84\xf9\x88\xf9\x88\xf9/\xf9/\xf94\xf9\xe3\xf8\xdb\xf8\xdf\xf8\x9c\xf8\xa8\xf8\xa8\xf8i\xf8e\xf8v\xf8?\xf8.\xf86\xf8\x1d\xf8!\xf8\x14\xf8\xe6\xf7\xea\xf7\xf3\xf7\xc0\xf7\xc0\xf7\xbc\xf7\xa2\xf7\xaf\xf7\xb3\xf7\x9a\xf7\x9a\xf7\xa7\xf7\x9a\xf7\x89\xf7\x81\xf7t\xf7p\xf7p\xf7c\xf7c\xf7g\xf7g\xf7k\xf7_\xf74\xf70\xf79\xf74\xf79\xf74\xf7,\xf79\xf7E\xf7(\xf7\x1b\xf7\x13\xf7\x13\xf7\x1b\xf7\x1f\xf7\x17\xf7\x1f\xf7$\xf7\x1b\xf7\x1b\xf7\x13\xf7\x06\xf7\x17\xf7\x0f\x1f\a\x1f\x95#\x99#\x95#\x99#\x99#\x99#\x95#\x91#\x84#\x88#\x88#\x91#\x91#\x84#\x84#\x84#\x80#x#x#|#x#x#o#b#V#Z#^#b#g#k#k#s#o#k#s#^#V#V#Z#^#^#^#Z#^#^#g#Z#R#R#R#Z#b#V#R#V#V#M#Z#R#I#I#R#E#E#A#<#8#4#4#<#<#<#8#<#A#8#8#4#<#<#+#\x1f###\xa7!\xa3!\xaf!Z\x1e^\x1eU\x1e5!(!,!\xd6\x1f\xda\x1f\xe3\x1fF!F!=!\xdc \xdc \xcf p!c!V!p!\x81!t!\xaf!\xb8!\xc0!\xea!\xea!\xe6!<\x1e@\x1e@\x1e\xb0\x1f\xa8\x1f\xa8\x1f\x00 \r \x00 \t \x04 \r \xdc \xe0 \xe4 \xb6 \xb6 \xba _!g!c!\xb1\x1b\xb6\x1b\xa5\x1b\x1c\x1f\x1c\x1f\x14\x1f<\x1eE\x1eI\x1e>\x1f:\x1f>\x1f\xc9\x1f\xc9\x1f\xc1\x1f\xeb\x1f\xf4\x1f\xf8\x1f\x94 \x94 \x90 \xa9 \xa5 \x98 \x13!\x17!\x1b!\xcb \xc3 \xbe v\x1b\u007f\x1b{\x1bl\x1fq\x1fu\x1f\x16\x1e\x0e\x1e\x0e\x1eF\x1fF\x1f>\x1f\x97\x1f\x97\x1f\x9b\x1f\xde\x1f\xe7\x1f\xda\x1fw w { { { w \xf1 \xfa \xed \n!\x13! !p!t!l!\x85!x!t!\xa7!\xaf!\xab!\x1e\x19\x1a\x19#\x19!\x1d\x10\x1d\b\x1d\xdd\x1c\xe2\x1c\xee\x1cq\x1di\x1d`\x1d\xb2\x1e\xae\x1e\xb2\x1eo\x1eb\x1eb\x1e\x97\x1f\x97\x1f\x9b\x1f\x92\x1f\x92\x1f\x8e\x1f3 7 3 ] ] Y \xf9\x1b\xfe\x1b\xfe\x1b^\x19b\x19f\x19\x1a\x1e\t\x1e\x12\x1e\xcf\x1b\xd3\x1b\xcb\x1bZ\x1eZ\x1eb\x1e\xf9\x1d\xf0\x1d\xec\x1d\x04\x1b\xfc\x1a\x00\x1bh\x1ad\x1aS\x1aT\x1dX\x1d\\\x1dA\x1c=\x1cA\x1c8\x1e8\x1e@\x1e\x01\x1e\x01\x1e\x05\x1e\xee\x1e\xe9\x1e\xee\x1e:\x1f:\x1f>\x1f\xa3\x1f\x97\x1f\x92\x1f\x11 \r \x04 7 / * \x9b\x1a\xab\x1a\xab\x1a\xc7\x1b\xb1\x1b\xa9\x1b\xdb\x1d\xe8\x1d\xf0\x1d\xd9\x1c\xcd\x1c\xc8\x1c\xdd\x1e\xe1\x1e\xe5\x1eb\x1eZ\x1eM\x1e5\x1f5\x1f:\x1f\x86\x1f\x8a\x1f\x8a\x1f\xd6\x1f\xd6\x1f\xd6\x1fU H 7 Q U ] \xb2 \xb2 \xad \xd8 \xe0 \xe4 \x13!\x0f!\n!0!5!0!R!N![!}!p!c!\x81!\x89!\x92!\xa7!\x9e!\x92!\xab!\xbc!\xc4!\xd1!\xcd!\xc0!\xd5!\xd1!\xc4!\xd9!\xea!\xe6!\xea!\xf3!\xfb!\xff!\xfb!\xf7!\xff!\xff!\xfb!\xff!\b\"\x04\"\x10\"\f\"\b\"\f\"\f\"\x15\"\x19\"\f\"\b\"\x15\"\x10\"\f\"\x10\"\x15\"\f\"\x1d\"!\"\x1d\"\x15\"\f\"!\"v\x1br\x1b\xbe\x16q\x18\x85Ձ\xd5\xca\xd6\xc2ֽ\xd6\xff\xd5\x10\xd6\x19֮ת\u05ee\xd7^\xd7f\xd7b\xd7\x14\xd8\x0f\xd8\a\xd8B\xd8S\xd8W؆؊ؗ\xd8\xf8\xd8\xfc\xd8\xf8\xd8\r\xd9\xf8\xd8\xf8\xd8/\xd97\xd9H\xd9p\xd3d\xd3d\xd3R\xd5R\xd5A\u0557֤֠\xd6\xee\xd5\xee\xd5\xeaՙ\u05eeע\xd7M\xd7I\xd7<\xd7\xdd\xd7\xe9\xd7\x03\xd8`\xd8[\xd8O\xd8u\xd8u\xd8y\xd8\xe3\xd8\xe7\xd8\xe7\xd8\x04\xd9\x04\xd9\x04\xd9D\xd9L\xd9U\xd9\u007f\xd9wكٝ٘ٝ\xd9\xc7\xd9\xc7\xd9\xcb\xd9\xf5\xd9\xf5\xd9\xf1\xd9\x13\xda\x06\xda\n\xda \xda$\xda \xda9\xda9\xdaF\xda[\xda[\xdaW\xdag\xdal\xdag\xdal\xdag\xdatږڞڞښږڞڧڧگڼڸگڳگڸڼ\xda\xc0\xda\xcd\xda\xde\xda\xd9\xda\xd5\xda\xd1\xda\xcd\xda\xd5\xda\xea\xda\xea\xda\xef\xda\xe2\xda\xe2\xda\xe2\xda\xea\xda\xea\xda\xea\xda\xe6\xda\xf3\xda\x04\xdb\b\xdb\x00\xdb\xfb\xda\x04\xdb\x04\xdb\f\xdb\f\xdb\x04\xdb\x00\xdbH\xd9U\xd9Y\xd9\xd5\xd5\xd5\xd5\xd5\xd5\xdd\xd7\xd8\xd7\xe5\xd7\x14\xd8\x18\xd8\x18\xd8\a\xd8\v\xd8\a\xd8\xef\xd8\xef\xd8\xf4\xd8\xc1ظ\xd8\xc5\xd83\xd9D\xd9H\xd9w\xd9{هٲ٩٥\xd9\xe9\xd9\xe9\xd9\xe4\xd9\xfa\xd9\x02\xda\x02\xda0\xda9\xda5ھ\xd9\xc7\xd9\xc7\xd9\x10\xd6\xfb\xd5\xf3\xd54\xd7@\xd7E\xd7S\xd8W\xd8W\xd8\xc3\u05ff\xd7\xc8\xd7\xc9\xd8\xce\xd8\xde\xd8\xef\xd8\xeb\xd8\xe3\xd8\x15\xd9\"\xd9\x1e\xd9{\xd9{ٌ١٩١\xd9\xd8\xd9\xd4\xd9\xd8\xd9\x13\xda \xda\x13\xda,\xda,\xda,\xdal\xdal\xdalڭ\xe0\xad\xe0\xa0\xe01\xdf5\xdf5\xdf\xe3\xdd\xe7\xdd\xf8\xdd\xe0\xde\xe0\xde\xe9\xde\xce\xdd\xc1ݴݽ\xdd\xc5\xdd\xcaݗݗݟ\xdd1\xdd6\xdd1\xdd)\xdd)\xdd-\xdd\xe5\xdc\xe1\xdc\xddܻ\xdc\xc4\xdc\xc4ܦܙܑ\xdcs\xdc|܀\xdc\xea\xdc\xf2\xdc\xea\xdct\xe1o\xe1g\xe1J\xdfS\xdfNߙޙސ\xde(\xdf-\xdf9\xdf\x16\xde\x16\xde\x1a\xde\x16\xde\x11\xde\x1e\xde\xf8\xdd\xf0\xdd\xeb\xddy\xddy\xddy\xddq\xddu\xdd~\xdd6\xdd>\xdd>\xdd\x03\xdd\x03\xdd\xff\xdc\xea\xdc\xff\xdc\xfb\xdc\xd5\xdc\xcc\xdc\xd0ܻܷܞܕܪܦ܄܄܍\xdcx\xdcs\xdc|\xdcs\xdco\xdcb\xdcV\xdcZ\xdc^\xdcE\xdcI\xdcM\xdcA\xdc8\xdc4\xdc8\xdcA\xdcA\xdc0\xdc\x1f\xdc\x1b\xdc\x12\xdc#\xdc'\xdc\x16\xdc\x16\xdc\x1f\xdc'\xdc0\xdc#\xdc\xe5\xdc\xea\xdc\xe1ܿ\xe3\xcc\xe3\xd0\xe3k\xe1k\xe1g\xe1\xbc\xdf\xc0\u07fc\xdf\xf9\xe0\xfd\xe0\xf5\xe0p\xdf}\xdf}\xdf=\xdfJ\xdfF\xdf \xdf$\xdf(\xdf\u007f\xde\u007f\xde{\xde]\xdef\xden\xde\"\xde\"\xde\x1e\xde\xca\xdd\xd6\xdd\xdaݹݽݴ\xddu\xdd~݂\xddS\xddK\xddS\xdd\xcb\u07b2*\xae*>0F0O0(-\x1f-(-?,;,*,\x13-\x02-\x06-\x85+\x85+}+J+F+J+1+$+ +w*w*s*Q*Y*Y*\r*\x12*\x1a*D\x1b?\x1b7\x1b\xb3\x12\xaa\x12\xa2\x12Z#g#g#f\x1ef\x1ek\x1e\xb3\x1c\xb3\x1c\xab\x1c2\".\".\"a ] a $! !\x17!\xd7\"\xdb\"\xe4\"\x9c\"\x8f\"\x8b\"I#M#M#\xf6#\xff#\x03$)$%$\x1c$\x97$\x8e$\x97$\xf0$\xfc$\xfc$\xd0#\xbb#\xc8#I\x12E\x128\x12\xf7\x17\xfb\x17\xff\x17\xae#\xbf#\xb7#\xf8\x1a\xef\x1a\xf3\x1a\x1f\x1e/\x1e+\x1e_\x1cc\x1cg\x1c\xff\x1c\xf7\x1c\xf3\x1c\xb0\x1f\xb0\x1f\xa3\x1f\x86\x1f\x81\x1f\x86\x1fn n n \x96!\x96!\x96!\xef!\xef!\xe6!~\"z\"r\"\x12#\x1f#'#\x88#\x88#\x88#\xf6#\xf6#\xee#O$O$S$\x001\xfc0\x001\x878~8z8[0`0S0\x140\x140\x0f0\x1e,&,\",\x99*\x95*\xa1*\u007f,v,j,\xae*\xb2*\xb2*8*8*3*\u007f*\x84*\x84*\xc6)\xca)\xce)\x82)z)q)d)d)d)\xfb(\xfb(\xf2(\xc8(\xc8(\xd9(\xaf(\xaf(\xa2(H,D,L,\x1f9(9#9\x941\x8c1\x9d1\x8c \x83 \x83 \xe8.\xdb.\xdb.\xc2,\xcb,\xc2,\x01(\x06(\n(\xb0+\xb0+\xb0+\xb6*\xae*\xa6*>)G)G)\x01*\xfd)\xf4)z)u)q)\xe1(\xe6(\xe6(\xf2(\xff(\xff(\xb7(\xaf(\xaa(g(x(o(c(g(g(4(,(#(\xf9'\xf1'\x01(\x99\x1e\x95\x1e\x91\x1e\xb6\x1b\xa9\x1b\xb6\x1b\x06!\n!\xfe \x98 \x94 \x94 \xfc\x1f\xef\x1f\xe7\x1f\xd9!\xde!\xea!6\";\"6\"C\"6\"2\"\xf9\"\xf9\"\x01#g#Z#^#\x9e#\xa2#\x99#\v$\v$\xff#:$B$G$\x82$~$\x82$\xb9$\xb9$\xb4$\xeb$\xeb$\xe7$\r%\x16%\x1a%@%@%D%f%]%U%w%s%{%\xf5,\xf9,\xf5,V2J2J2\xaf-\xab-\x9a-n,v,\u007f,\xbc-\xb3-\xaf-\b,\x04,\x04,1+5+1+B+>+J+\x9d*\x95*\x90*\x05*\xfd)\xf8)\xc1)\xc6)\xc6)`)\\)S)\xfb(\a)\f)\xcc(\xc8(\xcc(\x80(|(\x89(A(E(R(\x17(\n(\x0e(\xdb'\xdb'\xdb'\x860\x860u0\xad1\xa51\x9d1\xb1,\xbe,\xc7,\x1f-\x17-\x1b-\x9e-\x9a-\x91-\xbf\x1e\xbf\x1e\xbb\x1e\x0f\x15\x0f\x15\x0f\x15N\x1c=\x1cA\x1c\xb7\x1e\xc3\x1e\xc8\x1e0\x1c,\x1c0\x1c^\x1eM\x1e@\x1e\xeb\x1f\xeb\x1f\xe3\x1f\x92\x1f\xa8\x1f\xac\x1f\u007f \xad\x16\xb1\x16\xe6\x15\xe2\x15\xd1\x15\f\x16\x04\x16\x11\x16\x8f\x16\x98\x16\x94\x16\x1b\x17\x1b\x17\x12\x17c\x17Z\x17R\x17\xaa\x17\xb3\x17\xaf\x17\xff\x17\x03\x18\x10\x18K\x18:\x18>\x18u\x18m\x18m\x18\x94\x14\x99\x14\x94\x14\x95\b\x8c\b\x95\b\xd8\x0f\xd4\x0f\xcf\x0f$\x151\x155\x15\xc4\x10\xc0\x10\xc0\x104\x12<\x128\x12\xe0\x14\xd0\x14\xd0\x14Q\x14H\x14@\x14\x99\x14\xa1\x14\xa5\x14\xd5\x15\xda\x15\xda\x15&\x16\"\x16\"\x16e\x16a\x16P\x16\xcf\x16\xdb\x16\xec\x16R\x17N\x17E\x17\x84\x17\x80\x17|\x17\xc8\x17\xd1\x17\xdd\x17-\x18%\x18\x1d\x18G\x18>\x18C\x18~\x18u\x18i\x18\xa0\x18\xa8\x18\xac\x18K\x1fS\x1fS\x1f\x9c\"\x9c\"\xa9\"\xeb\x1f\xde\x1f\xd2\x1fs\x1e\x80\x1e\x88\x1el\x1f[\x1f`\x1f\xaa\x1e\xa6\x1e\x99\x1e\xbd\x1d\xc6\x1d\xc6\x1d\xb1\x1d\xac\x1d\xb5\x1dq\x1dm\x1d`\x1d\xe6\x1c\xea\x1c\xd9\x1c\x9e\x1c\xa2\x1c\xab\x1cx\x1cx\x1ct\x1c4\x1cA\x1cE\x1c\x0e\x1c\n\x1c\x06\x1c\xdc\x1b\xdc\x1b\xdc\x1b\xa9\x1b\xad\x1b\xb1\x1b\x94\x1b\x87\x1b\x8b\x1b]\x1be\x1bj\x1bL\x1bD\x1bL\x1b.\x1b&\x1b\x1e\x1b\x04\x1b\r\x1b\x11\x1b\xf8\x1a\xfc\x1a\xf3\x1a\xd6\x1a\xda\x1a\xda\x1a\xc5\x1a\xc5\x1a\xc9\x1a\xb0\x1a\xb8\x1a\xb4\x1a\xa7\x1a\xab\x1a\xa3\x1a\x92\x1a\x8e\x1a\x92\x1a\x92\x1a\x9b\x1a\x9f\x1a\x8a\x1a\x81\x1a}\x1ap\x1a}\x1a}\x1au\x1ah\x1a_\x1a_\x1ad\x1a_\x1a[\x1a_\x1a[\x1aF\x1a>\x1aF\x1a9\x1a9\x1a>\x1aB\x1aB\x1a>\x1a9\x1a5\x1a)\x1a \x1a$\x1a$\x1a1\x1a-\x1a$\x1a\x1c\x1a$\x1a$\x1a \x1a\x0f\x1a\x0f\x1a\x18\x1a\x1c\x1a\x0f\x1a\x13\x1a \x1a \x1a\x18\x1a\x0f\x1a\x0f\x1a\x0f\x1a\a\x1a\x02\x1a\v\x1a\a\x1a\xfe\x19\xfe\x19\x02\x1a\x0f\x1a\a\x1a\xfa\x19\xfa\x19\xfa\x19\xf6\x19\x02\x1a\x02\x1a\xf6\x19\xf2\x19\xf2\x19\xed\x19\xf6\x19\xf2\x19\xe9\x19\xed\x19\xf6\x19\xf2\x19\xe1\x19\xd4\x19\xe1\x19\xf6\x19\xf6\x19\xe5\x19\xd8\x19\xd4\x19\xdc\x19\xdc\x19\xe1\x19\xe9\x19\xe9\x19\xe5\x19\xdc\x19\xd8\x19\xd0\x19\xd4\x19\xe1\x19\xd8\x19\xd8\x19\xd8\x19\xd8\x19\xd4\x19\xcc\x19\xcc\x19\xcc\x19\xd4\x19\xd4\x19\xc7\x19\xd8\x19\xd8\x19\xcc\x19\xbf\x19\xd4\x19\xe1\x19\xd4\x19\xbf\x19\xbb\x19\xc7\x19\xd0\x19\xcc\x19\xc3\x19{\x19{\x19\u007f\x19\x80\x12|\x12|\x12\x10\x11\b\x11\xff\x107\x14<\x14<\x14(\x15$\x15$\x15s\x14n\x14b\x14\x02\x15\x02\x15\v\x15\xf7\x15\xef\x15\xef\x15\x1d\x16\x15\x16\x11\x16T\x16L\x16C\x16\xb5\x16\xc2\x16\xb1\x16\x06\x17\x0e\x17\n\x170\x174\x174\x17o\x17g\x17g\x17\xa6\x17\xa2\x17\xa2\x17\xd9\x17\xdd\x17\xdd\x17\x96\x1c\x8d\x1c\x8d\x1cS+S+F+\x18+\x0f+\v+:$6$B$\xb3#\xae#\xaa#\xc1$\xc1$\xc1$\xc6\x1d\xc6\x1d\xd3\x1d\x06\x17\xf9\x16\xf1\x16\xdf\x1d\xdf\x1d\xdf\x1d\x0e\x1e\t\x1e\x0e\x1e\xba\x1b\xb1\x1b\xad\x1b\x9c\x1b\xa5\x1b\xa9\x1bA\x1cA\x1c4\x1c\xc2\x1b\xcf\x1b\xc7\x1b.\x1b7\x1b7\x1b\xfc\x13\xf8\x13\xf0\x13'\r+\r4\r\x9d\x0fb\x12V\x12\xd5\x1c\xdd\x1c\xe6\x1cJ\x1cN\x1cR\x1c\n\x1c\x0e\x1c\x02\x1cX\x1dT\x1dT\x1d\xb00\xbd0\xb0057$7 7\xd12\xd12\xd52e.i.m.\x0e/\x05/\xfd.\xa4.\xa8.\xac.\xd2)\xdb)\xd6)#(,(,(\x9b)\x93)\x93)\x14)\x18)\x18)\xb1'\xad'\xad'L'H'H'7'7'?'\xbc&\xbc&\xb8&9&5&$&\v\x1a\a\x1a\a\x1a\xe0\x16\xec\x16\xe8\x16\xab\x1a\xab\x1a\xa7\x1a\x19\x1d\x10\x1d\x1d\x1d\x0e\x1c\n\x1c\x02\x1c,\x1c(\x1c\x1b\x1ci\x1dm\x1dz\x1dM\x1eM\x1e@\x1e,(8(8(\x12*\x12*\x05*\xe6&\xef&\xf7&\x8c%\x8c%\x8c%w w { \x91\v\x91\v\x80\v\x15\n\x15\n\x19\n\xd0\x12\xe1\x12\xd9\x12l\x15l\x15h\x15\x86\x13\x82\x13~\x13\xd4\x14\xd8\x14\xd4\x14c\x17c\x17Z\x17\\\x18\\\x18`\x18\xb9\x18\xa8\x18\xa4\x18\xb2\x19\xbf\x19\xc3\x19\xd2\x1a\xc5\x1a\xb8\x1an\x1bv\x1b{\x1b\n\x1c\n\x1c\x02\x1c\xa2\x1c\x9a\x1c\x9a\x1c)\x1d\x1d\x1d!\x1d\xa8\x1d\xb1\x1d\xbd\x1d\x1f\x1e\x12\x1e\x12\x1e|\x1e|\x1ew\x1e\xd0\x1e\xd4\x1e\xdd\x1e>\x1f)\x1f \x1fl\x1fq\x1fu\x1f\xb4\x1f\xb0\x1f\xb4\x1f\xea\x12\xe5\x12\xe1\x12\xdc\b\xd4\b\xdc\b\xfa\r\xf2\r\xf2\r\xae\x14\xae\x14\xa5\x14/\x143\x147\x14\x8a\x13\x86\x13\x82\x13\xab\x15\xa7\x15\xab\x15\x9e\x17\xa6\x17\xaf\x17G\x186\x182\x18\xd2\x18\xca\x18\xb9\x18\xc3\x19\xd0\x19\xd0\x19\xbc\x1a\xb8\x1a\xb8\x1aT\x1bP\x1bL\x1b\xe8\x1b\xe0\x1b\xd3\x1bx\x1c|\x1cp\x1c\xfb\x1c\xfb\x1c\xf7\x1ce\x1di\x1dm\x1d\xdf\x1d\xdb\x1d\xca\x1d+\x1e/\x1e4\x1e\x99\x1e\x91\x1e\x8c\x1e\xe5\x1e\xdd\x1e\xc3\x1e\v\x1f\x18\x1f\x18\x1f`\x1f`\x1f`\x1f\x9f\x1f\x92\x1f\x97\x1f\xd2\x1f\xce\x1f\xc5\x1f\x04 \x00 \x04 / 3 3 ] Y Q n a f \x98 \x9d \x9d \xb6 \xad \xa9 \xcb \xc3 \xc7 \xe4 \xe9 \xe0 \xfa \xfe \x02!\x0f!\n!\x13!$!\x13!\x06!\x1b!(!(!5!0!5!A!=!0!A!9!9!=!=!N!R!J!N!_!V!R!R!J!R!c![!R!\xe22\xe62\xea2\x12;\x16;#;\xf85\xf05\xe75\x95/\x9d/\xa2/\x84/|/\x80/)0%0%0~.~.~.\u007f,\u007f,v,\x9b+\xa3+\x9f+\xfe*\xfa*\xfe*\x1e*'*\x1e*))6):)|(g(g(\xd7'\xd7'\xcf'?'C'L'\xc0&\xb8&\xb8&B&9&5&\xc7%\xcb%\xcb%b%b%]%\x00%\xfc$\xf4$\xa4$\xa8$\xac$d$`$W$\x18$\x10$\x14$\xd0#\xd0#\xc8#50B0F0\xfd:\xf0:\xe7:\xb47\xb87\xb87\xb40\xb00\xb80@/+/'/:0>0:0\xbb/\xb2/\xc8/\xfe \xfe \xf5 \xb8\x1f\xb4\x1f\xb4\x1f\x16\x1e\x16\x1e\x1a\x1e\f\x1d\xff\x1c\f\x1dZ\x1cN\x1c9\x1cj\x1br\x1br\x1b\x92\x1a\x8a\x1a\x8a\x1a\xf7\x17\xff\x17\xff\x17f\rs\rw\r'\r\x1a\r\x1f\rp\x10x\x10x\x10\x12\x12\x16\x12\x0e\x12N\x10N\x10N\x10\xfe\x0f\xfe\x0f\xfe\x0f\x9e\x10\xab\x10\xa7\x10.\x11*\x11&\x11\f\x11\b\x11\x10\x11\b\x11\x04\x11\f\x11?\x11C\x116\x11X\x11L\x11G\x11i\x11v\x11z\x11\x87\x11\x8f\x11\x98\x11\xa4\x11\x98\x11\x98\x11\xad\x11\xad\x11\xb9\x11\xb4\x15\xb4\x15\xb8\x15+\x1e/\x1e'\x1e\xd5!\xd1!\xcd! \x1f%\x1f \x1f\xa9\x1b\xad\x1b\xa5\x1b\x15\x1b\b\x1b\x04\x1bP\x1b]\x1be\x1b\xb0\x1a\x9f\x1a\x96\x1aG\x18K\x18C\x18+\r/\r+\r?\fK\fK\ff\x0fa\x0fY\x0fe\x11v\x11m\x11\x06\x10\x02\x10\x06\x10f\x0fa\x0ff\x0f\xed\x0f\xdc\x0f\xe4\x0f\x8d\x10\x8d\x10\x81\x10\x96\x10\x9a\x10\xa3\x10\x96\x10\x92\x10\x96\x10\xb3\x10\xab\x10\xaf\x10\xf7\x10\xea\x10\xe6\x10\x19\x11\x1d\x11\x19\x11&\x11\x19\x11\x10\x11.\x112\x11.\x11G\x11P\x11?\x11P\x11P\x11X\x11_\x15_\x15[\x15\xc6\x1d\xc2\x1d\xc2\x1dK\x1fF\x1fK\x1f,\x1c$\x1c\x1b\x1c\x8c\x19\x88\x19\x8c\x19\x8c\x19\x90\x19\x90\x19\xcc\x19\xcc\x19\xc7\x19\x05\x19\t\x19\r\x19\x18\x18\x14\x18\x10\x18\x80\x17x\x17o\x17\x17\x17,\x174\x17\xd3\x16\xcb\x16\xc2\x16L\x16L\x16P\x16\x0f\x15\v\x15\x0f\x15^\b^\bb\b\xa9\x05\xb1\x05\xb1\x05<\b<\b/\b\x13\v\x13\v\x17\v\x85\v\x89\v\x89\v(\v\x1f\v(\v\x96\v\x96\v\x9e\v~\fq\fm\f\x1a\r\x16\r\x1f\rs\ro\rs\r\xc8\r\xc3\r\xb2\r\x1c\x0e \x0e\x1c\x0e\x8a\x0e\x86\x0eu\x0e\xc5\x0e\xce\x0e\xc9\x0e\x00\x0f\t\x0f\t\x0f@\x0f7\x0f3\x0f\xaf\x10\xb8\x10\xc4\x10\u007f\x19{\x19s\x19\x11\x1b\x11\x1b\x1e\x1b<\x19<\x19D\x198\x170\x17(\x17\xcf\x16\xd7\x16\xe0\x16\x1b\x17\x17\x17\x0e\x17\xb1\x16\xb5\x16\xba\x16*\x11*\x116\x11\xb5\f\xa0\f\x9c\f$\t-\t$\t=\x049\x04B\x04\xc9\x04\xd1\x04\xd5\x04\xec\a\xe3\a\xe3\a\x9b\t\x8a\t\x85\t\xa3\t\xa7\t\xab\t\xc5\t\xc1\t\xcd\t\x87\n{\n{\ng\vk\vg\v\xff\v\xfb\v\xf3\vT\f\\\fe\f\xc2\f\xc2\f\xc6\f<\r8\r/\r\x99\r\xa2\r\xa2\r\xee\r\xfa\r\xff\rB\x0e>\x0e:\x0e\x86\x0e\x8e\x0e\x92\x0e\xc4\x10\xc0\x10\xc4\x10M\x19Q\x19U\x19\xd6\x1a\xcd\x1a\xd2\x1a\xf0\x18\xdf\x18\xd6\x18\xe0\x16\xe0\x16\xe0\x16\x83\x16\x83\x16\x8b\x16\x9d\x14\x94\x14\x94\x14E\xfeE\xfeR\xfe\x8d\xf7\x89\xf7\x85\xf7 \xfa)\xfa%\xfa\xa8\xff\x9b\xff\x97\xffG\x02G\x02B\x02`\x02\\\x02\\\x02\xc5\x02\xca\x02\xca\x021\x04,\x04,\x04\xb5\x05\xba\x05\xb5\x05\xcc\x06\xd1\x06\xdd\x06\x97\a\x8f\a\x8a\aM\bI\bD\b\x0f\t\x18\t\x1c\t\xeb\t\xde\t\xd2\t\x83\n\x94\n\x8b\n\x17\v\x13\v\x1f\v\xdf\xe7\xce\xe7\xca\xe7Z\xe8^\xe8b\xe8\xc0\xe6\xc0\xe6\xc4\xe6\xeb\xe4\xeb\xe4\xf8\xe4\x83\xe5\x83\xe5r\xe5\x9d\xe8\xae\xe8\xb7\xe8\x93\xf3\x86\xf3\x8f\xf3\xe1\xf4\xe5\xf4\xe1\xf4\xa6\xf2\xab\xf2\xaf\xf2\x85\xf0\x85\xf0\x81\xf0w\xefs\xefj\xef*\xe07\xe02\xe0\xc1\xd1\xce\xd1\xd7\xd1\xd3\xcf\xd3\xcf\xd3\xcf\xc0շռ\xd5C\xdb?\xdbG۞ܙܪ\xdcx\xdco\xdcb\xdcW\xddW\xddS\xdd,\xe14\xe18\xe1\x1d\xf8\x19\xf8!\xf82\xfd.\xfd.\xfd\x9d\xf9\x99\xf9\x95\xf9\xbb\xf4\xbf\xf4\xbf\xf4\x93\xf3\x86\xf3\x86\xf3\xc7\xf4\xd4\xf4\xd0\xf4\a\xf5\xfe\xf4\xfe\xf4\xdb\xf3\xe7\xf3\xe3\xf3\x82\xe9\x86\xe9\x86\xe9$\xe6\x1b\xe6\x17\xe6\x06\xe6\x17\xe6$\xe6\u007f\xe5{\xe5\x83\xe5\xdc\xe5\xe4\xe5\xe0\xe5K\xe7T\xe7K\xe7\x83\xf1\x83\xf1\x8f\xf15\xf5)\xf5-\xf5\xf0\xf3\x01\xf4\t\xf4\x83\xf1\x87\xf1\x87\xf1\x9f\xf0\x92\xf0\x92\xf0E\xe8Q\xe8I\xe8\xfb\xe1\xfb\xe1\xff\xe1\xa6\xe1\xa6\xe1\xa2\xe1d\xe4l\xe4u\xe4\x9e\xe6\xa7\xe6\xa2\xe6\x1d\xe7\x1d\xe7\x1d\xe7\b\xe7\b\xe7\xff\xe6\\\xe7i\xe7e\xe7\x1a\xe8\x16\xe8\x1f\xe8\xc2\xf6\xc2\xf6\xcb\xf6'\x01+\x01#\x01\xae\x01\xb3\x01\xbf\x01\xa4\xfd\xad\xfd\xb1\xfd\xee\xf9\xe1\xf9\xe1\xf9\x12\xf9\x1a\xf9\x1a\xf9k\xf9k\xf9f\xf9\xf9\xf8\x01\xf9\xf0\xf8$\xf7,\xf7=\xf7\xfe\xef\xfa\xef\xf1\xef:\xe9B\xe9K\xe9\xad\xea\xad\xea\xa9\xea\xfc\xee\x05\xef\r\xef(\xf0 \xf0$\xf0\xb0\xee\xa4\xee\xa4\xee\x05\xed\x16\xed\x1b\xed,\xed\x1f\xed\x1f\xed\xbb\xed\xae\xed\xa2\xed\v\xee!\xee\x1c\xee\x03\xee\a\xee\a\xee\xe5\xed\xea\xed\xdd\xed\xe1\xed\xe5\xed\xdd\xed\xf6\xed\xf6\xed\xf6\xed\x10\xee%\xee)\xee\xd5\xf2\xd5\xf2\xd9\xf2N\xf7N\xf7N\xf7*\xf8*\xf8*\xf8\xa9\xf6\xba\xf6\xba\xf6\xe5\xf4\xdc\xf4\xe9\xf4+\xf4/\xf43\xf4+\xf4#\xf4#\xf4\xf4\xf3\xfd\xf3\xfd\xf3\x8a\xf3\x93\xf3\x97\xf3l\xe9q\xe9l\xe9)\xdd!\xdd)\xdd$\xda(\xda$\xda\a\xdd\v\xdd\v\xdd\x12\xe1\x12\xe1\x17\xe1\t\xe3\xfd\xe2\x01\xe3<\xe38\xe3@\xe3w\xe3s\xe3{\xe3>\xe45\xe4B\xe4]\xe5a\xe5Y\xe5\xa2\xe6\x9e\xe6\x9e\xe6\xd2\xf3\xc6\xf3\xca\xf3\x1a\xf9\x0e\xf9\x01\xf9~\xf8~\xf8~\xf8\xc9\xf5\xd6\xf5\xd6\xf5\xf4\xf3\xf8\xf3\xf4\xf3\xbd\xf3\xc1\xf3\xce\xf3\x14\xee\x10\xee\a\xee\xb5\xe7\xb9\xe7\xb9\xe7\xf7\xe6\x03\xe7\b\xe7\x80\xe8\x80\xe8|\xe8?\xf1;\xf17\xf1'\xf9+\xf9/\xf9\xb0\xfa\xa8\xfa\xac\xfa\xce\xf8\xc6\xf8\xb9\xf8U\xf6a\xf6n\xf6O\xf5B\xf5B\xf5>\xf5F\xf5F\xf55\xf55\xf55\xf5\xb6\xf4\xb2\xf4\xb2\xf4\xe5\xef\xfa\xef\xfa\xef[\xdfJ\xdfF\xdft\xdax\xdat\xdaM\xdcR\xdcR\xdcP\xe0P\xe0P\xe0\x05\xe3\x01\xe3\t\xe3\x9d\xe3\x99\xe3\xa1\xe3\xa1\xe3\x95\xe3\x9d\xe35\xe4>\xe4B\xe4;\xe5.\xe53\xe5A\xe6E\xe6=\xe6c\xebg\xebl\xeb*\xf8.\xf8:\xf8\xd4\xf9\xdd\xf9\xcc\xf9\xd9\xf7\xdd\xf7\xd9\xf7F\xf5B\xf5O\xf58\xf43\xf48\xf4\xd6\xf3\xc6\xf3\xc6\xf3\xcb\xea\xcb\xea\xc3\xea\x02\x10\x06\x10\x9f\x1f^/^/M/<4A444\x962\x962\x922</8/</\x98,\x9c,\x87,$+ +(+^*U*I*\\)`)\\)8(4(=(\b'\x04'\x00'\xf6%\xe9%\xe9%\r%\x11%\r%G$K$K$\x88#\x88#\x91#\xd7\"\xdb\"\xdb\".\".\"&\"\x8d!\x92!\x85!\xfa \xf5 \xf5 { w { \x00 \t \r \x8e\x1f\x8a\x1f\x8a\x1f\x1c\x1f\x1c\x1f \x1f\xd4\x1e\xcc\x1e\xbf\x1eo\x1ew\x1es\x1e\x1f\x1e\x12\x1e\x0e\x1e\xce\x1d\xd3\x1d\xd3\x1d\x8f\x1d\x86\x1d\x86\x1dK\x1dT\x1dK\x1d\x03\x1d\xfb\x1c\x03\x1d\xd1\x1c\xd5\x1c\xd1\x1c\xc3\x1e\xbf\x1e\xbb\x1e=-4-9-\xf64\xee4\xf24\xaa4\xae4\xa24`0S0W0Y,Y,H,U*U*b*\xec)\xe3)\xec)S)>)C)E(N(V(\x00'\xfc&\x04'\xdc%\xd4%\xd8%\xf0$\xe7$\xeb$\x10$\v$\x18$k#b#g#\xa4\"\xa0\"\xa0\"\xf3!\xef!\xf3!V![!R!\xbe \xc3 \xd3 H D ; \xbd\x1f\xb8\x1f\xc5\x1fS\x1fO\x1fF\x1f\xd4\x1e\xd8\x1e\xdd\x1e|\x1e|\x1e\x80\x1e'\x1e#\x1e#\x1e\xd3\x1d\xd3\x1d\xd3\x1d\x86\x1d\x86\x1d\x8b\x1dC\x1dG\x1d6\x1d\xf7\x1c\xfb\x1c\xf7\x1c\xb3\x1c\xc4\x1c\xc4\x1c\x89\x1cx\x1c|\x1cE\x1cE\x1cE\x1c\n\x1c\x13\x1c\x13\x1c\xe4\x1b\xed\x1b\xe0\x1be\f`\fm\f\x88\x01\x80\x01|\x01\xea\xfe\xe1\xfe\xd9\xfe\xae\x01\xae\x01\xa6\x01\xd7\x05\xd7\x05\xe0\x05\xcc\b\xd4\b\xc7\b\xeb\t\xe7\t\xe2\ta\nn\nn\n$\v,\v\x1f\v*\f:\f:\fU\rE\rI\r[\x0e`\x0e`\x0e*\x0f&\x0f/\x0f\xed\x0f\xe4\x0f\xe9\x0f\xa3\x10\x9e\x10\x96\x10?\x116\x11.\x11\xc6\x11\xb9\x11\xb5\x11R\x12b\x12k\x12\xff\x12\xfb\x12\xe5\x12`\x13h\x13h\x13\xdf\x13\xda\x13\xce\x137\x147\x147\x14\x94\x14\x88\x14\x90\x14\xe5\x14\xed\x14\xfa\x14S\x15J\x159\x15\x89\x15\x92\x15\x81\x15\xdf\x13\xd2\x13\xd2\x13\x8f\x11\xa0\x11\xa0\x11\x10\x11\x04\x11\x04\x11\xdf\x11\xd7\x11\xd7\x11$\x1a$\x1a\x1c\x1a\" 7 D \xed \xe9 \xe4 \x8e\x0e\x8e\x0e\x8e\x0e\xbf\x01\xb7\x01\xbb\x01\xaf\xfc\xaf\xfc\xab\xfcR\xfcV\xfcR\xfc\xfb\xfe\xff\xfe\xf6\xfe\\\x02W\x02S\x02\xda\x04\xe2\x04\xe2\x04R\x06R\x06Z\x06O\aK\aT\af\b^\bQ\b@\x0fL\x0f]\x0f\x99#\x95#\x95#\x12(\x0e(\x06(\xd5&\xcd&\xd1&\xa2#\xa6#\xaa#\x1b!\x17!\x17!t\x1cp\x1cp\x1c\"\x14\x1e\x14\x11\x14\xea\x12\xee\x12\xf2\x12\xe3\x13\xe7\x13\xeb\x13W\x15B\x159\x152\x16.\x16;\x16i\x16e\x16e\x162\x162\x167\x16*\x11.\x11&\x11\x89\x06\x8d\x06\x9e\x06\xdd\x01\xd5\x01\xd0\x01<\x010\x014\x01\x11\x03\x1a\x03\x1e\x03\xba\x05\xb1\x05\xba\x05^\bU\bU\be\fi\fe\f\xa9\x0f\xa9\x0f\xfc\xe9\xfb\xed\xff\xed\a\xee\xf3\xf5\xf8\xf5\x00\xf6\xa2\xf7\xa2\xf7\x96\xf7\x12\xed\n\xed\x0e\xed\xa2\xe6\xaf\xe6\xb7\xe6\x19\xe5\x11\xe5\b\xe5\xe7\xe2\xe7\xe2\xdf\xe2}\xdf}߁߮\u07b6\u07b2\xde\"\xe0.\xe0.\xe0O\xe2K\xe2O\xe2[\xe9d\xe9d\xe9\xd7\xfd\xd3\xfd\xca\xfd\xe3\x02\xf0\x02\xf4\x02\xd0\x01\xc8\x01\xc8\x01\xc3\xf9\xc8\xf9\xcc\xf9\n\xeb\x0f\xeb\x13\xebX\xe7X\xe7X\xe7\xba\xe5\xbe\xe5\xba\xe5R\xe6Z\xe6_\xe6\xf0\xe7\xe8\xe7\xec\xe7O\xe9O\xe9W\xe9\x93\xe7\x97\xe7\x97\xe7\xba\xe5\xba\xe5\xb6\xe5\x87\xe5\x87\xe5\x83\xe5=\xe6A\xe6A\xe67\xe0;\xe02\xe0[\xdag\xdag\xda\"\xd9\"\xd9\x1a\xd96\xdb?\xdb6\xdb\xda\xdd\xeb\xdd\xdf\xdd\x1c\xdf\x17\xdf(\xdf\x11\xe0\b\xe0\f\xe0\x12\xe1\x12\xe1\x12\xe1\x8a\xe2\x93\xe2\x97\xe2k\xe6_\xe6_\xe6\"\xea&\xea\"\xea\f\xec\x04\xec\x15\xec2\xec.\xec.\xec<\xed8\xed<\xed\xfa\xef\xf1\xef\xed\xef\x1a\xea\"\xea\x11\xea1\xe4B\xe4O\xe4\x86\xe2\x82\xe2\x8a\xe2\xae\xe3\xaa\xe3\xa1\xe3\xbe\xe5\xb1\xe5\xb1\xe5\xa4\xe7\x97\xe7\x97\xe7\xf2\xe8\xf6\xe8\xff\xe8\x8f\xec\x93\xec\x98\xecv\xf1\u007f\xf1v\xf1\x00\xec\b\xec\x04\xec*\xe7\x1d\xe7!\xe7\x94\xe5\x94\xe5\x87\xe5Z\xe6c\xe6g\xe6/\xe84\xe8/\xe8\x12\xed\x16\xed#\xed1\xfa5\xfa-\xfa\x04\xfb\r\xfb\t\xfb\xf3\xf5\xfc\xf5\xf8\xf5d\xeem\xeeu\xeeV\xedV\xedZ\xed)\xee)\xee%\xee]\xefb\xefY\xef9\xf05\xf0=\xf0\xcb\xf6\xc7\xf6\xc2\xf6\x03\xff\xff\xfe\x10\xff\xae\x01\xae\x01\xb3\x01\x0e\x01\x16\x01\x1b\x01)\xff!\xff\x18\xffT\xfdX\xfdT\xfd0\xfc,\xfc0\xfc\xfa\xf9\xf2\xf9\xf2\xf9\xbe\xf1\xba\xf1\xbe\xf1\a\xee\a\xee\a\xee\xb7\xed\xbb\xed\xc8\xed\xbe\xf1\xb1\xf1\xbe\xf1\xa0\xf8\x9c\xf8\x9c\xf8\xd0\xf9\xd4\xf9\xd8\xf9s\xf9b\xf9^\xf9q\xf8v\xf8q\xf8\x89\xf7\x96\xf7\x96\xf7\xfb\xfe\xfb\xfe\a\xff\xa0\a\xac\a\xa4\ac\vg\vg\v\x9c\f\x97\f\x8f\f\xc8\v\xd5\v\xd1\v\xbc\t\xb0\t\xb4\t\x82\az\az\a\x9c\x05\xa5\x05\xad\x05J\x04B\x04F\x04@\x03H\x03<\x03\xf7\x04\xfc\x04\xf3\x04\xa7\t\xb0\t\xab\t\xd1\v\xdd\v\xe6\vN\vV\vN\v-\t5\t9\t\xdd\x06\xea\x06\xf2\x06\f\x05\x00\x05\xfc\x04\xaa\x03\xaa\x03\xaa\x03\x9c\x00\x9c\x00\xa9\x00{\xef{\xef\u007f\xef\\\xecX\xecX\xec\xaf\xf2\xb3\xf2\xaf\xf2\xb8\xfa\xbd\xfa\xce\xfa[\xfcV\xfcR\xfc\xf5\xfb\xfa\xfb\xed\xfb\xc5\xfa\xc1\xfa\xc5\xfa\xe8\xf8\xe8\xf8\xe3\xf8l\xf0y\xf0p\xf0i\xeca\xecm\xec\x85\xeb\x85\xebt\xeb\xd4\xef\xd4\xef\xd8\xefN\xfcV\xfc_\xfc\xdf\xff\xe3\xff\xe3\xff*\x00*\x002\x00\xf6\xfe\xf6\xfe\xf6\xfeP\xfdP\xfd\\\xfd\xc0\xfc\xc9\xfc\xc9\xfc}\x04y\x04y\x04,\v0\v9\vT\f\\\fT\fF\tJ\tB\tX\x05e\x05r\x05`\x02\\\x02S\x02\xdb\x00\xdb\x00\xdb\x00\\\x00\\\x00a\x00\x15\x00\x1d\x00\x15\x00\x9f\xff\x93\xff\x97\xff2\x00;\x00C\x00n\x03b\x03\x95(\x90*\x8c*\x99*\x90*\x8c*\x84*O)X)S)\xa0'\xa9'\xad'5&$&$&\x1e%\"%\x1e%\xa9\"\xa0\"\x9c\"\x9a\x10\x96\x10\x96\x10\x9d\b\x9d\b\xa1\b\x8d\x06\x89\x06|\x06\x93\a\x97\a\x93\a\xc9\t\xc5\t\xc1\t\xe2\v\xdd\v\xf3\v\x80\r\x80\rw\rB\x0e:\x0e:\x0e\xb8\x0e\xb4\x0e\x9f\x0e\x1a\x0f&\x0f;\x0f\xcb\x0f\xcb\x0f\xbe\x0fa\x11T\x11e\x11\xff\x1c\xfb\x1c\xee\x1c\xcf\"\xcf\"\xc2\"B$O$B$\x9e#\x95#\x95#*\"2\"?\"\xdc \xd3 \xdc \xda\x1f\xc5\x1f\xc1\x1fO\x1fW\x1fS\x1f\xae\x1e\xaa\x1e\xa6\x1e\f\x18\a\x18\x14\x18V\x12Z\x12R\x12\xc4\x10\xc4\x10\xbc\x10\xc9\x10\xc9\x10\xcd\x10\xb1\x11\xa9\x11\xa4\x11\xa6\x12\xaa\x12\xa2\x12q\x13m\x13m\x13\xca\x13\xc5\x13\xca\x13$\x15 \x15(\x15e\x1ba\x1bn\x1b\xbd\x1d\xb9\x1d\xb1\x1d8\x1e<\x1e<\x1e\xdf\x1d\xd7\x1d\xdb\x1dv\x1b\u007f\x1b\x83\x1b\xe5\x14\xe0\x14\xdc\x14\xd0\x12\xd9\x12\xd0\x12k\x12g\x12b\x12\xd0\x12\xd4\x12\xd0\x12\x8a\x13~\x13y\x13\"\x14'\x14\x1e\x14\x90\x14\x94\x14\x99\x14)\x1a\x1c\x1a\x0f\x1a\x03\x1f\x03\x1f\a\x1f !\x1b!\x1b!\x81!\x85!\x81!\xe9 \xe9 \xe0 \xf8\x1f\xfc\x1f\x04 \x0f\x1f\xfe\x1e\xfa\x1e^\x1eZ\x1eU\x1e\x86\x1dv\x1d\x8b\x1dd\x13W\x13W\x13\xd0\r\xc8\r\xbf\r\xa2\v\x9a\v\x9e\v\x89\v\x91\v\x85\v\x8f\f\x8f\f\x8f\f\xcc\r\xc8\r\xcc\r\xef\x0e\xef\x0e\xde\x0e\xa5\x0f\xa9\x0f\xa9\x0f=\x10=\x105\x10\xa7\x10\xbc\x10\xb3\x10\b\x11\xff\x10\x04\x11\xe2\x1c\xdd\x1c\xd9\x1c\xc9&\xc0&\xbc&B+B+B+;,*,*,\x0f+\x02+\x02+\x18)\x18)\x18)7';'2'\x86$\x8a$\x8a$\xba \xad \xb6 \x82\x1d~\x1d~\x1d\xb6\x1b\xa9\x1b\x90\x1b\xb9\x18\xca\x18\xd2\x18\xce\x13\xd6\x13\xda\x13g\x12R\x12M\x12\x1f\x12\x1b\x12\x1f\x12|\x12s\x12\x80\x12\xcf\x0f\xd8\x0f\xdc\x0f-\a\x1d\a)\a\xbf\x01\xb3\x01\x99\x01i\x00m\x00v\x00\xce\x02\xc5\x02\xca\x02\x12\x06\x1b\x06\x17\x06\xd8\b\xd0\b\xcc\b\x94\n\x94\n\x87\n\xff\v\x03\f\f\fL\x0f;\x0f3\x0f\xd4\x12\xd9\x12\xd9\x12.\x16\"\x16\"\x16\xa4\x11\xa4\x11\xa0\x11\xfb\v\xf7\v\xf7\vH\x05L\x05L\x05\x01\x01\xf0\x00\xf9\x00\xb4\xff\xb4\xff\x9f\xff\\\x00a\x00X\x00\v\x02\x14\x02\x14\x02\xf6\x03\xed\x03\xe5\x03'\x03'\x03\x16\x03\x88\x01\x88\x01\x95\x01\xf9\x00\xf9\x00\xf9\x00'\x01'\x01#\x01\xf1\x05\xf9\x05\xec\x05\xa2\r\xa6\r\xae\r\x0f\x15\x13\x15\xfe\x14~\x18\x86\x18\x82\x18\xf0\x13\xe7\x13\xeb\x13\xcf\x11\xc2\x11\xc6\x11\xd5\x10\xcd\x10\xcd\x105\x0e%\x0e\x18\x0e\xcd\v\xd1\v\xc8\v\x9c\n\xa1\n\xad\n\xcf\n\xcf\n\xc7\n|\vg\vg\ve\fq\fz\ff\rZ\rM\r\xc5+\xcd+\xd2+\xdb?\xd2?\xd2?\xd7?\xd7?\xd7?\xdb?\xce?\xce?\xce?\xce?\xca?\xc2?\xb9?\xb1?\xd1>\xd9>\xdf3\xe5/\xac\x13\xbd\x13\x1d\x11\x19\x11\x15\x113\x0f;\x0f;\x0f\xbf\r\xae\r\xaa\r+\r+\r4\r\xe3\f\xe3\f\xe8\f\xf3\v\xf7\v\xf7\v\x90\n\x90\n\x8b\n\xc3\b\xd0\b\xd0\bL\x05T\x05T\x05O\xffK\xff>\xff\xae\xf9\xae\xf9\xbb\xf9\x00\xf6\x11\xf6\b\xf6\xae\xf4\xae\xf4\xbf\xf4\x1c\xf5\x18\xf5\x1c\xf5\x10\xf3\x10\xf3\f\xf3\xee\xe8\xee\xe8\xee\xe8\xeb\xe4\xeb\xe4\xe7\xe4I\xe3M\xe3D\xe3|\xe1t\xe1t\xe1H\xe0P\xe0L\xe0\xda\xdf\xd6\xdf\xda\xdfh\xdfc\xdfh\xdfh\xdf_\xdfh\xdf\x04\xe0\x00\xe0\x00\xe0\xec\xe0\xf5\xe0\xf1\xe0\xfb\xe1\xf7\xe1\xf7\xe1\xf8\xe2\xf8\xe2\x01\xe3\xd8\xe3\xdc\xe3\xe9\xe3\xc5\xe4\xc5\xe4\xc5\xe4n\xe5r\xe5r\xe5\x06\xe6\xfe\xe5\x06\xe6\xa2\xe6\xaf\xe6\xaf\xe6C\xe7C\xe7C\xe7\xf2\x06\xfb\x06\xf7\x06\xd0\x19\xd0\x19\xc7\x19\xc3\x19\xd8\x19\xe5\x19\xe5\x19\xed\x19\xf6\x19\xf6\x19\xe9\x19\xe5\x19\xf2\x19\x02\x1a\a\x1a\a\x1a\v\x1a\v\x1a\a\x1a\v\x1a\x0f\x1a\x0f\x1a\x13\x1a\x0f\x1a\xc0\x17\xc8\x17\xd5\x17\x89\x15\x81\x15\x85\x15\x82\x13y\x13~\x13e\x11\\\x11\\\x11\x90\x0f\x94\x0f\x90\x0f\x9d\r\x9d\r\x95\r\xd5\v\xdd\v\xdd\v\x1e\n*\n\x1e\n\x84\b\x8c\b\x9d\b-\a2\a%\a\xb1\x05\xb1\x05\xb5\x05p\x04\x81\x04}\x04<\x037\x033\x03\x14\x02\x18\x02\x14\x02\n\x01\n\x01\x1b\x01\x15\x00\x10\x00\x10\x00\x1c\xff!\xff-\xff<\xfe<\xfeA\xfei\xfdm\xfd\x82\xfd\xa3\xfc\x96\xfc\x92\xfc\xdc\xfb\xe0\xfb\xe9\xfbH\xfbH\xfb;\xfb\x8e\xfa\xa3\xfa\x9b\xfa\a\xfa\a\xfa\xff\xf9|\xf9\x8c\xf9\x80\xf9\xf4\xf8\xf0\xf8\xec\xf8q\xf8z\xf8z\xf8\xff\xf7\b\xf8\b\xf8\x9a\xf7\x9e\xf7\x9e\xf70\xf74\xf7,\xf7\xcf\xf6\xd3\xf6\xdc\xf6{\xf6\x83\xf6\u007f\xf6&\xf6*\xf63\xf6\xde\xf5\xe2\xf5\xe2\xf5\x85\xf5y\xf5\x8a\xf5F\xf5O\xf5S\xf5\a\xf5\a\xf5\x0f\xf5\xd4\xf4\xd8\xf4\xd0\xf4\x90\xf4\x88\xf4\x8c\xf4b\xf4j\xf4s\xf48\xf4/\xf48\xf4\t\xf4\x16\xf4\x16\xf4\xe7\xf3\xdb\xf3\xdb\xf3\xa8\xf3\xac\xf3\xa8\xf3\x86\xf3\x8a\xf3\x93\xf3~\xf3\x82\xf3z\xf3K\xf3O\xf3`\xf3C\xf3:\xf3>\xf3!\xf3\x18\xf3\x18\xf3\x03\xf3\a\xf3\x03\xf3\xea\xf2\xe6\xf2\xe6\xf2\xc8\xf2\xd1\xf2\xdd\xf2\xc8\xf2\xc8\xf2\xc8\xf2\xaf\xf2\xb7\xf2\xb3\xf2\xab\xf2\xaf\xf2\xb3\xf2\x9e\xf2\xa6\xf2\x9e\xf2\x89\xf2\x89\xf2\x91\xf2\x8d\xf2\x9a\xf2\x89\xf2o\xf2c\xf2Z\xf2N\xf2^\xf2c\xf2k\xf2o\xf2c\xf2v\xf1r\xf1r\xf1\u007f\xf1\x87\xf1\x8f\xf1\x8b\xf1\x8f\xf1\x98\xf1\x9c\xf1\x98\xf1\x94\xf1\x8f\xf1\x94\xf1\x87\xf1\x87\xf1\x94\xf1\x9c\xf1\x98\xf1\x98\xf1\x98\xf1\xa9\xf1\xba\xf1\xbe\xf1\xb5\xf1\xad\xf1\xa9\xf1\xb1\xf1\xb5\xf1\xc2\xf1\xba\xf1\xb5\xf1\xba\xf1\xc2\xf1\xbe\xf1\xba\xf1\xbe\xf1\xd3\xf1\xd3\xf1\xc2\xf1\xba\xf1\xc6\xf1\xc2\xf1\xcf\xf1\xd7\xf1\xdb\xf1\xd3\xf1\xcb\xf1\xd3\xf1\xe0\xf1\xdb\xf1\xe4\xf1\xe8\xf1\xe4\xf1\xe0\xf1\xe8\xf1\xe8\xf1\xe8\xf1\xe4\xf1\xe4\xf1\xe4\xf1\xe4\xf1\xe8\xf1\xec\xf1\xe8\xf1\xe8\xf1\xec\xf1\xf9\xf1\x06\xf2\x17\xf2\x12\xf2\n\xf2\x01\xf2\x01\xf2\x01\xf2\x0e\xf2\x0e\xf2\x17\xf2\x0e\xf2\xce\xfa\xce\xfa\xc1\xfa\xbd\xfa\xc1\xfa\xc9\xfa\xd2\xfa\xda\xfa\xd6\xfa\xce\xfa\xce\xfa\xda\xfa\xd2\xfa\xc1\xfa\xc5\xfa\xce\xfa\xce\xfa\xce\xfa\xc9\xfa\xd2\xfa\xce\xfa\xce\xfa\xda\xfa\xde\xfa\xda\xfa\xde\xfa\xde\xfa\xda\xfa\xda\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xe3\xfa\xeb\xfa\xeb\xfa\xde\xfa\xd6\xfa\xc9\xfa\xd2\xfa\xda\xfa\xde\xfa\xda\xfa\xde\xfa\xe3\xfa\xeb\xfa\xde\xfa\xda\xfa\xde\xfa\xeb\xfa\xe7\xfa\xe3\xfa\xe7\xfa\xeb\xfa\xe7\xfa\xe3\xfa\xeb\xfa\xfc\xfa\x00\xfb\x00\xfb\xf8\xfa\xe7\xfa\xef\xfa\xf4\xfa\xeb\xfa\xe7\xfa\xef\xfa\x00\xfb\xfc\xfa\t\xfb\r\xfb\x04\xfb\xf4\xfa\xef\xfa\xf8\xfa\x00\xfb\x04\xfb\x00\xfb\x00\xfb\t\xfb\xfc\xfa\xf8\xfa\xf8\xfa\t\xfb\t\xfb\x04\xfb\x00\xfb\x11\xfb\x1a\xfb\x11\xfb\r\xfb\r\xfb\x15\xfb\x1e\xfb\x1e\xfb\x11\xfb\x1a\xfb\x1a\xfb\x11\xfb\"\xfb+\xfb\"\xfb\x1e\xfb&\xfb+\xfb&\xfb&\xfb\"\xfb\"\xfb\"\xfb\x15\xfb\x1a\xfb\x1a\xfb\x1a\xfb\x1a\xfb+\xfb&\xfb&\xfb\x1e\xfb\"\xfb&\xfb\"\xfb\x1e\xfb&\xfb+\xfb+\xfb7\xfb;\xfb3\xfb/\xfb&\xfb\"\xfb3\xfb7\xfb3\xfb/\xfb&\xfb&\xfb+\xfb7\xfb3\xfb7\xfb7\xfb@\xfb3\xfb&\xfb;\xfbw\xf9s\xf9w\xf9\xe8\xf6\xe4\xf6\xe4\xf6\x84\xf4\x88\xf4\x99\xf4R\xf2A\xf2E\xf2\xf7\xf0\xf3\xf0\xf7\xf0h\xf0t\xf0\x81\xf0\x85\xf0}\xf0y\xf0\xde\xf0\xde\xf0\xde\xf0n\xf1i\xf1e\xf1\xf9\xf1\x01\xf2\x0e\xf2\xab\xf2\x9e\xf2\xaf\xf2>\xf3)\xf3%\xf3\xb5\xf3\xb9\xf3\xbd\xf3/\xf48\xf4D\xf4\x9d\xf4\x90\xf4\x9d\xf4\x02\xf5\x02\xf5\v\xf5S\xf5F\xf5>\xf5\x8f\xf3\x97\xf3\xa4\xf3\xa0\xf1\x8f\xf1\x94\xf1U\xefU\xefY\xef\x16\xed\x0e\xed\x12\xed\xd1\xeb\xd9\xeb\xd5\xebg\xebg\xebg\xeb\x92\xeb\x8d\xeb\x8d\xeb.\xec.\xec6\xec\x05\xed\xfd\xec\xf9\xec\xd9\xed\xd9\xed\xdd\xed\xb9\xee\xb4\xee\xb4\xee\u007f\xefw\xef{\xef1\xf0=\xf0B\xf0\xeb\xf0\xeb\xf0\xeb\xf0\x83\xf1\u007f\xf1\x87\xf1\x1b\xf2\x12\xf2\n\xf2\x9e\xf2\xa6\xf2\xab\xf2-\xf3%\xf3%\xf3q\xf3q\xf3q\xf3&\xf1*\xf1;\xf1u\xeem\xee`\xee\x1d\xec\x1d\xec\x1d\xec \xe9%\xe9-\xe9\f\xe7\x10\xe7\f\xe7\xed\xe5\xf5\xe5\xfe\xe5\xd7\xe5\xdc\xe5\xdc\xe5_\xe6c\xe6k\xe6\\\xe7`\xe7`\xe7f\xe8^\xe8b\xe8\x82\xe9\x86\xe9\x82\xe9\x9d\xea\xa1\xea\xa5\xea\xa3\xeb\x9e\xeb\xa7\xeb\x98\xec\x93\xec\x93\xecs\xed\x80\xed|\xedO\xeeK\xeeG\xee\r\xef\x16\xef\x16\xef\xc3\xef\xba\xef\xba\xefh\xf0l\xf0p\xf0\x11\xf1\x15\xf1\x11\xf1\xa5\xf1\xa9\xf1\xad\xf10\xf2,\xf24\xf2\xbb\xf2\xc4\xf2\xcc\xf2O\xf3T\xf3T\xf3\xd6\xf3\xd2\xf3\xc6\xf3\t\xf4\x12\xf4\x12\xf4\xe2\xf0\xd1\xf0\xd1\xf04\xedE\xedI\xed\xe4\xea\xe0\xea\xe4\xeao\xe8\x84\xe8|\xe8\x94\xe5\x83\xe5v\xe5\x84\xe3\x8c\xe3\x88\xe3\x97\xe2\x9b\xe2\xa4\xe2\xb0\xe2\xb9\xe2\xbd\xe2j\xe3f\xe3b\xe3_\xe4d\xe4_\xe4\xa1\xe5\xa1\xe5\xa9\xe5\xf7\xe6\xfb\xe6\xf7\xe6+\xe8\x16\xe8\x1f\xe8S\xe9O\xe9K\xe9f\xeaf\xeaa\xeac\xebc\xebg\xeb\\\xece\xeca\xec<\xedI\xedR\xed\v\xee\v\xee\x14\xee\xce\xeep\xe6t\xe6?\xe7K\xe7T\xe7\xf9\xe7\t\xe8\x01\xe8\xa6\xe8\xaa\xe8\xae\xe8O\xe9W\xe9S\xe9\xf8\xe9\x00\xea\xfc\xe9\x87\xea\x94\xea\x8c\xea\x1b\xeb \xeb$\xeb\xa3\xeb\xbc\xeb\xc4\xeb2\xec2\xecC\xec\xad\xec\xad\xec\xad\xec'\xed,\xed<\xed\xa2\xed\xa6\xed\x9e\xed'\xef'\xef/\xef\xb0\xf3\xbd\xf3\xb9\xf3s\xf9b\xf9k\xf9j\xfb{\xfb\u007f\xfb\x04\xfd\xfb\xfc\x04\xfd%\xff-\xff:\xff\xd3\x00\xca\x00\xd7\x00\xc4\x01\xc4\x01\xc8\x01\x10\x02\x14\x02%\x02\x14\x02\x1c\x02\x1c\x02\xbb\x01\xbb\x01\xc4\x014\x01<\x01A\x01\xa0\x00\xa4\x00\xa9\x00%\xff6\xff-\xff\x85\xfc\x81\xfc\x8d\xfcZ\xf9^\xf9Q\xf9\xfd\xf3\x05\xf4\x16\xf4\"\xf12\xf12\xf1u\xeem\xeem\xee2\xecC\xecP\xec\x0f\xeb\n\xeb\x06\xebU\xea]\xeaj\xeaL\xeaH\xeaU\xea\x83\xea\x8c\xea\x83\xea\xf5\xea\x06\xeb\x13\xeb\x85\xeb\x89\xeb\x8d\xeb*\xec*\xec*\xec\xa9\xec\xa9\xec\xad\xecA\xed8\xedA\xed\xc4\xed\xe5\xed\xe1\xedS\xeeW\xeed\xee\xd6\xee\xda\xee\xda\xeeY\xefb\xefY\xef\xae\xef\xc3\xef\xcb\xef,\xf05\xf0B\xf0\x89\xf0\x92\xf0\x89\xf0\xde\xf0\xe6\xf0\xfc\xf0L\xf1X\xf1P\xf1\xa9\xf1\xb1\xf1\xbe\xf1\xf9\xf1\xfd\xf1\n\xf2N\xf2I\xf2N\xf2\x84\xf2\x9a\xf2\xa2\xf2\xe1\xf2\xdd\xf2\xf7\xf26\xf3>\xf36\xf3z\xf3\x82\xf3\xa0\xf3\xce\xf3\xca\xf3\xbd\xf3\xfd\xf3\xf8\xf3\xf8\xf3'\xf48\xf48\xf4s\xf4w\xf4\x84\xf4\xae\xf4\xb2\xf4\xb6\xf4\xe9\xf4\xe9\xf4\xf2\xf4\x1c\xf5-\xf5)\xf5_\xf5S\xf5[\xf5u\xf5\x8e\xf5\x96\xf5\xc5\xf5\xcd\xf5\xde\xf5\xf3\xf5\xe7\xf5\xeb\xf5*\xf6&\xf6&\xf6?\xf6Y\xf6U\xf6{\xf6v\xf6\x83\xf6\x9c\xf6\xad\xf6\xb1\xf6\xd7\xf6\xc7\xf6\xd3\xf6\xf5\xf6\n\xf7\n\xf7R\xf7R\xf7c\xf7T\xfdi\xfd\\\xfd`\x02`\x02q\x02\xec\x05\x01\x06\x06\x06\x1a\b\x16\b/\b9\tF\tO\t\xd6\t\xcd\t\xd2\tt\v\x89\v\x91\v\xee\r\xf2\r\x03\x0e\x87\x0f\x94\x0f\x90\x0f\x81\x10\x8d\x10\xa7\x10\xc9\x10\xd1\x10\xcd\x10\x92\x10\x92\x10\x9e\x10\xf1\x0f\xfe\x0f\xf5\x0f7\x0f@\x0fY\x0fd\x0el\x0eq\x0e\x91\r\x88\r\x95\r\x97\f\xa8\f\xb1\f\xe2\v\xd5\v\xdd\v\x06\v\x1f\v\x1f\vP\nP\na\n\x96\t\xb8\t\xb4\t\a\t\a\t\x1c\tj\b\u007f\bs\b\xd2\a\xd6\a\x01\bq\a\x86\au\a\xe6\x06\xd9\x06\xf2\x06o\x06\x89\x06\x89\x06\x1b\x06#\x06=\x06\xb1\x05\xd7\x05\xcf\x05v\x05z\x05\x9c\x05\"\x057\x05;\x05\xef\x04\xe6\x04\b\x05\x9a\x04\xc9\x04\xd5\x04\x9a\x04\x9a\x04\xc0\x04_\x04\x89\x04\x85\x04[\x04l\x04\xab\x04J\x04\x89\x04\x96\x04\xa3\x04\x9f\x04\x00\x05\xd7\x05\xdb\x05\xd3\x05\x8b\x05\x83\x05\x83\x05C\x05C\x05?\x05\x00\x05\x00\x05\x11\x05\xd1\x04\xc5\x04\xc0\x04\x8e\x04\x96\x04\x8e\x04S\x04S\x04J\x04\x06\x04\x13\x04\x17\x04\xe5\x03\xe5\x03\xdc\x03\x99\x03\x9d\x03\xa5\x03w\x03{\x03\u007f\x03D\x03M\x03U\x03+\x03\x1e\x03\x1a\x03\xf4\x02\xfc\x02\t\x03\xd6\x02\xd6\x02\xd6\x02\xbd\x02\xb9\x02\xac\x02\x82\x02\x8e\x02\x97\x02y\x02~\x02~\x02\\\x02\\\x02O\x02-\x02-\x02>\x02)\x02)\x02!\x02\a\x02\x10\x02\xff\x01\xd9\x01\xdd\x01\xe5\x01\xcc\x01\xcc\x01\xd5\x01\xb7\x01\xb7\x01\xb7\x01\xa6\x01\xa6\x01\xa6\x01\xca\x00\xd7\x00\xdb\x00\xf2\xf9\xe9\xf9\xfa\xf9\xa3\xf5\x9f\xf5\x96\xf5\xa6\xf2\xaf\xf2\xab\xf2\xda\xf0\xd6\xf0\xd6\xf0\xe5\xef\xe9\xef\xed\xef\x94\xef\x94\xef\x99\xef\xb6\xef\xb6\xef\xaa\xef\xc5\xee\xca\xee\xca\xee\xd7\xec\xd7\xec\xd3\xec\n\xeb\x02\xeb\xfe\xea\xef\xe9\xf8\xe9\xf8\xe9h\xe9h\xe9u\xe9y\xe9u\xe9u\xe9\xd6\xe9\xce\xe9\xd6\xe9{\xeaj\xeaa\xea$\xeb \xeb\x1b\xeb\xfb\xeb\x04\xec\f\xec\xe8\xec\xdf\xec\xdf\xec\xc4\xed\xb7\xed\xb3\xed\x8e\xee\x8e\xee\x97\xee{\xefn\xefn\xefJ\xf0F\xf0B\xf0\xf7\xf0\xfc\xf0\b\xf1\xc2\xf1\xc2\xf1\xcb\xf1o\xf2t\xf2k\xf2\x14\xf3\x1d\xf3%\xf3\xc6\xf3\xbd\xf3\xc1\xf3U\xf4U\xf4^\xf4\xf2\xf4\xf6\xf4\xe9\xf4u\xf5p\xf5p\xf5,\xf79\xf7=\xf7\xb7\xf9\xb2\xf9\xae\xf9\x92\xfc\x92\xfc\x92\xfc\xbf\x01\xb7\x01\xb3\x01\xe9\x03\xe9\x03\xdc\x03\xde\x04\xe6\x04\xeb\x04a\x05e\x05]\x05\x9c\x05\x9c\x05\xa5\x05\xea\x06\xe6\x06\xe1\x06\x95\b\x99\b\xa6\b\x11\n\b\n\x04\n\xed\n\xf5\n\xf5\ng\vc\vg\v\x89\v\x80\v\x85\vx\v\x80\v\x85\vJ\vJ\vE\v\xed\n\xe4\n\xdc\n\x8b\n\x94\n\x8b\n\x1e\n\x1e\n\x1e\n\xab\t\xb0\t\xab\tF\tJ\tJ\t\xe1\b\xdc\b\xd0\bb\bj\bo\b\x05\b\t\b\r\bm\ad\aT\az\xfd\x87\xfd\x8b\xfdW\xf5[\xf5W\xf5\xa7\xf0\xa7\xf0\xb4\xf0\xc8\xed\xcc\xed\xd0\xed*\xec\x19\xec\x10\xecF\xebF\xeb5\xeb\x0f\xeb\x0f\xeb\x06\xeb0\xeb5\xeb(\xeb\x13\xeb\x13\xeb\x13\xeb\xaa\xe8\xa2\xe8\xa6\xe8$\xe60\xe6,\xe6\x13\xe4\x0f\xe4\x18\xe4\xf4\xe2\xf8\xe2\xf8\xe2u\xe2i\xe2i\xe2~\xe2\x82\xe2u\xe2\xe3\xe2\xd7\xe2\xdb\xe2\xa1\xe3\xaa\xe3\xae\xe3\x85\xe4\x85\xe4\x81\xe4\x8b\xe5\x94\xe5\x94\xe5g\xe6k\xe6c\xe6\xb3\xe6\xb3\xe6\xab\xe6\xcd\xe6\xcd\xe6\xd5\xe6:\xe7K\xe7?\xe7\xa8\xe7\xa4\xe7\xb5\xe7M\xe8I\xe8I\xe8\x03\xe9\a\xe9\a\xe9\xda\xe9\xd2\xe9\xc9\xe9\xa5\xea\xb2\xea\xad\xeag\xebg\xebp\xeb\x92\xeb\x89\xeb\x89\xebp\xebp\xebg\xebc\xebg\xebg\xeb\xf0\xec\xec\xec\xec\xec\xcd\xf0\xd6\xf0\xda\xf0\xbc\xf5\xb0\xf5\xa7\xf5r\xfb\u007f\xfb\x83\xfb\xeb\x04\xf3\x04\xef\x043\b8\b3\b\xef\t\xe7\t\xde\t\xcb\n\xcf\n\xcf\n=\vA\v9\v\xab\v\xa7\v\xab\v\xf4\x0e\xeb\x0e\xe3\x0eA\x12I\x12R\x12\xfe\x14\xfa\x14\xfa\x14\xc6\x16\xc6\x16\xcb\x16\xcc\x17\xd1\x17\xdd\x17K\x18O\x18O\x18G\x18:\x186\x18\xea\x17\xf7\x17\xf2\x17c\x17^\x17^\x17\xa5\x16\xad\x16\xb5\x16\xf3\x15\xf3\x15\xeb\x15 \x15(\x15(\x15U\x14Y\x14U\x14y\x13y\x13u\x13\x95\x12\x99\x12\x9e\x12\xc2\x11\xb9\x11\xc2\x11\xff\x10\xff\x10\xff\x10\x02\x10\x06\x10\x02\x10\xae\r\xb2\r\xbf\r\xd7\n\xdc\n\xdc\n\xb0\a\xa0\a\xa8\at\x04y\x04\x85\x04\v\xff\xfb\xfe\xff\xfe\n\xfc\xfa\xfb\xf1\xfb\xac\xfa\xb4\xfa\xc1\xfaH\xfb@\xfb@\xfb\xa9\x00\xa4\x00\x9c\x00H\x05H\x05H\x05\xcf\x05\xcb\x05\xcf\x05F\x04J\x04[\x04\xd0\x01\xbb\x01\xb7\x01\b\xfd\b\xfd\x04\xfdf\xfbn\xfbn\xfb}\xfa}\xfa\x86\xfa\xdd\xf9\xd8\xf9\xd0\xf9\xe9\xf9\xe5\xf9\xe1\xf9\x81\xfcx\xfcp\xfc\x9d\x03\x94\x03\x99\x03\xd1\x06\xdd\x06\xe1\x06\xe9\b\xdc\b\xd8\b&\n\"\n\x1e\n\xe0\n\xed\n\xed\nR\vE\vA\vp\v|\vt\vV\vR\vV\v9\v4\v$\v\xe4\n\xed\n\xf1\n\xa9\n\xba\n\xad\nL\nH\nH\n\xe7\t\xeb\t\xf3\t\x96\t\x92\t\x85\t)\t$\t$\t\xc7\b\xbf\b\xbf\bs\b{\b{\b\x1a\b\x16\b\x1e\b\xc6\a\xce\a\xce\a>\a:\a6\a\xec\x00\xe4\x00\xe8\x00k\xf7g\xf7V\xf7\x03\xf3\a\xf3\xf7\xf2\x13\xf0\x1c\xf0$\xf0O\xeeS\xeeS\xee0\xed4\xed<\xed\xa9\xec\x9c\xec\x98\xecr\xecm\xecz\xec\x98\xec\x93\xec\x93\xec\xdb\xec\xcf\xec\xcf\xec0\xed4\xed4\xed!\xec\x19\xec\x19\xec\xde\xe9\xeb\xe9\xde\xe9\xac\xe7\xac\xe7\xb5\xe7=\xe64\xe6=\xe6H\xe5D\xe5D\xe5\xd2\xe4\xc9\xe4\xd2\xe4\xc5\xe4\xc1\xe4\xbc\xe4\xf3\xe4\xfc\xe4\x04\xe5\x02\xe6\n\xe6\x02\xe6`\xe7e\xe7`\xe7\xd0\xe8\xd8\xe8\xe1\xe8Q\xeaD\xea;\xea\x89\xeb\x85\xeb\x89\xeb\xd7\xec\xdb\xec\xdf\xec\xff\xed\xee\xed\xe5\xed\xeb\xee\xf0\xee\xeb\xee\xd8\xef\xd8\xef\xe5\xef\xc0\xf0\xbc\xf0\xaf\xf0\x87\xf1\x83\xf1\x83\xf1t\xf2t\xf2t\xf2\x16\xf4\x12\xf4\t\xf4\x00\xf6\x00\xf6\x04\xf6\xe2\xf7\xe6\xf7\xee\xf7\xd0\xf9\xd4\xf9\xd8\xf9\x8c\xfb\x8c\xfb\x90\xfb*\xfd\x15\xfd\x19\xfd-\xff-\xff\x1c\xff\xbf\x01\xbf\x01\xc4\x01\xa8\x02\x97\x02\x8e\x02\r\x03\x1e\x03'\x03b\x03b\x03f\x03\x8c\x03\x8c\x03\x88\x03\x94\x03\x9d\x03\xae\x03\xb2\x03\xb2\x03\xb2\x03\xa1\x03\x9d\x03\xaa\x03\x9d\x03\x90\x03\x94\x03\x88\x03\x88\x03s\x03]\x03j\x03b\x03M\x03]\x03b\x03@\x03<\x03/\x03\x16\x03\x1e\x03'\x03\x00\x03\xfc\x02\x05\x03\xe7\x02\xe7\x02\xeb\x02\xc1\x02\xb9\x02\xc1\x02\xac\x02\xa4\x02\x9f\x02~\x02~\x02\x82\x02y\x02~\x02q\x02G\x02B\x02G\x02:\x02>\x02:\x02\x14\x02\x10\x02\x18\x02\x14\x02\x14\x02\v\x02\xee\x01\xf2\x01\xff\x01\xee\x01\xe1\x01\xe1\x01\xd0\x01\xc8\x01\xc4\x01\xb7\x01\xbf\x01\xbb\x01\xaa\x01\xae\x01\xae\x01\x99\x01\x91\x01\x88\x01|\x01\x88\x01\x80\x01k\x01s\x01x\x01^\x01V\x01Z\x01R\x01Z\x01V\x01E\x01M\x01M\x01<\x018\x014\x01+\x01'\x01'\x01\x1b\x01\x12\x01\x16\x01\n\x01\n\x01\n\x01\x05\x01\xf9\x00\xec\x00\xe4\x00\xf0\x00\xec\x00\xdf\x00\xe8\x00\xf0\x00\xdf\x00\xe8\x00\xe4\x00\xd7\x00\xd3\x00\xd7\x00\xd7\x00\xdf\x00\xe4\x00\xd7\x00\xd3\x00\xca\x00\xc6\x00\xca\x00\xbe\x00\xb5\x00\xb9\x00\xb5\x00\xb9\x00\xb9\x00\xb5\x00\xa4\x00\xa9\x00\xb1\x00\xb1\x00\xa4\x00\xa4\x00\xa0\x00\x9c\x00\x8f\x00\x87\x00\x8b\x00\x8b\x00z\x00z\x00~\x00v\x00v\x00r\x00v\x00~\x00\x87\x00~\x00z\x00\x82\x00r\x00e\x00i\x00e\x00v\x00\x87\x00z\x00m\x00m\x00i\x00a\x00T\x00\\\x00e\x00i\x00i\x00m\x00e\x00a\x00a\x00X\x00X\x00e\x00e\x00a\x00L\x00C\x00C\x00L\x00\\\x00L\x00?\x006\x00?\x00P\x00P\x00C\x00;\x00G\x00L\x00?\x00?\x00G\x00?\x00G\x00?\x00.\x00*\x002\x006\x00;\x006\x006\x00*\x00.\x006\x00?\x006\x00\x1d\x00\x15\x00\x15\x00!\x00;\x00\x05\xfe\x01\xfe\xfd\xfd\xb6\xfb\xad\xfb\xa5\xfb\xe9\xf9\xf6\xf9\xf6\xf9\xac\xf8\xa8\xf8\xac\xf8\xcd\xf7\xd1\xf7\xcd\xf7(\xf7(\xf7$\xf7\xbe\xf6\xc2\xf6\xcb\xf6\x90\xf6\x87\xf6\x90\xf6\u007f\xf6{\xf6v\xf6n\xf6{\xf6{\xf6\x87\xf6\u007f\xf6\x83\xf6\xa5\xf6\x98\xf6\x98\xf6\xcb\xf6\xdc\xf6\xdc\xf6\x06\xf7\n\xf7\x02\xf7\xd7\xf6\xd7\xf6\xe4\xf6\xc0\xf7\xaf\xf7\xbc\xf7\x87\xfb\u007f\xfbr\xfb \x041\x041\x04\r\b\r\b\x16\b{\n{\n\u007f\n\x1d\f\x19\f\x19\f<\r<\r<\r\xf2\r\xe9\r\xe9\rW\x0e`\x0eh\x0e\x9f\x0e\x97\x0e\x97\x0e\xa8\x0e\xac\x0e\xa3\x0e\x8e\x0e\x8a\x0e\x81\x0e`\x0eq\x0el\x0e)\x0e-\x0e1\x0e\xa2\r\x9d\r\x99\rB\x0e>\x0e:\x0e\u007f\x0f\x8c\x0f\x9d\x0f\xa7\x10\x96\x10\x8d\x10\x87\x11\x87\x11\x8f\x11#\x12\x1f\x12\x1f\x12x\x12s\x12x\x12\xc4\x12\xcc\x12\xcc\x12h\x13h\x13d\x13\xf8\x13\xf8\x13\xf8\x13b\x14U\x14b\x14\xa1\x14\xa5\x14\xa9\x14\x88\x14\u007f\x14\x90\x14\x1a\x14\t\x14\r\x14d\x13d\x13q\x13\x16\x12\n\x12\x05\x12\xf1\x0f\xf5\x0f\xf1\x0f\xb7\r\xb7\r\xbf\r\xa2\v\x9e\v\x96\vp\tu\ty\t\x86\a\x86\a\x86\a\x9c\x05\x9c\x05\xa0\x051\x041\x04 \x04$\x041\x04$\x04\xc5\x04\xc5\x04\xbc\x04e\x05n\x05v\x05\x12\x06\x17\x06\x12\x06A\x06E\x06N\x06\xcf\x05\xc6\x05\xc2\x05\x15\x05\x19\x05\x19\x05N\x04W\x04J\x04\u007f\x03\x94\x03\x99\x03\xce\x02\xd2\x02\xda\x02\x14\x02\x10\x02\x1c\x02V\x01I\x01V\x01\xce\xfa\xce\xfa\xc9\xfa\xe1\xf4\xe9\xf4\xf2\xf4c\xf0c\xf0c\xf0\x01\xed\xf5\xec\xf9\xec\x83\xea\u007f\xea\u007f\xea\xbb\xe8\xae\xe8\xbb\xe8\x8f\xe7\x86\xe7\x86\xe7\xcd\xe6\xd5\xe6\xd5\xe6Z\xe6V\xe6k\xe6N\xe6=\xe60\xe6=\xe6=\xe69\xe6t\xe6x\xe6p\xe6\xb7\xe6\xc0\xe6\xc8\xe62\xe7.\xe76\xe7\xbd\xe7\xb9\xe7\xb1\xe7\x1f\xe8\x16\xe8\x0e\xe8\xcd\xe6\xd9\xe6\xdd\xe6\x8e\xe4\x96\xe4\x9f\xe4\x86\xe2\x82\xe2\x86\xe2\xe8\xe0\xdb\xe0\xd3\xe0\x96ߟ߫\xdf\xf1\xde\xe9\xde\xed\xde\u007f\xde\u007f\xde\u007f\xdej\xdej\xdejވ\xde\u007f\xdew\xde\xc7\xde\xcb\xde\xd4\xdeJ\xdfJ\xdf=\xdf\xcd\xdf\xd6\xdf\xe2ߋ\xe0\x8b\xe0\x87\xe04\xe1(\xe1\x1f\xe1\xf2\xe1\xff\xe1\xf7\xe1\xbd\xe2\xc6\xe2\xc6\xe2\x9d\xe3\x9d\xe3\x9d\xe3y\xe4\x81\xe4u\xe4H\xe5U\xe5U\xe5(\xe6,\xe69\xe6\f\xe7\xff\xe6\xff\xe6\xd7\xe7\xdb\xe7\xe3\xe7\xbb\xe8\xbb\xe8\xc3\xe8\x9b\xe9\x92\xe9}\xe93\xea@\xeaQ\xea\x17\xeb\x13\xeb\x13\xeb\xcd\xeb\xc9\xeb\xd5\xeb\x8b\xec\x8b\xec\x9c\xec^\xedZ\xedR\xed\xff\xed\xf6\xed\xf6\xed\xa4\xee\x9f\xee\xa4\xeeQ\xefQ\xefQ\xef\xfe\xef\x06\xf0\x06\xf0\x9f\xf0\x9a\xf0\x9a\xf0?\xf1;\xf1*\xf1\xba\xf1\xba\xf1\xba\xf1A\xf2R\xf2Z\xf2\xdd\xf2\xd5\xf2\xd9\xf2`\xf3\\\xf3O\xf3\xbd\xf3\xbd\xf3\xce\xf3M\xf4M\xf4M\xf4\xc3\xf4\xc3\xf4\xc7\xf45\xf55\xf5>\xf5\xa7\xf5\xa3\xf5\xa3\xf5\b\xf6\xfc\xf5\x04\xf6e\xf6e\xf6e\xf6\xcf\xf6\xd3\xf6\xcb\xf6(\xf7,\xf70\xf7\x81\xf7|\xf7\x8d\xf7\xea\xf7\xe2\xf7\xd5\xf7\x1d\xf8\x19\xf8\x1d\xf8v\xf8~\xf8\x86\xf8\xd7\xf8\xd3\xf8\xce\xf8\x16\xf9\x12\xf9\x12\xf9Z\xf9Q\xf9M\xf9\x99\xf9\x95\xf9\x99\xf9\xdd\xf9\xd4\xf9\xd8\xf9\x14\xfa\x18\xfa\x14\xfaS\xfa[\xfa`\xfa\x92\xfa\x8a\xfa\x81\xfa\xb8\xfa\xc1\xfa\xd2\xfa\x04\xfb\xfc\xfa\xef\xfaL\xfbY\xfbL\xfbk\x01x\x01|\x01\u007f\b\u007f\b\x88\b+\r\x1f\r#\r\x96\x10\x8d\x10\x9a\x10)\x13%\x13)\x13\x02\x15\x02\x15\xfe\x14a\x16i\x16v\x16k\x17c\x17g\x17\x18\x18\x18\x18\x14\x18\x82\x18~\x18u\x18\xa8\x18\xb0\x18\xa8\x18\xb5\x18\xb9\x18\xb5\x18\x8f\x18\x8f\x18\x97\x18\\\x18\\\x18`\x18\x14\x18\x14\x18\x10\x18\xaf\x17\xaf\x17\xaa\x17=\x17=\x178\x17\x01\x17\x06\x17\x01\x17)\x18!\x18%\x18)\x1a)\x1a1\x1a0\x1c4\x1c4\x1c\xca\x1d\xc6\x1d\xbd\x1d\a\x1f\v\x1f\x18\x1f\xeb\x1f\xef\x1f\xeb\x1fw { r \xa1 \xa5 \xb2 \xc7 \xcb \xcb \xad \xa9 \xad w r j \t \x11 \t \x8a\x1f\x8e\x1f\x97\x1f\x14\x1f\x0f\x1f\x1c\x1fs\x1ek\x1eo\x1e\xce\x1d\xca\x1d\xce\x1d\x1d\x1d\x1d\x1d\x19\x1dg\x1cg\x1c_\x1c\x9c\x1b\xa9\x1b\xa9\x1b\xeb\x1a\xe7\x1a\xef\x1a5\x1a1\x1a1\x1ao\x19j\x19o\x19\xb9\x18\xb5\x18\xa0\x18\xd9\x17\xe1\x17\xea\x174\x17,\x17,\x17v\x16i\x16r\x16\xbc\x15\xb4\x15\xaf\x15\x06\x15\x13\x15\x1c\x15U\x14D\x14Q\x14\xb4\x13\xac\x13\x9f\x13\xf6\x12\xf6\x12\xee\x12M\x12M\x12V\x12\xb1\x11\xad\x11\xb1\x11\x1d\x11\x1d\x11\x1d\x11\x85\x10\x89\x10t\x10\xd8\x0f\xe4\x0f\xf1\x0f]\x0fL\x0fQ\x0f\xce\x0e\xce\x0e\xc5\x0e:\x0e5\x0e>\x0e\xc3\r\xc8\r\xcc\rI\r@\r@\r\xd7\f\xd7\f\xd7\f`\fe\f\\\f\xd9\v\xe2\v\xe6\v|\v|\vt\v\xf9\n\x02\v\n\v\xad\n\xad\n\xa5\n?\n?\nD\n\xeb\t\xda\t\xcd\t[\t_\tl\tI\x06=\x06E\x06\x9d\xfb\xa5\xfb\xa1\xfb\xd6\xf5\xda\xf5\xd6\xf5\x1b\xf2#\xf2#\xf2j\xeff\xefn\xefk\xedo\xedg\xed\xd5\xeb\xd1\xeb\xd9\xeb\xa5\xea\xa1\xea\xa9\xea\xd2\xe9\xde\xe9\xda\xe9B\xe9F\xe9F\xe9\xf2\xe8\xe5\xe8\xe1\xe8\xb2\xe8\xb2\xe8\xb2\xe8\xa6\xe8\xa2\xe8\xa2\xe8\xaa\xe8\xaa\xe8\xa6\xe8\xcc\xe8\xcc\xe8\xd0\xe8\x03\xe9\v\xe9\x03\xe9S\xe9W\xe9K\xe9\x92\xe9\x97\xe9\xa8\xe9\t\xea\x00\xea\x00\xea\x97\xe9\x8a\xe9\x82\xe9\xd7\xe7\xdb\xe7\xdb\xe7\xcb\xe5\xcf\xe5\xc2\xe5\x0f\xe4\x13\xe4-\xe4\xb9\xe2\xa4\xe2\xac\xe2\x9a\xe1\x95\xe1\x95\xe1\xd3\xe0\xcf\xe0\xcf\xe0L\xe0P\xe0P\xe0\b\xe0\xfc\xdf\xfc\xdf\xc9\xdf\xd1\xdf\xc9ߎߎߖߒ߉߉ߧߣߚ\xdf\x00\xe0\x04\xe0\x11\xe0\xb5\xe0\xb1\xe0\xad\xe0o\xe1k\xe1g\xe1>\xe2G\xe2>\xe2\t\xe3\r\xe3\r\xe3j\xe3j\xe3j\xe3\x8c\xe3\x90\xe3\x90\xe3\xa6\xe3\xa6\xe3\xa1\xe3\xbf\xe3\xbf\xe3\xc3\xe3\xed\xe3\xe5\xe3\xe5\xe3)\xe4-\xe4)\xe4\x85\xe4\x8a\xe4\x81\xe4*\xe5&\xe5*\xe5\xd9\xe6\xdd\xe6\xd5\xe6\xe9\xe8\xfa\xe8\xff\xe80\xeb$\xeb$\xeb,\xed,\xed#\xed\xce\xee\xd2\xee\xe7\xee\x0f\xf0\xfa\xef\xf1\xef\xc5\xf0\xc9\xf0\xcd\xf0\xd7\xf1\xdb\xf1\xdb\xf1\xfb\xf2\xf2\xf2\xee\xf23\xf4<\xf43\xf4p\xf5h\xf5l\xf5\x8b\xf6\x9c\xf6\xa1\xf61\xf5$\xf5$\xf5=\xf2N\xf2N\xf2{\xef{\xef\x8c\xefA\xed0\xed'\xedN\xebJ\xebJ\xeb\xde\xe9\xe7\xe9\xde\xe9\xf2\xe8\xf6\xe8\xf6\xe8\x9d\xe8\xaa\xe8\xae\xe8d\xe9[\xe9`\xe9\xf1\xea\xf1\xea\xed\xeae\xeca\xeca\xec4\xed8\xed4\xed\xc4\xed\xbf\xed\xc8\xed\x03\xee\x03\xee\v\xee\xee\xed\xee\xed\xf6\xed0\xed,\xed8\xedC\xec;\xec2\xec,\xeb,\xeb0\xeb\t\xea\r\xea\x15\xea\xfa\xe8\xfa\xe8\x03\xe9\xc6\xe7\xc2\xe7\xb5\xe7\x98\xe5\x98\xe5\x98\xe5b\xe3o\xe3j\xe3t\xe1t\xe1x\xe1\xd1\xdf\xd6\xdf\xdaߪޥޝެݹݴ\xddG\xddG\xddG\xdd\xe8\xe0\xec\xe0\xec\xe0k\xe8k\xe8f\xe8\xd9\xf2\xd9\xf2\xdd\xf2\xf8\a\x01\b\x05\b\xa0\x11\x9c\x11\x8f\x11k\x17o\x17t\x17\xa5\x1b\xa1\x1b\xa1\x1b\xd0\x1e\xc3\x1e\xc3\x1e\xcb \xcf \xdc 6\"2\"6\"\xfd\"\xf9\"\xf9\"I#M#I#M#^#Z#\xec\"\xe8\"\xf9\"\x82\"\x87\"z\"\xd1!\xd1!\xd5!5!5!F!\x90 \x8c \x90 \xda\x1f\xde\x1f\xde\x1f%\x1f \x1f\x14\x1fQ\x1eU\x1e^\x1e-\x1f-\x1f>\x1fG$G$>$\xa0)\xa0)\xa0)\x8f.\x8f.\x86.\xc42\xcd2\xcd2\xc55\xc55\xc15\xc15\xbd5\xca5\xca5\xc55\xbd5\xbd5\xb95\xb45\xc55\xd25\xd65\xce5\xce5\xd25\xda5\xd65\xda5\xdf5\xda5\xe35\xe75\xda5\xd65\xda5\xdf5\xd65\xda5\xe35\xe75\xe75\xe35\xdf5\xe35\xda5\xd65\xd25\xd25\xd65\xdf5\xdf5\xf45\x935\x8e5\x865#4'4#4\xbc2\xbc2\xbc2U1]1]1\xf6/\xee/\xf2/\x9c.\x9c.\xa0.R-N-N-\xe7+\xde+\xe2+o*o*b*\xfb(\f)\x14)~'\x83'\x87'\x88%n%f%\x1b###+#\xd3 \xcf \xcb \x84\x1e\x80\x1ew\x1e9\x1cE\x1cE\x1c)\x1a-\x1a-\x1a\x18\x18\x18\x18\x18\x18?\x16?\x16;\x16'\x14/\x147\x14\xa9\x11\xa4\x11\xa0\x11\r\x0f\r\x0f\x11\x0f`\fi\fi\f\xcd\t\xcd\t\xcd\t\xee\x06\xee\x06\xe1\x06F\x04=\x049\x04\x80\x01\x84\x01\x8d\x01a\xfdm\xfda\xfd\xf1\xfb\xed\xfb\xed\xfb\xde\xfa\xd2\xfa\xda\xfa)\xfa)\xfa-\xfa\x91\xf9\x84\xf9\x80\xf9\x05\xf9\x12\xf9\x16\xf9\xf3\xf7\x03\xf8\xfb\xf7\x19\xf6\"\xf6\"\xf6s\xf4\x80\xf4{\xf4\xf2\xf2\xea\xf2\xea\xf2\xc2\xf1\xcb\xf1\xc6\xf1\xcd\xf0\xc9\xf0\xcd\xf0\x06\xf0\v\xf0\x17\xf0w\xefb\xef]\xef\xeb\xee\xf0\xee\xf0\xee\xa8\xee\xac\xee\x9b\xeeW\xeeS\xeeS\xee1\xee6\xee-\xee\x10\xee\x14\xee\x1c\xee\x1c\xee!\xee\x18\xee\x14\xee\x1c\xee%\xeeq\xeeh\xeeh\xee\xf8\xee\xf4\xee\xeb\xee\x94\xef\x94\xef\x8c\xef9\xf0=\xf0F\xf0\xef\xf0\xfc\xf0\xf3\xf0\x8f\xf1\x9c\xf1\xa5\xf14\xf24\xf20\xf2\xc0\xf2\xc0\xf2\xc0\xf2O\xf3X\xf3X\xf3\xd2\xf3\xca\xf3\xdf\xf3o\xf4o\xf4b\xf4\xd8\xf4\xdc\xf4\xe5\xf4_\xf5[\xf5O\xf5\xbc\xf5\xc5\xf5\xc1\xf5\"\xf6\"\xf6&\xf6\x9c\xf6\xa5\xf6\x94\xf6\xf5\xf6\xf5\xf6\xf5\xf6g\xf7g\xf7c\xf7\xbc\xf7\xb7\xf7\xb7\xf7\x14\xf8\x19\xf8\x14\xf8i\xf8m\xf8m\xf8\xc2\xf8\xbd\xf8\xb9\xf8\x01\xf9\x01\xf9\xfd\xf8E\xf98\xf9<\xf9|\xf9\x88\xf9\x9d\xf9\xd8\xf9\xc8\xf9\xc3\xf9\x03\xfa\x14\xfa\x18\xfaS\xfaK\xfaF\xfa\x80\xf9\x84\xf9|\xf9A\xf7A\xf7A\xf7\xed\xf4\xfa\xf4\a\xf5\xf7\xf2\xf7\xf2\xfb\xf2e\xf1X\xf1L\xf1\xfa\xef\xfa\xef\xfa\xef\xdf\xee\xd6\xee\xe3\xee\x10\xee\x03\xee\xfb\xedM\xedR\xedR\xed\xbe\xec\xb5\xec\xb5\xecL\xecL\xecL\xec\xfb\xeb\x04\xec\x04\xec\xc4\xeb\xc9\xeb\xbc\xeb\x92\xeb\x89\xeb\x8d\xebp\xebx\xebx\xeb}\xeb}\xeb\x89\xeb\x9a\xeb\x96\xeb\x96\xeb\xab\xeb\xa3\xeb\xa3\xeb\xc9\xeb\xc0\xeb\xc0\xeb\xe6\xeb\xde\xeb\xe2\xeb\x10\xec\x19\xec!\xec\\\xecX\xecT\xec~\xec~\xec~\xec\xca\xec\xd3\xec\xd3\xec\xd7\xec\xdf\xec\xe8\xec\x19\xec\x19\xec\x19\xec\xe9\xea\xed\xea\xf1\xea\xac\xe9\xb0\xe9\xac\xe9\xaa\xe8\xbb\xe8\xb2\xe8\xca\xe7\xce\xe7\xd3\xe7\x19\xe7\x19\xe7!\xe7\x91\xe6\x91\xe6\x9e\xe6(\xe6$\xe6$\xe6\xdc\xe5\xd7\xe5\xcb\xe5\x90\xe5\x94\xe5\x8b\xe5a\xe5n\xe5\u007f\xe5{\xe5{\xe5{\xe5{\xe5{\xe5\u007f\xe5\x98\xe5\x90\xe5\x98\xe5\xcb\xe5\xcb\xe5\xba\xe5\xe0\xe5\xe0\xe5\xe4\xe5\x17\xe6\x1f\xe6(\xe6k\xe6c\xe6g\xe6\xb3\xe6\xb3\xe6\xb7\xe6\xd9\xe6\xd5\xe6\xcd\xe6\xd1\xe6\xd1\xe6\xd5\xe6\xd1\xe6\xe2\xe6\xf7\xe6\b\xe7\xff\xe6\xf3\xe6\x03\xe7\b\xe7\x10\xe76\xe72\xe7.\xe7T\xe7X\xe7T\xe7\x8b\xe7\x8b\xe7\x8b\xe7\xbd\xe7\xc2\xe7\xb9\xe7\t\xe8\x12\xe8\x0e\xe8Z\xe8Q\xe8M\xe8\x95\xe8\xa2\xe8\xa6\xe8\xf6\xe8\xff\xe8\x03\xe9K\xe95\xe9:\xe9\x92\xe9\x97\xe9\xa8\xe9\x83\xea\u007f\xea{\xea\b\xec\xf7\xeb\xf3\xeb\xbf\xed\xc4\xed\xc8\xed\x9d\xef\x99\xef\x99\xefi\xf1n\xf1v\xf1>\xf3C\xf3C\xf3\xfe\xf4\xf2\xf4\xf2\xf4\xc7\xf6\xc2\xf6\xc2\xf6\x8f\xf8\x93\xf8\x93\xf8\x86\xfa\x8a\xfa\x81\xfa[\xfcg\xfct\xfc\xe8\xfd\xdb\xfd\xdf\xfdS\xffK\xffB\xffI\x01M\x01M\x01\xd3\x00\xca\x00\xd3\x00\xbe\xfd\xb1\xfd\xad\xfd\x06\xfc\x13\xfc\x06\xfc\xbd\xfa\xc1\xfa\xce\xfa\xc3\xf9\xb7\xf9\xae\xf9\xdb\xf8\xdb\xf8\xdb\xf8*\xf8%\xf8%\xf8\xfb\xf7\xea\xf7\xe6\xf7\x10\xf8\x10\xf8\x19\xf8`\xf8`\xf8`\xf8\xa4\xf8\xa0\xf8\xa0\xf8\xc2\xf8\xc2\xf8\xb1\xf8\xdd\xf9\xee\xf9\xf2\xf9\xa3\xfc\x9a\xfc\xa3\xfc\x1a\x03\x1a\x03\x1e\x03\x1b\x06\x12\x06\x17\x06#\b\x1a\b\x12\b\xc1\t\xc9\t\xc9\t\x06\v\n\v\x06\v!\f.\f*\f\xf9\f\xf4\f\xf0\f\xa6\r\xa2\r\xaa\rB\x0e>\x0e>\x0e\xb8\x0e\xb8\x0e\xb8\x0e\x15\x0f\x15\x0f\x1e\x0fj\x0fr\x0fr\x0f\u007f\x0f{\x0f{\x0f\t\x0f\x04\x0f\x00\x0f\x05\r\xf9\f\xec\f\xc5\t\xd2\t\xc9\t\x8f\x05\x94\x05\x87\x05}\xfc}\xfct\xfc\b\xf8\x10\xf8\x19\xf8_\xf5d\xf5d\xf5\x8f\xf3\x8f\xf3\x86\xf3\x1b\xf2\x12\xf2\x1b\xf2\x15\xf1\f\xf1\b\xf1$\xf0 \xf0 \xf0/\xef'\xef/\xefm\xeeu\xeeq\xee\xae\xed\xb3\xed\xc4\xed#\xed#\xed\x1f\xedv\xecr\xece\xec\xd5\xeb\xd5\xeb\xde\xeb\xaf\xeb\xaf\xeb\xaf\xeb\xa3\xeb\x9a\xeb\x96\xeb\x9a\xeb\x9a\xeb\x9e\xeb_\xebW\xeb[\xeb\xf1\xea\xf1\xea\xe4\xeaj\xeaf\xear\xea\x1a\xea\"\xea&\xea\xd6\xe9\xd6\xe9\xd2\xe9\xb4\xe9\xb0\xe9\xac\xe9]\xean\xean\xeaR\xebN\xeb=\xeb \xeb\x17\xeb$\xeb\x94\xea\x98\xea\x9d\xea\xb8\xe9\xb4\xe9\xb0\xe9\xc3\xe8\xc3\xe8\xbf\xe8\xdb\xe7\xd7\xe7\xdf\xe7\x10\xe7\x10\xe7\x14\xe7\x81\xe6\x89\xe6\x85\xe6\xf1\xe5\xed\xe5\xf1\xe5a\xe5a\xe5a\xe59\xe49\xe49\xe4\xfd\xe2\x05\xe3\xfd\xe2\xd9\xe1\xd5\xe1\xdd\xe1\xe4\xe0\xec\xe0\xf1\xe0\x19\xe0\b\xe0\f\xe0F\xdf=\xdf9ߺ\u07bf\u07baވސތސޙޙ\u07b6\u07b2ޮުުޥ\xde\x1e\xde\x1e\xde\"\xde~\xddu\xdd~\xdd\xd0\xdc\xd5\xdc\xe1\xdcV\xdcR\xdcV\xdc\xe8\xdb\xdf\xdb\xe8\xdb\xf5\xdb\xf0\xdb\xe4\xdb,\xdc4\xdc8܈܄܀\xdc\xc8\xdc\xd0\xdc\xd0\xdc\x1c\xdd\x1c\xdd)\xdd:\xdd:\xdd:\xdd6\xdd1\xdd1\xdd\x18\xdd\x1c\xdd%\xdd\x14\xdd\v\xdd\x14\xdd\a\xdd\x03\xdd\x10\xdd\xff\xdc\xee\xdc\xeeܮܻܳ\xdc\n\xdc\xfd\xdb\xfd\xdbG\xdb2\xdb.ۅ\xda}\xda}\xda\xf1\xd9\xf1\xd9\xed\xd9n\xd9r\xd9r\xd9+\xd9/\xd9/\xd9\xfc\xd8\x04\xd9\x15\xd9\x11\xd9\x11\xd9\x15\xd9@\xd9;\xd9/\xd9]\xd9f\xd9j٥٥٥ٌِك\xd9]\xd9a\xd9f\xd93\xd97\xd9;\xd9&\xd9+\xd93\xd9\x1e\xd9\x11\xd9\t\xd9\x15\xd9\x1a\xd9\x15\xd9\x04\xd9\x00\xd9\xfc\xd8\xd2\xd8\xde\xd8\xce؟أأ؊؊؎\xd8`\xd8S\xd8[\xd8:\xd8K\xd8O\xd8-\xd8)\xd8 \xd8\x03\xd8\a\xd8\v\xd8\xe9\xd7\xee\xd7\xf2\xd7\xe1\xd7\xdd\xd7\xdd\xd7\xe1\xd7\xdd\xd7\xd8\xd7\xdd\xd7\xe9\xd7\xee\xd7\x03\xd8\v\xd8\x0f\xd8-\xd8-\xd81\xd8d\xd8[\xd8Wؗ؟؛\xd8\xde\xd8\xe3\xd8\xde\xd83\xd97\xd9;ِهك\xd9\xe0\xd9\xe9\xd9\xe9\xd9[\xda_\xda[ڼڸڳ\xda!\xdb&\xdb;۾۵۱\xdb\x1f\xdc\x16\xdc\x1f\xdc\xc4\xdc\xd0\xdc\xcc\xdch\xddh\xddh\xdd\xf8\xdd\xf0\xdd\xf0ݥުު\xdec\xdf_\xdf[\xdf;\xe07\xe07\xe0\x17\xe1\x1f\xe1\x17\xe1\xf7\xe1\xee\xe1\xea\xe1\xbd\xe2\xc1\xe2\xc6\xe2\x99\xe3\x9d\xe3\x9d\xe3\x8e\xe4\x8e\xe4\x8a\xe4\xa1\xe5\xa5\xe5\xa5\xe5\xd5\xe6\xd1\xe6\xd1\xe6\x05\xe8\x0e\xe8\t\xe8 \xe9\x18\xe9 \xe9L\xeaD\xeaH\xea[\xebW\xebR\xebe\xeca\xecX\xec^\xedg\xedV\xedO\xeeO\xeeK\xee3\xef<\xef<\xef_\xf0h\xf0c\xf0\xf5\xf1\xf1\xf1\xe8\xf1\xac\xf3\xb0\xf3\xa0\xf3}\xf5\x8a\xf5\x96\xf5\x85\xf7\x85\xf7|\xf7\x88\xf9\x99\xf9\xa2\xf9\xf5\xfb\xf1\xfb\xf1\xfb8\x018\x01A\x01\xae\b\xaa\b\xb2\b\xa8\x0e\xa8\x0e\xb0\x0e\x86\x13\x82\x13\x86\x13\x84\x17\x8d\x17\x84\x17\xeb\x1a\xe2\x1a\xe2\x1a\xe3\x1d\xe8\x1d\xdf\x1d\x83 \x87 { \xb5\"\xad\"\xb5\"\xa4$\x9b$\x8e$1&,&,&\x8f'\x9c'\xa9'\x03)\xfb(\xfb(@*<*8*[+W+[+e,j,a,4-4-4-\xe6-\xe6-\xee-\x82.v.m.\xdf.\xe8.\xf0.</</</|/\x88/\x84/\xa2/\x9d/\xa2/\xd8/\xdd/\xd4/-0)0)0}0\x860\x860\xc50\xc10\xbd0\xeb0\xef0\xef0\t1\x041\x111*1&1\x1e1\x1a1&1*1\xe01\xdc1\xd81z3v3z3-5%5!5\xbf6\xb26\xbf6*8&8*8^9V9R9\xc09\xc09\xc89\xd19\xd59\xd99\xd99\xd59\xd59\xd99\xd99\xd99\xd99\xc49\xc09\xd99\xe69\xd99\xdd9\xe19\xd59\xd59\xdd9\xe19\xe19\xdd9\xdd9\xd99\xd99\xdd9\xe19\xd99\xd59\xea9\xf29\xee9\xe69\xe69\xe19\xd99\xe19\xe69\xea9\xee9\xf79\xf79\xfb9\xf79\xfb9\xf79\xf79\xf29\xee9\xea9\xfb9\xf29\xea9\xe69\xf79\xf29^9^9^9\xc28\xbe8\xc68*8\"8\"8}7\x857\x897\xd86\xcb6\xc76+6+6+6\x825\x825\x825\xd04\xd44\xd44#4#4'4v3~3\x823\xc92\xbc2\xaf2\xfa1\x022\n2U1Y1U1\x920\x9b0\x9b0\xe1/\xdd/\xdd///4/4/z.i.`.\xb3-\xb7-\xaf-\xed,\x02-\x02-D,7,;,\x8e+\x96+\x9f+\xe5*\xe1*\xe5*3*/*+*q)q)q)\xc4(\xc4(\xb3(\xfd'\x01(\x06(n'r'e'\xc0&\xc0&\xb4&\xfe%\x06&\x06&b%b%j%\xc1$\xb4$\xb0$\x10$\a$\x03$g#o#o#\xc6\"\xc6\"\xca\".\"6\".\"\x8d!\x96!\x96!\xe9 \xf1 \xf5 * \" \" >\x1f>\x1f>\x1fU\x1eU\x1eU\x1ei\x1dq\x1d~\x1d\x9a\x1c\x96\x1c\x8d\x1c\xa1\x1b\xa1\x1b\xa1\x1b\xd2\x1a\xde\x1a\xde\x1a\v\x1a\v\x1a\v\x1a@\x198\x19<\x19z\x18~\x18\x82\x18\xb7\x17\xb7\x17\xbb\x17\x06\x17\x06\x17\x06\x17C\x167\x16C\x16\x9a\x15\x92\x15\x96\x15\xfa\x14\xf6\x14\xf6\x14Q\x14M\x14D\x14\xa8\x13\xa4\x13\xac\x13\x1c\x13\x10\x13\v\x13o\x12s\x12\x80\x12\xe8\x11\xec\x11\xec\x11a\x11i\x11r\x11\xea\x10\xe2\x10\xe2\x10A\x109\x10F\x10\xd4\x0f\xcf\x0f\xc7\x0f;\x0f;\x0fH\x0f\xd6\x0e\xda\x0e\xe3\x0ed\x0e`\x0e[\x0e\xe9\r\xf2\r\xe5\r|\rw\r|\r\x0e\r\x12\r\x12\r\xac\f\xa8\f\xac\fC\f?\f?\f\xc4\v\xc8\v\xc8\v\xad\n\xad\n\xa9\n\xdc\b\xd8\b\xd8\b\xbb\x06\xb7\x06\xb3\x06\xf1\x03\xf6\x03\xf1\x03\xd9\xfc\xd5\xfc\xe6\xfc\t\xf9\xfd\xf8\xf9\xf8*\xf6*\xf63\xf6\xfd\xf3\xfd\xf3\x01\xf4,\xf2(\xf2,\xf2\xa3\xf0\xa3\xf0\xa3\xf0Q\xefQ\xefQ\xef%\xee%\xee%\xee,\xed'\xed\x1b\xed*\xec*\xec!\xec,\xeb0\xeb0\xebY\xeaY\xeaY\xea\x8a\xe9\x82\xe9\x8e\xe9\xe1\xe8\xd4\xe8\xd0\xe8'\xe8'\xe8+\xe8\xb1\xe7\xb1\xe7\xb1\xe7C\xe72\xe7%\xe7\xc4\xe6\xc8\xe6\xcd\xe6t\xe6t\xe6t\xe6,\xe6$\xe6\x1f\xe6\xe4\xe5\xed\xe5\xf1\xe5\xcb\xe5\xcb\xe5\xcb\xe5$\xe6\x1f\xe6\x17\xe6\xa7\xe6\xb7\xe6\xb3\xe6C\xe7?\xe7G\xe7\xdf\xe7\xdb\xe7\xdf\xe7|\xe8o\xe8o\xe8\v\xe9\x14\xe9\x1c\xe9\xc5\xe9\xbd\xe9\xac\xe9\"\xea&\xea+\xeaQ\xeaY\xea]\xeaa\xea]\xea]\xean\xeaf\xeaY\xea&\xea+\xea;\xea\xb0\xe9\xa8\xe9\xac\xe9\x14\xe9\x1c\xe9%\xe9\x9d\xe8\x99\xe8\x99\xe8\x1f\xe8\x16\xe8\x16\xe8\xa8\xe7\xa4\xe7\xa4\xe7C\xe7P\xe7K\xe7\xe2\xe6\xdd\xe6\xea\xe6\x9e\xe6\x91\xe6\x85\xe6\x06\xe6\x06\xe6\n\xe6{\xe5\u007f\xe5\u007f\xe5\xe2\xe4\xe2\xe4\xde\xe4>\xe4B\xe4B\xe4\xb6\xe3\xb2\xe3\xb6\xe38\xe38\xe3<\xe3\xdf\xe2\xec\xe2\xe7\xe2~\xe2~\xe2~\xe2-\xe2:\xe2>\xe2\xfb\xe1\xff\xe1\xff\xe1\xcc\xe1\xcc\xe1\xcc\xe1\xa6\xe1\xa6\xe1\x9e\xe1\x91\xe1\x9e\xe1\xa6\xe1\x9e\xe1\x8d\xe1\x84\xe1\xdd\xe1\xe1\xe1\xea\xe1z\xe2~\xe2\x82\xe2\x1e\xe3'\xe3'\xe3\xd0\xe3\xd0\xe3\xcc\xe3h\xe4_\xe4W\xe4\xfc\xe4\b\xe5\r\xe5\xa5\xe5\xa1\xe5\xa1\xe50\xe6A\xe6R\xe6\xdd\xe6\xd1\xe6\xcd\xe6e\xe7e\xe7`\xe7\xec\xe7\xec\xe7\xf0\xe7o\xe8o\xe8w\xe8\xf6\xe8\xff\xe8\a\xe9\x82\xe9}\xe9\x86\xe9\t\xea\x04\xea\x00\xea\x8c\xea\x90\xea\x90\xea,\xeb,\xeb\x1b\xeb\xe2\xeb\xe6\xeb\xde\xeb\xad\xec\xb5\xec\xad\xec\x80\xed\x91\xed\x91\xed%\xee)\xee%\xee\x8a\xee\x8a\xee\x86\xee\xda\xee\xdf\xee\xdf\xee+\xef3\xef@\xef\x88\xef\u007f\xef\x83\xef\xd0\xef\xc7\xef\xba\xef\xfe\xef\xfe\xef\v\xf0[\xf0c\xf0p\xf0\xb8\xf0\xaf\xf0\xab\xf0\xe2\xf0\xd6\xf0\xde\xf02\xf12\xf1.\xf1r\xf1i\xf1i\xf1\xad\xf1\xa5\xf1\xa9\xf1\xfd\xf1\xf5\xf1\xf9\xf18\xf24\xf2(\xf2o\xf2t\xf2k\xf2\xa6\xf2\xab\xf2\xb3\xf2\xea\xf2\xee\xf2\xee\xf2)\xf3\x18\xf3\x18\xf3`\xf3d\xf3i\xf3\x82\xf3~\xf3m\xf3\xee\xf2\xfb\xf2\xf7\xf2I\xf2N\xf2R\xf2\xa9\xf1\xb5\xf1\xb1\xf1\x00\xf1\xf7\xf0\x04\xf1p\xf0p\xf0p\xf0\xf6\xef\xed\xef\xf6\xef{\xefn\xefs\xef\x05\xef\x05\xef\r\xef\xb0\xee\xa8\xee\x9b\xeeS\xeeW\xee`\xee6\xee6\xee1\xee:\xee6\xee:\xee\\\xeeW\xeed\xee\x9f\xee\x93\xee\x8e\xee\xce\xee\xca\xee\xc5\xee\x05\xef\x01\xef\xfc\xee+\xef'\xef\"\xeff\xefw\xef{\xef\xb6\xef\xa5\xef\xaa\xef\v\xf0\x02\xf0\x06\xf0\x81\xf0\x81\xf0}\xf0\xef\xf0\xeb\xf0\xef\xf0a\xf1a\xf1a\xf1\xd7\xf1\xcf\xf1\xd3\xf1E\xf24\xf20\xf2\x9e\xf2\x91\xf2\x89\xf2\xfb\xf2\x03\xf3\xf2\xf2`\xf3d\xf3m\xf3\xca\xf3\xc1\xf3\xbd\xf3+\xf4+\xf4#\xf4s\xf4{\xf4s\xf4\xd4\xf4\xdc\xf4\xd4\xf4)\xf5)\xf5-\xf5\x8a\xf5\x85\xf5}\xf5\xd2\xf5\xd2\xf5\xc9\xf5\xd6\xf5\xe2\xf5\xe2\xf5J\xf5B\xf5>\xf5\x99\xf4\x9d\xf4\x9d\xf4\xe7\xf3\xe3\xf3\xdb\xf36\xf32\xf3>\xf3\x9e\xf2\x84\xf2\x84\xf2\xfd\xf1\x12\xf2\n\xf2\x83\xf1\x87\xf1\x8f\xf1\x19\xf1\"\xf1&\xf1\xb8\xf0\xb8\xf0\xcd\xf0y\xf0y\xf0c\xf0\v\xf0\v\xf0\x1c\xf0\xd0\xef\xcb\xef\xdc\xef\x9d\xef\x8c\xef\x83\xefU\xefU\xefU\xef'\xef+\xef+\xef\r\xef\t\xef\xf0\xee\xce\xee\xce\xee\xda\xee\xc5\xee\xce\xee\xc5\xee\xb4\xee\xb4\xee\xb0\xee\xac\xee\xa8\xee\xc5\xee\xf4\xee\xe7\xee\xdf\xee\x05\xef\xfc\xee\xfc\xee<\xefH\xefM\xef{\xefs\xefj\xef\x94\xef\x94\xef\x99\xef\xcb\xef\xc3\xef\xcb\xef\xfa\xef\xfa\xef\xfe\xef\x1c\xf0\x17\xf0(\xf0_\xf0h\xf0p\xf0\x9a\xf0\x92\xf0\x89\xf0\xbc\xf0\xc5\xf0\xc9\xf0\xf3\xf0\xef\xf0\xf3\xf0&\xf1\x1d\xf1\"\xf1X\xf1T\xf1P\xf1r\xf1r\xf1v\xf1\xad\xf1\xa9\xf1\xa0\xf1\xbe\xf1\xcb\xf1\xcf\xf1\xfd\xf1\xfd\xf1\xf9\xf10\xf2(\xf2(\xf2V\xf2Z\xf2V\xf2\x84\xf2\x84\xf2\x89\xf2\xb3\xf2\xc0\xf2\xc4\xf2\xdd\xf2\xd9\xf2\xdd\xf2\x1d\xf3\x18\xf3\f\xf3-\xf32\xf32\xf3T\xf3X\xf3`\xf3\xa0\xf3\x9b\xf3\x9b\xf3\xc6\xf3\xc1\xf3\xb0\xf3\xd2\xf3\xd2\xf3\xce\xf3\t\xf4\x1e\xf4\x16\xf4+\xf4+\xf4'\xf4Q\xf4Q\xf4Q\xf4\x88\xf4\x90\xf4\x84\xf4\xaa\xf4\xa1\xf4\x9d\xf4\xc7\xf4\xcc\xf4\xd4\xf4\xfe\xf4\a\xf5\x0f\xf51\xf5$\xf5 \xf5J\xf5B\xf5B\xf5u\xf5u\xf5d\xf5\x8a\xf5\x9b\xf5\xa3\xf5\xc5\xf5\xbc\xf5\xc1\xf5\xef\xf5\xeb\xf5\xda\xf5\xfc\xf5\xf8\xf5\xf3\xf5&\xf6*\xf6\x1e\xf6D\xf6L\xf6D\xf6e\xf6a\xf6e\xf6\x94\xf6\x90\xf6\x8b\xf6\xa1\xf6\xa1\xf6\xa5\xf6\xbe\xf6\xcf\xf6\xdc\xf6\xf5\xf6\xe8\xf6\xe8\xf6\x0e\xf7\x0e\xf7\n\xf7(\xf7(\xf7,\xf7\x1f\xf7(\xf7$\xf7\xed\xf6\xe8\xf6\xf1\xf6\xcb\xf6\xc7\xf6\xcf\xf6\xad\xf6\x9c\xf6\x98\xf6\u007f\xf6\u007f\xf6\u007f\xf6e\xf6j\xf6j\xf6H\xf6D\xf6D\xf6&\xf6\x15\xf6\x19\xf6\x19\xf6\x1e\xf6\x19\xf6\xf3\xf5\xef\xf5\xfc\xf5\xf3\xf5\xf3\xf5\xde\xf5\xd2\xf5\xe2\xf5\xe7\xf5\xd2\xf5\xc9\xf5\xcd\xf5\xc9\xf5\xcd\xf5\xc5\xf5\xac\xf5\xac\xf5\xbc\xf5\x00\xf6\xfc\xf5\x04\xf6\xbe\xf6\xad\xf6\xa5\xf6\x9e\xf7\xa2\xf7\xb3\xf7\xb9\xf8\xb5\xf8\xb1\xf8\xbf\xf9\xb7\xf9\xc8\xf9\xf4\xfa\xef\xfa\xef\xfa=\xfc9\xfc0\xfc\xcf\xfd\xd7\xfd\xd7\xfd\x16\x01\n\x01\x12\x01\xe3\x02\xd2\x02\xc5\x02\x02\x04\x1c\x04\x13\x04\x00\x05\xfc\x04\x00\x05\xc2\x05\xbe\x05\xba\x05c\x06x\x06|\x06\xff\x06\xee\x06\xee\x066\a-\a%\a2\a6\a2\a!\a%\a6\a%\a\x18\a\x10\a\xf2\x06\xea\x06\xf2\x06\xdd\x06\xe1\x06\xea\x06\xd1\x06\xc8\x06\xc0\x06\xc8\x06\xc0\x06\xb7\x06\xc8\x06\xd5\x06\xd9\x06\xc4\x06\xc4\x06\xc8\x06\xaf\x06\xa6\x06\x9a\x06|\x06|\x06\x84\x06E\x06I\x06V\x06\n\x06\xfd\x05\xf5\x05\xa5\x05\xa9\x05\xba\x05e\x05]\x05e\x05\x11\x05\b\x05\f\x05\xc0\x04\xc0\x04\xbc\x04c\x04W\x04S\x04\xfa\x03\x02\x04\x06\x04\xba\x03\xb2\x03\xae\x03U\x03U\x03Y\x03\x05\x03\x11\x03\x16\x03\xb9\x02\xb0\x02\xb0\x02'\x03/\x03\"\x03B\x04F\x04B\x04a\x05r\x05n\x05^\x06g\x06o\x06`\a`\ai\a8\b3\b/\b\xe9\b\xe1\b\xe1\b}\t\x8a\t\x92\t&\n\x15\n\r\n\xa1\n\xa1\n\x98\n\xad\n\xad\n\xa9\n.\n*\n3\n}\t}\tl\t\x95\b\x9d\b\xa1\b\xb5\a\xb9\a\xbd\a\x9a\x06\x9a\x06\xa2\x06*\x05&\x05\x1d\x05/\x03/\x03+\x03!\xff)\xff%\xfft\xfc\x81\xfc}\xfc\xd6\xfa\xda\xfa\xda\xfa\x91\xf9\x88\xf9\x8c\xf9\x8b\xf8\x86\xf8\x86\xf8\xd1\xf7\xd5\xf7\xcd\xf79\xf79\xf7,\xf7\xb1\xf6\xb6\xf6\xbe\xf6Y\xf6a\xf6Y\xf6\xe7\xf5\xeb\xf5\xef\xf5\x81\xf5}\xf5u\xf5\a\xf5\v\xf5\v\xf5\x9d\xf4\x99\xf4\x9d\xf48\xf48\xf4@\xf4\xe3\xf3\xdb\xf3\xce\xf3\x8a\xf3\x97\xf3\x97\xf3O\xf3G\xf3G\xf3\a\xf3\a\xf3\x10\xf3\xdd\xf2\xe1\xf2\xdd\xf2\x9a\xf2\x91\xf2\x84\xf2Z\xf2Z\xf2^\xf28\xf24\xf20\xf2\x01\xf2\x01\xf2\n\xf2\xe8\xf1\xe4\xf1\xe0\xf1\xbe\xf1\xc6\xf1\xbe\xf1\xad\xf1\xba\xf1\xba\xf1\x9c\xf1\x8b\xf1\x8b\xf1r\xf1v\xf1n\xf1r\xf1r\xf1i\xf1T\xf1X\xf1X\xf1L\xf1P\xf1P\xf1C\xf17\xf1;\xf1\x04\xf1\b\xf1\xfc\xf0B\xf0J\xf0S\xf0n\xefb\xeff\xef\x9b\xee\xa8\xee\x9f\xee\xd5\xed\xd5\xed\xc4\xed\x12\xed\x12\xed\n\xedT\xecT\xecX\xec\xc4\xeb\xc0\xeb\xc4\xebF\xebF\xebF\xeb\xc3\xea\xbe\xea\xbe\xeaD\xeaL\xeaL\xea\xd6\xe9\xce\xe9\xd2\xe9}\xe9y\xe9y\xe9)\xe9)\xe9%\xe9\xd0\xe8\xc8\xe8\xc3\xe8\x80\xe8\x80\xe8\x88\xe8I\xe8^\xe8V\xe8\x12\xe8\t\xe8\t\xe8\xdb\xe7\xce\xe7\xce\xe7\xb1\xe7\xb9\xe7\xb5\xe7\x8f\xe7\x8b\xe7\x82\xe7`\xe7`\xe7\\\xe7C\xe7G\xe7?\xe7\x14\xe7\f\xe7\x14\xe7\b\xe7\x14\xe7\x1d\xe7\x03\xe7\x03\xe7\x14\xe7T\xe7C\xe7?\xe7\xbd\xe7\xbd\xe7\xb9\xe7^\xe8b\xe8Z\xe8\xe9\xe8\xfa\xe8\x03\xe9\x9f\xe9\x97\xe9\x97\xe93\xea3\xea7\xea\xd4\xea\xc3\xea\xc3\xeaR\xebR\xeb[\xeb\xe2\xeb\xd9\xeb\xd5\xebm\xece\xeci\xec\xf0\xec\xec\xec\xdf\xecs\xeds\xedg\xed\xe5\xed\xea\xed\xee\xedq\xeeu\xeeu\xee\xf0\xee\xf0\xee\xf0\xeeb\xeff\xefn\xef\xe5\xef\xe0\xef\xe5\xef_\xf0[\xf0J\xf0\xc0\xf0\xc5\xf0\xc5\xf02\xf17\xf12\xf1\x98\xf1\x94\xf1\xa0\xf1\x06\xf2\x06\xf2\x01\xf2t\xf2\x80\xf2\x80\xf2\xd9\xf2\xdd\xf2\xe6\xf2K\xf3>\xf36\xf3\x8a\xf3\x9b\xf3\x9b\xf3\x01\xf4\xf4\xf3\xf8\xf3U\xf4Y\xf4Y\xf4\xae\xf4\xaa\xf4\xa6\xf4\v\xf5\x18\xf5\x1c\xf5h\xf5_\xf5S\xf5\xc5\xf5\xcd\xf5\xd6\xf5H\xf6L\xf6D\xf6\xa9\xf6\xa9\xf6\xb1\xf6\x17\xf7\x17\xf7\x1f\xf7\x9e\xf7\xa2\xf7\x9a\xf7\xf3\xf7\xf7\xf7\xf7\xf7e\xf8i\xf8v\xf8\xd7\xf8\xce\xf8\xca\xf8\x01\xf9\t\xf9\x0e\xf94\xf9'\xf9\x1f\xf9f\xf9f\xf9k\xf9y\xfa\x8a\xfa\x86\xfaV\xfcJ\xfcA\xfc\x1c\xff1\xff6\xffj\x03b\x03b\x032\x052\x052\x05\x91\x06\x91\x06\x9e\x06\xce\a\xca\a\xc6\a\xd8\b\xdc\b\xd8\b\xbc\t\xbc\t\xb8\t\u007f\n\x87\n\x8b\nE\vJ\vJ\v\xea\v\xe6\v\xe6\v\x82\f\x86\f\x8f\f\x1f\r\x1f\r#\r\xcc\r\xc3\r\xbb\r}\x0e\x81\x0e}\x0e\x1a\x0f&\x0f*\x0f\xc7\x0f\xb6\x0f\xba\x0fF\x10N\x10R\x10\xd1\x10\xc4\x10\xc4\x10?\x11G\x11\\\x11\xca\x11\xca\x11\xc6\x11'\x12+\x12+\x12\x84\x12\x80\x12\x80\x12\xc8\x12\xc4\x12\xc8\x12\x1c\x13!\x13%\x13h\x13h\x13m\x13\xb4\x13\xa8\x13\xac\x13\xe7\x13\xda\x13\xdf\x13\x1e\x14'\x14'\x14Y\x14Y\x14Q\x14\x88\x14\x8c\x14\x8c\x14\xc7\x14\xcb\x14\xc7\x14\xfe\x14\xfa\x14\xfa\x145\x159\x151\x15\xe4\x16\xe4\x16\xe0\x16\xe8\x16\xe8\x16\xec\x16\xf1\x16\xf5\x16\xf5\x16\xec\x16\xec\x16\xf5\x16\xf1\x16\xe4\x16\xe8\x16\xe0\x16\xe8\x16\xe4\x16\xe8\x16\xec\x16\xe4\x16\xcf\x16\xc6\x16\xcb\x16\xd3\x16\xc2\x16\xc2\x16\xbe\x16\xba\x16\xba\x16\xb1\x16\xb5\x16\xba\x16\xa0\x16\x9c\x16\x8f\x16z\x16\x83\x16\x87\x16~\x16~\x16~\x16r\x16i\x16v\x16X\x16T\x16]\x16C\x16?\x16C\x16*\x162\x16;\x16*\x16\"\x16\"\x16\xf7\x15\xf3\x15\xf3\x15\x92\x15\x96\x15\x9f\x151\x151\x15,\x15\xb2\x14\xb2\x14\xae\x14/\x143\x14<\x14\xbd\x13\xbd\x13\xc1\x13G\x13G\x13G\x13\xd4\x12\xd4\x12\xc8\x12^\x12k\x12g\x12\xec\x11\xf0\x11\xec\x11\x82\x11\x82\x11\x87\x11\x15\x11\x1d\x11\x1d\x11\xbc\x10\xb8\x10\xb3\x10F\x10A\x10N\x10\xfa\x0f\xfe\x0f\xf1\x0f\x87\x0f\x8c\x0f\x8c\x0f3\x0f3\x0f7\x0f\xf4\x0e\xfc\x0e\x00\x0f\xda\x0e\xd2\x0e\xc5\x0e\xac\x0e\xb0\x0e\xbd\x0e\xb0\x0e\x9f\x0e\x97\x0e\x92\x0e\xa3\x0e\xa8\x0e\x92\x0e\x86\x0e\x86\x0eu\x0e}\x0e\x86\x0eu\x0eu\x0ed\x0eB\x0e>\x0e>\x0e\v\x0e\v\x0e\v\x0e\xd0\r\xcc\r\xd0\r\x9d\r\x91\r\x8c\r^\rk\ro\r4\r#\r\x1a\r\xe8\f\xe8\f\xf4\f\xbd\f\xbd\f\xbd\f~\f\x82\f\x82\fC\fC\fC\f\x14\f\x19\f\x19\f\xee\v\xf3\v\xee\v\xc0\v\xbc\v\xb7\v\x89\v\x91\v\x91\v_\vR\vN\v\x1f\v(\v,\v\x06\v\x06\v\xf9\n\xc7\n\xc2\n\xcb\n\xb1\n\xb1\n\xa5\n\x8b\n\x87\n{\nD\nD\nP\n*\n*\n&\n\xf8\t\x04\n\x00\n\xe2\t\xde\t\xd6\t\x85\t\x8e\t\x92\t\xd4\b\xd0\b\xcc\bq\au\aq\aP\x05L\x05L\x05\xfb\x01\xff\x01\a\x025\xfc5\xfc0\xfc\x91\xf9\x84\xf9\x91\xf9\xaf\xf7\xb7\xf7\xb3\xf7\x11\xf6\b\xf6\xfc\xf5\x95\xf4\x9d\xf4\x9d\xf4`\xf3d\xf3i\xf3N\xf2N\xf2I\xf2H\xf1L\xf1L\xf1c\xf0c\xf0c\xf0\x8c\xef\x83\xef\x88\xef\xce\xee\xca\xee\xc5\xee\v\xee\x10\xee\x10\xeeb\xedk\xeds\xed\xdf\xec\xdb\xec\xdf\xec;\xec.\xec2\xec\xa3\xeb\x9e\xeb\xa3\xeb \xeb$\xeb(\xeb\x94\xea\x94\xea\x9d\xea3\xea3\xea/\xea\xc9\xe9\xc1\xe9\xb4\xe9l\xe9h\xe9l\xe9B\xe9B\xe9:\xe9\a\xe9\a\xe9\x0f\xe9\xc3\xe8\xae\xe8\xaa\xe8V\xe8V\xe8V\xe8\xf4\xe7\xec\xe7\xec\xe7\x97\xe7\x9c\xe7\x8f\xe7\x1d\xe72\xe7.\xe7\xb7\xe6\xaf\xe6\xb7\xe6Z\xe6c\xe6p\xe6\n\xe6\x06\xe6\n\xe6\xd7\xe5\xcf\xe5\xc7\xe5\xb1\xe5\xb1\xe5\xb1\xe5\xc2\xe5\xc2\xe5\xc2\xe5\xf5\xe5\xf5\xe5\xf5\xe5A\xe60\xe64\xe6\xa2\xe6\xa2\xe6\x9a\xe6\xe6\xe6\xe6\xe6\xea\xe6K\xe7C\xe7?\xe7\x93\xe7\xa4\xe7\xac\xe7\xf0\xe7\xe8\xe7\xf4\xe78\xe8#\xe8\x12\xe8V\xe8b\xe8b\xe8\x8c\xe8\x88\xe8\x80\xe8\xaa\xe8\xbb\xe8\xbb\xe8\xd8\xe8\xdd\xe8\xd4\xe8\xfa\xe8\xf6\xe8\xfa\xe8 \xe9\x1c\xe9\x18\xe9>\xe9F\xe9B\xe9`\xe9d\xe9d\xe9\x82\xe9u\xe9q\xe9\x9b\xe9\xa3\xe9\x9f\xe9\xbd\xe9\xc1\xe9\xc5\xe9\xe7\xe9\xeb\xe9\xf4\xe9\r\xea\x00\xea\xfc\xe9&\xea&\xea\"\xea@\xeaD\xeaD\xeaY\xeaa\xeaf\xea\x83\xea\x83\xea\x87\xea\xb6\xea\xba\xea\xb2\xea\xcf\xea\xd4\xea\xcf\xea\xf1\xea\xf5\xea\xf1\xea\x13\xeb\n\xeb\x0f\xeb5\xeb5\xeb5\xebR\xebJ\xebJ\xebt\xebx\xebt\xeb\x96\xeb\x9a\xeb\x8d\xeb\xab\xeb\xb8\xeb\xb8\xeb\xcd\xeb\xc9\xeb\xcd\xeb\xea\xeb\xea\xeb\xea\xeb\x19\xec&\xec\x19\xec;\xec;\xec?\xec\\\xecX\xecT\xec\x87\xec\x87\xecz\xec\xa0\xec\x9c\xec\x9c\xec\xbe\xec\xb9\xec\xb9\xec\xdf\xec\xdf\xec\xd7\xec\xf0\xec\xec\xec\xe4\xec\n\xed\x0e\xed\x16\xed8\xed<\xed<\xed^\xed^\xedV\xeds\xedx\xed|\xed\x95\xed\x91\xed\x99\xed\xbb\xed\xb7\xed\xb7\xed\xe5\xed\xe5\xed\xe1\xed\x1c\xee!\xee\x18\xeeS\xeeW\xeeW\xee\x8e\xee\x8e\xee\x86\xee\xc5\xee\xca\xee\xca\xee\x11\xef\x1a\xef\x1a\xeff\xefb\xefb\xef\xb6\xef\xb6\xef\xae\xef\xfe\xef\x06\xf0\v\xf0$\xf0 \xf0\x17\xf0\x17\xf0 \xf0\x1c\xf0\x02\xf0\xfe\xef\xfe\xef\xe0\xef\xe5\xef\xf1\xef\xba\xef\xba\xef\xba\xef]\xefb\xefU\xef\xeb\xee\xe7\xee\xdf\xee~\xee\x86\xee\x82\xee\x14\xee\x14\xee\x1c\xee\xc4\xed\xbb\xed\xb7\xedk\xedk\xedk\xed<\xed4\xed,\xed\n\xed\x0e\xed\x0e\xed\xec\xec\xf5\xec\x01\xed\xe8\xec\xdf\xec\xdb\xec\xdf\xec\xdf\xec\xdf\xec\xec\xec\xd7\xec\xd3\xec\xd7\xec\xd3\xec\xca\xec\xca\xec\xd3\xec\xdb\xec\xe4\xec\xdf\xec\xd7\xec\xe4\xec\xdf\xec\xe4\xec\xe8\xec\xe4\xec\xd3\xec\xc2\xec\xc6\xec\xcf\xec\xca\xec\xcf\xec\xcf\xec\xd3\xec\xc2\xec\xb9\xec\xb9\xec\xca\xec\xca\xec\xbe\xec\xb9\xec\xc2\xec\xcf\xec\xc6\xec\xbe\xec\xd7\xec\xe4\xec\xec\xec\xf5\xec\xf5\xec\xe8\xec\xf9\xec\xf9\xec\xf5\xec\x05\xed\x0e\xed\x05\xed\x05\xed\n\xed\x12\xed\x1b\xed#\xed'\xed8\xed4\xed4\xedE\xed8\xed4\xedM\xedI\xedR\xedZ\xedV\xedM\xed^\xedg\xeds\xed\x8d\xed\x84\xed\x80\xed\x84\xed\x8d\xed\x91\xed\xa2\xed\xa6\xed\xa6\xed\xaa\xed\xb3\xed\xae\xed\xc4\xed\xbf\xed\xbb\xed\xc8\xed\xc8\xed\xd5\xed\xe1\xed\xdd\xed\xd5\xed\xf2\xed\a\xee\x10\xee\x14\xee\x03\xee\x03\xee!\xee)\xee!\xeeu\xeey\xee\x86\xee\r\xef\r\xef\t\xef\x88\xef\x83\xef\u007f\xef\x06\xf0\x02\xf0\xfe\xef\x8e\xf0\x9a\xf0\xa3\xf0]\xf1H\xf17\xf1=\xf2N\xf2I\xf2O\xf3X\xf3X\xf3s\xf4j\xf4j\xf4\x9f\xf5\xa3\xf5\xac\xf5\xe4\xf6\xe0\xf6\xd3\xf6\x14\xf8!\xf8%\xf8\x88\xf9w\xf9\x84\xf9\r\xfb\r\xfb\r\xfb\xd1\xfc\xd9\xfc\xde\xfcm\x00r\x00z\x00@\x037\x037\x03\xde\x04\xda\x04\xd5\x04\x1b\x06\x1b\x06\x1b\x062\a2\a2\a\x1a\b\x1e\b#\b\xf2\b\xf6\b\xf6\b\xb4\t\xb4\t\xb4\te\ne\n]\n\xf5\n\xfe\n\x06\v\x9a\v\x91\v\x96\v%\f6\f:\f\xa4\f\x97\f\x97\f\x12\r\x1a\r\x16\r\x8c\r\x8c\r\x91\r\x03\x0e\xfa\r\xf6\rh\x0ed\x0eS\x0e\xac\x0e\xb4\x0e\xa8\x0e\xf8\x0e\x00\x0f\t\x0fU\x0fD\x0fL\x0f\xa9\x0f\xad\x0f\x9d\x0f\xdc\x0f\xdc\x0f\xe0\x0f \x10,\x10=\x10t\x10p\x10t\x10\xab\x10\xab\x10\xa7\x10\xde\x10\xd9\x10\xd9\x10\b\x11\xff\x10\xff\x10;\x116\x11*\x11a\x11a\x11\\\x11\x8f\x11\x93\x11\x8b\x11\xad\x11\xbe\x11\xcf\x11\xec\x11\xe4\x11\xd7\x11\xf5\x11\xf0\x11\xf0\x11\x1f\x12\x1b\x12\x1f\x12E\x12A\x12A\x12b\x12^\x12Z\x12k\x12s\x12k\x12x\x12\x80\x12\x8d\x12\xaa\x12\xa6\x12\xa2\x12\xae\x12\xb7\x12\xb3\x12\xc8\x12\xc8\x12\xc8\x12\xd9\x12\xdd\x12\xe5\x12\xe5\x12\xe1\x12\xdd\x12\xee\x12\xf2\x12\xfb\x12\x03\x13\x03\x13\x03\x13\x10\x13\x10\x13\x10\x13!\x13\x18\x13\x18\x13)\x13%\x13\x10\x13\x14\x13\x18\x13\x14\x13\x10\x13\x18\x13!\x13\xdd\x12\xd9\x12\xd4\x12s\x12o\x12o\x12\x16\x12#\x120\x12\xca\x11\xb9\x11\xb9\x11X\x11X\x11X\x11\xf7\x10\xf3\x10\xf7\x10\x89\x10\x85\x10\x85\x10\xe0\x0f\xdc\x0f\xd4\x0f\x04\x0f\x04\x0f\t\x0f:\x0e>\x0e>\x0ek\rf\rk\r\x9c\f\x9c\f\x97\f\xc8\v\xc0\v\xab\v\xd3\n\xdc\n\xdc\n\xf8\t\xf8\t\x00\n$\t$\t$\t3\b3\b3\bO\aO\aO\aZ\x06c\x06k\x06X\x05P\x05C\x05(\x04,\x04$\x04\xd2\x02\xdf\x02\xd2\x02\xfd\x00\n\x01\x16\x01\xf5\xfd\xe8\xfd\xe4\xfd\x8d\xfc\x85\xfc\x85\xfcw\xfb{\xfb\x8c\xfb\x9b\xfa\x8e\xfa\x8e\xfa\xbf\xf9\xc3\xf9\xcc\xf9\xfd\xf8\xe8\xf8\xf0\xf8P\xf8T\xf8`\xf8\xd5\xf7\xcd\xf7\xd9\xf7k\xf7g\xf7_\xf7\xf1\xf6\xf9\xf6\xf5\xf6\x87\xf6\x94\xf6\x98\xf6\x1e\xf6\x15\xf6\"\xf6\x9b\xf5\x92\xf5\x8a\xf5\v\xf5\a\xf5\xfe\xf4\x90\xf4\x9d\xf4\x99\xf43\xf4@\xf4I\xf4\xe7\xf3\xd6\xf3\xce\xf3z\xf3\x86\xf3\x8a\xf3C\xf3>\xf3-\xf3\xee\xf2\xf7\xf2\xf7\xf2\xc0\xf2\xb7\xf2\xc0\xf2\xa2\xf2\x9a\xf2\x9e\xf2\x84\xf2\x89\xf2\x8d\xf2\x84\xf2\x80\xf2x\xf2c\xf2c\xf2c\xf2N\xf2I\xf2R\xf20\xf2(\xf2#\xf2\n\xf2\x0e\xf2\x17\xf2#\xf2,\xf2(\xf2E\xf2E\xf2A\xf2k\xf2k\xf2g\xf2\x8d\xf2\x95\xf2\x91\xf2\xc8\xf2\xcc\xf2\xc8\xf2\xee\xf2\xfb\xf2\xfb\xf2\xdd\xf2\xe1\xf2\xe6\xf2\xb7\xf2\xaf\xf2\xa2\xf2Z\xf2V\xf2c\xf20\xf28\xf28\xf2\xf1\xf1\xf5\xf1\xf1\xf1\xbe\xf1\xba\xf1\xb1\xf1\u007f\xf1\x83\xf1\x83\xf1L\xf1L\xf1C\xf1\b\xf1\b\xf1\xfc\xf0\xa3\xf0\x9a\xf0\x9a\xf0F\xf0J\xf0F\xf0\xed\xef\xf6\xef\xf1\xef\x8c\xef\x99\xef\x94\xef3\xef/\xef3\xef\xc5\xee\xca\xee\xce\xeed\xee\\\xeeh\xee\xf6\xed\xf2\xed\xe5\xed\x88\xed\x84\xed\x84\xed0\xed4\xed<\xed\xf0\xec\xe8\xec\xf0\xec\xb1\xec\x9c\xec\x93\xecP\xecT\xeca\xec!\xec!\xec\x10\xec\xb3\xeb\xb8\xeb\xc0\xeb=\xeb=\xebA\xeb\xba\xea\xbe\xea\xcf\xea]\xeaL\xeaL\xea\xe7\xe9\xe7\xe9\xeb\xe9\x86\xe9\x82\xe9\x82\xe9\x1c\xe9\x18\xe9\x14\xe9\xb2\xe8\xae\xe8\xaa\xe8E\xe8M\xe8Z\xe8\xec\xe7\xe3\xe7\xdf\xe7m\xe7m\xe7m\xe7\xff\xe6\b\xe7\f\xe7\x9a\xe6\x96\xe6\x91\xe6,\xe64\xe64\xe6\xc7\xe5\xcb\xe5\xd3\xe5n\xe5n\xe5e\xe5\xf8\xe4\xfc\xe4\xfc\xe4\x9f\xe4\xa3\xe4\xa3\xe4>\xe4F\xe4W\xe4\xfa\xe3\xf2\xe3\xf2\xe3\x95\xe3\x90\xe3\x95\xe3D\xe3I\xe3M\xe3\x05\xe3\xf8\xe2\xe7\xe2\x97\xe2\xa4\xe2\xb5\xe2i\xe2`\xe2\\\xe2\x14\xe2\x18\xe2\x18\xe2\xd9\xe1\xd9\xe1\xdd\xe1\xa6\xe1\xa6\xe1\xa6\xe1o\xe1c\xe1c\xe1=\xe1=\xe1=\xe1\n\xe1\n\xe1\n\xe1\xdb\xe0\xe4\xe0\xdb\xe0\xba\xe0\xc2\xe0\xb1\xe0\x83\xe0\x83\xe0\x87\xe0a\xe0e\xe0r\xe0a\xe0e\xe0e\xe0L\xe0H\xe0X\xe0C\xe0H\xe0H\xe0?\xe02\xe02\xe0&\xe0\"\xe0&\xe07\xe02\xe0*\xe0*\xe0\x1d\xe0\x1d\xe0.\xe02\xe0.\xe0L\xe0H\xe0C\xe0]\xe0P\xe0H\xe0n\xe0z\xe0v\xe0\x8b\xe0\x8f\xe0\x98\xe0\xb5\xe0\xb1\xe0\xad\xe0\xd3\xe0\xcb\xe0\xcb\xe0\xf9\xe0\xf9\xe0\xfd\xe0,\xe1(\xe1#\xe1c\xe1c\xe1Z\xe1\x95\xe1\x9e\xe1\x9e\xe1\xee\xe1\xf2\xe1\xfb\xe1G\xe2G\xe2G\xe2\x8f\xe2\x86\xe2\x82\xe2\xd2\xe2\xdb\xe2\xe3\xe2/\xe3+\xe3\x1e\xe3^\xe3j\xe3^\xe3\x90\xe3\x99\xe3\xaa\xe3\xe5\xe3\xdc\xe3\xd8\xe3)\xe4)\xe4$\xe4p\xe4l\xe4}\xe4\xcd\xe4\xd6\xe4\xde\xe43\xe5&\xe5\"\xe5{\xe5\x8b\xe5\x90\xe5\xd7\xe5\xcb\xe5\xc7\xe5\x17\xe6\x17\xe6,\xe6\x9e\xe6\x96\xe6\x91\xe6\x03\xe7\xff\xe6\x03\xe7m\xe7z\xe7\x82\xe7\xec\xe7\xe8\xe7\xe8\xe7V\xe8Q\xe8V\xe8\xc8\xe8\xd4\xe8\xd4\xe8:\xe9:\xe9>\xe9\x9f\xe9\x9f\xe9\x9b\xe9\t\xea\t\xea\x11\xeaw\xeaw\xeaw\xea\xfe\xea\xf1\xea\xfa\xea\x9e\xeb\x9e\xeb\x9a\xeb?\xecC\xec?\xec\xe4\xec\xdb\xec\xd7\xec\x84\xed\x95\xed\x91\xed-\xee!\xee%\xee\xc5\xee\xc5\xee\xc1\xeef\xefw\xefn\xef\xe9\xef\xe5\xef\xe0\xefF\xf0F\xf0J\xf0\xab\xf0\xab\xf0\xab\xf0\x1d\xf1\"\xf1*\xf1\x8f\xf1\x87\xf1\x8b\xf1\xf9\xf1\xfd\xf1\xec\xf1^\xf2k\xf2k\xf2\xcc\xf2\xc8\xf2\xc8\xf22\xf32\xf36\xf3\xa8\xf3\xa4\xf3\xa4\xf3\x01\xf4\x01\xf4\xfd\xf3I\xf4U\xf4Y\xf4\x8c\xf4\x88\xf4\x88\xf4\xc3\xf4\xbb\xf4\xbf\xf4\xfe\xf4\a\xf5\a\xf5>\xf55\xf5-\xf5d\xf5u\xf5u\xf5\xb8\xf5\xb0\xf5\xa7\xf5\xe7\xf5\xef\xf5\xeb\xf5\x1e\xf6&\xf6&\xf6P\xf6L\xf6D\xf6j\xf6{\xf6\x83\xf6\xa1\xf6\x9c\xf6\xa1\xf6\xd7\xf6\xdc\xf6\xd7\xf6\x13\xf7\n\xf7\x0e\xf7Z\xf7Z\xf7_\xf7\x96\xf7\x96\xf7\x9e\xf7\xe6\xf7\xe2\xf7\xdd\xf7!\xf8*\xf82\xf8q\xf8i\xf8q\xf8\xb9\xf8\xb1\xf8\xa8\xf8\xfd\xf8\x05\xf9\t\xf9I\xf9V\xf9^\xf9\x88\xf9|\xf9s\xf9\xb2\xf9\xb7\xf9\xc3\xf9\xf2\xf9\xe5\xf9\xe5\xf9\x1c\xfa)\xfa)\xfa[\xfa[\xfaW\xfay\xfay\xfa}\xfa\xac\xfa\xb8\xfa\xb4\xfa\xe3\xfa\xe3\xfa\xe3\xfa\x15\xfb\x1e\xfb\x1a\xfba\xfb]\xfbf\xfb\xa1\xfb\xa1\xfb\xa1\xfb\xe0\xfb\xd8\xfb\xd4\xfb\x17\xfc\x17\xfc\x1b\xfcl\xfcp\xfcl\xfc\xab\xfc\xab\xfc\xa7\xfc\xf7\xfc\xf7\xfc\xf7\xfcG\xfd?\xfd;\xfd\x8f\xfd\x98\xfd\x98\xfd\xec\xfd\xe4\xfd\xd7\xfd+\xfe+\xfe0\xfe\x95\xfe\x9e\xfe\x91\xfe\xf6\xfe\xf6\xfe\a\xff\x8e\xff\x93\xff\x8e\xff\xa0\x00\x9c\x00\xa0\x00\x1b\x01\x1f\x01\x1f\x01x\x01s\x01s\x01\xcc\x01\xcc\x01\xd9\x01\x03\x02\xee\x01\xf2\x011\x02-\x021\x02m\x02u\x02\x82\x02\xac\x02\xa4\x02\xa8\x02\xda\x02\xd6\x02\xd2\x02\xf8\x02\xf4\x02\xfc\x02\"\x03\"\x03+\x03Q\x03U\x03U\x03j\x03f\x03n\x03\x90\x03\x88\x03\x83\x03\x99\x03\xa1\x03\xa5\x03\xae\x03\xaa\x03\xa5\x03\xaa\x03\xae\x03\xaa\x03\xae\x03\xb2\x03\xb2\x03\xb2\x03\xaa\x03\xa5\x03\xb2\x03\xbf\x03\xbf\x03\xbf\x03\xba\x03\xbf\x03\xcb\x03\xc7\x03\xcb\x03\xe5\x03\xe0\x03\xe0\x03\v\x04\v\x04\x02\x04$\x04 \x04$\x04N\x04J\x04J\x04l\x04h\x04h\x04\x81\x04\x81\x04\x81\x04\x85\x04}\x04\x81\x04\x96\x04\x92\x04\x89\x04\x96\x04\x9a\x04\x9f\x04\x8e\x04\x92\x04\x89\x04c\x04W\x04h\x04J\x04F\x04B\x04\x0f\x04\x13\x04\x0f\x04\xf6\x03\xfa\x03\xf1\x03\xe0\x03\xe5\x03\xed\x03\xdc\x03\xd8\x03\xd4\x03\xcb\x03\xc7\x03\xc7\x03\xbf\x03\xc3\x03\xcb\x03\xc7\x03\xc3\x03\xbf\x03\xaa\x03\xae\x03\xc3\x03\xfa\x03\xf1\x03\xe5\x03B\x04=\x04F\x04\x96\x04\x92\x04\x8e\x04\xcd\x04\xc9\x04\xc5\x04\xf7\x04\xef\x04\xeb\x04\x11\x05\"\x05\"\x05H\x05T\x05P\x05e\x05a\x05X\x05v\x05\x83\x05r\x05z\x05z\x05r\x05i\x05v\x05\x83\x05\u007f\x05r\x05r\x05\u007f\x05z\x05z\x05\x98\x05\x9c\x05\x87\x05\xcf\x05\xcf\x05\xd3\x05(\x060\x06,\x06x\x06x\x06g\x06t\x06\x80\x06\x84\x06\x84\x06\x89\x06\x89\x06|\x06x\x06t\x06k\x06o\x06o\x06R\x06R\x06V\x064\x06=\x06A\x06\x1b\x06\x12\x06\x0e\x06\xe8\x05\xe8\x05\xf1\x05\xe4\x05\xe4\x05\xe4\x05\xc6\x05\xc6\x05\xcb\x05\xc6\x05\xd3\x05\xcb\x05\xba\x05\xbe\x05\xba\x05\xa9\x05\xad\x05\xb1\x05i\x05a\x05]\x05\xe2\x04\xd5\x04\xd1\x04$\x04,\x045\x04j\x03b\x03b\x03m\x02h\x02m\x02\x16\x01\x12\x01\x0e\x01M\xfeE\xfeM\xfe\xfb\xfc\xfb\xfc\x00\xfd\x06\xfc\n\xfc\n\xfc7\xfbH\xfbH\xfb\x8a\xfa}\xfa}\xfa\xd4\xf9\xdd\xf9\xd8\xf98\xf9<\xf9<\xf9\xac\xf8\xa4\xf8\xa0\xf8\xff\xf7\b\xf8\x10\xf8g\xf7c\xf7Z\xf7\xb6\xf6\xbe\xf6\xbe\xf6*\xf6&\xf6&\xf6\x8a\xf5\x85\xf5\x8e\xf5\x02\xf5\v\xf5\v\xf5\x84\xf4\x88\xf4\x84\xf4\xfd\xf3\r\xf4\x05\xf4q\xf3z\xf3z\xf3\xf2\xf2\xee\xf2\xf2\xf2\xfb\xf2\xf2\xf2\xf2\xf2i\xf3i\xf3\\\xf3\xe7\xf3\xf0\xf3\xf8\xf3\x9d\xf4\xaa\xf4\xb6\xf4J\xf5B\xf5>\xf5\xd6\xf5\xcd\xf5\xc9\xf5v\xf6\x83\xf6\x90\xf60\xf7\x1b\xf7$\xf7\xd5\xf7\xcd\xf7\xbc\xf7m\xf8v\xf8v\xf8'\xf9'\xf9'\xf9\xd8\xf9\xd0\xf9\xcc\xf9\x97\xfa\x9b\xfa\xa3\xfaj\xfba\xfba\xfb[\xfc_\xfc[\xfc?\x00;\x006\x00S\x04J\x04J\x04\xbb\x06\xc0\x06\xc4\x06\xb2\b\xbf\b\xc7\b{\n\u007f\n\u007f\n\xff\v\xee\v\xea\vE\rE\rQ\r\x97\x0e\x8e\x0e}\x0e\xad\x0f\xad\x0f\x9d\x0f\xaf\x10\xb8\x10\xaf\x10\xa9\x11\xad\x11\xad\x11\x99\x12\x9e\x12\xa6\x12y\x13m\x13y\x13Q\x14H\x143\x14\xf6\x14\xfa\x14\x06\x15\xbc\x15\xc0\x15\xb8\x15e\x16n\x16v\x16#\x17\x1b\x17\x1f\x17\xb7\x17\xaa\x17\xaa\x17O\x18O\x18T\x18\xd6\x18\xd2\x18\xce\x18Y\x19M\x19M\x19\xdc\x19\xe5\x19\xdc\x19[\x1ad\x1ah\x1a\xc9\x1a\xc9\x1a\xcd\x1aD\x1bD\x1bD\x1b\xb6\x1b\xba\x1b\xc7\x1b$\x1c\x17\x1c\x02\x1cc\x1cc\x1cp\x1c\xd5\x1c\xd5\x1c\xcd\x1c!\x1d!\x1d%\x1d\x86\x1d~\x1d~\x1d\xd7\x1d\xd7\x1d\xd3\x1d\x1f\x1e\x1f\x1e#\x1e|\x1ew\x1es\x1e\xaa\x1e\xa2\x1e\xa6\x1e\xf2\x1e\xfa\x1e\xf2\x1e)\x1f-\x1f5\x1f\x86\x1f\x81\x1fq\x1f\xb0\x1f\xb0\x1f\xb0\x1f\xe7\x1f\xef\x1f\xf4\x1f/ 3 * L Q U \x90 \x87 \x83 \xba \xc3 \xbe \xe4 \xe9 \xed \x17!\n!\x0f!=!5!,!R!V!g!\x8d!\x85!\x85!\xab!\xa3!\x9e!\xc4!\xd5!\xd1!\xe6!\xe6!\xe2!\xfb!\xfb!\xfb!&\"*\"\x1d\"?\"L\"L\"\\\"P\"P\"v\"r\"m\"~\"~\"\x82\"\xa0\"\xb1\"\xa0\"\xad\"\xb5\"\xc6\"\xcf\"\xca\"\xc6\"\xd3\"\xcf\"\xdb\"\xf0\"\xec\"\xec\"\x01#\xf5\"\xec\"\n#\n#\x01#\x01#\x05#\x0e###\x1f#+#8#+###'#'#4#I#M#E#E#A#I#R#R#V#V#Z#R#I#I#R#M#M#R#^#b#b#Z#^#^#b#b#Z#Z#^#k#s#k#g#s#x#o#s#x#k#g#b#k#k#k#s#b#V#M#E#I#M#V#V#Z#R#^#^#M#R#V#Z#^#R#A#E#Z#R#A#A#A#M#R#E#A#A#+#0#0#+#'#'###'#\x1b#\x12#\x1f###\x12#\x0e#\x05#\xfd\"\x01#\xfd\"\xfd\"\x05#\x0e#\x01#\xfd\"\xf9\"\xec\"\xe4\"\xdb\"\xd3\"\xdf\"\xe8\"\xdb\"\xdf\"\xdb\"\xcf\"\xcf\"\xcf\"\xc6\"\xbe\"\xbe\"\xb1\"\xa9\"\xad\"\xb1\"\xb1\"\xa9\"\xa0\"\x9c\"\xa4\"\x9c\"\x98\"\x8f\"~\"\x8b\"\x8b\"z\"i\"v\"r\"a\"e\"e\"a\"X\"X\"\\\"\\\"G\"?\"?\"?\"6\"6\"*\"*\".\"\x19\"\x19\"\x19\"\f\"\x10\"\x04\"\xef!\xfb!\xff!\xe6!\xe6!\xef!\xe2!\xde!\xd9!\xcd!\xcd!\xd9!\xcd!\xc0!\xbc!\xb3!\xab!\xa7!\x9e!\xa7!\xa7!\x92!\x89!\x89!\x85!\x81!}!l!l!x!g!_![!R!_![!A!=!J!5!0!,! !\x17!\x17!\n!\x13!\x13!\n!\x06!\xfe \xe4 \xe9 \xe9 \xe0 \xdc \xe0 \xcb \xcb \xc7 \xba \xcb \xcf \xbe \xb2 \xad \xa1 \xa5 \xa5 \x8c \x87 \x94 { { { j ] j a j j L H Y H @ 3 \x15 & & \x11 \x1a \x1a \x04 \t \r \xf8\x1f\xfc\x1f\xfc\x1f\xe7\x1f\xe3\x1f\xe7\x1f\xd6\x1f\xce\x1f\xc9\x1f\xbd\x1f\xb8\x1f\xb8\x1f\xac\x1f\xb4\x1f\xac\x1f\x9b\x1f\x9f\x1f\x9b\x1f\x8a\x1f\x86\x1f\x81\x1fu\x1fy\x1fq\x1f[\x1fd\x1fu\x1fh\x1fW\x1fW\x1fB\x1fB\x1fO\x1f5\x1f-\x1f)\x1f)\x1f-\x1f\x18\x1f\v\x1f\x14\x1f\x14\x1f\a\x1f\a\x1f\x03\x1f\xe9\x1e\xee\x1e\xfa\x1e\xd8\x1e\xd0\x1e\xdd\x1e\xc8\x1e\xbf\x1e\xbb\x1e\xb2\x1e\xb2\x1e\xaa\x1e\x9d\x1e\xa2\x1e\xa2\x1e\x8c\x1e\x84\x1e\x91\x1e|\x1es\x1eo\x1eZ\x1eb\x1eo\x1eQ\x1eM\x1e@\x1e'\x1e4\x1e+\x1e\x12\x1e\x1a\x1e\x1f\x1e\x0e\x1e\x01\x1e\xfd\x1d\xf0\x1d\xf9\x1d\x01\x1e\xec\x1d\xdf\x1d\xdb\x1d\xca\x1d\xd3\x1d\xd3\x1d\xb9\x1d\xb5\x1d\xbd\x1d\xa4\x1d\x9c\x1d\x97\x1d\x86\x1d\x8b\x1d\x93\x1dz\x1dz\x1dz\x1de\x1di\x1di\x1dG\x1dK\x1dP\x1d6\x1d6\x1d6\x1d\x19\x1d\x10\x1d\f\x1d\xfb\x1c\b\x1d\x03\x1d\xea\x1c\xe6\x1c\xe2\x1c\xbc\x1c\xbc\x1c\xc0\x1c\x9e\x1c\x9e\x1c\xab\x1c\x96\x1c\x91\x1c\x80\x1cZ\x1cg\x1cg\x1cJ\x1cN\x1cN\x1c,\x1c,\x1c0\x1c\x13\x1c\x13\x1c\x1b\x1c\xfe\x1b\xfe\x1b\xe8\x1b\xd3\x1b\xdc\x1b\xe0\x1b\xc7\x1b\xc7\x1b\xbe\x1b\xa5\x1b\xa9\x1b\xad\x1b\x98\x1b\x98\x1b\x94\x1b{\x1br\x1be\x1bH\x1b]\x1b]\x1b3\x1b.\x1b&\x1b\xfc\x1a\x04\x1b\x11\x1b\xe7\x1a\xde\x1a\xd6\x1a\xb0\x1a\xb0\x1a\xbc\x1a\x9f\x1a\x9b\x1a\x8a\x1a[\x1a_\x1ad\x1a>\x1aB\x1a9\x1a\x1c\x1a\x13\x1a\x0f\x1a\xed\x19\xed\x19\xed\x19\xd0\x19\xcc\x19\xd0\x19\xa6\x19\x9d\x19\xae\x19\x8c\x19\x88\x19\x8c\x19o\x19f\x19^\x19<\x19@\x19<\x19\x12\x19\x16\x19\x12\x19\xec\x18\xf0\x18\xf4\x18\xe3\x18\xe3\x18\xd6\x18\xa8\x18\xa4\x18\xa4\x18\x86\x18\x86\x18~\x18`\x18q\x18u\x18C\x18>\x18G\x18\x18\x18\x1d\x18\x14\x18\xdd\x17\xe1\x17\xdd\x17\xb3\x17\xc0\x17\xc8\x17\xaa\x17\xa2\x17\x95\x17c\x17c\x17Z\x17#\x17#\x17,\x17\xf9\x16\xf1\x16\xf1\x16\xc2\x16\xc2\x16\xcb\x16\x8b\x16\x8f\x16\x9c\x16i\x16]\x16P\x16\x1d\x16\x1d\x16\x1d\x16\xeb\x15\xe6\x15\xe2\x15\xb4\x15\xb8\x15\xbc\x15\x8e\x15\x89\x15\x85\x15_\x15_\x15[\x155\x151\x15,\x15\x02\x15\xfe\x14\xf1\x14\xc7\x14\xd4\x14\xd4\x14\x9d\x14\x99\x14\x99\x14n\x14j\x14f\x147\x14D\x14D\x14\x1e\x14'\x14'\x14\xf0\x13\xe7\x13\xe7\x13\xbd\x13\xc1\x13\xbd\x13\x8e\x13\x93\x13\x93\x13W\x13\\\x13`\x13!\x13\x18\x13-\x13\x03\x13\xfb\x12\xf6\x12\xae\x12\xae\x12\xae\x12\x84\x12\x8d\x12\x88\x12I\x12E\x12M\x12\x0e\x12\x05\x12\x05\x12\xd7\x11\xe8\x11\xf9\x11\xb5\x11\xad\x11\xb1\x11\x82\x11\x82\x11\x87\x11X\x11a\x11e\x112\x11&\x11&\x11\x04\x11\f\x11\b\x11\xd5\x10\xd1\x10\xd1\x10\xaf\x10\xb8\x10\xbc\x10\x92\x10\x92\x10\x8d\x10[\x10N\x10N\x10,\x10,\x100\x10\x06\x10\x0f\x10\x1b\x10\xf1\x0f\xf1\x0f\xe0\x0f\xbe\x0f\xbe\x0f\xc7\x0f\x9d\x0f\x98\x0f\x98\x0f{\x0fw\x0fr\x0fQ\x0fQ\x0fQ\x0f*\x0f/\x0f*\x0f\x04\x0f\x00\x0f\t\x0f\xda\x0e\xde\x0e\xde\x0e\xb8\x0e\xbd\x0e\xc5\x0e\x9b\x0e\x97\x0e\x9f\x0e\x86\x0e\x8e\x0e\x8a\x0e`\x0ed\x0eq\x0eO\x0eB\x0eB\x0e%\x0e%\x0e)\x0e\x0f\x0e\x18\x0e\x18\x0e\xf6\r\xf2\r\xe5\r\xd0\r\xdd\r\xe1\r\xbf\r\xae\r\xbb\r\x99\r\x95\r\x91\rs\r|\r|\rf\rf\ro\rU\rE\r@\r'\r/\r8\r\x12\r\x12\r\t\r\xe3\f\xe3\f\xf9\f\xdf\f\xdb\f\xd3\f\xb9\f\xb5\f\xbd\f\xa0\f\x97\f\x97\f~\f~\f~\fm\fi\f`\fP\fT\fT\fC\fK\fG\f%\f!\f!\f\f\f\x10\f\b\f\xe6\v\xea\v\xf7\v\xd1\v\xc8\v\xc8\v\xa2\v\xa7\v\xa7\v\x89\v\x96\v\xa2\v\x85\v\x85\v|\vV\vR\vR\v4\v9\v9\v\x17\v\x1f\v,\v\x06\v\xf1\n\xe8\n\xd3\n\xe4\n\xed\n\xcb\n\xcb\n\xd3\n\xb1\n\xa9\n\xad\n\x94\n\x98\n\x98\nv\nr\nr\na\nj\na\nD\nP\nY\n.\n&\n\x19\n\xfc\t\xf8\t\x00\n\xef\t\xf8\t\xeb\t\xcd\t\xd2\t\xcd\t\xb0\t\xc1\t\xcd\t\xbc\t\xb0\t\x9f\t\x8a\t\x85\t\x81\tl\tu\tp\t[\t_\td\t[\tW\t[\t[\t_\tl\td\t_\tS\tJ\tJ\tO\tW\t[\t[\tW\td\td\tW\tO\tJ\tW\th\td\t[\tW\td\td\th\th\t[\t[\t_\td\t_\t_\tW\tW\t_\tW\tW\td\th\th\th\td\t[\tO\tO\t[\tW\tW\t_\td\tW\t[\t_\t_\tS\tS\tW\th\t[\t[\td\tW\tF\tO\tW\t[\td\th\t}\tl\t[\tl\tu\ty\t\x8a\t\x92\t\x92\t\x9f\t\x9f\t\x9f\t\xb4\t\xab\t\xa7\t\xc5\t\xc1\t\xb8\t\xd6\t\xe2\t\xe7\t\xeb\t\xe2\t\xe7\t\xfc\t\b\n\b\n\b\n\b\n\x11\n\x19\n\x19\n\x1e\n3\n.\n7\nD\nD\n?\nP\nP\nT\nY\nP\nT\nj\nr\nj\nr\ne\na\nv\nr\nv\n\u007f\n\x83\n\x83\n\x90\n\x98\n\xa9\n\xb1\n\xa1\n\x98\n\x98\n\xa1\n\xa9\n\xa9\n\xad\n\xba\n\xc2\n\xc7\n\xbe\n\xc7\n\xcf\n\xd3\n\xd3\n\xd3\n\xd7\n\xdc\n\xdc\n\xd7\n\xed\n\xe4\n\xdc\n\xe4\n\xd3\n\xd7\n\xf5\n\xfe\n\xfe\n\x06\v\x02\v\xf5\n\xf9\n\xf1\n\xe8\n\xf9\n\x06\v\n\v\x06\v\n\v\n\v\n\v\x06\v\x0e\v\n\v\x0e\v\x0e\v\x02\v\xf9\n\xfe\n\xf9\n\xf5\n\xf5\n\xe8\n\xed\n\xe8\n\xcf\n\xcf\n\xed\n\xe4\n\xd7\n\xcb\n\xcb\n\xcb\n\xc2\n\xb6\n\xba\n\xbe\n\xa9\n\xa9\n\xb6\n\xa9\n\xad\n\xa9\n\xa1\n\xa5\n\x9c\n\x87\n\x8b\n\x8b\nn\nn\nr\n{\n{\nv\nj\ne\nj\ne\nj\ne\nT\n]\n]\n]\n]\nL\n;\n;\n;\n.\n3\n3\n*\n*\n\"\n\x11\n\x15\n\x19\n\r\n\x1e\n\x1e\n\r\n\x00\n\b\n\b\n\xf8\t\xf8\t\xde\t\xe2\t\xe7\t\xcd\t\xcd\t\xcd\t\xb0\t\xb0\t\xb0\t\x96\t\x9f\t\x9b\tu\th\th\t[\t[\td\tS\tO\tB\t-\t-\t-\t \t\x18\t\x0f\t\x02\t\x02\t\xfe\b\xfa\b\a\t\xf6\b\xd8\b\xe1\b\xed\b\xe1\b\xd4\b\xcc\b\xc3\b\xbb\b\xbb\b\xaa\b\xa6\b\xae\b\xa1\b\xaa\b\xaa\b\x95\b\x8c\b\x8c\b{\b\x84\b\x84\b\x84\b\x88\b{\bb\bb\bb\bY\bf\b^\bD\bD\bI\b8\b8\b3\b\x1a\b\x16\b\x1e\b\x1a\b\x1e\b\x1e\b\r\b\x12\b\t\b\xf4\a\xf0\a\xf8\a\xf0\a\xe7\a\xec\a\xdf\a\xdb\a\xdb\a\xce\a\xce\a\xce\a\xc6\a\xb9\a\xc1\a\xb0\a\xb0\a\xa8\a\x86\a\x8f\a\x9b\ai\ai\a`\a2\a>\aO\a!\a%\a%\a\xf2\x06\xf7\x06\xf7\x06\xc0\x06\xc0\x06\xc0\x06\xa6\x06\xa6\x06\xa2\x06t\x06x\x06\x89\x06c\x06^\x06^\x060\x06,\x06,\x06\x12\x06\x12\x06\x0e\x06\xe8\x05\xe8\x05\xec\x05\xc6\x05\xcf\x05\xcb\x05\xa5\x05\x9c\x05\xa5\x05\x8b\x05\u007f\x05\u007f\x05T\x05X\x05]\x05;\x05;\x052\x05\x00\x05\f\x05\x15\x05\xfc\x04\xef\x04\xef\x04\xc9\x04\xc0\x04\xbc\x04\x9f\x04\x9a\x04\x9a\x04t\x04t\x04t\x04B\x04F\x04B\x04\x0f\x04\x06\x04\xfa\x03\xcb\x03\xd0\x03\xe0\x03\xaa\x03\xa1\x03\xa5\x03Y\x03Q\x03M\x03\xe3\x02\xe7\x02\xe3\x02h\x02m\x02h\x02\xe5\x01\xea\x01\xee\x01M\x01E\x01A\x01z\x00r\x00r\x00S\xffS\xffK\xff\xdd\xfe\xea\xfe\xea\xfe\x99\xfe\x91\xfe\x88\xfe<\xfeA\xfeI\xfe\x1b\xfe\x1b\xfe\x0e\xfe\xdb\xfd\xdf\xfd\xdf\xfd\xad\xfd\xa9\xfd\xa9\xfdr\xfdi\xfdm\xfd?\xfdC\xfd?\xfd\x15\xfd\x19\xfd*\xfd\b\xfd\x04\xfd\x00\xfd\xd5\xfc\xc4\xfc\xc9\xfc\xb8\xfc\xb8\xfc\xab\xfc\x85\xfc\x8d\xfc\x8d\xfcg\xfcl\xfct\xfcN\xfcJ\xfcN\xfc \xfc\x1b\xfc\x17\xfc\xf5\xfb\xe9\xfb\xe4\xfb\xbe\xfb\xb6\xfb\xbe\xfb\xa9\xfb\xa9\xfb\xa9\xfb\u007f\xfb\x83\xfb\x87\xfbf\xfbj\xfbf\xfb3\xfb;\xfb;\xfb\r\xfb\t\xfb\x00\xfb\xc1\xfa\xc1\xfa\xd2\xfa\xac\xfa\xb0\xfa\xac\xfay\xfau\xfa}\xfau\xfal\xfad\xfa[\xfad\xfah\xfa`\xfa[\xfa`\xfah\xfad\xfaW\xfaW\xfaW\xfaS\xfa[\xfad\xfad\xfa`\xfa[\xfaS\xfaO\xfaS\xfa[\xfaS\xfaS\xfaO\xfaS\xfaK\xfaK\xfaK\xfaB\xfaF\xfaF\xfaK\xfaF\xfa:\xfa>\xfaO\xfaO\xfaK\xfaB\xfa>\xfa>\xfa:\xfa:\xfaB\xfaB\xfaF\xfaB\xfaB\xfaF\xfa>\xfa:\xfaW\xfaW\xfaS\xfa`\xfad\xfaq\xfa}\xfay\xfa}\xfa\x92\xfa\x92\xfa\x86\xfa\x92\xfa\x8e\xfa\x8e\xfa\x97\xfa\x97\xfa\x97\xfa\x8e\xfa\x97\xfa\x92\xfa\x8a\xfa\x8a\xfa\x8a\xfa\x8a\xfa\x8e\xfa\x86\xfa\x86\xfa\x8e\xfa\x8a\xfa}\xfau\xfaq\xfaq\xfay\xfau\xfay\xfa}\xfau\xfah\xfal\xfah\xfal\xfau\xfau\xfaq\xfau\xfau\xfau\xfau\xfal\xfal\xfad\xfa`\xfaS\xfa[\xfal\xfal\xfad\xfah\xfah\xfah\xfa[\xfaW\xfaW\xfad\xfa`\xfad\xfad\xfa`\xfa[\xfaW\xfa[\xfaW\xfaK\xfa5\xfa1\xfa1\xfa \xfa%\xfa\x1c\xfa\v\xfa\x0f\xfa \xfa\x0f\xfa\xff\xf9\xf6\xf9\xe5\xf9\xf6\xf9\xf6\xf9\xdd\xf9\xd4\xf9\xdd\xf9\xd8\xf9\xd4\xf9\xd0\xf9\xc3\xf9\xb7\xf9\xc3\xf9\xbb\xf9\xb7\xf9\xb2\xf9\x9d\xf9\xa6\xf9\xaa\xf9\xa2\xf9\xa2\xf9\xa2\xf9\x99\xf9\x95\xf9\x95\xf9\x80\xf9\x84\xf9|\xf9f\xf9k\xf9k\xf9b\xf9f\xf9s\xf9k\xf9k\xf9b\xf9^\xf9^\xf9V\xf9E\xf9E\xf9I\xf9@\xf9@\xf9@\xf94\xf94\xf9E\xf98\xf94\xf9<\xf9<\xf9<\xf9E\xf9E\xf9M\xf9<\xf9/\xf9+\xf94\xf94\xf9/\xf94\xf9\x1f\xf9+\xf9<\xf98\xf94\xf9/\xf9+\xf9'\xf9/\xf9+\xf9+\xf9#\xf9\x16\xf9#\xf9#\xf9\xf9\xf8\xf4\xf8\xf4\xf8\xdb\xf8\xec\xf8\xf4\xf8\xd3\xf8\xc2\xf8\xc6\xf8\xb1\xf8\xb9\xf8\xb9\xf8\x97\xf8\x8f\xf8\x8b\xf8\x86\xf8\x8f\xf8\x82\xf8e\xf8q\xf8m\xf8P\xf8K\xf8K\xf86\xf8:\xf8:\xf8%\xf8!\xf8!\xf8\x19\xf8\x1d\xf8\x10\xf8\xf3\xf7\xee\xf7\xee\xf7\xdd\xf7\xe2\xf7\xd9\xf7\xcd\xf7\xd1\xf7\xd9\xf7\xc8\xf7\xc8\xf7\xc0\xf7\xab\xf7\xb3\xf7\xaf\xf7\x96\xf7\x96\xf7\x9e\xf7\x89\xf7\x89\xf7\x89\xf7x\xf7\x81\xf7t\xf7g\xf7t\xf7|\xf7p\xf7t\xf7p\xf7k\xf7Z\xf7Z\xf7Z\xf7c\xf7_\xf7Z\xf7Z\xf7_\xf7Z\xf7V\xf7J\xf7A\xf7A\xf7J\xf7J\xf7=\xf70\xf74\xf7,\xf7(\xf7\x1f\xf7,\xf70\xf7,\xf7,\xf7,\xf7\x1f\xf7\x1f\xf7\x1f\xf7\x1b\xf7\x1b\xf7\x13\xf7\x0e\xf7\x13\xf7\x17\xf7\x06\xf7\x02\xf7\x0e\xf7\x06\xf7\x06\xf7\x02\xf7\xfe\xf6\xf9\xf6\xfe\xf6\xf9\xf6\xed\xf6\xf1\xf6\xed\xf6\xf1\xf6\xf5\xf6\xf1\xf6\xf9\xf6\xf9\xf6\xf9\xf6\xf1\xf6\xe8\xf6\xe8\xf6\xed\xf6\xf5\xf6\xf1\xf6\xd7\xf6\xdc\xf6\xe0\xf6\xd7\xf6\xd3\xf6\xcf\xf6\xcf\xf6\xdc\xf6\xe4\xf6\xe4\xf6\xed\xf6\xe4\xf6\xcf\xf6\xcb\xf6\xd3\xf6\xcf\xf6\xc2\xf6\xc7\xf6\xcf\xf6\xcb\xf6\xbe\xf6\xba\xf6\xc2\xf6\xcb\xf6\xcf\xf6\xc7\xf6\xba\xf6\xcf\xf6\xc7\xf6\xb6\xf6\xb1\xf6\xbe\xf6\xcb\xf6\xcf\xf6\xba\xf6\xba\xf6\xb1\xf6\xb6\xf6\xa5\xf6\xa1\xf6\xa9\xf6\xb6\xf6\xc2\xf6\xba\xf6\xad\xf6\xad\xf6\xb1\xf6\xb6\xf6\xa9\xf6\xa9\xf6\xa5\xf6\xa9\xf6\xb1\xf6\xb6\xf6\xa5\xf6\x9c\xf6\x94\xf6\x98\xf6\x94\xf6\xa1\xf6\xa1\xf6\xad\xf6\xba\xf6\xb1\xf6\xad\xf6\xa9\xf6\xa5\xf6\xa5\xf6\xa9\xf6\xa9\xf6\x98\xf6\x90\xf6\x94\xf6\x98\xf6\x94\xf6\x98\xf6\xa1\xf6\xa9\xf6\xa1\xf6\x98\xf6\x98\xf6\xa5\xf6\xa5\xf6\x98\xf6\x90\xf6\xa1\xf6\xa1\xf6\x9c\xf6\xa5\xf6\x98\xf6\x94\xf6\x9c\xf6\x94\xf6\x90\xf6\x87\xf6\x94\xf6\x94\xf6\x90\xf6\x87\xf6\x87\xf6\x8b\xf6\x90\xf6\x90\xf6\x8b\xf6\x8b\xf6\x94\xf6\x98\xf6\xa5\xf6\xa9\xf6\x98\xf6\x94\xf6\x98\xf6\xa1\xf6\xa9\xf6\x98\xf6\x94\xf6\x8b\xf6\x8b\xf6\x98\xf6\x8b\xf6\x8b\xf6\x9c\xf6\x94\xf6\x8b\xf6\x87\xf6\u007f\xf6{\xf6\x87\xf6\x8b\xf6\x83\xf6\u007f\xf6\x83\xf6v\xf6j\xf6j\xf6v\xf6\u007f\xf6\x83\xf6\x83\xf6\u007f\xf6{\xf6r\xf6e\xf6Y\xf6]\xf6j\xf6a\xf6Y\xf6U\xf6]\xf6n\xf6r\xf6a\xf6]\xf6Y\xf6]\xf6a\xf6e\xf6a\xf6a\xf6Y\xf6U\xf6a\xf6]\xf6]\xf6Y\xf6L\xf6L\xf6P\xf6L\xf6Y\xf6e\xf6e\xf6r\xf6a\xf6]\xf6{\xf6\x8b\xf6\x94\xf6\xa1\xf6\x9c\xf6\x90\xf6\x98\xf6\x98\xf6\xa1\xf6\xad\xf6\xa5\xf6\xa9\xf6\xba\xf6\xb6\xf6\xb6\xf6\xcb\xf6\xcf\xf6\xcf\xf6\xe4\xf6\xe0\xf6\xdc\xf6\xed\xf6\xf1\xf6\xf1\xf6\xf9\xf6\xf1\xf6\xed\xf6\xf5\xf6\xf5\xf6\xf9\xf6\x13\xf7\x0e\xf7\x13\xf7\x1b\xf7\x1b\xf7\x1b\xf7\x1b\xf7\x1b\xf7\x13\xf7\x13\xf7\x13\xf7\x0e\xf7\x17\xf7\x1b\xf7\x13\xf7\x17\xf7$\xf7\x1b\xf7\x13\xf7$\xf7(\xf7\x1b\xf7$\xf7$\xf7\x17\xf7\x17\xf7\x0e\xf7\x0e\xf7\x06\xf7\n\xf7\x1b\xf7\x1f\xf7\x0e\xf7\x17\xf7\x1b\xf7\x1f\xf74\xf7A\xf74\xf7,\xf7$\xf7\x1f\xf70\xf7(\xf7\x1f\xf74\xf74\xf74\xf7,\xf7(\xf7$\xf7(\xf7(\xf7,\xf70\xf7,\xf74\xf7A\xf7A\xf74\xf7=\xf7,\xf7(\xf7R\xf7V\xf7R\xf7g\xf7t\xf7k\xf7p\xf7k\xf7x\xf7\x89\xf7\x85\xf7\x9e\xf7\xb7\xf7\xa7\xf7\x9e\xf7\xb3\xf7\xaf\xf7\xaf\xf7\xc4\xf7\xc4\xf7\xc4\xf7\xdd\xf7\xd5\xf7\xd5\xf7\xf3\xf7\xf7\xf7\xe6\xf7\xee\xf7\xee\xf7\xf7\xf7\f\xf8\b\xf8\f\xf8!\xf8!\xf8!\xf8*\xf8%\xf8!\xf82\xf8:\xf8:\xf8:\xf8?\xf8K\xf8P\xf8G\xf8:\xf8!\xf8*\xf8!\xf8\b\xf8\x14\xf8%\xf8\x10\xf8\x03\xf8\x03\xf8\xf7\xf7\xf3\xf7\xea\xf7\xd9\xf7\xd1\xf7\xd9\xf7\xd9\xf7\xd9\xf7\xc8\xf7\xbc\xf7\xc4\xf7\xc4\xf7\xb7\xf7\xbc\xf7\xb7\xf7\xa7\xf7\xab\xf7\xb3\xf7\xa2\xf7\x9a\xf7\x8d\xf7\x89\xf7\x91\xf7\x91\xf7\x81\xf7\x81\xf7\x85\xf7p\xf7p\xf7t\xf7k\xf7g\xf7g\xf7_\xf7Z\xf7J\xf7E\xf7Z\xf7g\xf7J\xf7=\xf7A\xf70\xf7,\xf7,\xf7$\xf7$\xf7$\xf7\x1f\xf7$\xf7$\xf7\x1f\xf7\x17\xf7\x0e\xf7\x06\xf7\n\xf7\x06\xf7\xf1\xf6\xed\xf6\xed\xf6\xf1\xf6\xfe\xf6\xf1\xf6\xe4\xf6\xe0\xf6\xe0\xf6\xd7\xf6\xe8\xf6\xe4\xf6\xcf\xf6\xcf\xf6\xe0\xf6\xd3\xf6\xcb\xf6\xc7\xf6\xc7\xf6\xc7\xf6\xbe\xf6\xba\xf6\xb6\xf6\xbe\xf6\xb1\xf6\xa5\xf6\xa9\xf6\xad\xf6\xb1\xf6\xb6\xf6\x98\xf6\x98\xf6\x9c\xf6{\xf6\u007f\xf6\u007f\xf6j\xf6e\xf6U\xf67\xf6H\xf6U\xf6.\xf6\"\xf6*\xf6\r\xf6\x15\xf6\x19\xf6\xef\xf5\xe7\xf5\xe2\xf5\xc9\xf5\xc9\xf5\xcd\xf5\xb0\xf5\xb0\xf5\xb0\xf5\xa3\xf5\x9f\xf5\xa3\xf5\x8a\xf5\x8a\xf5\x92\xf5}\xf5p\xf5p\xf5[\xf5S\xf5S\xf5>\xf5J\xf5B\xf5)\xf5$\xf5 \xf5\x02\xf5\x0f\xf5\v\xf5\xe9\xf4\xed\xf4\xfa\xf4\xe5\xf4\xe5\xf4\xe5\xf4\xd0\xf4\xd0\xf4\xc7\xf4\xb2\xf4\xb6\xf4\xbf\xf4\xb2\xf4\xb2\xf4\xbb\xf4\xa6\xf4\x99\xf4\x9d\xf4\x88\xf4s\xf4j\xf4b\xf4s\xf4{\xf4b\xf4Y\xf4Y\xf4Y\xf4Q\xf4<\xf4/\xf4I\xf4Q\xf43\xf4'\xf4+\xf4#\xf4'\xf4'\xf4\x12\xf4\t\xf4\t\xf4\t\xf4\r\xf4\x05\xf4\x05\xf4\x16\xf4\x16\xf4\t\xf4\x05\xf4\xfd\xf3\xf8\xf3\x01\xf4\r\xf4\r\xf4\x05\xf4\x01\xf4\x05\xf4\r\xf4\t\xf4\t\xf4\r\xf4\x12\xf4\x12\xf4\x12\xf4\x16\xf4\x16\xf4\r\xf4\x12\xf4\x1e\xf43\xf48\xf43\xf4'\xf4'\xf4+\xf43\xf4<\xf43\xf43\xf48\xf43\xf4'\xf4+\xf4<\xf48\xf4+\xf43\xf4<\xf48\xf48\xf4D\xf4M\xf4U\xf4M\xf48\xf48\xf4D\xf4I\xf4Q\xf4U\xf4Y\xf4Y\xf4Y\xf4U\xf4U\xf4U\xf4Q\xf4Y\xf4Y\xf4Q\xf4Q\xf4b\xf4U\xf4Q\xf4Y\xf4f\xf4f\xf4^\xf4Y\xf4b\xf4j\xf4o\xf4o\xf4{\xf4{\xf4w\xf4\x80\xf4w\xf4w\xf4w\xf4\x8c\xf4\x8c\xf4\x90\xf4\x8c\xf4\x8c\xf4\x84\xf4\x88\xf4\x88\xf4\x88\xf4\x88\xf4\x8c\xf4\x90\xf4\x90\xf4\x90\xf4\x8c\xf4\x90\xf4\x99\xf4\x90\xf4\x95\xf4\xbb\xf4\xbf\xf4\xb6\xf4\xc7\xf4\xd0\xf4\xd8\xf4\xf2\xf4\xe9\xf4\xe5\xf4\xfe\xf4\x02\xf5\xfa\xf4\x0f\xf5\x13\xf5\x13\xf5)\xf5)\xf5-\xf5B\xf59\xf59\xf5J\xf5B\xf5B\xf5h\xf5h\xf5l\xf5\x81\xf5\x8a\xf5}\xf5\x8a\xf5\x85\xf5\x8e\xf5\xa7\xf5\x96\xf5\x9f\xf5\xc1\xf5\xbc\xf5\xb4\xf5\xc5\xf5\xc5\xf5\xc5\xf5\xd2\xf5\xda\xf5\xda\xf5\xe2\xf5\xef\xf5\xe7\xf5\xef\xf5\xf3\xf5\xfc\xf5\b\xf6\x00\xf6\x00\xf6\x19\xf6\x1e\xf6\x11\xf6\"\xf63\xf6H\xf6Y\xf6L\xf6L\xf6e\xf6j\xf6r\xf6{\xf6n\xf6j\xf6\x87\xf6\x90\xf6\x94\xf6\xa9\xf6\xa5\xf6\x98\xf6\xb1\xf6\xbe\xf6\xc2\xf6\xd7\xf6\xd3\xf6\xc7\xf6\xe4\xf6\xf5\xf6\xf5\xf6\n\xf7\x06\xf7\x06\xf7\x1b\xf7\x1b\xf7\x1b\xf70\xf7\x1f\xf7\x17\xf7=\xf7N\xf7N\xf7_\xf7_\xf7_\xf7|\xf7|\xf7|\xf7\x9a\xf7\x91\xf7\x91\xf7\xa7\xf7\xab\xf7\xb3\xf7\xc0\xf7\xbc\xf7\xc0\xf7\xe2\xf7\xe2\xf7\xdd\xf7\xe2\xf7\xdd\xf7\xe2\xf7\xff\xf7\x03\xf8\x03\xf8\x14\xf8\f\xf8\x14\xf82\xf8%\xf8\x1d\xf8!\xf8*\xf82\xf8G\xf8?\xf8?\xf8T\xf8\\\xf8X\xf8i\xf8e\xf8e\xf8v\xf8~\xf8~\xf8\x8f\xf8\x8b\xf8\x93\xf8\xa0\xf8\x8f\xf8\x93\xf8\xa8\xf8\xa0\xf8\x97\xf8\xb1\xf8\xb9\xf8\xc2\xf8\xd3\xf8\xb9\xf8\xb1\xf8\xce\xf8\xd7\xf8\xdb\xf8\xe3\xf8\xec\xf8\xec\xf8\xfd\xf8\x01\xf9\x05\xf9\x12\xf9\x0e\xf9\x05\xf9\x1a\xf9\x1f\xf9\x16\xf94\xf98\xf94\xf9E\xf9M\xf9M\xf9b\xf9b\xf9s\xf9\x84\xf9w\xf9w\xf9\x91\xf9\x9d\xf9\x9d\xf9\xb2\xf9\xaa\xf9\x95\xf9\xaa\xf9\xaa\xf9\xa2\xf9\xb2\xf9\xc3\xf9\xd0\xf9\xd8\xf9\xd4\xf9\xd8\xf9\xf2\xf9\xe5\xf9\xdd\xf9\xe9\xf9\xf2\xf9\xe9\xf9\xf2\xf9\xee\xf9\xee\xf9\xff\xf9\xff\xf9\a\xfa\x14\xfa\x14\xfa\x18\xfa \xfa\x18\xfa \xfa-\xfa)\xfa1\xfa>\xfa:\xfa1\xfaB\xfa:\xfa:\xfaK\xfaS\xfaF\xfaK\xfaW\xfa`\xfal\xfah\xfal\xfa\x81\xfa}\xfau\xfa\x81\xfa}\xfay\xfa\x8a\xfa\x8e\xfa\x81\xfa\x9b\xfa\x9b\xfa\xa3\xfa\xb8\xfa\xb8\xfa\xb0\xfa\xb4\xfa\xb4\xfa\xb0\xfa\xc1\xfa\xc1\xfa\xc1\xfa\xd6\xfa\xe3\xfa\xef\xfa\x00\xfb\x00\xfb\xfc\xfa\xfc\xfa\xf8\xfa\x00\xfb\x15\xfb\r\xfb\xfc\xfa\x04\xfb\x11\xfb\x15\xfb\x1a\xfb\x11\xfb\x1a\xfb3\xfb/\xfb/\xfb@\xfb@\xfb;\xfbD\xfbH\xfbU\xfbU\xfbL\xfbQ\xfba\xfbj\xfba\xfba\xfb]\xfbj\xfbn\xfbn\xfbj\xfb\u007f\xfb\u007f\xfbw\xfb\x87\xfb\x98\xfb\x9d\xfb\x9d\xfb\xa1\xfb\xad\xfb\xa9\xfb\xa1\xfb\xa1\xfb\xa5\xfb\xa5\xfb\xa5\xfb\xa5\xfb\x9d\xfb\x94\xfb\x98\xfb\x98\xfb\x98\xfb\x90\xfb\x94\xfb\xa1\xfb\xa1\xfb\x98\xfb\x9d\xfb\xa5\xfb\x9d\xfb\x8c\xfb\x87\xfb\x9d\xfb\xa9\xfb\xad\xfb\xba\xfb\xba\xfb\xad\xfb\xad\xfb\xa9\xfb\xa5\xfb\x9d\xfb\x9d\xfb\x98\xfb\xa1\xfb\x98\xfbr\xfbn\xfb\u007f\xfbf\xfbY\xfbU\xfbL\xfbL\xfbU\xfb;\xfb/\xfb\"\xfb\t\xfb\x1a\xfb\x1e\xfb\r\xfb\r\xfb\r\xfb\r\xfb\xfc\xfa\xef\xfa\xe7\xfa\xe3\xfa\xe3\xfa\xda\xfa\xd2\xfa\xc9\xfa\xc1\xfa\xc9\xfa\xc5\xfa\xb8\xfa\xb8\xfa\xbd\xfa\xac\xfa\xa3\xfa\xa3\xfa\x9b\xfa\x9f\xfa\x92\xfa}\xfa}\xfa\x8a\xfa}\xfa}\xfay\xfa`\xfad\xfah\xfaW\xfah\xfau\xfa[\xfaF\xfa:\xfa1\xfa1\xfa-\xfa \xfa%\xfa-\xfa \xfa \xfa\x1c\xfa\v\xfa\a\xfa\a\xfa\a\xfa\v\xfa\x03\xfa\xfa\xf9\x03\xfa\xfa\xf9\xee\xf9\xee\xf9\xf2\xf9\xdd\xf9\xcc\xf9\xcc\xf9\xd4\xf9\xe1\xf9\xdd\xf9\xcc\xf9\xbf\xf9\xbf\xf9\xaa\xf9\xae\xf9\xa2\xf9\x88\xf9\x8c\xf9\x8c\xf9f\xf9f\xf9f\xf9@\xf9@\xf9I\xf9\x1a\xf9\x0e\xf9\x0e\xf9\xf0\xf8\xf0\xf8\xf0\xf8\xf4\xf8\xf4\xf8\xec\xf8\xfd\xf8\xfd\xf8\x01\xf9\x12\xf9\x12\xf9#\xf9I\xf9@\xf9<\xf9M\xf9@\xf9E\xf9V\xf9V\xf9V\xf9k\xf9k\xf9s\xf9\x8c\xf9\x80\xf9\x88\xf9\xa2\xf9\xaa\xf9\x9d\xf9\xaa\xf9\xaa\xf9\xb7\xf9\xbf\xf9\xbf\xf9\xbb\xf9\xcc\xf9\xc3\xf9\xc8\xf9\xe1\xf9\xdd\xf9\xd0\xf9\xe1\xf9\xe1\xf9\xe9\xf9\xfa\xf9\xfa\xf9\xfa\xf9\x0f\xfa\x14\xfa\x0f\xfa%\xfa)\xfa%\xfa1\xfa1\xfa5\xfaO\xfaO\xfaK\xfaK\xfaB\xfaS\xfah\xfa[\xfaS\xfal\xfaq\xfal\xfay\xfa\x86\xfa\x86\xfa\x92\xfa\x8a\xfa\x8e\xfa\x92\xfa\x9f\xfa\xa3\xfa\xb0\xfa\x9f\xfa\x9b\xfa\xb8\xfa\xc5\xfa\xc9\xfa\xda\xfa\xce\xfa\xbd\xfa\xc9\xfa\xd2\xfa\xd2\xfa\xda\xfa\xde\xfa\xde\xfa\xe7\xfa\xe3\xfa\xe3\xfa\xef\xfa\xef\xfa\xef\xfa\xf8\xfa\xef\xfa\xeb\xfa\xef\xfa\xf8\xfa\xf8\xfa\xeb\xfa\xe7\xfa\xde\xfa\xc9\xfa\xce\xfa\xc9\xfa\xb4\xfa\xb4\xfa\xb4\xfa\x9f\xfa\xac\xfa\xac\xfa\x8a\xfa\x86\xfa}\xfay\xfal\xfa`\xfau\xfa\x8e\xfa\x9b\xfa\xb8\xfa\xbd\xfa\xc1\xfa\xe7\xfa\xda\xfa\xda\xfa\x11\xfb\x11\xfb\x1a\xfbU\xfbY\xfbY\xfb\x94\xfb\x90\xfb\x94\xfb\xcb\xfb\xbe\xfb\xc3\xfb\x06\xfc\x0f\xfc\x17\xfcR\xfcN\xfc=\xfc\x85\xfc\x92\xfc\x8d\xfc\xd1\xfc\xd1\xfc\xd1\xfc\x04\xfd\x00\xfd\x15\xfde\xfdT\xfdL\xfd\xa0\xfd\xa0\xfd\x98\xfd\xdb\xfd\xf0\xfd\xf9\xfdE\xfeE\xfeE\xfe\x99\xfe\x95\xfe\x88\xfe\xd9\xfe\xd9\xfe\xdd\xfe>\xff:\xffB\xff\f\x00\x10\x00\x19\x00\xca\x00\xbe\x00\xca\x004\x010\x01#\x01k\x01x\x01\x80\x01\xc4\x01\xbb\x01\xbb\x01\xea\x01\xe1\x01\xe1\x01\x18\x02\x1c\x02\x1c\x02K\x02O\x02O\x02\x82\x02\x86\x02\x86\x02\xa8\x02\xa4\x02\xa4\x02\xce\x02\xd2\x02\xd2\x02\xf8\x02\xf0\x02\xf4\x02\x11\x03\x11\x03\r\x03\"\x03\x1e\x03/\x03Q\x03U\x03b\x03n\x03f\x03j\x03\x88\x03\x88\x03\x8c\x03\xa1\x03\x90\x03\x94\x03\xbf\x03\xcb\x03\xc7\x03\xe5\x03\xd8\x03\xd8\x03\xf1\x03\xe5\x03\xe0\x03\xfe\x03\xf1\x03\xed\x03\x02\x04\v\x04\x17\x04 \x04\x13\x04\x17\x041\x041\x04(\x049\x04B\x04B\x04F\x04=\x04B\x04S\x04N\x04J\x04h\x04t\x04p\x04\x85\x04y\x04y\x04\x85\x04\x81\x04\x85\x04\x9f\x04\x9a\x04\x92\x04\x9f\x04\xa3\x04\x9f\x04\xab\x04\xab\x04\xab\x04\xb8\x04\xbc\x04\xb4\x04\xc5\x04\xc9\x04\xd5\x04\xde\x04\xda\x04\xd5\x04\xd1\x04\xcd\x04\xd5\x04\xde\x04\xda\x04\xda\x04\xde\x04\xde\x04\xe6\x04\xe6\x04\xeb\x04\xe6\x04\xe2\x04\xe6\x04\xd5\x04\xe2\x04\xde\x04\xda\x04\xe2\x04\xeb\x04\xeb\x04\xef\x04\xe6\x04\xe2\x04\xe6\x04\xde\x04\xda\x04\xde\x04\xe2\x04\xe6\x04\xe6\x04\xe2\x04\xe6\x04\xef\x04\xef\x04\xeb\x04\xe6\x04\xef\x04\xf3\x04\xf3\x04\xef\x04\xe6\x04\xeb\x04\xef\x04\xef\x04\xf7\x04\xf7\x04\xef\x04\xef\x04\xf3\x04\x00\x05\b\x05\xfc\x04\xef\x04\xeb\x04\xf3\x04\xfc\x04\xf7\x04\xf3\x04\xf3\x04\xef\x04\xfc\x04\xf7\x04\xf7\x04\xf3\x04\xf3\x04\x04\x05\b\x05\f\x05\b\x05\x04\x05\xfc\x04\xf7\x04\xf3\x04\x04\x05\x15\x05\x11\x05\x15\x05\f\x05\x04\x05\x04\x05\x19\x05\x11\x05\f\x05\x11\x05\x15\x05\x15\x05\"\x05\x19\x05\x11\x05\"\x05.\x05.\x052\x05.\x05*\x05&\x05*\x05.\x05&\x05&\x05\"\x05\x1d\x05&\x05\"\x05\f\x05\x04\x05\b\x05\xfc\x04\x04\x05\b\x05\xef\x04\xeb\x04\xf3\x04\xf7\x04\xf3\x04\xe6\x04\xde\x04\xe2\x04\xe2\x04\xd5\x04\xd5\x04\xda\x04\xcd\x04\xd1\x04\xde\x04\xc0\x04\xc9\x04\xd1\x04\xd1\x04\xc9\x04\xc5\x04\xaf\x04\xaf\x04\xaf\x04\xa3\x04\xab\x04\xb4\x04\xab\x04\x9f\x04\x9f\x04\x96\x04\x9a\x04\xa3\x04\x9a\x04\x96\x04\x89\x04\x85\x04\x89\x04\x89\x04\x81\x04t\x04y\x04p\x04t\x04t\x04h\x04l\x04y\x04l\x04h\x04p\x04p\x04t\x04p\x04W\x04N\x04F\x049\x04N\x04_\x04[\x04F\x04B\x04=\x049\x045\x049\x04F\x04J\x04F\x045\x04$\x04\x1c\x041\x041\x04$\x04 \x04\x1c\x04\x13\x04\x1c\x04\x17\x04\xfa\x03\x06\x04\x13\x04\xfe\x03\x06\x04\v\x04\xfe\x03\x06\x04\x02\x04\xf1\x03\xe9\x03\xdc\x03\xd4\x03\xd4\x03\xd8\x03\xd8\x03\xd0\x03\xcb\x03\xd0\x03\xd8\x03\xd8\x03\xc3\x03\xcb\x03\xc7\x03\xbf\x03\xb2\x03\xba\x03\xb6\x03\xb2\x03\xae\x03\xa5\x03\xaa\x03\x9d\x03\x99\x03\x8c\x03\x90\x03\x9d\x03\x99\x03\x8c\x03m\x00z\x00v\x00\x82\x00~\x00z\x00\x8b\x00\x8b\x00\x8b\x00\x93\x00\x8b\x00\x8f\x00\xa4\x00\xad\x00\xad\x00\xc2\x00\xbe\x00\xb9\x00\xc2\x00\xca\x00\xc6\x00\xca\x00\xdf\x00\xe8\x00\xec\x00\xdb\x00\xd3\x00\xf0\x00\xf5\x00\xec\x00\xec\x00\xf5\x00\xf0\x00\xe8\x00\xe8\x00\xe8\x00\xdb\x00\xd7\x00\xca\x00\xa9\x00\xb5\x00\xb5\x00\xa4\x00\xa9\x00\xa0\x00\x82\x00\x87\x00\x8b\x00v\x00v\x00m\x00\\\x00a\x00\\\x00T\x00P\x00C\x00G\x00P\x00T\x00G\x00?\x00;\x00.\x002\x002\x00\x04\x00\x04\x00\xf8\xff\xbd\xff\xc5\xff\xc1\xff\xa8\xff\xb9\xff\xb9\xff\x97\xff\x97\xff\xa4\xff\x93\xff\x8a\xff~\xffh\xffu\xffy\xffu\xff~\xff~\xffh\xff`\xffm\xff`\xff\\\xffO\xffO\xffO\xffG\xff-\xff-\xff-\xff%\xff)\xff1\xff\x1c\xff\x14\xff\x14\xff\x10\xff\x18\xff\x10\xff\x03\xff\x03\xff\x03\xff\xc8\xfe\xbf\xfe\xc4\xfe\x91\xfe\x8d\xfe|\xfeA\xfeM\xfeR\xfe\x05\xfe\xfd\xfd\x01\xfe\xcf\xfd\xd3\xfd\xd7\xfd\x9c\xfd\x93\xfd\x93\xfde\xfde\xfda\xfd2\xfd2\xfd.\xfd\x00\xfd\x00\xfd\x15\xfd\xf3\xfc\xea\xfc\xe2\xfc\xab\xfc\xb3\xfc\xc0\xfc\xa3\xfc\x96\xfc\x8d\xfcc\xfcc\xfc_\xfc=\xfc=\xfc5\xfc\x17\xfc\x17\xfc\x1b\xfc\xfe\xfb\xfe\xfb\xf5\xfb\xe0\xfb\xe9\xfb\xe9\xfb\xc7\xfb\xc3\xfb\xcb\xfb\xb6\xfb\xba\xfb\xa9\xfb\x8c\xfb\x87\xfb{\xfb]\xfb]\xfba\xfbH\xfb;\xfb3\xfb\x1a\xfb\x15\xfb\x1a\xfb\xf8\xfa\x00\xfb\t\xfb\xf4\xfa\xef\xfa\xef\xfa\xce\xfa\xc9\xfa\xce\xfa\xc5\xfa\xc5\xfa\xc5\xfa\xb8\xfa\xb8\xfa\xc5\xfa\xb4\xfa\xb0\xfa\xbd\xfa\xb0\xfa\xac\xfa\xa8\xfa\x97\xfa\x9b\xfa\x97\xfa\x97\xfa\x92\xfa\x92\xfa\x86\xfay\xfa}\xfau\xfaq\xfau\xfa`\xfad\xfad\xfaF\xfaF\xfaB\xfa%\xfa%\xfa1\xfa\x1c\xfa\x14\xfa\v\xfa\xf2\xf9\x03\xfa\x03\xfa\xdd\xf9\xd0\xf9\xd0\xf9\xbb\xf9\xb7\xf9\xbb\xf9\x99\xf9\x99\xf9\x9d\xf9\x8c\xf9\x84\xf9\x80\xf9f\xf9b\xf9f\xf9V\xf9V\xf9M\xf9<\xf94\xf98\xf9+\xf9+\xf9+\xf9\t\xf9\x01\xf9\x01\xf9\xf0\xf8\xf9\xf8\xf4\xf8\xce\xf8\xdb\xf8\xdb\xf8\xbd\xf8\xce\xf8\xd3\xf8\xac\xf8\x97\xf8\x97\xf8\x8b\xf8\x86\xf8z\xf8\\\xf8X\xf8`\xf8G\xf8G\xf8G\xf82\xf8.\xf8.\xf8\x14\xf8\x19\xf8\x10\xf8\xe6\xf7\xe2\xf7\xe2\xf7\xb7\xf7\xb7\xf7\xc4\xf7\xab\xf7\xaf\xf7\xab\xf7\x85\xf7\x89\xf7\x8d\xf7g\xf7p\xf7p\xf7A\xf7=\xf7A\xf7\x1f\xf7$\xf70\xf7\x1f\xf7\n\xf7\n\xf7\xed\xf6\xed\xf6\xf1\xf6\xd3\xf6\xcb\xf6\xc7\xf6\xa9\xf6\xa1\xf6\xa1\xf6\x8b\xf6\x90\xf6\x8b\xf6r\xf6\u007f\xf6\x87\xf6Y\xf6U\xf6]\xf6D\xf6H\xf6;\xf6\"\xf6\"\xf6*\xf6\x11\xf6\x00\xf6\xf8\xf5\xde\xf5\xeb\xf5\xe7\xf5\xcd\xf5\xe2\xf5\xde\xf5\xbc\xf5\xb8\xf5\xbc\xf5\xa3\xf5\x92\xf5\x92\xf5\x85\xf5\x8e\xf5\x8e\xf5}\xf5\x81\xf5\x85\xf5h\xf5[\xf5h\xf5J\xf5>\xf5B\xf51\xf5-\xf5 \xf5\v\xf5 \xf5$\xf5\v\xf5\a\xf5\v\xf5\xfa\xf4\xfe\xf4\xfa\xf4\xe1\xf4\xe5\xf4\xe9\xf4\xcc\xf4\xc7\xf4\xc7\xf4\xb6\xf4\xbb\xf4\xb6\xf4\xaa\xf4\xb2\xf4\xb6\xf4\x9d\xf4\x99\xf4\x90\xf4{\xf4o\xf4j\xf4b\xf4f\xf4f\xf4^\xf4b\xf4Y\xf4I\xf4@\xf4D\xf4/\xf4<\xf4I\xf43\xf43\xf4<\xf4'\xf4'\xf4\x1e\xf4\t\xf4\x05\xf4\t\xf4\x01\xf4\r\xf4\x05\xf4\xf4\xf3\xf4\xf3\xf4\xf3\xf0\xf3\xe7\xf3\xf0\xf3\xe3\xf3\xd6\xf3\xd2\xf3\xd6\xf3\xd2\xf3\xdb\xf3\xd6\xf3\xc1\xf3\xbd\xf3\xb9\xf3\xb9\xf3\xbd\xf3\xb9\xf3\xb5\xf3\xbd\xf3\xb5\xf3\xb5\xf3\xac\xf3\xa4\xf3\xa8\xf3\xb0\xf3\xa8\xf3\xa0\xf3\x9b\xf3\x9b\xf3\xa0\xf3\xa4\xf3\x8f\xf3\x86\xf3\x82\xf3\x93\xf3\x97\xf3\xa4\xf3\x9b\xf3\xa0\xf3\xa0\xf3\x9b\xf3\x93\xf3\x86\xf3\x8a\xf3\x97\xf3\x9b\xf3\x8f\xf3\x82\xf3\x86\xf3\x86\xf3\x82\xf3\x82\xf3\x8a\xf3\x86\xf3\x8f\xf3\x8a\xf3~\xf3\x82\xf3\x93\xf3\x93\xf3\x93\xf3\x8f\xf3\x8a\xf3\x8f\xf3\x8a\xf3z\xf3~\xf3\x86\xf3\x8a\xf3\x82\xf3\x86\xf3\x86\xf3z\xf3~\xf3~\xf3\x86\xf3\x93\xf3\x93\xf3\x8a\xf3\xa0\xf3\xac\xf3\xa8\xf3\xa0\xf3\xa0\xf3\xa4\xf3\xa8\xf3\xa0\xf3\x93\xf3\x9b\xf3\xa8\xf3\xac\xf3\xb0\xf3\xa4\xf3\x97\xf3\x93\xf3\xa4\xf3\xa0\xf3\xa4\xf3\x9b\xf3\x9b\xf3\xa0\xf3\xa4\xf3\xa0\xf3\x9b\xf3\xa4\xf3\xb0\xf3\xa8\xf3\x9b\xf3\xa4\xf3\xbd\xf3\xb5\xf3\xac\xf3\xa8\xf3\xa4\xf3\xa4\xf3\xa4\xf3\xa8\xf3\xac\xf3\xb0\xf3\xa4\xf3\xa0\xf3\xa0\xf3\x9b\xf3\xa8\xf3\xb5\xf3\xb5\xf3\xb0\xf3\xb5\xf3\xb0\xf3\xb5\xf3\xbd\xf3\xb5\xf3\xb0\xf3\xac\xf3\xac\xf3\xb9\xf3\xb9\xf3\xb5\xf3\xb9\xf3\xb9\xf3\xb5\xf3\xb5\xf3\xc1\xf3\xc1\xf3\xb9\xf3\xbd\xf3\xc6\xf3\xca\xf3\xd2\xf3\xca\xf3\xca\xf3\xd2\xf3\xca\xf3\xca\xf3\xe3\xf3\xec\xf3\xec\xf3\xf0\xf3\xf0\xf3\xec\xf3\xf0\xf3\xf0\xf3\xe7\xf3\xec\xf3\xf0\xf3\xf8\xf3\x01\xf4\xfd\xf3\xfd\xf3\x05\xf4\x01\xf4\xf8\xf3\x16\xf4\x1e\xf4\x16\xf4\x12\xf4\x1e\xf4+\xf4/\xf4'\xf4+\xf43\xf43\xf4/\xf48\xf4M\xf4Q\xf4^\xf4M\xf48\xf4M\xf4U\xf4Y\xf4j\xf4b\xf4Y\xf4Y\xf4f\xf4j\xf4{\xf4s\xf4o\xf4w\xf4s\xf4o\xf4{\xf4\x88\xf4\x88\xf4\x90\xf4\x99\xf4\x9d\xf4\xa1\xf4\x99\xf4\x99\xf4\xaa\xf4\xa6\xf4\xa6\xf4\xb6\xf4\xbb\xf4\xbb\xf4\xc3\xf4\xc7\xf4\xcc\xf4\xd4\xf4\xc7\xf4\xc3\xf4\xd0\xf4\xdc\xf4\xdc\xf4\xe5\xf4\xf2\xf4\xf6\xf4\xfa\xf4\xed\xf4\xf6\xf4\a\xf5\x0f\xf5\a\xf5\v\xf5\x13\xf5\x13\xf5 \xf5)\xf5 \xf5-\xf59\xf5B\xf5J\xf5B\xf5F\xf5O\xf5J\xf5B\xf5J\xf5F\xf5J\xf5_\xf5l\xf5p\xf5u\xf5h\xf5_\xf5h\xf5p\xf5l\xf5p\xf5u\xf5y\xf5}\xf5\x81\xf5y\xf5}\xf5}\xf5\x81\xf5\x81\xf5\x8a\xf5}\xf5y\xf5\x85\xf5\x92\xf5\x8a\xf5\x8a\xf5\x8e\xf5\x85\xf5\x81\xf5}\xf5}\xf5}\xf5}\xf5\x85\xf5\x8e\xf5\x8e\xf5\x8a\xf5\x92\xf5\x92\xf5\x9b\xf5\x96\xf5\xa7\xf5\xac\xf5\xa3\xf5\x9b\xf5\x9b\xf5\x8e\xf5\x9b\xf5\x96\xf5\x9b\xf5\xa3\xf5\xa7\xf5\xac\xf5\xa7\xf5\x96\xf5\x9f\xf5\xac\xf5\xb0\xf5\xa7\xf5\xa7\xf5\xb4\xf5\xb4\xf5\xac\xf5\xb0\xf5\xa7\xf5\xa7\xf5\xac\xf5\xb0\xf5\xb8\xf5\xc5\xf5\xbc\xf5\xb8\xf5\xc1\xf5\xc5\xf5\xc9\xf5\xcd\xf5\xd2\xf5\xc5\xf5\xc1\xf5\xc1\xf5\xbc\xf5\xc5\xf5\xc5\xf5\xc9\xf5\xc9\xf5\xcd\xf5\xd6\xf5\xcd\xf5\xcd\xf5\xcd\xf5\xcd\xf5\xc5\xf5\xcd\xf5\xd2\xf5\xd6\xf5\xd2\xf5\xd2\xf5\xde\xf5\xda\xf5\xcd\xf5\xd2\xf5\xcd\xf5\xc5\xf5\xc1\xf5\xc1\xf5\xcd\xf5\xde\xf5\xd6\xf5\xd2\xf5\xcd\xf5\xd6\xf5\xcd\xf5\xcd\xf5\xd2\xf5\xd6\xf5\xd2\xf5\xd2\xf5\xd2\xf5\xcd\xf5\xc5\xf5\xc1\xf5\xcd\xf5\xd6\xf5\xcd\xf5\xc1\xf5\xc1\xf5\xb4\xf5\xb0\xf5\xb4\xf5\xc1\xf5\xc5\xf5\xbc\xf5\xb8\xf5\xb4\xf5\xb0\xf5\xa7\xf5\xa7\xf5\xac\xf5\xa3\xf5\xa3\xf5\xa7\xf5\x9f\xf5\xa7\xf5\xa3\xf5\x9b\xf5\xa7\xf5\xac\xf5\x9f\xf5\x9f\xf5\x96\xf5\x8e\xf5\x8e\xf5\x96\xf5\xa7\xf5\xb4\xf5\xb0\xf5\xc1\xf5\xc5\xf5\xc1\xf5\xbc\xf5\xbc\xf5\xcd\xf5\xd6\xf5\xd2\xf5\xde\xf5\xf8\xf5\xeb\xf5\xe2\xf5\xf8\xf5\xf8\xf5\xfc\xf5\r\xf6\x11\xf6\b\xf6*\xf6&\xf6&\xf6P\xf6L\xf6L\xf6{\xf6v\xf6r\xf6\x98\xf6\xa1\xf6\xa5\xf6\xc7\xf6\xcb\xf6\xbe\xf6\xe8\xf6\xf1\xf6\xed\xf6\x17\xf7(\xf7\x1f\xf7=\xf70\xf79\xf7Z\xf7V\xf7Z\xf7|\xf7\x81\xf7\x81\xf7\xa2\xf7\x9a\xf7\x96\xf7\xc0\xf7\xc0\xf7\xc4\xf7\xea\xf7\xe6\xf7\xe6\xf7\b\xf8\b\xf8\x03\xf8\x14\xf8\x1d\xf8!\xf8C\xf8P\xf8P\xf8e\xf8m\xf8i\xf8~\xf8\x86\xf8\x86\xf8\xa8\xf8\xac\xf8\xb9\xf8\xdb\xf8\xd7\xf8\xd7\xf8\xf4\xf8\xf0\xf8\xf0\xf8\t\xf9\x16\xf9\x0e\xf9#\xf9+\xf98\xf9M\xf9M\xf9M\xf9k\xf9k\xf9o\xf9\x88\xf9\x84\xf9\x8c\xf9\xaa\xf9\xb2\xf9\xb7\xf9\xd8\xf9\xc3\xf9\xc3\xf9\xe5\xf9\xe9\xf9\xe9\xf9\xff\xf9\x03\xfa\x03\xfa \xfa%\xfa%\xfaF\xfaO\xfaK\xfa`\xfah\xfah\xfau\xfay\xfay\xfa\x92\xfa\x97\xfa\x9b\xfa\xac\xfa\xb0\xfa\xb0\xfa\xc9\xfa\xc9\xfa\xc1\xfa\xd6\xfa\xd2\xfa\xd2\xfa\xeb\xfa\xef\xfa\xf4\xfa\t\xfb\r\xfb\x11\xfb\x1a\xfb\x1a\xfb\x1e\xfb7\xfb@\xfbD\xfbY\xfbQ\xfbH\xfb]\xfba\xfbj\xfb{\xfb{\xfbw\xfb\x87\xfb\x94\xfb\x90\xfb\xa1\xfb\xa1\xfb\xa1\xfb\xb2\xfb\xb2\xfb\xb6\xfb\xcb\xfb\xcb\xfb\xcb\xfb\xd8\xfb\xcf\xfb\xcb\xfb\xe0\xfb\xd8\xfb\xd8\xfb\xf1\xfb\xf5\xfb\xfa\xfb\xfa\xfb\xfa\xfb\xfe\xfb\n\xfc\x06\xfc\n\xfc\x17\xfc\x1b\xfc$\xfc,\xfc(\xfc,\xfc=\xfc9\xfc5\xfcA\xfcJ\xfcJ\xfcR\xfcV\xfcV\xfc[\xfc_\xfcc\xfcx\xfcx\xfcp\xfc\x85\xfc\x89\xfc\x85\xfc\x9a\xfc\x9a\xfc\x96\xfc\xa3\xfc\xa3\xfc\xab\xfc\xbc\xfc\xbc\xfc\xbc\xfc\xc0\xfc\xc0\xfc\xb3\xfc\xbc\xfc\xbc\xfc\xbc\xfc\xd9\xfc\xe2\xfc\xe2\xfc\xe6\xfc\xe2\xfc\xde\xfc\xef\xfc\xea\xfc\xde\xfc\xde\xfc\xe2\xfc\xef\xfc\xfb\xfc\x00\xfd\xf7\xfc\xf7\xfc\x00\xfd\x00\xfd\x10\xfd\x10\xfd\x10\xfd\x10\xfd\x15\xfd\x15\xfd\x10\xfd\x04\xfd\f\xfd\x15\xfd!\xfd\x19\xfd\x19\xfd!\xfd*\xfd\x19\xfd\x10\xfd\x10\xfd\x19\xfd\x1d\xfd\x1d\xfd\x1d\xfd&\xfd*\xfd!\xfd!\xfd&\xfd\x1d\xfd\x19\xfd!\xfd\x1d\xfd\x1d\xfd\x1d\xfd&\xfd.\xfd6\xfd6\xfd&\xfd\x1d\xfd!\xfd\x15\xfd\x19\xfd.\xfd6\xfd.\xfd2\xfd;\xfdG\xfd\\\xfd\\\xfdP\xfdT\xfdT\xfd\\\xfdi\xfdi\xfde\xfdz\xfdz\xfd\x87\xfd\x98\xfd\x93\xfd\x8f\xfd\x98\xfd\x9c\xfd\x98\xfd\xa4\xfd\xa0\xfd\xa0\xfd\xb9\xfd\xc2\xfd\xb9\xfd\xcf\xfd\xcf\xfd\xd7\xfd\xec\xfd\xe8\xfd\xf0\xfd\x1b\xfe\x1b\xfe\x12\xfe0\xfe8\xfe<\xfeb\xfeZ\xfeZ\xfes\xfes\xfe|\xfe\x9e\xfe\x99\xfe\x9e\xfe\xbf\xfe\xb7\xfe\xbb\xfe\xe5\xfe\xe5\xfe\xea\xfe\x14\xff\x14\xff\v\xff6\xff6\xff6\xffq\xffu\xffh\xff\xb0\xff\xbd\xff\xca\xffP\x00L\x00G\x00\x8b\x00\x87\x00~\x00\xb5\x00\xbe\x00\xb9\x00\xe4\x00\xec\x00\xec\x00\n\x01\x05\x01\x01\x01'\x010\x01#\x01E\x01M\x01I\x01^\x01b\x01g\x01\x80\x01x\x01|\x01\x95\x01\x91\x01\x88\x01\xaa\x01\xbf\x01\xbb\x01\xcc\x01\xc4\x01\xc4\x01\xe1\x01\xe5\x01\xe1\x01\xf2\x01\xf2\x01\xee\x01\xff\x01\xfb\x01\x03\x02\x1c\x02\x1c\x02\x1c\x02!\x02\x1c\x02!\x02:\x02B\x02K\x02W\x02S\x02S\x02q\x02q\x02d\x02u\x02~\x02y\x02\x86\x02~\x02y\x02\x82\x02\x86\x02\x82\x02\x93\x02\x9b\x02\x97\x02\xa8\x02\xa8\x02\x9f\x02\xb0\x02\xb4\x02\xb4\x02\xbd\x02\xb4\x02\xbd\x02\xc5\x02\xc1\x02\xc1\x02\xd2\x02\xd2\x02\xd2\x02\xdf\x02\xda\x02\xda\x02\xf0\x02\xf0\x02\xeb\x02\xf0\x02\xe7\x02\xf8\x02\x00\x03\xf8\x02\xf4\x02\xfc\x02\xf8\x02\x00\x03\x11\x03\r\x03\r\x03\x16\x03\r\x03\t\x03\"\x03\"\x03\x1e\x03+\x03'\x03\"\x03+\x033\x03+\x033\x037\x03<\x03M\x03<\x037\x03H\x03H\x03D\x03Q\x03U\x03Y\x03]\x03U\x03Y\x03b\x03b\x03]\x03]\x03Y\x03]\x03f\x03n\x03j\x03j\x03n\x03j\x03n\x03s\x03s\x03{\x03\u007f\x03\x83\x03\x88\x03\x83\x03\u007f\x03\x8c\x03\x90\x03\x8c\x03\x90\x03\x90\x03\x90\x03\x99\x03\x99\x03\x99\x03\xa1\x03\x94\x03\x90\x03\x9d\x03\xa5\x03\xa5\x03\xa1\x03\xa1\x03\xa1\x03\xa1\x03\x9d\x03\xa5\x03\xb2\x03\xb2\x03\xb2\x03\xb6\x03\xb2\x03\xb2\x03\xb6\x03\xb6\x03\xb6\x03\xba\x03\xba\x03\xba\x03\xc3\x03\xbf\x03\xbf\x03\xcb\x03\xc7\x03\xc7\x03\xd0\x03\xd4\x03\xd4\x03\xd0\x03\xc7\x03\xcb\x03\xd0\x03\xc7\x03\xc7\x03\xd4\x03\xd8\x03\xd8\x03\xd8\x03\xd8\x03\xe0\x03\xdc\x03\xdc\x03\xd8\x03\xe5\x03\xe5\x03\xe0\x03\xe5\x03\xe0\x03\xdc\x03\xd8\x03\xd8\x03\xe5\x03\xf6\x03\xf6\x03\xed\x03\xe9\x03\xe9\x03\xe5\x03\xf1\x03\xe9\x03\xe5\x03\xf1\x03\xf1\x03\xed\x03\xf1\x03\xf1\x03\xf1\x03\xf6\x03\xf1\x03\xf1\x03\xf6\x03\xf1\x03\xfa\x03\xfe\x03\xf6\x03\xf1\x03\xfa\x03\xfa\x03\xfa\x03\xf6\x03\xed\x03\xf1\x03\xf6\x03\xf6\x03\xf6\x03\xfa\x03\xf6\x03\xfa\x03\xfe\x03\xfe\x03\xf6\x03\xfe\x03\x06\x04\x06\x04\x02\x04\xfe\x03\xfe\x03\x02\x04\x02\x04\x06\x04\v\x04\x06\x04\x02\x04\x06\x04\x02\x04\xfe\x03\x06\x04\x13\x04\x0f\x04\x0f\x04\x06\x04\x0f\x04\x0f\x04\v\x04\xfe\x03\x02\x04\x02\x04\xfe\x03\xfa\x03\xfa\x03\x06\x04\x13\x04\v\x04\x02\x04\x02\x04\v\x04\x06\x04\x02\x04\x06\x04\v\x04\v\x04\x0f\x04\x02\x04\x02\x04\x06\x04\x02\x04\x0f\x04\x13\x04\x0f\x04\x0f\x04\x0f\x04\x06\x04\x06\x04\x06\x04\v\x04\x06\x04\v\x04\v\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\v\x04\x0f\x04\x13\x04\x02\x04\x06\x04\v\x04\v\x04\x06\x04\x0f\x04\x0f\x04\x0f\x04\x0f\x04\x06\x04\x02\x04\xfe\x03\x02\x04\x02\x04\xfa\x03\xfe\x03\x02\x04\x02\x04\v\x04\x0f\x04\x06\x04\xfa\x03\xfe\x03\x02\x04\x02\x04\x02\x04\xfe\x03\xfa\x03\xfe\x03\x02\x04\x02\x04\x02\x04\xfe\x03\xfa\x03\xfe\x03\x02\x04\xfe\x03\xf6\x03\xfa\x03\xfe\x03\xfe\x03\xfa\x03\xfa\x03\xfa\x03\xfa\x03\xfe\x03\xfa\x03\xf1\x03\xf6\x03\xf6\x03\xe9\x03\xe9\x03\xed\x03\xf6\x03\xf1\x03\xed\x03\xf1\x03\xf6\x03\xf1\x03\xf1\x03\xe9\x03\xe9\x03\xed\x03\xed\x03\xe9\x03\xe5\x03\xe5\x03\xed\x03\xe5\x03\xdc\x03\xe0\x03\xe0\x03\xdc\x03\xe0\x03\xdc\x03\xdc\x03\xdc\x03\xd4\x03\xd8\x03\xd8\x03\xe0\x03\xe5\x03\xdc\x03\xd8\x03\xd0\x03\xc7\x03\xcb\x03\xcb\x03\xd0\x03\xd4\x03\xd0\x03\xcb\x03\xcb\x03\xcb\x03\xcb\x03\xd0\x03\xcb\x03\xcb\x03\xc7\x03\xc3\x03\xbf\x03\xc3\x03\xc3\x03\xbf\x03\xbf\x03\xc3\x03\xc3\x03\xba\x03\xb6\x03\xb2\x03\xb2\x03\xba\x03\xbf\x03\xae\x03\xb2\x03\xaa\x03\xb2\x03\xae\x03\xae\x03\xaa\x03\xa5\x03\xaa\x03\xae\x03\xaa\x03\x9d\x03\x99\x03\x9d\x03\xa1\x03\xa1\x03\x99\x03\x94\x03\x90\x03\x90\x03\x88\x03\x88\x03\x8c\x03\x94\x03\x94\x03\x94\x03\x8c\x03\x90\x03\x88\x03\x83\x03\x88\x03\x83\x03\u007f\x03\x83\x03\x83\x03\x83\x03\u007f\x03{\x03{\x03{\x03{\x03s\x03j\x03j\x03j\x03j\x03n\x03s\x03s\x03n\x03j\x03j\x03f\x03f\x03b\x03b\x03]\x03b\x03b\x03]\x03Y\x03Q\x03M\x03M\x03Q\x03M\x03M\x03Q\x03H\x03D\x03D\x03@\x03D\x03@\x03@\x03D\x03@\x03<\x03@\x03<\x037\x037\x03<\x037\x03/\x03'\x03\"\x03'\x03/\x03'\x03\x1e\x03\"\x03\"\x03\"\x03\"\x03\x16\x03\x11\x03\x16\x03\x16\x03\x11\x03\x16\x03\x11\x03\r\x03\r\x03\x05\x03\x05\x03\t\x03\t\x03\t\x03\x05\x03\xf8\x02\xf8\x02\xfc\x02\xf4\x02\xf8\x02\xf8\x02\xf4\x02\xf4\x02\xf0\x02\xeb\x02\xeb\x02\xeb\x02\xeb\x02\xf0\x02\xeb\x02\xe3\x02\xeb\x02\xe7\x02\xe3\x02\xe3\x02\xe7\x02\xdf\x02\xda\x02\xda\x02\xdf\x02\xd6\x02\xd2\x02\xd6\x02\xd6\x02\xd2\x02\xce\x02\xce\x02\xca\x02\xc5\x02\xc5\x02\xc5\x02\xc1\x02\xc1\x02\xc1\x02\xc5\x02\xc5\x02\xc5\x02\xc1\x02\xc1\x02\xc1\x02\xc1\x02\xbd\x02\xbd\x02\xb9\x02\xb4\x02\xb4\x02\xb0\x02\xb0\x02\xb0\x02\xb4\x02\xb4\x02\xb0\x02\xac\x02\xb0\x02\xac\x02\xa8\x02\xa4\x02\xa8\x02\x9b\x02\x9b\x02\x9f\x02\x9f\x02\xa8\x02\xa4\x02\x9b\x02\x9b\x02\x97\x02\x97\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x93\x02\x8e\x02\x8a\x02\x8a\x02\x86\x02\x82\x02\x82\x02~\x02\x82\x02\x82\x02~\x02y\x02~\x02y\x02y\x02y\x02\x82\x02~\x02y\x02y\x02y\x02q\x02m\x02m\x02m\x02m\x02m\x02m\x02h\x02h\x02m\x02d\x02d\x02h\x02h\x02d\x02`\x02`\x02`\x02\\\x02`\x02W\x02W\x02\\\x02W\x02S\x02S\x02W\x02W\x02W\x02S\x02O\x02K\x02K\x02G\x02K\x02K\x02K\x02G\x02>\x02B\x02G\x02G\x02B\x02>\x02:\x02:\x02>\x02B\x02:\x02:\x02:\x026\x02:\x02:\x02:\x02:\x02:\x026\x021\x02)\x02%\x02)\x02-\x02)\x02)\x02)\x02)\x02)\x02%\x02!\x02\x1c\x02\x1c\x02!\x02!\x02!\x02!\x02!\x02!\x02\x1c\x02\x18\x02\x18\x02\x14\x02\x14\x02\x18\x02\x14\x02\x14\x02\x10\x02\v\x02\x03\x02\x03\x02\x03\x02\v\x02\v\x02\v\x02\v\x02\v\x02\a\x02\a\x02\a\x02\a\x02\a\x02\x03\x02\xfb\x01\xfb\x01\xff\x01\xff\x01\xfb\x01\xfb\x01\xf6\x01\xf2\x01\xf6\x01\xf6\x01\xfb\x01\xf6\x01\xf2\x01\xf2\x01\xf6\x01\xee\x01\xf2\x01\xf2\x01\xea\x01\xee\x01\xf2\x01\xee\x01\xea\x01\xe5\x01\xe5\x01\xea\x01\xe5\x01\xe1\x01\xe1\x01\xe1\x01\xdd\x01\xe1\x01\xe1\x01\xdd\x01\xdd\x01\xe1\x01\xe1\x01\xd9\x01\xd9\x01\xd5\x01\xdd\x01\xd9\x01\xd5\x01\xd0\x01\xd0\x01\xd5\x01\xd9\x01\xd0\x01\xcc\x01\xd0\x01\xd0\x01\xcc\x01\xcc\x01\xc8\x01\xc8\x01\xc8\x01\xcc\x01\xcc\x01\xc8\x01\xc4\x01\xbf\x01\xc4\x01\xc4\x01\xbf\x01\xb7\x01\xb7\x01\xbb\x01\xbf\x01\xbf\x01\xbb\x01\xbb\x01\xb7\x01\xb7\x01\xb3\x01\xb7\x01\xb7\x01\xb7\x01\xb3\x01\xae\x01\xae\x01\xae\x01\xae\x01\xae\x01\xae\x01\xaa\x01\xaa\x01\xaa\x01\xa6\x01\xaa\x01\xa6\x01\xa6\x01\xa2\x01\xa2\x01\x9e\x01\x99\x01\x9e\x01\x9e\x01\x9e\x01\x99\x01\x99\x01\x99\x01\x99\x01\x9e\x01\x9e\x01\x99\x01\x95\x01\x95\x01\x95\x01\x91\x01\x91\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x8d\x01\x84\x01\x84\x01\x88\x01\x84\x01\x84\x01\x88\x01\x88\x01\x88\x01\x84\x01\x80\x01\x80\x01|\x01|\x01|\x01|\x01x\x01|\x01x\x01x\x01x\x01s\x01s\x01s\x01o\x01o\x01o\x01o\x01o\x01o\x01o\x01k\x01g\x01g\x01k\x01k\x01k\x01g\x01g\x01b\x01b\x01g\x01g\x01b\x01^\x01b\x01^\x01Z\x01Z\x01Z\x01Z\x01V\x01V\x01V\x01V\x01R\x01R\x01R\x01M\x01M\x01R\x01R\x01M\x01I\x01I\x01M\x01M\x01I\x01I\x01I\x01E\x01A\x01E\x01A\x01E\x01E\x01E\x01A\x01A\x01A\x01A\x01A\x018\x018\x018\x018\x018\x014\x014\x018\x014\x010\x010\x010\x010\x010\x010\x010\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01+\x01'\x01'\x01#\x01#\x01'\x01#\x01#\x01#\x01\x1f\x01\x1b\x01\x1b\x01\x1b\x01\x1b\x01\x1f\x01\x1b\x01\x1b\x01\x1f\x01\x1f\x01\x1b\x01\x16\x01\x16\x01\x12\x01\x12\x01\x16\x01\x16\x01\x12\x01\x16\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x0e\x01\n\x01\n\x01\n\x01\x05\x01\x05\x01\n\x01\n\x01\x05\x01\n\x01\n\x01\x05\x01\n\x01\x01\x01\x01\x01\x01\x01\x05\x01\x05\x01\x05\x01\x01\x01\x01\x01\xfd\x00\xfd\x00\xfd\x00\xfd\x00\xf9\x00\xf9\x00\xf9\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf5\x00\xf9\x00\xf9\x00\xf5\x00\xf5\x00\xf5\x00\xf0\x00\xf5\x00\xf5\x00\xf5\x00\xf0\x00\xec\x00\xec\x00\xec\x00\xec\x00\xec\x00\xf0\x00\xec\x00\xec\x00\xec\x00\xec\x00\xe8\x00\xe8\x00\xe4\x00\xe4\x00\xe4\x00\xe4\x00\xe8\x00\xe4\x00\xe4\x00\xe4\x00\xdf\x00\xdf\x00\xdf\x00\xe4\x00\xdf\x00\xdb\x00\xdb\x00\xd7\x00\xd7\x00\xdb\x00\xd7\x00\xdb\x00\xdb\x00\xd7\x00\xd7\x00\xdb\x00\xdb\x00\xd7\x00\xd7\x00\xd7\x00\xd3\x00\xd3\x00\xcf\x00\xcf\x00\xcf\x00\xcf\x00\xcf\x00\xd3\x00\xd3\x00\xcf\x00\xca\x00\xcf\x00\xcf\x00\xcf\x00\xca\x00\xca\x00\xca\x00\xca\x00\xca\x00\xca\x00\xca\x00\xca\x00\xca\x00\xc6\x00\xc2\x00\xc6\x00\xc6\x00\xc6\x00\xc6\x00\xc6\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xc2\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xbe\x00\xb9\x00\xb9\x00\xb5\x00\xb5\x00\xb5\x00\xb9\x00\xb9\x00\xb9\x00\xb5\x00\xb5\x00\xb5\x00\xb5\x00\xb1\x00\xb1\x00\xad\x00\xad\x00\xad\x00\xad\x00\xad\x00\xad\x00\xad\x00\xad\x00\xad\x00\xad\x00\xa9\x00\xa9\x00\xa9\x00\xa9\x00\xa9\x00\xa4\x00\xa4\x00\xa4\x00\xa4\x00\xa0\x00\xa0\x00\xa4\x00\xa0\x00\xa0\x00\xa0\x00\xa0\x00\xa0\x00\xa0\x00\xa0\x00\xa0\x00\x9c\x00\x9c\x00\x9c\x00\x9c\x00\x98\x00\x98\x00\x98\x00\x98\x00\x98\x00\x93\x00\x98\x00\x98\x00\x98\x00\x98\x00\x93\x00\x93\x00\x93\x00\x93\x00\x93\x00\x93\x00\x8f\x00\x8f\x00\x8f\x00\x8f\x00\x8b\x00\x8b\x00\x8b\x00\x87\x00\x87\x00\x8b\x00\x87\x00\x87\x00\x82\x00~\x00\x82\x00\x82\x00\x82\x00\x82\x00~\x00~\x00\x82\x00~\x00z\x00z\x00z\x00z\x00z\x00z\x00z\x00v\x00v\x00v\x00v\x00v\x00v\x00r\x00r\x00r\x00r\x00r\x00m\x00m\x00m\x00m\x00i\x00i\x00i\x00m\x00i\x00i\x00i\x00i\x00i\x00i\x00i\x00e\x00e\x00e\x00e\x00e\x00e\x00a\x00a\x00a\x00a\x00a\x00a\x00a\x00a\x00a\x00\\\x00\\\x00\\\x00X\x00X\x00X\x00X\x00X\x00X\x00X\x00X\x00T\x00T\x00T\x00T\x00P\x00T\x00T\x00T\x00T\x00T\x00P\x00P\x00P\x00L\x00L\x00L\x00L\x00L\x00L\x00L\x00L\x00L\x00L\x00G\x00G\x00G\x00G\x00G\x00G\x00G\x00G\x00C\x00C\x00C\x00C\x00G\x00C\x00C\x00C\x00C\x00C\x00C\x00C\x00C\x00C\x00?\x00?\x00?\x00?\x00?\x00?\x00?\x00?\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x00;\x006\x006\x006\x00;\x006\x006\x006\x006\x006\x006\x006\x006\x002\x002\x002\x002\x002\x002\x002\x002\x002\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00.\x00*\x00*\x00.\x00*\x00*\x00*\x00*\x00*\x00*\x00*\x00&\x00&\x00&\x00&\x00*\x00&\x00&\x00&\x00&\x00&\x00&\x00&\x00&\x00&\x00&\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00!\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x1d\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x19\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x15\x00\x10\x00\x15\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\x10\x00\f\x00\f\x00\f\x00\f\x00\f\x00\f\x00\f\x00\f\x00\f\x00\f\x00\f\x00\f\x00\f\x00\f\x00\f\x00\f\x00\f\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\b\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x04\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00")
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package main
-
-var shootSample = []byte("RIFF\x8a)\x00\x00WAVEfmt \x10\x00\x00\x00\x01\x00\x01\x00D\xac\x00\x00\x88X\x01\x00\x02\x00\x10\x00data\xc4(\x00\x00\f\xfd\xfa\xfc\xf7\xfb\xe0\xfb\"\xfb\f\xfbk\xfaP\xfa\xc8\xf9\xab\xf9/\xf9\x10\xf9\xa4\xf8\x84\xf8\x1c\xf8\xfd\xf7\x9c\xf7|\xf7%\xf7\x00\xf7\xaf\xf6\x8c\xf6@\xf6\x1b\xf6\xd5\xf5\xad\xf5p\xf5G\xf5\xcb\xfa\xb8\xfa@\xf4\x17\xf4\xdf\xf3\xb4\xf3\x86\xf3Z\xf3)\xf3\x03\xf3\xd2\xf2\xb1\xf2\x82\xf2[\xf22\xf2\v\xf2\xe6\xf1\xbf\xf1\x9d\xf1p\xf1U\xf1+\xf1\x12\xf1\x11\xf1\x1d\xf1\x1a\xf1%\xf1)\xf1\xcd\x17\xc8\x17^\xf0[\xf0p\xf0p\xf0\x83\xf0\x84\xf0\x9a\xf0\x9b\xf0\xb4\xf0\xb2\xf0\xca\xf0\xca\xf0\xe4\xf0\xea\xf0\xfd\xf0\x06\xf1\x1a\xf1\x1b\xf1;\xf1;\xf1\\\xf1T\xf1z\xf1t\xf1\x95\xf1\x94\xf1\xb7\xf1\xb7\xf1|\x15x\x15\x8f\xf0\x8b\xf0\xb3\xf0\xb1\xf0\xd3\xf0\xd7\xf0\xf8\xf0\x01\xf1\x1e\xf1!\xf1H\xf1H\xf1m\xf1l\xf1\x93\xf1\x95\xf1\xbd\xf1\xbb\xf1\xe0\xf1\xe0\xf1\b\xf2\v\xf2-\xf24\xf2V\xf2R\xf2}\xf2\u007f\xf2e\x19i\x19\x95\xf0\x9c\xf0\xc3\xf0\xcc\xf0\xf6\xf0\xf4\xf0 \xf1 \xf1T\xf1O\xf1~\xf1}\xf1\xaa\xf1\xa4\xf1\xd4\xf1\xd4\xf1\x04\xf2\xfe\xf10\xf2-\xf2V\xf2X\xf2\x84\xf2\x83\xf2\xb1\xf2\xb1\xf2\xdb\xf2\xd9\xf2\n#\t#f\xf0g\xf0\x98\xf0\x98\xf0\xce\xf0\xc9\xf0\x02\xf1\xfc\xf00\xf1.\xf1d\xf1f\xf1\x91\xf1\x94\xf1\xc1\xf1\xc4\xf1\xf5\xf1\xf7\xf1&\xf2%\xf2Q\xf2S\xf2\x85\xf2\x84\xf2\xb2\xf2\xb0\xf2\xdc\xf2\xdf\xf2\x84\x1a\x81\x1a\xe2\xef\xe1\xef\x19\xf0\x1d\xf0O\xf0Q\xf0\x8c\xf0\x84\xf0\xc0\xf0\xbe\xf0\xfb\xf0\xf5\xf0.\xf1%\xf1g\xf1b\xf1\x97\xf1\x94\xf1\xc9\xf1\xc7\xf1\x01\xf2\xfe\xf1/\xf23\xf2\\\xf2f\xf2\x91\xf2\x96\xf2\xc1\xf2\xc6\xf2F'@'V\xefR\xef\x9a\xef\x94\xef\xd1\xef\xd0\xef\r\xf0\f\xf0I\xf0E\xf0\x82\xf0\x81\xf0\xc0\xf0\xb9\xf0\xf8\xf0\xf3\xf05\xf1,\xf1h\xf1b\xf1\x9f\xf1\xa0\xf1\xd3\xf1\xd1\xf1\a\xf2\n\xf2?\xf27\xf2o\xf2t\xf2\x9d\x15\x9d\x15\x00\x1d\x01\x1d\x8c\xee\x90\xee\xc8\xee\xd5\xee\v\xef\x16\xefQ\xefP\xef\x90\xef\x8f\xef\xd1\xef\xd1\xef\x11\xf0\x10\xf0P\xf0J\xf0\x8c\xf0\x89\xf0\xc4\xf0\xc2\xf0\x01\xf1\xfd\xf0:\xf17\xf1u\xf1t\xf1\xaa\xf1\xab\xf1\xe6\xf1\xdf\xf1U-S-\x84\xed\x80\xed\xcb\xed\xcc\xed\x15\xee\x18\xeeT\xeeX\xee\x9d\xee\xa1\xee\xe4\xee\xe2\xee&\xef\"\xefl\xefn\xef\xaa\xef\xa9\xef\xe9\xef\xe8\xef,\xf0)\xf0i\xf0l\xf0\xa4\xf0\xa7\xf0\xe3\xf0\xe7\xf0\"\xf1\x1e\xf1Z\xf1]\xf1\x8a/\x90/\xb7\xec\xbb\xec\x03\xed\v\xedP\xedV\xed\x9e\xed\xa0\xed\xe8\xed\xe5\xed/\xee.\xeew\xee{\xee\xbd\xee\xbe\xee\x00\xef\x05\xefD\xefG\xef\x8b\xef\x89\xef\xd0\xef\xc9\xef\r\xf0\x0f\xf0O\xf0P\xf0\x8f\xf0\x8a\xf0\xce\xf0\xc8\xf0]0U0\xe9\xeb\xe2\xeb3\xec4\xec\x83\xec\x83\xec\xd5\xec\xd6\xec \xed&\xedq\xedm\xed\xba\xed\xb7\xed\x06\xee\x00\xeeQ\xeeJ\xee\x98\xee\x95\xee\xdd\xee\xdf\xee#\xef\"\xefh\xefi\xef\xac\xef\xa9\xef\xf0\xef\xf1\xef1\xf00\xf0p\xf0p\xf0\xbd3\xb53\xdf\xea\xdf\xea7\xeb6\xeb\x88\xeb\x8d\xeb\xdc\xeb\xe3\xeb.\xec5\xec\x82\xec\x87\xec\xd1\xec\xd6\xec\x1f\xed#\xedn\xeds\xed\xba\xed\xbf\xed\b\xee\t\xeeR\xeeQ\xee\x9b\xee\x9a\xee\xeb\xee\xe4\xee)\xef(\xefr\xefl\xef\xb8\xef\xae\xef\xb95\xb15\xfa\xe9\xf3\xe9R\xeaK\xea\xad\xea\xa8\xea\x06\xeb\xff\xeaZ\xeb]\xeb\xaf\xeb\xb2\xeb\x04\xec\x05\xecU\xec]\xec\xa8\xec\xad\xec\xfb\xec\xfc\xecJ\xedJ\xed\x9a\xed\x97\xed\xe6\xed\xe1\xed5\xee,\xee\u007f\xee}\xee\xc6\xee\xc5\xee\n\xef\n\xefS\xefQ\xef\xac7\xac7b\xe9d\xe9\xbe\xe9\xc1\xe9\x1e\xea#\xeav\xea|\xea\xd6\xea\xd6\xea2\xeb'\xeb\x86\xeb\x85\xeb\xdc\xeb\xdb\xeb0\xec0\xec\x81\xec\x87\xec\xd4\xec\xd4\xec&\xed%\xedu\xedt\xed\xc5\xed\xc3\xed\x12\xee\x12\xee\\\xee^\xee\xa5\xee\xa9\xee\xea\xee\xf7\xee\x8d9\x8d9H\xe8F\xe8\xab\xe8\xac\xe8\x0f\xe9\v\xe9p\xe9n\xe9\xce\xe9\xcf\xe9)\xea-\xea\x8b\xea\x8a\xea\xe5\xea\xe2\xea@\xeb<\xeb\x94\xeb\x93\xeb\xec\xeb\xef\xebA\xec?\xec\x93\xec\x98\xec\xe6\xec\xe7\xec:\xed6\xed\x86\xed\x86\xed\xd8\xed\xd4\xed!\xee#\xeeW;\\;B\xe7C\xe7\xab\xe7\xad\xe7\x12\xe8\x11\xe8w\xe8w\xe8\xe2\xe8\xdd\xe8?\xe9=\xe9\xa3\xe9\x9b\xe9\x01\xea\x00\xeaa\xea]\xea\xb8\xea\xbc\xea\x15\xeb\x15\xebo\xebn\xeb\xc5\xeb\xc3\xeb\x1e\xec\x19\xecu\xecp\xec\xc7\xec\xc1\xec\x16\xed\x16\xedg\xedf\xed\xb7\xed\xb9\xed(=+=\x1b\xe6\x1b\xe6\x89\xe6\x87\xe6\xf8\xe6\xf4\xe6d\xe7\\\xe7\xcf\xe7\xc7\xe72\xe82\xe8\x98\xe8\x99\xe8\xfe\xe8\xfe\xe8]\xe9`\xe9\xc4\xe9\xbc\xe9\"\xea \xea\x82\xea}\xea\xdb\xea\xda\xea6\xeb0\xeb\x94\xeb\x88\xeb\xec\xeb\xe5\xeb>\xec;\xec\x8d\xec\x97\xec\xe4\xec\xe6\xec8\xed9\xed\xf9>\xfb>\x83\xe5\x84\xe5\x8e\xe5\x8a\xe5\a\xe6\x02\xe6\x84\xe6{\xe6\xf9\xe6\xf3\xe6j\xe7l\xe7\xd7\xe7\xda\xe7N\xe8O\xe8\xb9\xe8\xb8\xe8$\xe9#\xe9\x91\xe9\x8f\xe9\xf6\xe9\xf2\xe9d\xea]\xea\xc6\xea\xbf\xea(\xeb&\xeb\u007f\xeb\x88\xeb\xe4\xeb\xe5\xebA\xecB\xec\x9d\xec\xa0\xec\xf6\xec\xf9\xec%@'@\x1a\xe5!\xe5\x95\xe5\x9c\xe5\x0f\xe6\x19\xe6\x8a\xe6\x8b\xe6\x06\xe7\xfa\xe6|\xe7p\xe7\xe8\xe7\xe9\xe7T\xe8[\xe8\xc4\xe8\xc4\xe80\xe9.\xe9\x9f\xe9\x95\xe9\n\xea\xfc\xe9n\xeah\xea\xcb\xea\xcd\xea,\xeb4\xeb\x8c\xeb\x90\xeb\xea\xeb\xf3\xebI\xecO\xec\xa9\xec\xa4\xec\x02\xed\x01\xed\x01.\x06. @)@\xef\xe4\xef\xe4p\xe5j\xe5\xec\xe5\xe4\xe5b\xe6c\xe6\xd5\xe6\xdb\xe6I\xe7O\xe7\xbd\xe7\xbf\xe7.\xe80\xe8\x9e\xe8\x9e\xe8\b\xe9\v\xe9t\xe9z\xe9\xdb\xe9\xde\xe9F\xeaD\xea\xac\xea\xa4\xea\x10\xeb\t\xebh\xebq\xeb\xc7\xeb\xce\xeb'\xec+\xec\x84\xec\x85\xec\xe1\xec\xde\xec:\xed9\xed$@$@\xc5\xe4\xc8\xe4D\xe5A\xe5\xc0\xe5\xc0\xe5;\xe69\xe6\xb2\xe6\xab\xe6*\xe7#\xe7\x9e\xe7\x9a\xe7\b\xe8\b\xe8v\xe8z\xe8\xe6\xe8\xea\xe8P\xe9T\xe9\xba\xe9\xb7\xe9#\xea \xea\x88\xea\x83\xea\xf0\xea\xe7\xeaR\xebF\xeb\xb2\xeb\xa4\xeb\r\xec\v\xech\xecg\xec\xc1\xec\xc4\xec\x1a\xed\x1c\xedo\xedv\xed%@%@i\xe4i\xe4\xed\xe4\xe9\xe4d\xe5h\xe5\xe4\xe5\xe4\xe5]\xe6^\xe6\xd5\xe6\xd0\xe6G\xe7F\xe7\xbc\xe7\xb8\xe7.\xe8*\xe8\x96\xe8\x97\xe8\x05\xe9\b\xe9n\xe9q\xe9\xda\xe9\xd8\xe9?\xea<\xea\xa5\xea\xa3\xea\x0e\xeb\x04\xebn\xebc\xeb\xce\xeb\xc6\xeb&\xec$\xec\x80\xec\x83\xec\xd8\xec\xdc\xec2\xed2\xed\x12.\x11.%@%@\x1c\xe4\x1c\xe4\x9e\xe4\x9c\xe4\x1d\xe5\x1b\xe5\x98\xe5\x95\xe5\x15\xe6\x12\xe6\x89\xe6\x89\xe6\x03\xe7\x00\xe7x\xe7s\xe7\xe4\xe7\xe5\xe7V\xe8U\xe8\xc0\xe8\xc4\xe8/\xe9.\xe9\x9f\xe9\x94\xe9\a\xea\xfc\xe9e\xeai\xea\xc7\xea\xc8\xea/\xeb,\xeb\x90\xeb\x8b\xeb\xea\xeb\xed\xebK\xecI\xec\xa5\xec\xa1\xec\x01\xed\xfa\xecX\xedQ\xed(@$@\xda\xe3\xdd\xe3\\\xe4]\xe4\xdf\xe4\xda\xe4Z\xe5^\xe5\xd2\xe5\xd7\xe5Q\xe6Q\xe6\xc1\xe6\xc7\xe69\xe7A\xe7\xaa\xe7\xb2\xe7\x1d\xe8\x1d\xe8\x8c\xe8\x8e\xe8\xf9\xe8\xfe\xe8^\xe9g\xe9\xce\xe9\xc6\xe96\xea2\xea\x9e\xea\x95\xea\xfb\xea\xfa\xea^\xeb`\xeb\xb8\xeb\xbc\xeb\x1b\xec\x1b\xecw\xect\xec\xd5\xec\xca\xec*\xed%\xed\x83\xed\x82\xed!@)@K4N4\xec\xe3\xe7\xe3g\xe4p\xe4\xeb\xe4\xef\xe4h\xe5l\xe5\xde\xe5\xe3\xe5^\xe6]\xe6\xd5\xe6\xd5\xe6J\xe7D\xe7\xb9\xe7\xbd\xe7(\xe8-\xe8\x93\xe8\xa1\xe8\x03\xe9\b\xe9k\xe9o\xe9\xd5\xe9\xda\xe9<\xeaA\xea\x9e\xea\xa6\xea\x00\xeb\n\xebf\xebe\xeb\xc3\xeb\xc6\xeb#\xec#\xec\x80\xec\x83\xec\xd9\xec\xd9\xec3\xed2\xed\x89\xed\x87\xed'@$@%@#@r\xe3r\xe3\xf6\xe3\xf7\xe3v\xe4{\xe4\xf5\xe4\xf8\xe4p\xe5t\xe5\xed\xe5\xed\xe5c\xe6d\xe6\xdb\xe6\xe2\xe6Q\xe7Q\xe7\xc3\xe7\xc2\xe74\xe82\xe8\xa2\xe8\xa1\xe8\x12\xe9\r\xe9w\xe9t\xe9\xe2\xe9\xe1\xe9I\xeaE\xea\xaf\xea\xa9\xea\x10\xeb\a\xebm\xebq\xeb\xce\xeb\xce\xeb)\xec.\xec\x85\xec\x86\xec\xdf\xec\xe3\xec9\xed9\xed\x8d\xed\x90\xed\xaf>\xb0>%@$@n\xe3q\xe3\xf2\xe3\xf5\xe3u\xe4q\xe4\xf4\xe4\xef\xe4v\xe5r\xe5\xef\xe5\xf0\xe5a\xe6k\xe6\xd5\xe6\xe0\xe6N\xe7P\xe7\xc1\xe7\xbe\xe72\xe82\xe8\x9e\xe8\xa0\xe8\x06\xe9\x0e\xe9u\xe9{\xe9\xdc\xe9\xdd\xe9A\xeaJ\xea\xa4\xea\xad\xea\t\xeb\x0e\xebm\xebh\xeb\xd0\xeb\xc3\xeb*\xec*\xec\x83\xec\x83\xec\xdb\xec\xe1\xec2\xed6\xed\x8a\xed\x98\xed\xdc\xed\xe7\xed\\7a7%@#@!\xe3\x1c\xe3\xaa\xe3\xa5\xe3,\xe4(\xe4\xab\xe4\xa9\xe4(\xe5%\xe5\xa4\xe5\xa3\xe5\x1c\xe6\x1c\xe6\x96\xe6\x92\xe6\x0e\xe7\t\xe7~\xe7|\xe7\xf0\xe7\xed\xe7d\xe8]\xe8\xcc\xe8\xca\xe89\xe93\xe9\xa2\xe9\x9e\xe9\x04\xea\n\xean\xeam\xea\xcd\xea\xd2\xea1\xeb1\xeb\x95\xeb\x8e\xeb\xf1\xeb\xf2\xebL\xecP\xec\xa8\xec\xaa\xec\xfe\xec\x00\xedX\xedY\xed\xae\xed\xb1\xed\b\xee\x03\xeeR!U! @(@\xb2\xe2\xb4\xe2;\xe38\xe3\xc3\xe3\xba\xe3C\xe4A\xe4\xc5\xe4\xc4\xe4B\xe5?\xe5\xbe\xe5\xb9\xe5;\xe61\xe6\xac\xe6\xb2\xe6 \xe7$\xe7\x90\xe7\x93\xe7\b\xe8\x05\xe8t\xe8p\xe8\xe3\xe8\xdb\xe8N\xe9G\xe9\xb6\xe9\xb1\xe9\x1e\xea\x1b\xea\x82\xea~\xea\xe4\xea\xe5\xeaG\xebE\xeb\xa4\xeb\xa8\xeb\x02\xec\xfd\xebf\xec\\\xec\xbc\xec\xb7\xec\x16\xed\x14\xedb\xedj\xed\xbd\xed\xc3\xed\x10\xee\x15\xeea\xeei\xee\"@#@\x19\x18\x19\x18\xc1\xe2\xbe\xe2H\xe3?\xe3\xc9\xe3\xcd\xe3E\xe4N\xe4\xca\xe4\xd0\xe4H\xe5I\xe5\xc6\xe5\xc5\xe58\xe6@\xe6\xb2\xe6\xb8\xe6\"\xe70\xe7\x95\xe7\xa1\xe7\f\xe8\x0f\xe8z\xe8|\xe8\xe3\xe8\xea\xe8O\xe9V\xe9\xba\xe9\xb9\xe9$\xea#\xea\x87\xea\x86\xea\xea\xea\xec\xeaJ\xebP\xeb\xa2\xeb\xac\xeb\x06\xec\b\xeca\xeca\xec\xbf\xec\xbb\xec\x18\xed\x19\xedl\xedq\xed\xc3\xed\xc9\xed\x18\xee\x19\xeek\xeeh\xee!@\"@%@\"@ \xe2 \xe2\xaf\xe2\xa7\xe24\xe3/\xe3\xbd\xe3\xb4\xe3:\xe49\xe4\xbc\xe4\xb9\xe49\xe53\xe5\xba\xe5\xac\xe51\xe6+\xe6\xa6\xe6\xa3\xe6\x1b\xe7\x18\xe7\x87\xe7\x8d\xe7\xf9\xe7\x01\xe8m\xe8j\xe8\xd8\xe8\xd9\xe8C\xe9D\xe9\xa9\xe9\xaf\xe9\x0e\xea\x10\xeaz\xeax\xea\xdc\xea\xdc\xea>\xeb;\xeb\x9b\xeb\x9c\xeb\xfc\xeb\xfb\xebW\xecW\xec\xb3\xec\xb0\xec\f\xed\t\xedZ\xeda\xed\xb7\xed\xbb\xed\b\xee\x10\xee_\xeeb\xee\xae\xee\xaf\xee\"@$@ @\"@\xe2\xe1\xe6\xe1k\xe2n\xe2\xf2\xe2\xf4\xe2{\xe3}\xe3\xfd\xe3\xfe\xe3~\xe4\x84\xe4\xfe\xe4\xfe\xe4v\xe5z\xe5\xf9\xe5\xf6\xe5l\xe6l\xe6\xdf\xe6\xe8\xe6X\xe7X\xe7\xc3\xe7\xcd\xe74\xe8=\xe8\xa1\xe8\xac\xe8\x0f\xe9\x13\xe9{\xe9x\xe9\xe5\xe9\xe5\xe9F\xeaI\xea\xaf\xea\xad\xea\x0f\xeb\f\xebp\xebo\xeb\xce\xeb\xcd\xeb0\xec+\xec\x84\xec\x86\xec\xe2\xec\xde\xec;\xed5\xed\x92\xed\x8e\xed\xe6\xed\xe1\xed6\xee6\xee\x89\xee\x89\xee\xde\xee\xd7\xee\xa3!\xa9!\x1f@ @F\x16J\x16\x8a\xe1\x8b\xe1\x13\xe2\x12\xe2\x9e\xe2\xa2\xe2*\xe3%\xe3\xac\xe3\xa7\xe3/\xe4-\xe4\xaf\xe4\xad\xe4)\xe5.\xe5\xa7\xe5\xa7\xe5\"\xe6\x1e\xe6\x97\xe6\x99\xe6\x0f\xe7\v\xe7}\xe7~\xe7\xf1\xe7\xec\xe7c\xe8_\xe8\xcc\xe8\xce\xe80\xe9:\xe9\x9e\xe9\x9f\xe9\x05\xea\n\xeaj\xeaj\xea\xcf\xea\xcf\xea0\xeb4\xeb\x91\xeb\x8e\xeb\xee\xeb\xeb\xebJ\xecO\xec\xa3\xec\xa7\xec\xfa\xec\x03\xedX\xedV\xed\xa7\xed\xaf\xed\x04\xee\xff\xedT\xeeT\xee\xa4\xee\xaa\xee\xf4\xee\xf3\xee\xb2!\xad!!@ @:(6(0\xe11\xe1\xbe\xe1\xbd\xe1H\xe2F\xe2\xd4\xe2\xd2\xe2V\xe3W\xe3\xdb\xe3\xdb\xe3_\xe4]\xe4\xd6\xe4\xe0\xe4T\xe5\\\xe5\xd1\xe5\xd6\xe5M\xe6K\xe6\xc3\xe6\xc2\xe6:\xe75\xe7\xaf\xe7\xa7\xe7\x1a\xe8\x1a\xe8\x87\xe8\x89\xe8\xef\xe8\xf3\xe8\\\xe9\\\xe9\xc6\xe9\xc1\xe92\xea%\xea\x92\xea\x8c\xea\xf6\xea\xeb\xeaT\xebT\xeb\xb4\xeb\xb5\xeb\v\xec\x13\xeci\xecl\xec\xc4\xec\xc4\xec\x1d\xed \xedo\xedt\xed\xc8\xed\xca\xed\x1e\xee \xeep\xeer\xee\xc4\xee\xbe\xee\x14\xef\x0e\xef`\xef]\xef\xf5>\xf1>!@%@\xbf\x14\xbf\x14\xa6\xe0\xa9\xe05\xe18\xe1\xc4\xe1\xc7\xe1L\xe2Q\xe2\xd7\xe2\xd6\xe2a\xe3]\xe3\xe2\xe3\xe2\xe3b\xe4f\xe4\xe4\xe4\xdf\xe4c\xe5^\xe5\xde\xe5\xd5\xe5S\xe6M\xe6\xc4\xe6\xca\xe69\xe7@\xe7\xaa\xe7\xac\xe7\x1b\xe8\x1f\xe8\x8a\xe8\x91\xe8\xf3\xe8\xfb\xe8`\xe9b\xe9\xc7\xe9\xc3\xe9-\xea2\xea\x90\xea\x96\xea\xf6\xea\xf9\xeaS\xebU\xeb\xb5\xeb\xbb\xeb\x11\xec\x17\xecm\xecp\xec\xc9\xec\xc6\xec\x1e\xed#\xedw\xedy\xed\xce\xed\xcc\xed\x1c\xee\"\xeer\xeet\xee\xc0\xee\xc4\xee\x11\xef\x13\xefa\xefd\xef\xae\xef\xab\xef\x1d@!@\x1f@&@\xe4\xdf\xe7\xdfx\xe0w\xe0\t\xe1\n\xe1\x98\xe1\x97\xe1!\xe2'\xe2\xaa\xe2\xb0\xe2.\xe35\xe3\xbc\xe3\xb6\xe36\xe4;\xe4\xb5\xe4\xb9\xe49\xe5:\xe5\xaf\xe5\xb5\xe5,\xe6*\xe6\x9f\xe6\x9f\xe6\x17\xe7\x14\xe7\x8a\xe7\x86\xe7\xf6\xe7\xfc\xe7e\xe8j\xe8\xd4\xe8\xd6\xe8B\xe9A\xe9\xa3\xe9\xa6\xe9\r\xea\x0f\xeat\xeam\xea\xd6\xea\xd6\xea6\xeb6\xeb\x97\xeb\x93\xeb\xf5\xeb\xf6\xebP\xecK\xec\xaf\xec\xa9\xec\x06\xed\x04\xed[\xedZ\xed\xb1\xed\xb0\xed\x02\xee\a\xeeW\xeeW\xee\xa8\xee\xaa\xee\xf3\xee\xfb\xeeE\xefF\xef\x93\xef\x94\xef\xdc\xef\xda\xef+\xf0'\xf0\x1e@!@\"@!@5\x133\x13\xe2\xdf\xe6\xdfy\xe0x\xe0\n\xe1\x03\xe1\x9c\xe1\x93\xe1+\xe2\x1e\xe2\xaf\xe2\xad\xe24\xe30\xe3\xb5\xe3\xb9\xe33\xe4:\xe4\xb3\xe4\xba\xe41\xe56\xe5\xb2\xe5\xb2\xe5&\xe6*\xe6\x9f\xe6\x9e\xe6\x1a\xe7\x13\xe7\x88\xe7\x85\xe7\xf9\xe7\xf7\xe7e\xe8d\xe8\xd3\xe8\xce\xe8?\xe99\xe9\xa9\xe9\xa4\xe9\v\xea\n\xeas\xeam\xea\xd2\xea\xd5\xea2\xeb7\xeb\x90\xeb\x95\xeb\xf2\xeb\xf1\xebN\xecP\xec\xa5\xec\xa8\xec\x01\xed\x00\xedW\xedY\xed\xaa\xed\xaf\xed\x02\xee\xff\xedV\xeeQ\xee\xaa\xee\xa5\xee\xf4\xee\xf4\xeeD\xefC\xef\x94\xef\x8d\xef\xdd\xef\xdd\xef#\xf0(\xf0n\xf0o\xf0\xb9\xf0\xb1\xf0\x1e@\x1d@ @\x1f@\xa2ު\xde7\xdf?\xdf\xd1\xdf\xd5\xdfe\xe0g\xe0\xf4\xe0\xfa\xe0\x81\xe1\x87\xe1\x16\xe2\x0f\xe2\x9a\xe2\x9c\xe2\"\xe3#\xe3\xa4\xe3\xa6\xe3)\xe4/\xe4\xa7\xe4\xaa\xe4$\xe5&\xe5\xa3\xe5\x9f\xe5\x1c\xe6\x1a\xe6\x8f\xe6\x93\xe6\x03\xe7\a\xe7u\xe7}\xe7\xe8\xe7\xe8\xe7W\xe8V\xe8\xc5\xe8\xc0\xe8/\xe9,\xe9\x93\xe9\x97\xe9\x03\xea\xfa\xe9d\xeaa\xea\xc5\xea\xc7\xea&\xeb*\xeb\x86\xeb\x81\xeb\xea\xeb\xe1\xebD\xecA\xec\x9c\xec\x9d\xec\xf3\xec\xf3\xecN\xedJ\xed\xa3\xed\xa0\xed\xf6\xed\xf9\xedG\xeeH\xee\x9a\xee\x99\xee\xea\xee\xe6\xee9\xef:\xef\x83\xef\x85\xef\xce\xef\xd6\xef\x1a\xf0\x1c\xf0b\xf0e\xf0\xaa\xf0\xad\xf0\xef\xf0\xf4\xf0\x1f@ @\x1c@ @\x10&\x15&wޅ\xde\x15\xdf\x16ߪ߱\xdf>\xe0B\xe0\xd8\xe0\xd0\xe0`\xe1^\xe1\xeb\xe1\xef\xe1v\xe2y\xe2\x02\xe3\xfe\xe2\x84\xe3\x86\xe3\x05\xe4\x05\xe4\x8c\xe4\x82\xe4\x04\xe5\b\xe5}\xe5\x81\xe5\xf8\xe5\xf7\xe5s\xe6t\xe6\xe7\xe6\xea\xe6V\xe7]\xe7\xcb\xe7\xc8\xe7;\xe89\xe8\xa4\xe8\xa6\xe8\x11\xe9\r\xe9|\xe9z\xe9\xe4\xe9\xde\xe9G\xeaH\xea\xab\xea\xa9\xea\x0f\xeb\x06\xebo\xebi\xeb\xce\xeb\xcc\xeb$\xec)\xec\x83\xec\x81\xec\xd9\xec\xda\xec5\xed6\xed\x86\xed\x85\xed\xde\xed\xdd\xed5\xee/\xee\x82\xee\u007f\xee\xd8\xee\xce\xee&\xef\x1f\xefo\xefn\xef\xbd\xef\xb7\xef\b\xf0\x00\xf0R\xf0M\xf0\x95\xf0\x94\xf0\xd9\xf0\xdf\xf0 \xf1\"\xf1f\xf1h\xf18?9?\x1a@\"@\x1b@ @T\xddY\xdd\xf8\xdd\xf9ݐޕ\xde,\xdf-\xdf\xc6\xdf\xc1\xdfY\xe0Q\xe0\xe8\xe0\xe9\xe0u\xe1v\xe1\x05\xe2\x03\xe2\x86\xe2\x90\xe2\f\xe3\x17\xe3\x96\xe3\x9a\xe3\x16\xe4\x19\xe4\x98\xe4\x9a\xe4\x16\xe5\x19\xe5\x92\xe5\x93\xe5\t\xe6\v\xe6\x81\xe6\x83\xe6\xf7\xe6\xf6\xe6h\xe7g\xe7\xdb\xe7\xdc\xe7A\xe8J\xe8\xb1\xe8\xb5\xe8 \xe9\x1f\xe9\x8b\xe9\x8a\xe9\xec\xe9\xee\xe9S\xeaV\xea\xb4\xea\xb8\xea\x17\xeb\x18\xebw\xeb{\xeb\xd4\xeb\xd4\xeb1\xec3\xec\x89\xec\x8d\xec\xe4\xec\xe8\xec<\xed<\xed\x90\xed\x98\xed\xe6\xed\xe7\xed=\xee;\xee\x8b\xee\x8d\xee\xd8\xee\xdd\xee)\xef)\xefv\xefx\xef\xc2\xef\xc4\xef\f\xf0\r\xf0U\xf0W\xf0\x9c\xf0\x9f\xf0\xe4\xf0\xe4\xf0)\xf1'\xf1q\xf1i\xf1\xb3\xf1\xac\xf1\xf5\xf1\xf2\xf1\t8\x048\x1d@\x1a@\x1d@\x1c@x\xdct\xdc\x14\xdd\x17ݵݷ\xddV\xdeO\xde\xf3\xde\xe7ވ\xdf~\xdf\x1a\xe0\x19\xe0\xae\xe0\xa9\xe0:\xe1<\xe1\xc3\xe1\xc8\xe1J\xe2V\xe2\xda\xe2\xdc\xe2_\xe3_\xe3\xe1\xe3\xdf\xe3`\xe4b\xe4\xe4\xe4\xdf\xe4[\xe5Z\xe5\xd6\xe5\xdc\xe5L\xe6L\xe6\xc5\xe6\xc0\xe68\xe78\xe7\xa6\xe7\xa9\xe7\x17\xe8\x18\xe8\x88\xe8\x82\xe8\xf1\xe8\xee\xe8Z\xe9W\xe9\xc1\xe9\xc8\xe9%\xea(\xea\x87\xea\x8b\xea\xf1\xea\xe6\xeaR\xebL\xeb\xac\xeb\xa7\xeb\n\xec\f\xech\xece\xec\xbd\xec\xbd\xec\x17\xed\x16\xedi\xedn\xed\xc2\xed\xc2\xed\x12\xee\x1b\xeed\xeej\xee\xb5\xee\xb2\xee\b\xef\x04\xefU\xefW\xef\xa1\xef\xa0\xef\xec\xef\xe9\xef3\xf06\xf0}\xf0{\xf0\xc9\xf0\xc0\xf0\f\xf1\x05\xf1N\xf1N\xf1\x94\xf1\x8e\xf1\xd0\xf1\xd4\xf1\x13\xf2\x16\xf2O\xf2R\xf2\x94\xf2\x93\xf2\xce\xf2\xcc\xf2 @\x1f@\x1e@\x19@\x1f@\x1a@\xc4\xdb\xc6\xdbk\xdcj\xdc\x10\xdd\x0eݪݮ\xddF\xdeN\xde\xdf\xde\xe8\xdey\xdf~\xdf\x10\xe0\x11\xe0\xa4\xe0\xa0\xe0.\xe11\xe1\xc1\xe1\xbe\xe1G\xe2I\xe2\xd1\xe2\xcf\xe2U\xe3V\xe3\xd4\xe3\xd8\xe3U\xe4]\xe4\xda\xe4\xd8\xe4Q\xe5T\xe5\xcd\xe5\xd1\xe5F\xe6E\xe6\xba\xe6\xbd\xe6*\xe72\xe7\x9a\xe7\xa1\xe7\f\xe8\x13\xe8z\xe8|\xe8\xe7\xe8\xe8\xe8R\xe9Q\xe9\xb6\xe9\xb6\xe9$\xea\x1b\xea\x83\xea\x83\xea\xe5\xea\xe6\xeaB\xebF\xeb\xa4\xeb\xa6\xeb\x03\xec\xfe\xebc\xecY\xec\xb7\xec\xb2\xec\x12\xed\n\xede\xedg\xed\xb9\xed\xba\xed\v\xee\x0f\xee_\xee_\xee\xae\xee\xb4\xee\x00\xef\xfb\xeeO\xefE\xef\x96\xef\x9c\xef\xe1\xef\xe6\xef-\xf0,\xf0w\xf0u\xf0\xbb\xf0\xba\xf0\x04\xf1\x03\xf1B\xf1G\xf1\x87\xf1\x8c\xf1\xc7\xf1\xcb\xf1\x0e\xf2\n\xf2O\xf2L\xf2\x8a\xf2\x8c\xf2\xc8\xf2\xc9\xf2\x03\xf3\x04\xf3@\xf3@\xf3z\xf3w\xf3!@\x19@\x1b@\x19@\x1d@\x1f@o\"n\"\xe2\xda\xe3ڍۍ\xdb4\xdc1\xdc\xd6\xdc\xd3\xdcy\xddv\xdd\x10\xde\x16ޭް\xdeG\xdfK\xdf\xdc\xdf\xdd\xdfp\xe0o\xe0\xfd\xe0\x03\xe1\x8d\xe1\x8f\xe1\x18\xe2\x17\xe2\x9e\xe2\xa5\xe2$\xe3)\xe3\xac\xe3\xa9\xe30\xe4,\xe4\xaa\xe4\xaa\xe4*\xe5%\xe5\xa3\xe5\xa1\xe5\x1a\xe6\x19\xe6\x90\xe6\x93\xe6\x06\xe7\a\xe7w\xe7u\xe7\xe4\xe7\xe5\xe7S\xe8W\xe8\xbf\xe8\xc2\xe8&\xe90\xe9\x8f\xe9\x99\xe9\xf4\xe9\xfd\xe9\\\xea^\xea\xbe\xea\xbf\xea%\xeb\x1d\xeb\x80\xeb\x80\xeb\xde\xeb\xdd\xeb9\xec>\xec\x94\xec\x91\xec\xeb\xec\xee\xecD\xedA\xed\x99\xed\x95\xed\xf0\xed\xea\xed>\xee>\xee\x92\xee\x91\xee\xde\xee\xe0\xee,\xef0\xef|\xef|\xef\xc3\xef\xc4\xef\x12\xf0\x11\xf0Z\xf0Y\xf0\x9e\xf0\xa0\xf0\xe5\xf0\xe6\xf0*\xf1,\xf1n\xf1p\xf1\xb1\xf1\xb1\xf1\xf2\xf1\xee\xf14\xf20\xf2m\xf2r\xf2\xb0\xf2\xaf\xf2\xef\xf2\xeb\xf2-\xf3$\xf3a\xf3f\xf3\x99\xf3\x9e\xf3\xd1\xf3\xd6\xf3\n\xf4\x10\xf4?\xf4F\xf4\x1b@\x19@\x19@\x1c@\x18@\x1b@ @\x18@3\xd96\xd9\xdf\xd9\xe6ٕڑ\xda<\xdb;\xdb\xe3\xdb\xe4ۋ܆\xdc,\xdd*\xdd\xc7\xdd\xcb\xdde\xdee\xde\xff\xde\xffޕߖ\xdf*\xe0$\xe0\xbb\xe0\xbc\xe0C\xe1H\xe1\xd6\xe1\xd6\xe1\\\xe2_\xe2\xe5\xe2\xe4\xe2i\xe3g\xe3\xee\xe3\xed\xe3l\xe4k\xe4\xea\xe4\xeb\xe4_\xe5j\xe5\xda\xe5\xe0\xe5S\xe6W\xe6\xcd\xe6\xcb\xe6=\xe7<\xe7\xae\xe7\xac\xe7\x1f\xe8\x1b\xe8\x8d\xe8\x87\xe8\xf6\xe8\xf1\xe8^\xe9_\xe9\xc7\xe9\xc6\xe9'\xea'\xea\x8d\xea\x8a\xea\xf1\xea\xea\xeaO\xebN\xeb\xad\xeb\xaf\xeb\f\xec\b\xech\xecd\xec\xbb\xec\xbf\xec\x17\xed\x1a\xedk\xedo\xed\xbd\xed\xbf\xed\x14\xee\x15\xeeh\xee`\xee\xb8\xee\xb4\xee\b\xef\x05\xefR\xefR\xef\x9b\xef\x9b\xef\xe8\xef\xe6\xef3\xf03\xf0|\xf0x\xf0\xbe\xf0\xc2\xf0\x03\xf1\x05\xf1M\xf1I\xf1\x8d\xf1\x8a\xf1\xcf\xf1\xcf\xf1\x0f\xf2\x10\xf2R\xf2I\xf2\x8b\xf2\x8f\xf2\xc9\xf2\xcb\xf2\x04\xf3\n\xf3?\xf3@\xf3|\xf3\x80\xf3\xb2\xf3\xb4\xf3\xec\xf3\xed\xf3#\xf4$\xf4[\xf4Z\xf4\x8f\xf4\x90\xf4\xc6\xf4\xbd\xf4\xfb\xf4\xf4\xf4)\xf5$\xf5\x95?\x92?\x17@\x1c@\x15@\x1f@\x18@\x19@\xfa=\xf7=\xf2\xd7\xf8ףح\xd8Z\xd9Z\xd9\x0e\xda\aڷڼ\xda^\xdbf\xdb\x05\xdc\x06ܬܬ\xdcO\xddM\xdd\xe8\xdd\xea݆ރ\xde!\xdf\x1b߶ߵ\xdfF\xe0F\xe0\xd9\xe0\xd3\xe0d\xe1e\xe1\xef\xe1\xf0\xe1v\xe2{\xe2\xfd\xe2\x01\xe3\x86\xe3\x82\xe3\x05\xe4\x05\xe4\x83\xe4\x83\xe4\x01\xe5\x01\xe5w\xe5\x80\xe5\xf6\xe5\xf6\xe5j\xe6j\xe6\xdf\xe6\xdd\xe6Q\xe7S\xe7\xc5\xe7\xc2\xe7.\xe8.\xe8\x9c\xe8\x9e\xe8\x06\xe9\a\xe9p\xe9o\xe9\xd2\xe9\xd8\xe97\xea>\xea\x9f\xea\xa0\xea\xfd\xea\x00\xeb`\xeb]\xeb\xbf\xeb\xb8\xeb\x1e\xec\x15\xecv\xecu\xec\xcd\xec\xcb\xec&\xed&\xedy\xedy\xed\xc8\xed\xd2\xed\x1f\xee$\xeet\xeet\xee\xbe\xee\xc6\xee\r\xef\x13\xefY\xef^\xef\xa8\xef\xa7\xef\xf2\xef\xf4\xef<\xf0?\xf0\x83\xf0\x87\xf0\xc7\xf0\xca\xf0\x10\xf1\x13\xf1O\xf1T\xf1\x97\xf1\x9b\xf1\xd7\xf1\xd9\xf1\x1a\xf2\x14\xf2V\xf2W\xf2\x95\xf2\x97\xf2\xd2\xf2\xd3\xf2\x0f\xf3\r\xf3I\xf3F\xf3\x84\xf3\x81\xf3\xbd\xf3\xbc\xf3\xf2\xf3\xf5\xf3*\xf4'\xf4a\xf4\\\xf4\x9f\xf4\x93\xf4\xc6\xf4\xc6\xf4\xfc\xf4\x00\xf5/\xf50\xf5]\xf5`\xf5\x8d\xf5\x94\xf5\xb9\xf5\xc4\xf5\xeb\xf5\xee\xf5\x15\xf6 \xf6H\xf6J\xf6t8u8\x1a@\x1a@\x18@\x16@\x19@\x18@\x14@\x1a@\xafհ\xd5t\xd6p\xd62\xd7,\xd7\xe5\xd7\xe9ן؛\xd8P\xd9R\xd9\xfa\xd9\x05ڱڬ\xdaU\xdbT\xdb\xfc\xdb\xfdۛܤ\xdc@\xddC\xdd\xe1\xdd\xdf\xddx\xdex\xde\x16\xdf\x13߬ߤ\xdf=\xe0;\xe0\xcf\xe0\xcc\xe0Z\xe1X\xe1\xe7\xe1\xe2\xe1r\xe2o\xe2\xf3\xe2\xf3\xe2w\xe3u\xe3\xfc\xe3\xfa\xe3z\xe4z\xe4\xf5\xe4\xf2\xe4t\xe5j\xe5\xef\xe5\xe9\xe5`\xe6a\xe6\xd0\xe6\xd9\xe6B\xe7G\xe7\xb3\xe7\xbc\xe7'\xe8&\xe8\x8e\xe8\x8f\xe8\xfe\xe8\xfb\xe8e\xe9b\xe9\xca\xe9\xcd\xe90\xea2\xea\x94\xea\x90\xea\xf8\xea\xf1\xea[\xebP\xeb\xb5\xeb\xb1\xeb\x0e\xec\x0e\xeci\xecg\xec\xc4\xec\xc0\xec\x1b\xed\x18\xedm\xedm\xed\xc2\xed\xc6\xed\x1a\xee\x11\xeen\xeec\xee\xbc\xee\xb3\xee\x05\xef\x05\xefQ\xefP\xef\xa1\xef\x9d\xef\xec\xef\xe7\xef0\xf02\xf0{\xf0w\xf0\xc2\xf0\xbb\xf0\v\xf1\x01\xf1N\xf1D\xf1\x90\xf1\x88\xf1\xcc\xf1\xc8\xf1\x14\xf2\x0f\xf2N\xf2M\xf2\x8d\xf2\x8d\xf2\xc8\xf2\xc6\xf2\x05\xf3\x02\xf3@\xf3@\xf3x\xf3\x81\xf3\xaf\xf3\xb2\xf3\xe8\xf3\xec\xf3#\xf4 \xf4X\xf4U\xf4\x8c\xf4\x8c\xf4\xbe\xf4\xc0\xf4\xf6\xf4\xf1\xf4*\xf5 \xf5Y\xf5R\xf5\x89\xf5\x85\xf5\xb7\xf5\xb7\xf5\xe5\xf5\xe4\xf5\x14\xf6\x10\xf6@\xf6>\xf6m\xf6k\xf6\x98\xf6\x96\xf6\xc5\xf6\xc4\xf6\xea\xf6\xee\xf6\x12\xf7\x1b\xf7<\xf7=\xf7f\xf7b\xf7\x8f\xf7\x89\xf7\xb4\xf7\xb1\xf7\x17@\x15@\x16@\x15@\x1a@\x11@\x1c@\x16@\x17@\x15@\x9fә\xd3d\xd4c\xd4-\xd5.\xd5\xea\xd5\xf0ը֯\xd6h\xd7e\xd7 \xd8 \xd8\xd3\xd8\xd5\u0605م\xd97\xda6\xda\xdc\xda\xdfڃۉ\xdb-\xdc+\xdc\xd1\xdc\xd1\xdcp\xddn\xdd\v\xde\rިޥ\xde@\xdf<\xdf\xd1\xdf\xd2\xdfd\xe0c\xe0\xf4\xe0\xf7\xe0{\xe1\x81\xe1\n\xe2\r\xe2\x91\xe2\x90\xe2\x19\xe3\x1b\xe3\x9d\xe3\x96\xe3\x1b\xe4\x1c\xe4\x9d\xe4\x95\xe4\x19\xe5\x15\xe5\x92\xe5\x93\xe5\a\xe6\a\xe6~\xe6{\xe6\xf2\xe6\xee\xe6g\xe7d\xe7\xd2\xe7\xd6\xe7?\xe8>\xe8\xa9\xe8\xb2\xe8\x15\xe9\x14\xe9}\xe9~\xe9\xe4\xe9\xe6\xe9H\xeaE\xea\xa9\xea\xaa\xea\v\xeb\n\xebm\xebg\xeb\xc7\xeb\xc8\xeb\"\xec&\xec{\xec}\xec\xd8\xec\xd4\xec0\xed+\xed\x83\xed\x81\xed\xd6\xed\xd3\xed+\xee%\xeeu\xeey\xee\xc9\xee\xca\xee\x12\xef\x18\xef`\xefc\xef\xa8\xef\xae\xef\xf8\xef\xf9\xefA\xf0>\xf0\x89\xf0\x84\xf0\xd2\xf0\xcd\xf0\x12\xf1\x15\xf1T\xf1Y\xf1\x9c\xf1\x9a\xf1\xdc\xf1\xd9\xf1\x1c\xf2\x18\xf2[\xf2W\xf2\x97\xf2\x97\xf2\xd6\xf2\xd4\xf2\x10\xf3\x0e\xf3J\xf3N\xf3\x84\xf3\x83\xf3\xb9\xf3\xbe\xf3\xf0\xf3\xf6\xf3'\xf4.\xf4a\xf4b\xf4\x93\xf4\x95\xf4\xca\xf4\xc7\xf4\xfc\xf4\xff\xf4,\xf5.\xf5_\xf5\\\xf5\x8d\xf5\x89\xf5\xbd\xf5\xc2\xf5\xec\xf5\xea\xf5\x18\xf6\x1b\xf6D\xf6H\xf6p\xf6q\xf6\xa1\xf6\x9d\xf6\xcb\xf6\xc5\xf6\xf5\xf6\xf0\xf6\x1a\xf7\x1d\xf7B\xf7A\xf7l\xf7h\xf7\x93\xf7\x93\xf7\xba\xf7\xb6\xf7\xe0\xf7\xdc\xf7\x04\xf8\xfd\xf7-\xf8%\xf8O\xf8G\xf8r\xf8i\xf8\x8d\xf8\x91\xf8\xb4\xf8\xb2\xf8\xd1\xf8\xce\xf8\xf7\xf8\xed\xf8\x17\xf9\x11\xf98\xf9/\xf9\x15@\x18@\x13@\x12@\x15@\x15@\x17@\x16@\x18@\x17@\x14@\x14@\x82%\x85%\x96ї\xd1i\xd2e\xd29\xd3/\xd3\x02\xd4\xfc\xd3\xcb\xd4\xc2ԌՋ\xd5M\xd6K\xd6\n\xd7\t\xd7\xc2\xd7\xc0\xd7r\xd8y\xd8+\xd9*\xd9\xd8\xd9\xd7وڅ\xda-\xdb1\xdb\xd7\xdb\xd8\xdby\xdcz\xdc\x1e\xdd\x1aݶݽ\xddT\xdeV\xde\xeb\xde\xedނ߄\xdf\x15\xe0\x11\xe0\xa8\xe0\xa6\xe02\xe14\xe1\xc0\xe1\xc3\xe1H\xe2G\xe2\xce\xe2\xd0\xe2R\xe3O\xe3\xd4\xe3\xd3\xe3Q\xe4R\xe4\xd1\xe4\xce\xe4N\xe5J\xe5\xc5\xe5\xc4\xe5=\xe69\xe6\xb1\xe6\xb4\xe6#\xe7!\xe7\x90\xe7\x94\xe7\x03\xe8\x01\xe8n\xe8l\xe8\xd9\xe8\xd9\xe8>\xe9A\xe9\xa6\xe9\xa4\xe9\r\xea\r\xear\xeao\xea\xd3\xea\xd1\xea5\xeb1\xeb\x8f\xeb\x8c\xeb\xec\xeb\xe8\xebJ\xecI\xec\xa2\xec\x9d\xec\xf5\xec\xf8\xecP\xedK\xed\xa3\xed\xa3\xed\xf9\xed\xf4\xedG\xeeD\xee\x97\xee\x95\xee\xe9\xee\xe6\xee3\xef2\xef\u007f\xef~\xef\xc4\xef\xcd\xef\x10\xf0\x12\xf0\\\xf0]\xf0\xa5\xf0\xa0\xf0\xea\xf0\xe8\xf0-\xf1'\xf1p\xf1n\xf1\xb2\xf1\xad\xf1\xef\xf1\xf3\xf13\xf2/\xf2u\xf2j\xf2\xb0\xf2\xaa\xf2\xe6\xf2\xea\xf2#\xf3#\xf3]\xf3_\xf3\x94\xf3\x9d\xf3\xc9\xf3\xd5\xf3\x00\xf4\a\xf49\xf4>\xf4r\xf4o\xf4\xa5\xf4\xa4\xf4\xdd\xf4\xdc\xf4\v\xf5\t\xf5:\xf5A\xf5m\xf5n\xf5\x9a\xf5\x9c\xf5\xcf\xf5\xc9\xf5\xfc\xf5\xfd\xf5'\xf6*\xf6P\xf6W\xf6\x81\xf6\u007f\xf6\xab\xf6\xa7\xf6\xd8\xf6\xd3\xf6\xfe\xf6\xfb\xf6)\xf7(\xf7P\xf7O\xf7w\xf7r\xf7\x9f\xf7\xa1\xf7\xbf\xf7\xc3\xf7\xea\xf7\xeb\xf7\f\xf8\f\xf81\xf80\xf8T\xf8V\xf8r\xf8w\xf8\x99\xf8\x9a\xf8\xb9\xf8\xba\xf8\xdc\xf8\xd9\xf8\xfa\xf8\xfd\xf8\x1a\xf9\x1a\xf99\xf97\xf9[\xf9W\xf9v\xf9x\xf9\x94\xf9\x94\xf9\xb3\xf9\xad\xf9\xd3\xf9\xcd\xf9\xea\xf9\xe8\xf9\x05\xfa\x05\xfa!\xfa\x1d\xfa?\xfa;\xfaR\xfaS\xfam\xfao\xfa\x8a\xfa\x83\xfa\xa4\xfa\xa1\xfa\xb5\xfa\xbd\xfa\xca\xfa\xd4\xfa\xe6\xfa\xec\xfa\x12@\x15@\x10@\x14@\x13@\x12@\x12@\x14@\x13@\x15@\x13@\x13@\x15@\x13@\x87>\x88>/\xce2\xce\x12\xcf\x13\xcf\xec\xcf\xed\xcf\xc9\xd0\xc5Мў\xd1s\xd2i\xd2=\xd3:\xd3\x05\xd4\a\xd4\xcb\xd4\xcfԏՎ\xd5N\xd6T\xd6\x0e\xd7\b\xd7\xc7\xd7\xc1\xd7}\xd8y\xd8-\xd9.\xd9\xdb\xd9\xdbىڂ\xda3\xdb1\xdb\xd9\xdb\xd4\xdb{\xdcy\xdc\x1b\xdd\x1bݸݾ\xddQ\xdeV\xde\xec\xde\xebށ\xdf~\xdf\x13\xe0\x0f\xe0\xa5\xe0\xa2\xe04\xe14\xe1\xb7\xe1\xbf\xe1A\xe2H\xe2\xc3\xe2\xcd\xe2J\xe3M\xe3\xcf\xe3\xd2\xe3P\xe4J\xe4\xcd\xe4\xcc\xe4E\xe5G\xe5\xbf\xe5\xbf\xe51\xe67\xe6\xa9\xe6\xad\xe6\x1b\xe7\x1c\xe7\x8e\xe7\x87\xe7\xfc\xe7\xfe\xe7e\xe8c\xe8\xd1\xe8\xd8\xe89\xe9<\xe9\x9c\xe9\xa1\xe9\x05\xea\b\xeag\xeai\xea\xca\xea\xce\xea'\xeb.\xeb\x86\xeb\x83\xeb\xe4\xeb\xe4\xebB\xec>\xec\x94\xec\x9c\xec\xef\xec\xf2\xecF\xedD\xed\x9b\xed\x9a\xed\xee\xed\xee\xedC\xee=\xee\x90\xee\x8c\xee\xdb\xee\xd9\xee,\xef-\xefu\xeft\xef\xc3\xef\xc2\xef\t\xf0\x12\xf0L\xf0W\xf0\x9b\xf0\x95\xf0\xda\xf0\xdc\xf0\"\xf1&\xf1a\xf1f\xf1\xaa\xf1\xa7\xf1\xeb\xf1\xe7\xf1*\xf2'\xf2f\xf2g\xf2\xa1\xf2\xa4\xf2\xdd\xf2\xdf\xf2\x1b\xf3\x1b\xf3T\xf3T\xf3\x8c\xf3\x8d\xf3\xc7\xf3\xc6\xf3\x00\xf4\xfa\xf37\xf41\xf4k\xf4f\xf4\x9e\xf4\x9b\xf4\xcf\xf4\xcf\xf4\x04\xf5\x02\xf54\xf54\xf5d\xf5i\xf5\x93\xf5\x96\xf5\xc5\xf5\xc7\xf5\xed\xf5\xf7\xf5\x1a\xf6 \xf6L\xf6I\xf6x\xf6x\xf6\xa3\xf6\xa4\xf6\xc8\xf6\xcb\xf6\xf4\xf6\xf5\xf6\x1b\xf7#\xf7F\xf7F\xf7o\xf7n\xf7\x93\xf7\x97\xf7\xb7\xf7\xbe\xf7\xda\xf7\xe2\xf7\x02\xf8\x05\xf8+\xf8'\xf8K\xf8L\xf8l\xf8q\xf8\x93\xf8\x8d\xf8\xb2\xf8\xb2\xf8\xd2\xf8\xd4\xf8\xf3\xf8\xf6\xf8\x11\xf9\x13\xf91\xf92\xf9V\xf9P\xf9q\xf9o\xf9\x8d\xf9\x8b\xf9\xa9\xf9\xaf\xf9\xc6\xf9\xc7\xf9\xdd\xf9\xe5\xf9\xff\xf9\xff\xf9\x1a\xfa\x1b\xfa3\xfa7\xfaI\xfaQ\xfac\xfai\xfa\u007f\xfa\x85\xfa\x94\xfa\x9a\xfa\xb2\xfa\xb2\xfa\xc8\xfa\xca\xfa\xe2\xfa\xe5\xfa\xf4\xfa\xfb\xfa\x0e\xfb\x11\xfb(\xfb\"\xfb;\xfb=\xfbR\xfbR\xfbc\xfbc\xfb{\xfbw\xfb\x91\xfb\x8e\xfb\xa4\xfb\xa1\xfb\xb8\xfb\xb4\xfb\xcc\xfb\xca\xfb\xdc\xfb\xd9\xfb\xef\xfb\xef\xfb\x02\xfc\xff\xfb\x15\xfc\x15\xfc%\xfc'\xfc5\xfc;\xfcG\xfcG\xfcY\xfcZ\xfcg\xfci\xfcz\xfcy\xfc\x8b\xfc\x86\xfc\x97\xfc\x9a\xfc\xa5\xfc\xac\xfc\xb4\xfc\xb7\xfc\xde?\xdf?\x11@\x11@\x15@\x13@\x11@\x12@\x10@\x15@\x0f@\x10@\r@\x14@\x13@\x12@\x13@\x0e@\x15@\x13@\u007fɀ\xc9p\xcao\xcai\xcbf\xcbR\xccM\xcc?\xcd<\xcd\x18\xce\"\xce\xfa\xce\x02\xcf\xdb\xcf\xdaϵгЉъ\xd1]\xd2Z\xd2*\xd3\"\xd3\xf2\xd3\xf2ӺԳ\xd4z\xd5u\xd5>\xd69\xd6\xf5\xd6\xf3֪\u05ed\xd7h\xd8b\xd8\x18\xd9\x16\xd9\xc3\xd9\xcd\xd9n\xdau\xda\x15\xdb\x19\xdb\xc1ۿ\xdbc\xdce\xdc\x00\xdd\x06ݟݥ\xdd8\xde;\xde\xd6\xde\xd2\xdeg\xdfl\xdf\xfb\xdf\xfb߈\xe0\x8a\xe0\x19\xe1\x1c\xe1\xa3\xe1\xa4\xe1)\xe2-\xe2\xb1\xe2\xb6\xe26\xe38\xe3\xb4\xe3\xb3\xe38\xe45\xe4\xb8\xe4\xb6\xe40\xe5.\xe5\xa6\xe5\xa4\xe5\x1c\xe6\"\xe6\x90\xe6\x91\xe6\a\xe7\x04\xe7u\xe7w\xe7\xe1\xe7\xe6\xe7P\xe8N\xe8\xbe\xe8\xb4\xe8#\xe9#\xe9\x8b\xe9\x8a\xe9\xed\xe9\xf0\xe9Y\xeaP\xea\xb3\xea\xaf\xea\x16\xeb\x11\xebs\xebq\xeb\xcc\xeb\xcf\xeb&\xec(\xec\x83\xec\x82\xec\xdf\xec\xd7\xec/\xed.\xed\x82\xed\x88\xed\xd5\xed\xda\xed'\xee,\xeex\xeex\xee\xc3\xee\xcb\xee\x16\xef\x17\xefd\xefa\xef\xaf\xef\xac\xef\xf5\xef\xf5\xef9\xf0C\xf0\u007f\xf0\x87\xf0\xc9\xf0\xd0\xf0\n\xf1\x11\xf1P\xf1T\xf1\x94\xf1\x96\xf1\xcf\xf1\xd6\xf1\x12\xf2\x14\xf2U\xf2R\xf2\x8f\xf2\x91\xf2\xcc\xf2\xca\xf2\t\xf3\b\xf3D\xf3A\xf3{\xf3~\xf3\xb6\xf3\xb0\xf3\xea\xf3\xe6\xf3$\xf4\x1c\xf4Z\xf4U\xf4\x89\xf4\x8e\xf4\xbd\xf4\xbe\xf4\xed\xf4\xf2\xf4!\xf5$\xf5O\xf5R\xf5\x82\xf5\x82\xf5\xb5\xf5\xb3\xf5\xde\xf5\xe7\xf5\v\xf6\x10\xf64\xf6B\xf6f\xf6l\xf6\x8b\xf6\x96\xf6\xb7\xf6\xbd\xf6\xe7\xf6\xe1\xf6\x13\xf7\n\xf79\xf74\xf7`\xf7`\xf7\x83\xf7\x86\xf7\xad\xf7\xaa\xf7\xce\xf7\xd0\xf7\xf5\xf7\xf8\xf7\x19\xf8\x12\xf8?\xf8?\xf8b\xf8`\xf8~\xf8\x86\xf8\xa3\xf8\xa2\xf8\xc5\xf8\xc7\xf8\xe1\xf8\xe4\xf8\x04\xf9\t\xf9\"\xf9%\xf9C\xf9C\xf9`\xf9e\xf9~\xf9\x80\xf9\x9b\xf9\x9b\xf9\xbb\xf9\xbb\xf9\xd9\xf9\xd6\xf9\xf3\xf9\xed\xf9\x13\xfa\r\xfa'\xfa(\xfa<\xfaF\xfaY\xfaZ\xfap\xfaw\xfa\x8d\xfa\x8e\xfa\xa6\xfa\xa7\xfa\xbd\xfa\xbd\xfa\xda\xfa\xd5\xfa\xec\xfa\xec\xfa\x02\xfb\x05\xfb\x19\xfb\x17\xfb1\xfb-\xfbF\xfbA\xfbZ\xfbZ\xfbs\xfbl\xfb\x86\xfb\x80\xfb\x99\xfb\x98\xfb\xa8\xfb\xaf\xfb\xba\xfb\xc3\xfb\xcf\xfb\xd1\xfb\xe8\xfb\xe2\xfb\xfa\xfb\xf8\xfb\n\xfc\r\xfc\x14\xfc\x1e\xfc*\xfc2\xfc<\xfc@\xfcK\xfcO\xfca\xfcc\xfco\xfcp\xfc\x80\xfc\x80\xfc\x91\xfc\x8c\xfc\xa1\xfc\xa1\xfc\xaf\xfc\xae\xfc\xbc\xfc\xbe\xfc\xcd\xfc\xc5\xfc\xdf\xfc\xd9\xfc\xec\xfc\xe7\xfc\xf4\xfc\xfb\xfc\x00\xfd\x05\xfd\x10\xfd\x13\xfd \xfd\x1e\xfd+\xfd)\xfd9\xfd:\xfdC\xfdB\xfdU\xfdP\xfd^\xfdY\xfdk\xfde\xfdw\xfdr\xfd\x84\xfd}\xfd\x8b\xfd\x84\xfd\x9a\xfd\x94\xfd\xa8\xfd\x9d\xfd\xad\xfd\xa7\xfd\xba\xfd\xb4\xfd\xbe\xfd\xc2\xfd\xcd\xfd\xcd\xfd\xd4\xfd\xd1\xfd\xdf\xfd\xe0\xfd\xe7\xfd\xea\xfd\xee\xfd\xf1\xfd\xfd\xfd\xf7\xfd\x04\xfe\x05\xfe\r\xfe\t\xfe\x13\xfe\x13\xfe \xfe\x1e\xfe!\xfe&\xfe/\xfe/\xfe6\xfe5\xfe<\xfeD\xfeC\xfeJ\xfeJ\xfeQ\xfeS\xfeS\xfe_\xfeY\xfef\xfeb\xfem\xfeo\xfeq\xfes\xfex\xfex\xfe|\xfe\x86\xfe\x86\xfe\x8a\xfe\r@\x11@\x0f@\r@\x14@\n@\x16@\r@\x11@\v@\r@\x14@\x0f@\r@\x12@\r@\x10@\r@\x14@\x0e@\x11@\x11@\x10@\x0f@\x0e@\r@-\xe5.\xe5{\xc4}ĂōŇƏƍǏǋȇȆɁ\xc9v\xcay\xcaj\xcbi\xcbO\xccW\xcc7\xcdB\xcd\x19\xce$\xce\xfa\xce\xfd\xce\xd8\xcf\xdcϴаЄу\xd1S\xd2Q\xd2\"\xd3 \xd3\xea\xd3\xeeӭԳ\xd4r\xd5s\xd51\xd60\xd6\xf0\xd6\xf0֦ף\xd7V\xd8[\xd8\n\xd9\tٹٻ\xd9f\xdac\xda\x10\xdb\n۵ۯ\xdbT\xdcW\xdc\xf2\xdc\xf8ܓݖ\xdd.\xde-\xde\xc7\xde\xc1\xde]\xdfZ\xdf\xef\xdf\xe6\xdf~\xe0|\xe0\t\xe1\a\xe1\x92\xe1\x93\xe1\x1a\xe2\x1f\xe2\x9e\xe2\xa4\xe2#\xe3&\xe3\xa9\xe3\x9f\xe3'\xe4$\xe4\xa5\xe4\xa0\xe4\x1c\xe5\x1c\xe5\x97\xe5\x97\xe5\t\xe6\b\xe6\x81\xe6\x81\xe6\xf5\xe6\xef\xe6^\xe7b\xe7\xcf\xe7\xd1\xe79\xe8;\xe8\xa9\xe8\xaa\xe8\x0e\xe9\x11\xe9w\xe9s\xe9\xda\xe9\xd9\xe9B\xea:\xea\xa0\xea\x9c\xea\x03\xeb\xfb\xea^\xeb_\xeb\xb6\xeb\xb9\xeb\x13\xec\x16\xecm\xecm\xec\xc4\xec\xcb\xec\x16\xed\x19\xedp\xedo\xed\xc4\xed\xc3\xed\x15\xee\x11\xeee\xeeb\xee\xb3\xee\xb2\xee\x00\xef\xfd\xeeL\xefK\xef\x9c\xef\x97\xef\xe1\xef\xe2\xef*\xf0*\xf0k\xf0r\xf0\xb3\xf0\xba\xf0\xf7\xf0\x00\xf1;\xf1?\xf1~\xf1~\xf1\xc4\xf1\xbd\xf1\x04\xf2\xfe\xf1=\xf2=\xf2|\xf2|\xf2\xb8\xf2\xba\xf2\xf2\xf2\xf6\xf2/\xf31\xf3j\xf3h\xf3\x9e\xf3\x9f\xf3\xdc\xf3\xd5\xf3\x12\xf4\r\xf4D\xf4G\xf4x\xf4y\xf4\xa9\xf4\xaf\xf4\xdd\xf4\xde\xf4\x0f\xf5\x11\xf5@\xf5@\xf5t\xf5l\xf5\xa2\xf5\xa3\xf5\xd0\xf5\xce\xf5\xfc\xf5\xff\xf5*\xf6)\xf6U\xf6T\xf6\x81\xf6~\xf6\xa9\xf6\xaf\xf6\xd2\xf6\xd2\xf6\x00\xf7\xfe\xf6+\xf7&\xf7Q\xf7J\xf7q\xf7u\xf7\x9a\xf7\x9b\xf7\xc0\xf7\xc3\xf7\xe9\xf7\xe7\xf7\f\xf8\x05\xf8.\xf8,\xf8S\xf8P\xf8q\xf8u\xf8\x94\xf8\x96\xf8\xb0\xf8\xb8\xf8\xd5\xf8\xd7\xf8\xf5\xf8\xf5\xf8\x17\xf9\x18\xf93\xf97\xf9V\xf9T\xf9s\xf9p\xf9\x8c\xf9\x91\xf9\xa9\xf9\xa7\xf9\xc6\xf9\xcb\xf9\xe3\xf9\xe4\xf9\xfd\xf9\xfd\xf9\x1a\xfa\x18\xfa5\xfa3\xfaG\xfaL\xfah\xfaj\xfa\x81\xfa\x80\xfa\x9a\xfa\x96\xfa\xb1\xfa\xb2\xfa\xc5\xfa\xca\xfa\xe1\xfa\xe0\xfa\xf5\xfa\xf6\xfa\n\xfb\x10\xfb\"\xfb%\xfb9\xfb4\xfbL\xfbO\xfbc\xfbb\xfbz\xfbx\xfb\x8d\xfb\x8a\xfb\xa2\xfb\x9d\xfb\xb8\xfb\xb0\xfb\xc9\xfb\xc5\xfb\xda\xfb\xdd\xfb\xea\xfb\xee\xfb\xfb\xfb\x01\xfc\r\xfc\x12\xfc%\xfc!\xfc3\xfc4\xfcA\xfcH\xfcQ\xfcV\xfcd\xfcj\xfcs\xfcr\xfc\x84\xfc\x88\xfc\x91\xfc\x96\xfc\xa4\xfc\xa4\xfc\xb2\xfc\xb1\xfc\xc0\xfc\xc6\xfc\xd1\xfc\xd1\xfc\xda\xfc\xe0\xfc\xed\xfc\xed\xfc\xf7\xfc\xfc\xfc\a\xfd\a\xfd\x18\xfd\x15\xfd\x1f\xfd#\xfd.\xfd/\xfd=\xfd=\xfdO\xfdD\xfdW\xfdO\xfdd\xfd[\xfdo\xfdm\xfdx\xfdt\xfd\x87\xfd\x85\xfd\x8d\xfd\x8e\xfd\x99\xfd\x96\xfd\xa1\xfd\x9f\xfd\xb0\xfd\xaa\xfd\xb7\xfd\xb9\xfd\xc5\xfd\xc1\xfd\xcb\xfd\xcc\xfd\xd7\xfd\xd5\xfd\xe0\xfd\xe0\xfd\xeb\xfd\xeb\xfd\xf6\xfd\xee\xfd\xfc\xfd\xfd\xfd\x06\xfe\x01\xfe\x13\xfe\x0f\xfe!\xfe\x1d\xfe4\xfe.\xfe@\xfeA\xfeO\xfeQ\xfe\\\xfeb\xfel\xfeo\xfe|\xfe|\xfe\x86\xfe\x8a\xfe\x94\xfe\x9b\xfe\x9f\xfe\xa8\xfe\xac\xfe\xb3\xfe\xbe\xfe\xbe\xfe\xc8\xfe\xc7\xfe\xd1\xfe\xd6\xfe\xde\xfe\xdf\xfe\xe9\xfe\xec\xfe\xf3\xfe\xfb\xfe\xfd\xfe\x02\xff\n\xff\v\xff\x11\xff\x16\xff\x18\xff%\xff#\xff-\xff,\xff4\xff6\xff6\xffi\x1f\v \xbb Z!\x05\"\xaf\"T#\x04$\xa4$Q%\xf4%\xa0&F'\xed'\x98(<)\xe5)\x8e*2+\xdf+\x82,+-\xd0-~. /\xc6/n0\x151\xc01a2\n3\xb03R4\xf84\xb14T5\t5\xa25U5\xf15\xa1566\xe15w6\xc0\xc3\"\xc3y\xc2\xdf\xc19\xc1\x97\xc0\xf5\xbf\xf3\xbf\xf5\xbf\xfa\xbf\xb3\xc0\xb4\xc0\xcc\xc1\xd0\xc1\xe2\xc2\xe6\xc2\xf0\xc3\xf4\xc3\xfc\xc4\x03\xc5\x01\xc6\a\xc6\x04\xc7\n\xc7\x02\xc8\x03\xc8\x00\xc9\xfa\xc8\xf9\xc9\xef\xc9\xe8\xca\xe0\xca\xcf\xcb\xd0˸̸̜͜\xcd~\xce\u007f\xceV\xcfY\xcf3\xd01\xd0\x04\xd1\a\xd1\xdd\xd1\xd7Ѣҥ\xd2q\xd3r\xd36\xd44\xd4\xf5\xd4\xf8Ըպ\xd5v\xd6s\xd61\xd7*\xd7\xe6\xd7\xdbךؓ\xd8K\xd9?\xd9\xf3\xd9\xf0ٙڛ\xda?\xdbG\xdb\xe3\xdb\xe4ۆ܄\xdc%\xdd\x1f\xdd\xc3ݿ\xddT\xdeV\xde\xed\xde\xecށ\xdf~\xdf\x12\xe0\x10\xe0\xa1\xe0\xa0\xe0)\xe1'\xe1\xb3\xe1\xb9\xe1;\xe29\xe2\xbe\xe2\xc1\xe2=\xe3>\xe3\xbe\xe3\xc4\xe3<\xe4>\xe4\xba\xe4\xba\xe43\xe55\xe5\xa9\xe5\xab\xe5\x1f\xe6\"\xe6\x92\xe6\x94\xe6\x00\xe7\x06\xe7t\xe7t\xe7\xde\xe7\xe0\xe7Q\xe8L\xe8\xb4\xe8\xb7\xe8\x1c\xe9\x1c\xe9\u007f\xe9\x87\xe9\xe7\xe9\xe9\xe9K\xeaC\xea\xb1\xea\xa4\xea\x0e\xeb\x04\xebg\xebc\xeb\xc0\xeb\xc4\xeb\x1b\xec\x19\xect\xecn\xec\xce\xec\xcc\xec\x1f\xed\"\xedr\xedt\xed\xc2\xed\xca\xed\x16\xee\x1d\xeec\xeeg\xee\xb6\xee\xb6\xee\x05\xef\xfe\xeeO\xefN\xef\x98\xef\x99\xef\xe1\xef\xe6\xef*\xf0&\xf0q\xf0p\xf0\xb3\xf0\xb7\xf0\xf8\xf0\xf7\xf0;\xf1=\xf1x\xf1\u007f\xf1\xbb\xf1\xbd\xf1\x00\xf2\xf9\xf1>\xf26\xf2z\xf2w\xf2\xb4\xf2\xb4\xf2\xf1\xf2\xee\xf22\xf3%\xf3e\xf3c\xf3\x9d\xf3\x9c\xf3\xd0\xf3\xcf\xf3\b\xf4\t\xf4<\xf4A\xf4t\xf4r\xf4\xa2\xf4\xa4\xf4\xd9\xf4\xd6\xf4\n\xf5\t\xf58\xf5:\xf5k\xf5k\xf5\x9b\xf5\x99\xf5\xc3\xf5\xc8\xf5\xf5\xf5\xf2\xf5#\xf6\x1d\xf6M\xf6M\xf6x\xf6y\xf6\xa1\xf6\xa2\xf6\xc7\xf6\xce\xf6\xef\xf6\xfc\xf6\x16\xf7\x1f\xf7E\xf7D\xf7l\xf7m\xf7\x8f\xf7\x91\xf7\xb6\xf7\xb7\xf7\xdf\xf7\xde\xf7\x04\xf8\xfa\xf7'\xf8 \xf8H\xf8F\xf8h\xf8h\xf8\x8a\xf8\x8c\xf8\xab\xf8\xaf\xf8\xc8\xf8\xcf\xf8\xea\xf8\xec\xf8\f\xf9\v\xf90\xf9&\xf9N\xf9D\xf9f\xf9d\xf9\x85\xf9\x88\xf9\xa0\xf9\xa0\xf9\xbd\xf9\xbe\xf9\xd6\xf9\xd8\xf9\xf4\xf9\xf2\xf9\x12\xfa\x10\xfa(\xfa+\xfa>\xfaG\xfa[\xfa`\xfau\xfat\xfa\x8e\xfa\x8a\xfa\xa9\xfa\xa5\xfa\xc0\xfa\xbe\xfa\xd7\xfa\xd4\xfa\xef\xfa\xea\xfa\x04\xfb\x00\xfb\x19\xfb\x1b\xfb-\xfb3\xfb?\xfbI\xfbU\xfbX\xfbr\xfbp\xfb\x83\xfb\x84\xfb\x96\xfb\x96\xfb\xa9\xfb\xaa\xfb\xc1\xfb\xbc\xfb\xd3\xfb\xd1\xfb\xe3\xfb\xe1\xfb\xf5\xfb\xf5\xfb\x05\xfc\a\xfc\x19\xfc\x1b\xfc)\xfc*\xfc<\xfc7\xfcK\xfcJ\xfc_\xfcZ\xfco\xfcn\xfc\u007f\xfc}\xfc\x88\xfc\x93\xfc\x9b\xfc\x9a\xfc\xac\xfc\xa7\xfc\xb7\xfc\xb9\xfc\xc8\xfc\xc9\xfc\xd5\xfc\xd5\xfc\xe3\xfc\xe4\xfc\xf0\xfc\xf6\xfc\xfc\xfc\x02\xfd\f\xfd\n\xfd\x1a\xfd\x19\xfd&\xfd)\xfd0\xfd3\xfd@\xfdB\xfdM\xfdN\xfdV\xfdW\xfdc\xfdf\xfdo\xfdp\xfdy\xfd~\xfd\x85\xfd\x86\xfd\x90\xfd\x94\xfd\x9d\xfd\x9d\xfd\xa3\xfd\xa8\xfd\xb1\xfd\xaf\xfd\xbc\xfd\xbb\xfd\xc4\xfd\xc6\xfd\xd3\xfd\xcc\xfd\xdf\xfd\xd1\xfd\xea\xfd\xda\xfd\xf1\xfd\xe8\xfd\xf4\xfd\xf2\xfd\xfb\xfd\x01\xfe\a\xfe\x10\xfe\x16\xfe\x1f\xfe*\xfe-\xfeA\xfeB\xfeQ\xfeR\xfeZ\xfef\xfel\xfey\xfe\x80\xfe\x83\xfe\x8d\xfe\x91\xfe\xa0\xfe\xa1\xfe\xaf\xfe\xae\xfe\xbd\xfe\xbe\xfe\xc6\xfe\xcf\xfe\xd0\xfe\xe3\xfe\xdf\xfe\xec\xfe\xe9\xfe\xf7\xfe\xf9\xfe\x05\xff\x06\xff\x0e\xff\x10\xff\x1b\xff\x1c\xff(\xff%\xff1\xff2\xff;\xff<\xffC\xffJ\xffM\xffR\xffV\xffLISTJ\x00\x00\x00INFOICRD\f\x00\x00\x002015-01-17\x00\x00ISFT*\x00\x00\x00Sony Sound Forge 7.0 (libsndfile-1.0.24)\x00\x00id3 H\x00\x00\x00ID3\x03\x00\x00\x00\x00\x00=TDRC\x00\x00\x00\v\x00\x00\x002015-01-17TXXX\x00\x00\x00\x1e\x00\x00\x00Software\x00Sony Sound Forge 7.0\x00")
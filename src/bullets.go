@@ -1,6 +1,8 @@
 package main
 
-import ()
+import (
+	"sort"
+)
 
 // Bullet is our player bullets
 type Bullet struct {
@@ -13,14 +15,97 @@ type Bullet struct {
 	angle       int
 	toDelete    bool
 	hitbox      Hitbox
+	transform   Transform // rotation, scale and flip, on top of angle
+	pierce      int       // how many more targets it passes through, 0 stops at the next one
+	hits        []*Actor  // targets already hit, a bullet never hits the same one twice
 }
 
-//Bullets is an array of bullet
+// Bullets is an array of bullet
 type Bullets struct {
 	bullets []*Bullet
 	num     int
 }
 
+// Clean removes bullets that are to be deleted, reusing the same backing array
+func (b *Bullets) Clean() {
+	kept := b.bullets[:0]
+	for _, bullet := range b.bullets {
+		if !bullet.toDelete {
+			kept = append(kept, bullet)
+		}
+	}
+	for i := len(kept); i < len(b.bullets); i++ {
+		b.bullets[i] = nil // let the removed bullets be collected
+	}
+	b.bullets = kept
+	b.num = len(kept)
+}
+
+// HasHit reports whether the bullet has already hit this actor
+func (b *Bullet) HasHit(a *Actor) bool {
+	for _, h := range b.hits {
+		if h == a {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolve hits the overlapping targets in the order the bullet reaches them,
+// nearest along its direction of travel first, with ties broken by actor id so
+// the result never depends on how the candidates were found. A bullet that is
+// already spent, or a target that is dead or already hit, is skipped. Each hit
+// uses up one pierce and the bullet is spent once it has none left.
+func (b *Bullet) Resolve(targets []*Actor, hit func(a *Actor)) {
+	if b.toDelete {
+		return
+	}
+
+	// dead targets can never be hit again, so stop holding on to them
+	kept := b.hits[:0]
+	for _, a := range b.hits {
+		if !a.toDelete {
+			kept = append(kept, a)
+		}
+	}
+	for i := len(kept); i < len(b.hits); i++ {
+		b.hits[i] = nil
+	}
+	b.hits = kept
+
+	// distance along the direction of travel, or straight up when the bullet is still
+	cx, cy := b.x+b.hitbox.x+b.hitbox.w/2, b.y+b.hitbox.y+b.hitbox.h/2
+	dx, dy := b.vx, b.vy
+	if dx == 0 && dy == 0 {
+		dy = -1
+	}
+	along := func(a *Actor) float64 {
+		ax, ay := a.x+a.hitbox.x+a.hitbox.w/2, a.y+a.hitbox.y+a.hitbox.h/2
+		return (ax-cx)*dx + (ay-cy)*dy
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		di, dj := along(targets[i]), along(targets[j])
+		if di != dj {
+			return di < dj
+		}
+		return targets[i].id < targets[j].id
+	})
+
+	for _, a := range targets {
+		if a.toDelete || b.HasHit(a) {
+			continue
+		}
+		b.hits = append(b.hits, a)
+		hit(a)
+		if b.pierce <= 0 {
+			b.toDelete = true
+			b.hits = nil
+			return
+		}
+		b.pierce--
+	}
+}
+
 // func bulletExists(arr []*Bullet, index int) bool {
 // 	return (len(arr) > index)
 // }
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBulletResolve(t *testing.T) {
+	// a bullet flying up through a column of 8x8 targets, y is each target's top
+	tests := []struct {
+		name      string
+		pierce    int
+		targets   []float64 // y of each target, its id is its index
+		hit       []int     // ids the bullet has already hit
+		dead      []int     // ids already killed
+		want      []int     // ids hit, in order
+		wantSpent bool
+	}{
+		{name: "one target", targets: []float64{90}, want: []int{0}, wantSpent: true},
+		{name: "two overlapping, nearest first", targets: []float64{90, 96}, want: []int{1}, wantSpent: true},
+		{name: "three overlapping, nearest first", targets: []float64{86, 98, 92}, want: []int{1}, wantSpent: true},
+		{name: "pierce 1 hits two in order", pierce: 1, targets: []float64{86, 98, 92}, want: []int{1, 2}, wantSpent: true},
+		{name: "pierce 2 hits three in order", pierce: 2, targets: []float64{86, 98, 92}, want: []int{1, 2, 0}, wantSpent: true},
+		{name: "pierce left over", pierce: 5, targets: []float64{86, 98}, want: []int{1, 0}},
+		{name: "already hit is skipped", targets: []float64{90, 96}, hit: []int{1}, want: []int{0}, wantSpent: true},
+		{name: "dead is skipped", targets: []float64{90, 96}, dead: []int{1}, want: []int{0}, wantSpent: true},
+		{name: "nothing left to hit", targets: []float64{90, 96}, hit: []int{0}, dead: []int{1}},
+		{name: "equal distance by id", targets: []float64{92, 92, 92}, want: []int{0}, wantSpent: true},
+		{name: "equal distance pierce by id", pierce: 2, targets: []float64{92, 92, 92}, want: []int{0, 1, 2}, wantSpent: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bullet{x: 100, y: 100, vy: -6, pierce: tt.pierce, hitbox: Hitbox{w: 8, h: 8}}
+			actors := make([]*Actor, len(tt.targets))
+			for i, y := range tt.targets {
+				actors[i] = &Actor{id: i, x: 100, y: y, hitbox: Hitbox{w: 8, h: 8}}
+			}
+			for _, id := range tt.hit {
+				b.hits = append(b.hits, actors[id])
+			}
+			for _, id := range tt.dead {
+				actors[id].toDelete = true
+			}
+
+			// the candidates come in reverse id order, the result must not depend on it
+			targets := make([]*Actor, len(actors))
+			for i, a := range actors {
+				targets[len(actors)-1-i] = a
+			}
+			var got []int
+			b.Resolve(targets, func(a *Actor) {
+				got = append(got, a.id)
+			})
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("hit %v, want %v", got, tt.want)
+			}
+			if b.toDelete != tt.wantSpent {
+				t.Errorf("spent = %v, want %v", b.toDelete, tt.wantSpent)
+			}
+		})
+	}
+}
+
+func TestBulletResolveSpentBulletHitsNothing(t *testing.T) {
+	b := &Bullet{x: 100, y: 100, vy: -6, toDelete: true, hitbox: Hitbox{w: 8, h: 8}}
+	b.Resolve([]*Actor{{x: 100, y: 96, hitbox: Hitbox{w: 8, h: 8}}}, func(a *Actor) {
+		t.Errorf("a spent bullet hit something")
+	})
+}
+
+func TestBulletResolveForgetsDeadTargets(t *testing.T) {
+	b := &Bullet{x: 100, y: 100, vy: -6, pierce: 3, hitbox: Hitbox{w: 8, h: 8}}
+	first := &Actor{id: 0, x: 100, y: 96, hitbox: Hitbox{w: 8, h: 8}}
+	b.Resolve([]*Actor{first}, func(a *Actor) { a.Kill() })
+	if len(b.hits) != 1 {
+		t.Fatalf("hits after the first target = %d, want 1", len(b.hits))
+	}
+
+	second := &Actor{id: 1, x: 100, y: 90, hitbox: Hitbox{w: 8, h: 8}}
+	b.Resolve([]*Actor{second}, func(a *Actor) {})
+	if len(b.hits) != 1 || b.hits[0] != second {
+		t.Errorf("hits = %v, want only the live second target", b.hits)
+	}
+
+	b.pierce = 0
+	b.Resolve([]*Actor{{id: 2, x: 100, y: 94, hitbox: Hitbox{w: 8, h: 8}}}, func(a *Actor) {})
+	if !b.toDelete || b.hits != nil {
+		t.Errorf("a spent bullet should let go of its hits, got %d", len(b.hits))
+	}
+}
@@ -0,0 +1,23 @@
+// Code generated by go run ./tools. DO NOT EDIT.
+
+package main
+
+// embeddedAssets are the files bundled from assets/ by name, large ones are added by -tags large
+var embeddedAssets = map[string][]byte{
+	"atlas-1.png":                      bundleAtlas1Png,
+	"atlas-1.xml":                      bundleAtlas1Xml,
+	"audio/pulse-bass.wav":             bundleAudioPulseBassWav,
+	"audio/pulse-drums.wav":            bundleAudioPulseDrumsWav,
+	"audio/pulse-lead.wav":             bundleAudioPulseLeadWav,
+	"audio/sfx_exp_cluster5.wav":       bundleAudioSfxExpCluster5Wav,
+	"audio/sfx_exp_short_hard2.wav":    bundleAudioSfxExpShortHard2Wav,
+	"audio/sfx_weapon_shotgun2.wav":    bundleAudioSfxWeaponShotgun2Wav,
+	"audio/sfx_weapon_singleshot6.wav": bundleAudioSfxWeaponSingleshot6Wav,
+	"audio/sfx_wpn_laser12.wav":        bundleAudioSfxWpnLaser12Wav,
+	"data/animations.json":             bundleDataAnimationsJson,
+	"data/emitters.json":               bundleDataEmittersJson,
+	"data/sfx.json":                    bundleDataSfxJson,
+	"data/sounds.json":                 bundleDataSoundsJson,
+	"data/synth.json":                  bundleDataSynthJson,
+	"manifest.json":                    bundleManifestJson,
+}
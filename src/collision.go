@@ -0,0 +1,140 @@
+package main
+
+// CollisionLayer says what kind of thing a collider is, the matrix in Collisions says which layers meet
+type CollisionLayer int
+
+const (
+	collisionNone CollisionLayer = iota // never collides, the default for new actors
+	collisionPlayer
+	collisionPlayerBullet
+	collisionEnemy
+	collisionEnemyBullet
+	numCollisionLayers
+)
+
+// Collider is one party in a collision, only the field for its layer is set
+type Collider struct {
+	layer  CollisionLayer
+	player *Player
+	bullet *Bullet
+	actor  *Actor
+}
+
+// CollisionHandler is called with both parties, in the order the handler was registered with
+type CollisionHandler func(g *Game, a Collider, b Collider)
+
+// Collisions is the pair matrix of layers that collide and the handlers for each pair
+type Collisions struct {
+	matrix   [numCollisionLayers][numCollisionLayers]bool
+	handlers [numCollisionLayers][numCollisionLayers][]CollisionHandler
+}
+
+// Enable lets two layers collide without adding a handler
+func (c *Collisions) Enable(a CollisionLayer, b CollisionLayer) {
+	c.matrix[a][b] = true
+	c.matrix[b][a] = true
+}
+
+// Collides reports whether two layers are allowed to collide
+func (c *Collisions) Collides(a CollisionLayer, b CollisionLayer) bool {
+	return c.matrix[a][b]
+}
+
+// On enables a pair of layers and adds a handler for when they collide
+func (c *Collisions) On(a CollisionLayer, b CollisionLayer, handler CollisionHandler) {
+	c.Enable(a, b)
+	c.handlers[a][b] = append(c.handlers[a][b], handler)
+}
+
+// Dispatch calls the handlers for a collision, swapping the parties to match how each was registered
+func (c *Collisions) Dispatch(g *Game, a Collider, b Collider) {
+	for _, h := range c.handlers[a.layer][b.layer] {
+		h(g, a, b)
+	}
+	if a.layer != b.layer {
+		for _, h := range c.handlers[b.layer][a.layer] {
+			h(g, b, a)
+		}
+	}
+}
+
+// setupCollisions declares which layers collide and what happens when they do
+func (g *Game) setupCollisions() {
+	g.collisions = Collisions{}
+
+	g.collisions.On(collisionPlayerBullet, collisionEnemy, func(g *Game, bullet Collider, enemy Collider) {
+		b, a := bullet.bullet, enemy.actor // the bullet spends itself in Resolve
+		a.Kill()
+		g.score++
+		g.Emit("explosion_small", b.x, b.y)
+		g.EmitShards("debris", a.sprite, a.x, a.y)
+		g.EventAt(eventEnemyKilled, a.x+float64(a.imageWidth)/2)
+	})
+
+	g.collisions.On(collisionPlayer, collisionEnemy, func(g *Game, player Collider, enemy Collider) {
+		player.player.toDelete = true
+	})
+
+	g.collisions.On(collisionPlayer, collisionEnemyBullet, func(g *Game, player Collider, bullet Collider) {
+		player.player.toDelete = true
+	})
+}
+
+// actorCollider wraps an actor as a collision party
+func actorCollider(a *Actor) Collider {
+	return Collider{layer: a.collision, actor: a}
+}
+
+// collideBullet checks a player bullet against the actors near it, the bullet decides which it actually hits
+func (g *Game) collideBullet(b *Bullet) {
+	if b.toDelete {
+		return
+	}
+	// fast bullets are tested along the path to where they will be after this tick
+	fast := isFast(b.vx, b.vy)
+	bx, by, bw, bh := b.x+b.hitbox.x, b.y+b.hitbox.y, b.hitbox.w, b.hitbox.h
+	if fast {
+		bx, by, bw, bh = sweptBounds(b.x, b.y, b.hitbox, b.vx, b.vy)
+	}
+	candidates := g.actors.Query(bx, by, bw, bh)
+	overlapping := candidates[:0] // filter the query result in place
+	for _, a := range candidates {
+		if !g.collisions.Collides(collisionPlayerBullet, a.collision) {
+			continue
+		}
+		if fast {
+			if sweptHitTest(b.x, b.y, b.hitbox, b.vx, b.vy, a.x, a.y, a.hitbox) {
+				overlapping = append(overlapping, a)
+			}
+		} else if hitTest(a.x, a.y, a.hitbox, b.x, b.y, b.hitbox) {
+			overlapping = append(overlapping, a)
+		}
+	}
+	b.Resolve(overlapping, func(a *Actor) {
+		g.collisions.Dispatch(g, Collider{layer: collisionPlayerBullet, bullet: b}, actorCollider(a))
+	})
+}
+
+// collidePlayer checks the player against the actors near it, while not protected by spawn safety
+func (g *Game) collidePlayer() {
+	p := &g.player
+	if !p.alive || p.safety > 0 {
+		return
+	}
+	px, py := p.x+p.hitbox.x, p.y+p.hitbox.y
+	for _, a := range g.actors.Query(px, py, p.hitbox.w, p.hitbox.h) {
+		if a.toDelete || !g.collisions.Collides(collisionPlayer, a.collision) {
+			continue
+		}
+		hit := false
+		if isFast(a.vx, a.vy) {
+			// swept from where it was at the start of this tick
+			hit = sweptHitTest(a.x-a.vx, a.y-a.vy, a.hitbox, a.vx, a.vy, p.x, p.y, p.hitbox)
+		} else {
+			hit = hitTest(p.x, p.y, p.hitbox, a.x, a.y, a.hitbox)
+		}
+		if hit {
+			g.collisions.Dispatch(g, Collider{layer: collisionPlayer, player: p}, actorCollider(a))
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+	"github.com/hajimehoshi/ebiten/inpututil"
+	"image/color"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+const debugKey = ebiten.KeyF3 // toggles the debug overlay
+
+var (
+	debugPlayerColor = color.NRGBA{0x00, 0xff, 0x00, 0xcc}
+	debugEnemyColor  = color.NRGBA{0xff, 0x00, 0x00, 0xcc}
+	debugBulletColor = color.NRGBA{0xff, 0xff, 0x00, 0xcc}
+)
+
+// updateDebug turns the overlay on and off with the debug key
+func (g *Game) updateDebug() {
+	if inpututil.IsKeyJustPressed(debugKey) {
+		g.debug = !g.debug
+	}
+}
+
+// submitOutline queues a one pixel rectangle outline
+func submitOutline(q *RenderQueue, x float64, y float64, w float64, h float64, clr color.Color) {
+	q.SubmitRect(x, y, w, 1, clr, layerDebug)
+	q.SubmitRect(x, y+h-1, w, 1, clr, layerDebug)
+	q.SubmitRect(x, y, 1, h, clr, layerDebug)
+	q.SubmitRect(x+w-1, y, 1, h, clr, layerDebug)
+}
+
+// submitShape queues the outline of a hitbox owned by something at x, y in the shape it collides with
+func submitShape(q *RenderQueue, x float64, y float64, h Hitbox, clr color.Color) {
+	switch h.shape {
+	case shapeCircle:
+		cx, cy, r := h.circle(x, y)
+		steps := int(2*math.Pi*r) + 1
+		for i := 0; i < steps; i++ {
+			angle := 2 * math.Pi * float64(i) / float64(steps)
+			q.SubmitRect(math.Floor(cx+ldX(r, angle)), math.Floor(cy+ldY(r, angle)), 1, 1, clr, layerDebug)
+		}
+	case shapePixels:
+		if h.mask != nil {
+			// turned the way the sprite is drawn, then trimmed by the box
+			for _, p := range h.mask.edge {
+				px, py := h.turn(float64(p[0])+0.5, float64(p[1])+0.5)
+				px, py = math.Floor(px), math.Floor(py)
+				if px >= h.x && py >= h.y && px < h.x+h.w && py < h.y+h.h {
+					q.SubmitRect(x+px, y+py, 1, 1, clr, layerDebug)
+				}
+			}
+			return
+		}
+		submitOutline(q, x+h.x, y+h.y, h.w, h.h, clr)
+	default:
+		submitOutline(q, x+h.x, y+h.y, h.w, h.h, clr)
+	}
+}
+
+// submitHitboxes queues outlines for the hitbox of everything that can collide
+func (g *Game) submitHitboxes() {
+	if g.player.alive {
+		p := g.player
+		submitShape(&g.render, p.x, p.y, p.hitbox, debugPlayerColor)
+	}
+	for _, a := range g.actors.actors {
+		if a.toDelete {
+			continue
+		}
+		clr := debugEnemyColor
+		if a.group == groupEnemyBullet {
+			clr = debugBulletColor
+		}
+		submitShape(&g.render, a.x, a.y, a.hitbox, clr)
+	}
+	for _, b := range g.bullets.bullets {
+		if b.toDelete {
+			continue
+		}
+		submitShape(&g.render, b.x, b.y, b.hitbox, debugPlayerColor)
+	}
+}
+
+// durationMS is a duration in milliseconds, for printing
+func durationMS(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// drawDebugText prints timings, entity counts, the player and every gamepad under the score line
+func (g *Game) drawDebugText(screen *ebiten.Image) {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "FPS: %0.1f  TPS: %0.1f\n", ebiten.CurrentFPS(), ebiten.CurrentTPS())
+	fmt.Fprintf(&sb, "Update: %0.2fms  Draw: %0.2fms\n", durationMS(g.updateTime), durationMS(g.drawTime))
+
+	groups := make(map[string]int)
+	for _, a := range g.actors.actors {
+		groups[a.group]++
+	}
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	counts := make([]string, 0, len(names))
+	for _, name := range names {
+		counts = append(counts, fmt.Sprintf("%s %d", name, groups[name]))
+	}
+	fmt.Fprintf(&sb, "Actors: %s\n", strings.Join(counts, ", "))
+	fmt.Fprintf(&sb, "Bullets: %d  Particles: %d\n", len(g.bullets.bullets), g.particles.num)
+	fmt.Fprintf(&sb, "Player: x-%d y-%d safety %d alive %t\n", int(g.player.x), int(g.player.y), g.player.safety, g.player.alive)
+	fmt.Fprintf(&sb, "Intensity: %0.2f\n", g.music.intensity)
+
+	if len(g.gamepadIDs) == 0 {
+		sb.WriteString("Please connect your gamepad.\n")
+	}
+	ids := make([]int, 0, len(g.gamepadIDs))
+	for id := range g.gamepadIDs {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		fmt.Fprintf(&sb, "Gamepad (ID: %d, SDL ID: %s):\n", id, ebiten.GamepadSDLID(id))
+		fmt.Fprintf(&sb, "  Axes:    %s\n", strings.Join(g.axes[id], ", "))
+		fmt.Fprintf(&sb, "  Buttons: %s\n", strings.Join(g.pressedButtons[id], ", "))
+	}
+
+	ebitenutil.DebugPrintAt(screen, sb.String(), 0, 16)
+}
@@ -0,0 +1,302 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hajimehoshi/ebiten"
+	"log"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// ParticleDef is one burst of particles within an emitter, read from assets/data/emitters.json
+type ParticleDef struct {
+	Count     [2]int     `json:"count"`     // min and max particles spawned
+	Spread    [2]float64 `json:"spread"`    // particles start anywhere in this width and height from the emit point
+	VX        [2]float64 `json:"vx"`        // min and max horizontal speed
+	VY        [2]float64 `json:"vy"`        // min and max vertical speed
+	Size      [2]float64 `json:"size"`      // min and max starting size, 100 is the sprite at its own size
+	SizeV     float64    `json:"sizeV"`     // size change every tick
+	SizeCurve []CurveKey `json:"sizeCurve"` // scales the size over the particle's life, blended between keys
+	Life      [2]int     `json:"life"`      // min and max ticks to live
+	Forever   bool       `json:"forever"`   // never dies, life is ignored
+	Wrap      bool       `json:"wrap"`      // wrap back to the top once it falls off the bottom
+	Stretch   float64    `json:"stretch"`   // when set, height is scaled by vy/stretch and slow particles fade, like the stars
+	Sprite    SpriteName `json:"sprite"`    // atlas sprite, particles without one are never drawn
+	Layer     string     `json:"layer"`     // render layer name, see renderLayers
+	Colors    []string   `json:"colors"`    // up to three colour stops the sprite blends through over its life, as #rrggbb or #rrggbbaa
+	Fade      float64    `json:"fade"`      // fraction of the life after which alpha fades out, 0 never fades
+	Spin      [2]float64 `json:"spin"`      // min and max rotation speed in radians per tick
+	Gravity   float64    `json:"gravity"`   // added to vy every tick
+	Shards    [2]int     `json:"shards"`    // columns and rows to cut a sprite into, only used by EmitShards
+	Burst     float64    `json:"burst"`     // shards fly out from the centre at this speed per pixel of distance
+	Blend     string     `json:"blend"`     // "alpha" draws normally, "add" brightens what is underneath for glows
+
+	sprite    SpriteID        // resolved Sprite
+	layer     int             // resolved Layer
+	colors    []ebiten.ColorM // resolved Colors
+	colorRamp []ebiten.ColorM // Colors and Fade at colorSteps+1 ages, nil when the sprite keeps its own colour
+	blend     ebiten.CompositeMode
+	emitter   string // name of the emitter this is part of
+	index     int    // position in the emitter's parts
+	source    []byte // the part as written without spaces, to tell on reload whether it changed
+}
+
+// CurveKey is an [age, value] point on a curve over a particle's life, ages run from 0 to 1
+type CurveKey [2]float64
+
+// Emitter is a named effect made of one or more particle bursts
+type Emitter struct {
+	name  string
+	parts []*ParticleDef
+}
+
+// renderLayers are the layer names emitters can use
+var renderLayers = map[string]int{
+	"background":  layerBackground,
+	"trail":       layerTrail,
+	"enemy":       layerEnemy,
+	"player":      layerPlayer,
+	"effect":      layerEffect,
+	"bullet":      layerBullet,
+	"enemyBullet": layerEnemyBullet,
+	"ui":          layerUI,
+}
+
+// blendModes are the blend names emitters can use
+var blendModes = map[string]ebiten.CompositeMode{
+	"alpha": ebiten.CompositeModeSourceOver,
+	"add":   ebiten.CompositeModeLighter,
+}
+
+// parseColor reads #rrggbb or #rrggbbaa into colour scales between 0 and 1
+func parseColor(s string) ([4]float64, error) {
+	c := [4]float64{1, 1, 1, 1}
+	hex := strings.TrimPrefix(s, "#")
+	if len(hex) != 6 && len(hex) != 8 {
+		return c, fmt.Errorf("colour %q should be #rrggbb or #rrggbbaa", s)
+	}
+	for i := 0; i < len(hex)/2; i++ {
+		v, err := strconv.ParseUint(hex[i*2:i*2+2], 16, 8)
+		if err != nil {
+			return c, fmt.Errorf("colour %q: %v", s, err)
+		}
+		c[i] = float64(v) / 255
+	}
+	return c, nil
+}
+
+// loadEmitters reads the emitter definitions and resolves their sprites, layers and colours
+func loadEmitters(data []byte, sprites *SpriteTable) (map[string]*Emitter, error) {
+	var defs map[string][]json.RawMessage
+	if err := json.Unmarshal(data, &defs); err != nil {
+		return nil, fmt.Errorf("emitters: %v", err)
+	}
+
+	emitters := make(map[string]*Emitter, len(defs))
+	for name, sources := range defs {
+		parts := make([]*ParticleDef, len(sources))
+		for i, source := range sources {
+			p := &ParticleDef{emitter: name, index: i}
+			if err := json.Unmarshal(source, p); err != nil {
+				return nil, fmt.Errorf("emitter %s: %v", name, err)
+			}
+			var compact bytes.Buffer
+			if err := json.Compact(&compact, source); err != nil {
+				return nil, fmt.Errorf("emitter %s: %v", name, err)
+			}
+			p.source = compact.Bytes()
+			parts[i] = p
+
+			p.sprite = noSprite
+			if p.Sprite != "" {
+				p.sprite = sprites.ID(p.Sprite)
+				if p.sprite == noSprite {
+					return nil, fmt.Errorf("emitter %s: no sprite named %q", name, p.Sprite)
+				}
+			}
+
+			p.layer = layerEffect
+			if p.Layer != "" {
+				layer, ok := renderLayers[p.Layer]
+				if !ok {
+					return nil, fmt.Errorf("emitter %s: no layer named %q", name, p.Layer)
+				}
+				p.layer = layer
+			}
+
+			p.blend = ebiten.CompositeModeSourceOver
+			if p.Blend != "" {
+				blend, ok := blendModes[p.Blend]
+				if !ok {
+					return nil, fmt.Errorf("emitter %s: no blend mode named %q", name, p.Blend)
+				}
+				p.blend = blend
+			}
+
+			for i, key := range p.SizeCurve {
+				if key[0] < 0 || key[0] > 1 || (i > 0 && key[0] < p.SizeCurve[i-1][0]) {
+					return nil, fmt.Errorf("emitter %s: size curve ages should run from 0 to 1 in order, got %v", name, key[0])
+				}
+			}
+
+			if len(p.Colors) > 3 {
+				return nil, fmt.Errorf("emitter %s: at most 3 colours, got %d", name, len(p.Colors))
+			}
+			for _, s := range p.Colors {
+				c, err := parseColor(s)
+				if err != nil {
+					return nil, fmt.Errorf("emitter %s: %v", name, err)
+				}
+				p.colors = append(p.colors, ebiten.ScaleColor(c[0], c[1], c[2], c[3]))
+			}
+			if len(p.colors) > 0 || (p.Fade > 0 && p.Fade < 1) {
+				p.colorRamp = make([]ebiten.ColorM, colorSteps+1)
+				for j := range p.colorRamp {
+					p.colorRamp[j] = blendColor(p.colors, p.Fade, float64(j)/colorSteps)
+				}
+			}
+		}
+		emitters[name] = &Emitter{name: name, parts: parts}
+	}
+	return emitters, nil
+}
+
+// reloaded is the same part in a freshly loaded set of emitters, nil if it was
+// removed or changed
+func (p *ParticleDef) reloaded(emitters map[string]*Emitter) *ParticleDef {
+	e, ok := emitters[p.emitter]
+	if !ok || p.index >= len(e.parts) {
+		return nil
+	}
+	def := e.parts[p.index]
+	if !bytes.Equal(def.source, p.source) {
+		return nil
+	}
+	return def
+}
+
+// sizeScale is the size curve at an age from 0 to 1, 1 without a curve
+func (p *ParticleDef) sizeScale(age float64) float64 {
+	keys := p.SizeCurve
+	if len(keys) == 0 {
+		return 1
+	}
+	if age <= keys[0][0] {
+		return keys[0][1]
+	}
+	for i := 1; i < len(keys); i++ {
+		if age <= keys[i][0] {
+			a, b := keys[i-1], keys[i]
+			if b[0] == a[0] {
+				return b[1]
+			}
+			return a[1] + (b[1]-a[1])*(age-a[0])/(b[0]-a[0])
+		}
+	}
+	return keys[len(keys)-1][1]
+}
+
+// randFloat picks a value between min and max
+func randFloat(r [2]float64) float64 {
+	return r[0] + rand.Float64()*(r[1]-r[0])
+}
+
+// randInt picks a value between min and max inclusive
+func randInt(r [2]int) int {
+	if r[1] <= r[0] {
+		return r[0]
+	}
+	return r[0] + rand.Intn(r[1]-r[0]+1)
+}
+
+// emitter looks up an effect by name. A missing one is logged the first time
+// only, as some effects are emitted every tick.
+func (g *Game) emitter(name string) (*Emitter, bool) {
+	e, ok := g.emitters[name]
+	if !ok && !g.noEmitter[name] {
+		if g.noEmitter == nil {
+			g.noEmitter = make(map[string]bool)
+		}
+		g.noEmitter[name] = true
+		log.Printf("no emitter named %q", name)
+	}
+	return e, ok
+}
+
+// Emit spawns the named effect at x, y
+func (g *Game) Emit(name string, x float64, y float64) {
+	e, ok := g.emitter(name)
+	if !ok {
+		return
+	}
+	for _, def := range e.parts {
+		count := randInt(def.Count)
+		for i := 0; i < count; i++ {
+			p := g.particles.Spawn()
+			if p == nil {
+				return // pool is full
+			}
+			life := randInt(def.Life)
+			*p = Particle{
+				x:       x + rand.Float64()*def.Spread[0],
+				y:       y + rand.Float64()*def.Spread[1],
+				vx:      randFloat(def.VX),
+				vy:      randFloat(def.VY),
+				size:    randFloat(def.Size),
+				sizev:   def.SizeV,
+				life:    life,
+				maxLife: life,
+				forever: def.Forever,
+				spin:    randFloat(def.Spin),
+				gravity: def.Gravity,
+				def:     def,
+				tintVY:  math.NaN(),
+			}
+		}
+	}
+}
+
+// EmitShards breaks a sprite drawn with its top left at x, y into the pieces set by
+// the named emitter's shards grid, each one flying out from the middle of the sprite
+func (g *Game) EmitShards(name string, sprite SpriteName, x float64, y float64) {
+	e, ok := g.emitter(name)
+	if !ok {
+		return
+	}
+	id := g.spriteTable.ID(sprite)
+	w, h := g.spriteTable.Size(id)
+	midX, midY := x+float64(w)/2, y+float64(h)/2
+
+	for _, def := range e.parts {
+		cols, rows := def.Shards[0], def.Shards[1]
+		for i, img := range g.spriteTable.Shards(id, cols, rows) {
+			p := g.particles.Spawn()
+			if p == nil {
+				return // pool is full
+			}
+			sw, sh := img.Size()
+			cx := x + float64((i%cols)*w/cols) + float64(sw)/2
+			cy := y + float64((i/cols)*h/rows) + float64(sh)/2
+			life := randInt(def.Life)
+			*p = Particle{
+				x:       cx,
+				y:       cy,
+				vx:      randFloat(def.VX) + (cx-midX)*def.Burst,
+				vy:      randFloat(def.VY) + (cy-midY)*def.Burst,
+				size:    randFloat(def.Size),
+				sizev:   def.SizeV,
+				life:    life,
+				maxLife: life,
+				spin:    randFloat(def.Spin),
+				gravity: def.Gravity,
+				image:   img,
+				def:     def,
+				tintVY:  math.NaN(),
+			}
+		}
+	}
+}
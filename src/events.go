@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GameEvent is something that happens in the game that can make a sound
+type GameEvent string
+
+const (
+	eventPlayerShot  GameEvent = "player_shot"
+	eventPlayerDied  GameEvent = "player_died"
+	eventEnemyFired  GameEvent = "enemy_fired"
+	eventEnemyKilled GameEvent = "enemy_killed"
+	eventEnemyMissed GameEvent = "enemy_missed" // an enemy bullet left the bottom of the screen
+	eventWaveStart   GameEvent = "wave_start"
+	eventWaveCleared GameEvent = "wave_cleared"
+	eventLowLives    GameEvent = "low_lives"
+	eventMenuMove    GameEvent = "menu_move"
+	eventMenuSelect  GameEvent = "menu_select"
+)
+
+// gameEvents are the events assets/data/sounds.json can give a sound. There is
+// no extra life event as the game has no rule that gives a life yet, add one
+// here alongside that rule.
+var gameEvents = []GameEvent{
+	eventPlayerShot, eventPlayerDied, eventEnemyFired, eventEnemyKilled, eventEnemyMissed,
+	eventWaveStart, eventWaveCleared, eventLowLives, eventMenuMove, eventMenuSelect,
+}
+
+// EventSound is the sound an event plays, read from assets/data/sounds.json
+type EventSound struct {
+	Sound  SoundName `json:"sound"`  // name in the audio manager, a WAV or a synth sound
+	Volume float64   `json:"volume"` // 1 if not set
+	Pan    bool      `json:"pan"`    // pan to where the event happened
+}
+
+// loadSoundEvents reads the event to sound table, every sound must be loaded already
+func loadSoundEvents(data []byte, m *AudioManager) (map[GameEvent]*EventSound, error) {
+	var defs map[GameEvent]*EventSound
+	if err := json.Unmarshal(data, &defs); err != nil {
+		return nil, fmt.Errorf("sounds: %v", err)
+	}
+	known := make(map[GameEvent]bool, len(gameEvents))
+	for _, e := range gameEvents {
+		known[e] = true
+	}
+	for e, s := range defs {
+		if !known[e] {
+			return nil, fmt.Errorf("sounds: no event named %q", e)
+		}
+		if !m.Has(s.Sound) {
+			return nil, fmt.Errorf("sounds %s: no sound named %q", e, s.Sound)
+		}
+		if s.Volume == 0 {
+			s.Volume = 1
+		}
+	}
+	return defs, nil
+}
+
+// Event plays the sound for an event, centred. Events without a sound are silent.
+func (g *Game) Event(e GameEvent) {
+	if s, ok := g.soundEvents[e]; ok {
+		g.sfx.Play(s.Sound, s.Volume, 0)
+	}
+}
+
+// EventAt plays the sound for an event that happened at x, panned there if the table asks for it
+func (g *Game) EventAt(e GameEvent, x float64) {
+	s, ok := g.soundEvents[e]
+	if !ok {
+		return
+	}
+	pan := 0.0
+	if s.Pan {
+		pan = screenPan(x)
+	}
+	g.sfx.Play(s.Sound, s.Volume, pan)
+}
@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package main
-
-var packagepng = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x02\x00\x00\x00\x02\x00\b\x06\x00\x00\x00\xf4x\xd4\xfa\x00\x00'\x16IDATx\x9c\xec\xdd\r\x90ee}'\xfe\xa7[H\xfe\xfe\t*\xc8\x16\x04X\fk$\x10\x99\x197\x84\f\x84\x18\x17\xac8\x06\x01'\x88AP\xc6ݪe\xb2\x02\x15,\xf6\x85$\x06gF\xd7\x18j\x13K,\xd00٪\r3*\xba\xa0\xe1E\xd0٬\x10\xd6U@$\xcb\xcc@@\x8d\x195` \xcbK\xd4\x18S\xd1\xd4\xddzN\xdf\xe7\xce\xe9\xd3\xf7\xdc{nwO\xf7\xcc\xfc>\x9f\xaa\xae\xbe/\xcf}\xceso\xbf\xfc\xbe\xe7\xf59\xa0\xd7\xeb\xa5%2邦\xf6\xd08`\xf1|\xfcݳ\u007f\xafϿj\xee\xefm\xb3\xcd0\xf5\xd7M\xda~)\x973\xc9k\xba\xb4]H\xfb\xc5\x18O\xd7e/\xe6{\x1a\xf6\xb3+&\xe9\xbf\xeb{_h\x9f\x93\xf4\xb1\xd4&\xf9\x1c\xd2\x04\x9f\xef$\xcb\xef\xda\xef\xa4?\x8b.\xfd.\xf0gs\xc0|_\xd8\xc1B?\xe8\xe6\xeb\x05\x02\xf6~\xf9\x8fq\xd2\x02\xb5\x18\xcba\xfe\xea?\xa3\x97\x1f\x9b҉o\x99\xb9\xfd\xf0\x87Szd\xd7\xee6\xc3>\xefI\xdb/t\x1c\xe3\xda\x0e\x1b\xc7$c\x9e\xaf\xdcg}\x8c\xcd\xfb]\xc70i\xdb\xf9~\x0e\x93,\u007fT\xfbQ\xfd\x8ek;\xdf~\xbb\xf6\xd9\xe1}\xed\x89\x00\xb0\xa7\x92b\xe9\xd7?=\x96\xc4Sk\xdfX\xfd\xce\x1d~\xcbM\xed\xbfs\xc3\xfeэ\xfaG\x9a\xdbg\xcd\u007f\x96\xa3\xfa\x1c\xf6\x9a4\xa6Ȍ\xfb\a\xbcX\xda\xc6:\xea\xb9\xc5j\xbfX\xe3\xa9ٴ\xfeδ\xe2\xf9\xf7T\xb7w~\xff{i\xc3\xe5'\x8c\xec\u007f\xd2\xf6c\xc7\xdf\xd6\xef\xe63[\vV\xbd\xed\xd0qL2\xe6\xb6\xf1\xb6=\xde/4\xb9\xcfz?\xd5\xfd2\xe6<ޔF\x8fa\xbem'\xf9\x1c&\xf9|Ǎ\xa1C\xbf]>\xdf\xf9\xf4;\xb4\xcf\xda\xd8K\xdb.?\xdb\xe9\xa1K\x9b\\\xaf\xf6\xb5\xa7-\xe5\xb2FZ\xb7\xee\xb2^\xfd\xfb\xfe\xe4\xe6\xd7\x1c\xd5\xcb_#\x9a4\x9fkmۡ\xaf45u\xc4Я\xa7־\xb1\xf5\xb9\xfc\x95\x8b\xe0\xa8\xe7G\xbd~\x94\xcdG\x9d\xd8;\xfc\x96\x9br\xf1\xafn\xb7\xb5\xcb\u007fl\x93\xc8훯\xe9r\xbfu9-a\xa3z\xcd5\x8f\xce~\xac\xcb\xfd\x8e\x05\xaa\xf2\xf2cG\x8e\xbd\xcb\xfbXH\xfb\xc5\x18\xcf0\xa7\x1d\xfc\xb7\xd5\xd70\xc3^3I\xfbI~_\xea\xfd\xe6\xd7}\xf2\xd5\x17W_\xa3Ɛ\xbf\x9aEd\x921\xb7\x8d\xb7\xedg\xd36\x9e\xfa\x98\xdb~\u007f\x87\xbd\xbfIێ\xfa\x1c\xc6\x19\xf5\xf9N\xfas\xee:\x86\xc5\xe8wX\x9f\xcdߍ.\xbf\a\xf9\xfebl\x01X\xce\xe2\xd7[\xca-\x02g\xbc\ueb7d\xa3_|pz\xe9\xb1/O\xa7\xac8:\xbd\xf9\x9c5\xd5\xe3\xf9\xfb\xb6\x9bo\xefݷ\xf3\xf1\xf4\xb5]\x8f\xa4ǟ\xf9n\xba\xeb\x8e\x1b\xf6\xc9-\x15\x830sį\xa47_~\xed\x9c\xe7\xb7=\x98zkNJS\xdb\x1eLi\xcdI\xb3\x1e\xaf\xee\x97\xe7\xeb\xaf9\xf8\xbdO\xa4\x8f\\sY\xba\xad\xdf\xf7\x96-\xd7v\xfel\x8e\xb8\xf5s\xad\xcf=\xf9\xfaW\xf6\xca\xf7#n\xfd\xdc\xd0>G\xbd~\x94\x8f\xbd\xe2\xa4tث/\x1e\xdc^?\xaa\xf1$E3=:\xe45\x8f\x8e\xe9s\xd8kj\x1e\xfep\xaf\xdbk\xc6/g\xb0\xe6֦\xd6~\xf0\x0fe\xd42&\x1d\xd3\x02\xda/\xc6x6|a\xf7\x16\x95\r\xa9\xf6ٶ\xf41i\xfb\xa1?\x83\x0e\xfdn\xfa\xf9\v{\xe5\x9f\xff\xce\xef\x1f\xd8\xdav\xe8\xf2ӣ\x13\x8c\xb9\x19\x02\x1f\x1d\xf9>\xf2\x98\xf2x6|\xe1\xa3S\xf5~\xf22ʘ\xab\U00076f7f\x87?\xdc\xdb\xd0\xff\xd9ͫm\xdb\xe70\xea\xe7\xd1\xe5\xf3m\x1b\xc3\x04\xfd\x0eo;\xc9Ϣ}\xbc\xcd>\xebco\xfe,҈\xbf\x8d\xa9\x05\x1c\x04\xb8\xb7\xad\xf5\xeeт\x9b\vc)\xfc\xb9\xd0\xdf\xf3\xa5\xfbR\x0e\x03\x0fm\u007f \xbdb\xd5\xc9U\xd1\u007f\xd5Ϟ2x>\a\x81I\n\xddޠ\xbe%#\x17\xff\\\xb4\x9b\xef\xe1\ro\xffT\ufe6fޔ\x0ey\xd9\x1b\xd3'\xde\u007f\xd6Ը\xc7K\xbf\xa5\xbf\xa2\xd9\xef\xb85\xf2\xa6\xeb\x8f<\xac\xf7\xfa\x93OH\x87_\xb02=u\xe3\x8et\xeb\x03\x8f\xa6_\xfb\xd6\xd3\x13}\u07bdޓß\xf8\xf8\xbb{+\u007f\xe7S\xd5\xcf5\xcb?\xe3\x1d\xbfuV\xfb\xc1[\xb5?\xb6\xe6\xa6\xcf9\x05\xf5ķL5_3\u0590\u05cc-\xd4ÖS\x1ek\x19{~>\xff#\xcc7G\xf6]\xfb\x872\xe7\x9fMs\x19Þ[H\xfbI\xc73ʤ?\x8b\t\xc7S\x8d\xa5\xd9~\xd8Ϡ\xe8ڮ\xadm\xff\xb1\xa1\xbfs\x93|\xe6]>\x8f\x0e\xbfK#\x9f\x1f\xd7_\u05f6\xf3]f[\x1f\xf5\xe7\xbb|\x0e\x8f\xec\x9a]T\xbb|.]\u007f\u007f\xc6\xfc\r\xb6.\xb3\xa5\xf8\x0f\xfb[\x9d\xef.\x80\xbd\xad\xf8\xa7=5\xa6R\x14\xf3Z~.\xeeW\xff\xb7\x9b\xab\xe2\xff\xf4\xe3\x8f\xcdj\x97\xef\xe7\xc7\xf3\xf3\xf5\xad\x03\xf3\xd9=p\xe3\x97w\xed\xfeG\xbf\xe1C\xd5핫V\xf7\xf2W\x97\xd7\xd7ۖ\xd77\xfbm\xca\x05\xfc{\x87\xbe6\xe5\xaf\\\xac\xaf~\xe7[\xab\xc7_q\xca9\xbd\xfc\x1e\xf2W\xbe\x9d\x1f\xbb\xf2]7\xa4\xf5\x17\x9dU\xad\xed\xe7\xc7\xf3\xf7|??\xde\xf6\x9a\xdc_\xee\xb7,#/o\xd2ϥ.\x17\xff\xca\xf9W;\xbf\b\xce\xd8\xf2\x17U\xf1υ\xbf\x04\xbc\xfcXW\xe36\x8d\xa6z\"\xaf\x1f0T\xd7\u007f\xbcS\x1f\x93\xc8\xff\x00\xca\xd7\x10\x83\xa2\xd5\x1cːq\x0em\xbb\x14&\x1dOK\xfbI\xfb_\xb4\xf6{B\xad\xf8/\x8a\xf2\x9e\xf6\x86\xf7\xb6\xb7h~\x1e\x8f욳\x1bmA\xfd6\xcc\xfa\x1f\xd1\xf5g1\xaa\xf8\x0f1\xe9.\x80\xbd\xb1\xf0\xd7-ꁂ\xb9x\xe6\xb5\xfe\\ȶl\xb96\x9d\U0007a662X\x8a\u007f^\xeb߹\xe3\x1b鰣\x8f\x1f<\x9eo\xe7\x10p\xd7\x1d7\f\xb6\x1a\xe4~6lz[\xe71}壟I\xebv=\x92\x979\xf5\xb5]\x8f\xa4z\xe1Ϸwl\xbf\u007f*\x17\xf3Cw>\x9c֜w\xf6Զ\x9bo\xef=\xbb\xe2\xc4t\xc1O\x1d;\xd5l[\xd6b\xcbXҦ\xb7\xcdY^\xbd\x18\xe7B^\x8a\u007f\xb6\xfd\xfe\xdb\xd3\xd5\xd7\xf5ҕ\x97\x9e\x93\xb6\xdf\xff\xc5\xf4\xaau[ӝ\xf7\u007f{\xf0|.\xea\xf5\xfbG\x1f\xf7\xca\xf4\xc9k\xfemu\xfb\xea\xebnK[\u007fv*\xad<\xeeҙ\xfb\xef|k\x15\x126o\xfd\xd4`\xb9ͭ\x05]\xe4\xb5\xff4sp^*ߟZ\xfb\xc6\xea\xf1I\xb7\x024m>\xea\xc4\xdeӇ\x1d\x94\x9e~<\xa5\x1d\xdb\xefO\x97\u007f\xf0\xdb\xe9\xee\xeb_3xn\xfd\x13\x0f\x8f\xec?\xff\xa1\xd57}\x8eZ#\x9du\xb0N-\xed\xd7\x1f\x1f\xb7\x9c\xc1\xc1W]vC4\xc7\xd1\x16\x02\x1a\as\xcd9X\xaa\xd1f)M:\x9e\xb6\xf6\x93\xf6\xbfX\xe3\xd9#\x1a\xc5\u007f\xa2\xad C4\x0fdK\x1d?\x8bI\x97\x91:li\x9a\xb4\xed\x9e\x1aK\xdbg\xb2\x18\xcbn\xfb\xfd\xc9\xe1b\xa2\x9fń\xc5?M\x18\x00\xf6\xf6\xe2_\xb7\xe0c\x03ʚ\xf3\x86Mo\xcbų*\xfe\xb9\xc0炚\x8bC.\xf4%\b\x94\u009f\xbf\x97\xdd\x02\xb9}\xbe\x9d_\xbfiÇ:\x85\x80\\\xd4\xdf\xfb\xabo\xaanWk\x9d\xaf{k5\x86\xdc\xf7\x95\xff\xe6\xbc\xea\xf1\x1c.\xf2\xe3\xe5~\xb9\x9d\xc3\xc0\x19Wl\xec5\xdb\xe6\x90R\x8e]\xf8\xc4-\u007f\x94\xbfz\xbf\xf9\xdf?V\x85\x85\xb2\xdc\\\xb4\xc7\xc9\xc5\xff\xa2\x8bf\xfa}\xfc+\x9fK_\xbbosu\xfb\x03\x97~1\xadZ\xfds\xd5헞\xb2\xbe\xea+\xb7ۺ\xf5\xe6־\xba,o9<\xb5\xf6\x8d\xbd\xf5\xef;?\xfd\xf5\x9f\x1fVm\xcd\xc9\xc5\xff\x94W?\x9bv\xdeq|\xb5{g\xfdO?]\xb5\x99sV@\xfd\xc8\xda\x13w\xff\xc1mhy>\r\t\n\xcd#\xf6g\x05\x88\x11˩B@\xb5\x16\xf2\xe8\xec\xa2s\xe2\x90\u05ccsb\xed\xa0\xc2\xda\xebf\xed\x1bm\x9e\x82u\xfeUS\xc3\xde_ۘ\xe7<\xf6H\xedL\x86\xe6\x01\x8d-\xefa\xa2\xf1\x8cj?i\xff\x8b1\x9e\xe6\xe7S\u05f5ݐ1\x945\xd1\xc1\xefK\xb3\xaf\t\u007fF̓\xcdZ?\x8bI\xc6?\xe4wqw\x91z\xcb\xfcڶ-s\xdc)\x8d]\xc6\xdf\xf2s.\x06\u007f\x9f\xf5e-\xa0\xdf\xe6g\\\xfe\xbeǵ\x9b\xf5w\xdb7\xe7\xf7\xa1e,]\x8f\x01ؗ\x8a\u007fݼB@}\u007f\u007f\xea\x17\xd2R\xe4\xb3\\\f\xf2\x9ay\xbd\xc8増_\x93\vG\xaa\x85\x82R\x8c\xbb\x1e\x17\x90\x83B.ԩ_\xf8\xb3\x8b߷\xb1*\xf0\xb9\x8f,/#\x8f!\xcb}Vk\xf6\x8d\xc7\xf3؟]qb\xfa\xc3+6\x0eƓ\xbda\xed\xbfN\xcd \xb2\xed\xc1\xd4+k\xf1g\xae~\xe1`\v@\x0e\r;\xbe\xf2ͪ\xb0\xe7\xb5\xfas/\xff\xafU\xf1\xbeg\xcbE\xe9\xca\x1b\xae\xc9!\xa2jw\xe3\x97w\xa5\xfb>{h\xf5\xf8\xab\xd6m\xad\x02Bi\x9f\x83\xc2\xca㎩\x82H\xea\xef>\xa8/\xab\x1c0\xd8\xe5\x18\x80^\xef\xc9\xde\xd4\xd4\x11S\xa7\x9f\xb9\xa6w\u05fa\x9fL\x9f\xdc\xfc\xcd\xc1s\xe7\xae?\xa6\xdaL\u007f\xf7\x9dۦJ\xbb\x0e\xfd\rn\xf7\v{u{\xe5\xaaՃ]\x00\xa9\x1fƪ\xe3\x00\xb6\xdf_\xda\xee>5p܅^\xba\\\bf\x81m\xf2?\x89\xa1k\x9c\xc3.\x122\xea\x1c\xe1a\xedǍ\xa3\xf9\x9aQ\xedǍ\xb7&\xff\x03\x9b\xf3\xcfu\xd82\xf6\xb5\v\x13um;\xc1\x05\x98\x86\xfe\xb3\x9fo\xdfmǹ,\xf4\xf7\xbc\xe5\xf7jp\xccɸ-&\xe7_\xb5\xfb\xf8\x94\xb6\xf79ߟ\xc3B^3j\f]\xfa\x9d\xe4B[m\xefo\xc8\xdf\xed\xe0\xefgL\xdf]\xb6\x00\xec\xab\xc5?\xcdgK@^[.Et\xcdyg\x0f6\xfb\xa7~\x11\xcd\x05\xb4\x14\xf9\x15+_2X\xf3\xcf\x05\xee\xf1gf\np)\xe0\xa9\x1f\x1e\xee\xba\xe3\x86t\xdf\xce\x0fU\x85:\xf7?\xea\f\x81\\\x9c\xbf\xb6\xeb\x91ޠ`\xe6\x00\xb1\xf3\xe1\xc1\xf3\xb9\xe0\xe7e\x941\xe4\"]n\x97`R\x02A\x0e\r\xf9\xf5y\fy\x9c\xfd-\x12C\x97\x9d\x8b\xf6\xfa\x8b\xce\x1a\xdc/ſZ\xe6}\x9b\a\xc5\xfc\x93ל\x9d>\xfa\xd8_V\x8f\xe75\xe4\x19\x87\xce,\u007f\xdd\xd6\xf4\x81K_\x94V\xad>{\xd0>\xcb\xfd\xd4C@.\xfc\xd5n\x80\xd5gM\xf0\x93\x99)\xbcO\xbe\xfe\x95\xbd\v~\x90\xd2\xca\xdf\xf9\xd4  e\x1b\xfb\xf7\xf3\xf3\xb9\xdd$\xfa\x9b\xf6\xab\xfd\xf6\xa9\x16\xbc\xea\xfd\xe7\xdb\xe5\xf9s?{S\xa7\xdd\x01K\xa5\xb5P\x0e\xd1z\x8e\xf0B\xd4\xcf/n\x1bGc\x17E\xf5\x0f\xaam\xb3\xfdb\xecW\xdd[\x8d\xbbFD\u05cb\xf2\xd4\xda\x0e>Ƕ\x8b\xc2t\xbdN\xc5Dg\xb3,P\u007f\f\x93\xfc\x0e\x0eݔ=\xcf\xcflQ/\xd0յ\xdfI\u007f\x16]ڶ\xfc̺\x1e\x9f3.\x00\xec\xcbſ\x98(\x04\xe4\u0097\xfak\xd0\xdbn\xbe}֚\u007f\xea\x17\xe0\xd4\xd8\xec_\xff^\x9e/\xf2㹟rv@\xe9\u007f\x94-[\xae\x9d*\x9b틲\x05\xa1le(\xbb\x1a\x8e~\xf1Ƀ]\x13e\v@\x19Cق\x91\xdb\xe6\xe2۶\xf5!\xaf\x85oޚze\xdf|)\xfe\xb9h\x1fr\xca{\xaa\x82}\xe5\xa5\xe7T[\x02\xb6\xdf\u007f\xfb\xccx>;S\xf4Oy\xf5\xb3Ֆ\x80\xb2\x15 \xf5w\x05\xe4\xe2\u007f\xf5u\xb7Uk\xfb\xcf\xdd\xf7\x8eA\u007fe\x19e\xb9]\u007f.٭\x0f<Z\x9d\x92W\xb6f\xd4\xcf\xc2x\xfa\xf1\x99\xcf\xfa\x82\xa3\x8fOoz\xe8\xc1I\xbaM\xeb\xdfw\xfe\xa0\xb8W\x9b\xdeW\x9dQ\x85\xb8?9\xf6\x98\xea\xb1_\xda>\x13\xfcN\xdb~W\xfa\xfcw_T\xb5ͯ\xa9\f\xbb\xf2Wݸ\xe7\x17\xabM\xdb\xf3\xb55\xfe\xfa9\xc2;\xbf\u007f\xe0L\x11n^\x80\xa5yűQ\xe3\xe8??\xeb8\x84\xe6AJ\xfd-\r3G\xc5\xcf<W\xdfu1\xeb\xf8\x85\xdaAU\x83\u007f`\x93\xbc\xef\xf2\\[\xfb\xb6\xbe\x16\xab}\x97\xf1\xb4\xa9_Lg\xd8\xe78i\xdb\xfa\xe7^\xbf\xc8MK\u007f͟\xd1\x1c\v\xfd=\x1f\xf7\x9e\x9a\xaf\x1b\xf7\xf7з\xa8\x9fٞ\x1a\xc3|\u007f\x16\xa3\xda6\u007ff\xe3\xfen\xd3\xf0\x9fѸ]\x00\xfbC\x00H]\x03@}\xed\u007fæ\xb7\xcd\xda\xef\x9f\vM\xf3`\xbf\xfa\x9a|~m=,4_w\xd7\x1d7T\xc7\x02\xa4\xfe\xa6\xfaq\xd7\t(\a\xf0\x95\xb5\xf9,\x17\xa5\xb2&_6Oו\xd3\x11˖\x8a\"\a\x82\xfe&\xec\xd6e\xfe\xf5oL\xf5>\xffgG\xa6\xf3\xfe\xc7\x13S\xe5\xc8\xfd\\\xb0\x8b\xb2+ \x17\xf6\xbc\xa6\x9f\xfa\xc5?+\xbb\x02.\xff෫\xdd\x00\xa5]\xf3\xf5\xd9C\xf7\xdd6u\xf3k\x8e\xea\x9d\xf63\xdfJ?\xfe\xbb\xbd\xc1x\xba\xec\x028\xfd\xcc5\xbd\xfa\xae\x8c\xe3.|m\xb5\x8b\xe3\xe2\xf7m\xac\x0e\x9c\xac\xef:\xb9\xfb\xcem\x13\xed\x02h*[\x05\xfa\xb7S\xdb\xda~.d+\x9e\u007f\xd0\xe0~\xb5f]K\xdf\xe3\x9e_\xac6mϗ\xc7\xcb\x01K\xcd\xd7\xe4\xef\xe5\xf9z\xfb.㨿\xa6\xf43̰e7\x97\xdf\xd6n\x92\xf7]^\xdf\xd6~X_\x8bپ\xcbx\xda\xd4\x0f(\x1b\u05fek\xdb\xf9\xf4ٶ\xe6\xb8\xd0\xdf\xf3\xe6\xf3\xe3\xc61\xee\xef\xa1\xde\xef$\xefo\x92\xb6\x8b9\x86=\xf9\xf3\xed\xf2w\x9bZ~F\xa3\xb6\x00\xec/\xc5?u\xdd\nPߜ\xben\xdd#\x83B\x9f\x8bj\xd9\xf4_?\xf0\xaf\xaey@`i_\xb6\x0e\xac[w\xd9`\x13x\x97\xad\x00\xa9\u007f\x96\xc1K\x8f\x9d\xbdo?\xf7U\x0eB\xbc\xfb\xce-i\xc5ʟK;w|1\x9d~\xe6\xba9\xa1\xa0\xbc\xae,w\x94\\\x8c\xcf\xeb\xdf\xcek\xee\xd5E~n9u\xf0\xfc\xb6\u007f~o\xb56\u007fг\x9f\x19\xec\xeb\xcfk\xfc9\x04ԏ\x01\xa8\xb6\x1a\x1c\xf7\xcajw\u009a\xbf\xda\xfd\xfa\xb4\xf6\xde\xeabAY\x0e\x19\x9d>\x80\x9a\xb2\xe9?\xbf\u05cb.\xba\xb4zo\xb9\xe0\xe7\xd0\xf3\xde_}S\xf5y\xe4\xdb[\xb7^\x97N\xef\xef\nh\xbb8P\x17\xb9\xe0\x97\xd3&\xc7m\xea\xaf\x1f\x1c4\xb8\x88\xc8\x04\xcf/V\x9b\xb6\xe7\x87\x1eP8\xec@\xc4F?\xe3\xc6Q\x9e\xaf\xf73̰e\x0f{][\xbb\xae\xef\xbb<7\xaa\xfd\xb0\xe7\x16\xb3\xfd\xb8\xf1\xb4)\xef}\xd4\xe78i\xdb\xf9\xf49\xaa\xcdB\u007fϻ~\x0e]\xfa*\x16\xfb3\xdbScؓ?\xdfr\u007f\xdc\xdf\xed\xb0\xf7Զ\x05`\u007f*\xfeu\xad\xbf\xe0ͫ\xfc}\xe4\xb6m\x83\x82Z\xdf\xef_\x8aws\x8d\xba~\xca])\xb8\xf5\xe3\x01\xf2so>gM\xeaz\xb5\xc0r:_Y\xab/\x9b\xf1s\xd1\xcf\x05\xb0ܮ\a\x80ܦ*\x80\xfd\xdb\xe5u\xe5}\x8c\xda\x02P\xf7\x86\xb7\u007f\xaa\x97\xd7\xe0\xcb\xe6\xfel\xd5\xea\xb3ӡ/>d\xf0\x19\xe45\xfa\xe6\xf3\xf5\xfd\xfc\xcf>\xf3ܜ\xe7_z\xca\xfa9\x17\t*\xc6m\x01X\xb1\xf2%\xbd\x12z\xf2\xfbK\xfd\x83#S\u007fw\xc4\xce;~\xbd\xba]\x9e\xcfAi\xe7\x8eo\x8c|\xbf\xa3\xb6\x00\xcc\xd7\xd4\xd4^qX\x00\xc0X\x8b5\x17\xc0>/\x17㲹}\xcdygW\x85\xacl\xce/\xfb\xd4K@ȅ\xbc\xbe\x89=\xf5CB~<?_BBy]ي\x90\xfb\xcdr\x9bq\xbb\x00\xf2kv\xee\xf8Ƭ\xe2_\xfa\xfd\xe5w\xfc\xfb\xea{)\xfe\xf9{\xfd\xf1z\xfb\xe6\xb5\n\xba\xc8\xc5?\x17\xebs/\xbf}\xf0\x95\x8b{.\xea\xa9\x1fp^\xb5nk\xfa\xf5\xebf\xd2d\xfe^\x1d\xfd_+\xfe\xb9}\xfd\xf5e\xb7\xc0\xa46\xbesC\xaf\x9c\xf7\x9f\x8bz~\xaf\xd5\xf7\xd7}`\xb0\xeb\xa1\xfa,^\xf7\x81Yϧ\xfe\xf5\x02\xf2\xeb'^\xe8\x04r\xc1\xaf\u007f\x01\xec+\x86\xed\x02\xd8_\xd7\xfeӤ\a\x04\x96\xfd\xf83k\xdb۪\xc7V\xac| \xdd\xf3\xa5\xe3\a\x9b\xe4\xeb\xca邹ঙ}\xd6s\x8e\x1f\x98\xd4̵\x05f\xb6,\x94\x80\xf2\x87Wl\xac\x96\u007f\\m\r\xf8\xb9\xfb\xde18\xe5/\xb7{\xfc\x99ݧ#N\xea\xb7_\xf0`\xba\xb1\xf1X\xfe\frQ\xcfk\xfe\x87\xbe\xf8\x90jyE\xfdv)\xfe\xb9\xfd!\x87\xce\xedw\x12\xa7\x9f\xb9\xa6:%\xf2ON>!]\xbb\xeb\x9b\xd5gx\xd4\xff\xfej\xda\xfe\x13\xffT=?s\x16\xc2\xee\x83\x11\xef\xbe>\xa5U_\u007f^z\xe2\x17^V\xbd\xefן|B\xfa\xa5[\xfe\xa8\xea\xa7\xf5\x98\x80\x05Ε\xde\xfbػ\xe6\xb6벌Şc\xbdK\u007f\x8b=w\xfab\xcfI\xdfr\x9a\xd5\"\x9dq1\xebo_X\x83\xb9[\x00\xf6\xe7\xe2_tz\x8f\xcd¹b\xe5K\xaa兩\xd7O\xf5\xab\xdf/ϗ\xf6m\xfd\x8ds\xd7\x1d7L\xe5\x00Q\xdf\npϗ\xee\x1b\xac\xe1\x1ew\xe1k\xab\xc2_\x8e\xbc?\xe4\x94\xf7\fր\xcbY\x02e\xed?\xf7\xd3ur\xa22k\xdf\xfa\x8bΪ\xbe>\xf1\xfe\x99\xaf\"\x17\xf7\xbb\xef\xdcR\x05\x81\xfc\xb5j\xf5ك\xdb\xf9\xf1\xfa\x81\u007f嵥\xafz\xff\xe3\x94\x03\xfev\xee\xf8bu\xbd\xff˞\xfe^\x15z~\xef\xf4\xa3\xaa PBG.\xfc\xf9\xeb+\x1f\xfdL\xf5x~>\xb7\xcb\xed\xf3\xeb\xf2\xebs?\xb9\xbf\xb1\v}\xf9\xb13\x97\x16\x1evjMs\xde\xf1\xb6v]ͷ\xbf\xb6\xb6\xc3\xfak\xb6\x1bvM\x80\xb6v\xf3\xe9\xafm|\vi\x97\u007f\x17\xafk?ff،\x90\xe5~Q\xa6u.߁\x19\x8b1\x1b\xe0~\xa9~\xa5\xbf\\L\xf3Zxs\x1f\xff0\xe5jy\xc3\xfa\x9bT.\xda+W\xad\xee\x95\xfd\xf8\xf5>\x9a\xa7\xe1\xed>'\u007fF9=.\a\x91I\x8b\xff\xc1\xef}b\xf0X9p\xaf\u007fi\xe3I\xc6>xm\x91\xfb\xfd\xeeo\x1eU-g܁\x807\x1e\xf8\xf7)\x1d{L:\xbc\u007f\xf1\x9dϭ\xfft\x9a\xda\xf5HU\xd4/~\xc5[\xaa\xad\x1d\x0f]\xff\x9a\xeaJ\x849\xf4\xe4\xcf\xe6\xe2\xf7mL\x87?\xf4\xe1\xf4\xb5;\x1eI\u007f\xf1w/H\xebϿ*\xf5ο*\xcd\\\x17\xe0\xefӸ\xf3\f\xe6\\\x9aw\xcc\xfc\xeb\xad\xf3\x887\r\x9bK}\x92\xfe\xba\xcc\xf5=\xe4}\xa4Qs\x87\xd7\xfa\x1a\xdan\xd2\xfeƍ\xafk\xbb\x86\x99\xb5\xff_l\xdd\n\xd0\xfbإ\xbd\x1c$z)\xf5\xa6\xdet\xddT\xfd~\xce\a3\x17x\xfagU\xdb\xfc}\xe8\x95\x1c!(\x01\xa0\xa1y\xfe\u007f}\xdf\xfb\xac\xd9\x00\x1b\xaf\xcbm\x9b\xb3\x01\xa6~X(a\xa2~=\x80\xaevl\xbf\u007f\xaa\\\x12\xb8\x04\x92\xea\xf8\x80;~\xbd\xda\xef\x9d\xfaW\xe2\xdby\xc7\xc6\xc1r\xeas\x15t=\xf0/;\xedg\xbe\x95~\xf7\x98\xbfM\xe9\xfeo\xcfy\xee\xce\xfb\xab\xeb\x13TE}\xeb\xd6\xeb\xd2C\xf7\xddV\xdd.\xd7\xf7_s\xd2\xccf\xd57_~mu\xbb\x19H\x06\xd6\xfey\xfa\x8do\xbeh\xe48\xca4\xbf\xe5\xca|E\x0e5O}\xe7\x98\xf4\xe9;~\xbf\xba_\x8e\u007f\xa8\x0e\x82<\xfa\xf8\xf4\xe9\xf7\xfc~:\xe3;O\xa5\x87v}3\xadJ\xcf\x1b\xbc\xae\xcc\x15\xd0\xe5̀\xc19\xf2c\x8ack\xbb!\xe7Q\x0f\xce\x13n\xb9?\xaa\xbfT\x9d\n4\xfb~n{\xee\xfa\x95#?ö#\x82\x9b\xf3\ft9r\xb8K\u007f\xcd\xf1um7\xee}̬\xfd\u007f0\xad\xbf\xee\xd4\xe1\r\xfa\x93AU\xdf\xdft\xdd\xec\xfb)\xf5v\xff\x0e]R\xf5s\xf8-՝\x05_*\x1c\xf6\a\xf5\x00\x10i\xf3\xd8\xd0\u007f\x00e\xb6\xbfR\xf0\xeb\xfb\xf3Sc\xff\u007f\xb9\xc4o\x91\xef\xe7\xc2\xde<\x0e \xd5v\a|\xe4\xb6mUQn\xbev\x9c\xbc\x06_\xae3\x90j\a\xf9\xdd}\xfdk\xd2s\xf7\xed>\xc6 ?\xfet?[4\xafS0\xa9ǿ\xb2{\x1e\xfdr\xed\xfeR\xd4W\xad>{V\x81\xbf\xf3\xfeoW\x01!?^n\x8f\xeag\x94\xa1\xc5\xff\xe3\xefN\xaf\xfc\xb1\xef\xa4\xed\xe9\xa0\xeab@\x0f\x1d\xf6\xcd\xc1\u0590W\xac:\xb9\n\x00\xa9\x1f\x10n}\xfa{)\x1dvP\xd5>\xbf\xae\x14\x84\xd6\x100dn\xee\xae\xf3\xaf\xb7\xce#>Ls.\xf5\t\xfa\xeb2\xd7w}r\x90.s\x87W}\x95ל\xf8\x96\xa9E\x9d\x8b|\x01s\x96o\xe8__\xa9Z\xe3\xcf\xc5\u007f\xed\xbf\x9cy \u007f\xbf\xe5\xd4\xde\xe6K\xbf;\xf6\xb4̚\xda\x16\x80\x0fV!ੵ\xff7\xd9\x02\x003\xea\xa7\x01F\n\x00\xa9\x19\x00\xcaZv.\xee\xf5\x8b\x00\xa5\xda~\xfd\xd4?\xb0/\xf5\xc3A\xf34\xc0R\x94\xca\x01\x83\xa9V\xfc\xeb\x17\x03*\xa7\x14NZ\xa0\xeb!`܅p\xe6U\xfco9\xb5w\xf9\xb7>S\xdd̅\xbb\x14\xec\xfa\xed{\xb6\\T\x1d\x04X\xb6>ԷD\xe4\xdb\xcf>\xf3\xdc\xe0BAm}\\s\xe4kSZ{\xef\xac\xf1MM\x1d\xb1{\x8e\xff[n\x1a\\\x99\xaf\xee\xda\xff\xef\x1f\xab\x83\x00\xa7^\xff3s\xae\xd3_n\xf7n\xfd\xb3\xea \xc0\xcb\xfe\xe1G\xe6\xbc\xfe\xdc\xcf\xfea\x15\x02r\x88\xf8\xb5o==U\x9d\x068nִGv\xcd\x1c\x9cֵ]\x9bI\xe7\x04o\xeb\xafeƯ\xd4q\x0e\xffY\xe7z\x8f\x98\xa5p\xce|\xf2\xa3\xde˘\xfe\xc6\xf6Q{\x1f?\xfe\x8d\x1diw\xe1\xff\xe0\x90\x8e.I\xe9\x96\xff\x93\x06A`f\u009d*\xec\xd5w\x01\xf4\xc3_\xb5\xcc2\xcfC}\x0e\a\a\x01\xc2\xee\x83\x00\xa3\x15\xff\xd4|Ϲ`6/\xd0S..s\xd1E\xe7\r\xbef\x8e\a8xΦ\xf5|\xbf<Wo\x9f_\xdf\xdc\xff\x9f\xdb̧@\xe7ה\xe3\x10r\xb1\xff\xf4{~\xbf\xea+\u007f\xaf_o`\xdek\xfek\xef\x9d\xcaE\xfc\xcc\xd5/L\xcf}\xf5\xa6\xaa\xd8\xe7\xaf|;?V\x8a\u007f\xea_\xcf?\u007f5o\xe7\xe7s\xbb\xb6>\xaa\xf3\xf5\x1bſ\xa8\x8a\xff\x05+g\x15\xff\xbc\xd6\xf8\xf4c\xf7\xa6?\xfe\xd2̅\x99~\xf2\xed\x17\r\n\xfe\x9f\x1c{L\xfa\x85\xcf\xfe\xe9\xe0\x92\xbd\xf9\xf1\xfc|nW\xb5\u007f\xec\xdeY\x17\xbe\xc8\xfd\xe6\xfe\xf3rfiη]\xbf\\f^S\x1fvpZ\xd7v-\xaa\x82W\x8e\x03\xe8\xba\xdcI\xa6\xfb\\\xaey܇͙ޜ\xa3~\xc4\xfb\xa8\x8a\xfa\xda{\xa76\x1f\xf5\xbf\xfa\x17\xa2\xba\xa4\xdf\xea\x92\xea~\xf5\xf8\xda{\xa7\xcaV\x80\xa7nܑF}O3[\u007f\xa6\xea߁\x19\x8e\x01\xa8)W\xde\xdbv\xf3\xed\xb3\x1e\xab_ (\xf57\xe5\xb7y\xf393[\b\xea\x17\xfc)J\xbf]\xae\xcc7L}zಬgW\x9cXM\xfaS\xfa,\xd3\xff\xce7\x04T!\xe3\x94sz/=e}:\xe4e3\x8f\x1d\xf4\xecg\x063\x04~dŖꪁ\xdb\x1e\x9c\x1b\x1a\xf3k\xff\xfa7\xa6z\xbf\xfc\xa7gW\xed\xf3\xe7v\xc8\xcbvO\xccs\xe5\xa5\xe7T\x97\x02\x1e\xb6ܼ\xf6_\x15\xff\xda\f\u007f\xb9\x88\xffqJ\xe9\xf7N?!\xad\xbf`e\xda\xf4\xe7\x87\r\x82Nu\x94\xffo\xadL\xe9\x81Gg\xce\x12\xb8\xe2\xd1t\xeda\aU\xcfW3\x1e\xfe\xf4\xd3U\x11\xf8\x0fw?\x91Rz\"\xfd\xca\xcfΜB\x99\xfb?w\xfd\xcat\xfd\x03\x8fV\xbb\x81\x86ͷ\x9d\x9asn7'\xb2i\x9b3~³\x026]\xf3hZ\xf1\xfc\xfc~\xef\x19\xbf\xdc1\xc5\u007f!s\xf87\xe7C\x9f\xef$A\x9d\xe6L\xef\x18b\x06\x9b\xf9o9\xb5\x97֦j\xad\xbf*\xfckgwwĭ\x9f\x9bzr\xed\x1b\a\xbbufݟ\xbd\x96\xaf\xf8C\x83\x00А\x8bv.\xf4\xfd]\x01s\xfei\xac[wY/\x17\xdef\x91-\xf3\xf2\xe7p\xb0e˵Sk\x1a\xbb\xf97m\xf8P/\xd5.\x0e4\x1f\xe5l\x80\\\xe0r?\xd5\xc1\x84\xfd\x03\nKH)\x97\xc7]\x88\xab\xaf\xbb\xad\x9a\xb4\xa7\x9c\xbaw\xf5;o\xaa\xfa\u007f\xf6\x99\xe7\x06\xd7\xef\xaf.\x15\x9c\x9f\xab\xddN\xfdK\n\xa7S\xce\xe9\x95\xe3\x14\xae\xbc|\xa6\x8f\xdc_\xee\xb7M^+\xdf|\xc5ǫ۟\xfb\xbb\x17\xa4\x0f\u007f\xe7/\xa7\xde\xf2\x82\u007fQ}f\xd5?\xf3[g\x8e%X\xb1\xf2%\xbd\\\xfc\x1fy\xc7\xe7\xd3\xe6+N\x9b\xf5\xfd\xb2\xf7\x9cV\x85\x80\x8d\xef\xda4\xb5\xb1\xdfo\xe9\xe3\rw\xdd^\xf5\xf7\xca\x1f\xfbN\xda|Žs\xb7\x02\xf4\xb5ιݵ]\xdb<\xee-s\xf9w\xeao\x1es}wy\x1fs\xd6\xccg\xcd\xd3?\xe1\x1c\xff\ry\xf9\x9f\xff\xee\x8bf\x8f\xb3\xeb\xfb\xa8\xa96\xf5\xaf\xbd\xa4\xff}\xf6se3\xfe\x11\xb7\xde<\xeb\xf5\xcd\xfb\xc0p\xe5\x18\x80\x88\xbb\x00Ұ\xb5\x82\\\xe0\xcb\xda\xfe\x9a\xf3\xce\x1e\xfa\x8f\xa4L\x1aT\x0f\b\xb9\xc0\x8f\x9a\xe4g\xdbͷ\xf7\xcaV\x81\xb6Y\xf9\xba\xca˿\xf8}\x1b\a\x17\xfeI\xfd\xcb\xe2\xe6\xfb\v9\xf0o0\xd6\aS\xaf>g\u007fY\xfb\xcfE\xbd9\xf6a[\x1b\xf2g8n\xfe\xff\xa6.\x93\x01\x15\xd7\x1fy\xd8`\xa2\x9e\xcb?\xf8\xedt\xcd%3\xbb\x1f6\x1fub\xb5o\xbfk?\xd51\x00\xa3\xe6\xbfoۤ?\x9f9\xbc\xc7\xcc\xdf\xddu.\xf3A\xd1\x1c\xb5\x96\xdeq|s\n\xf0|\xde\xef\xa89\xcaG\x8c\xa1\xf5}\f;\xee\xa1\xda\n0w\xb7\x91\xfd\xf8\xb00\a\x04.\xfei\xd8\xd9\x00e7@\xea\x1f\xd87\xec4\xbarT~Jo\x1b<6\xaa\xf8\xe7~\xfe\xcbU\xbf=\xe8\u007f\xa1\xcar.\xb8\xe3\x86Y\x8f7\xef\xcfםCN\x03ls\xc9\x0f\xfe\xe7D\xfd\xae9\xe9\x85\xf3\x1c\xd5n\xb9\xc8\x1f\xfc\xe5]\xbd\v~\xea\xd8A\xf1\xbf\xf1˻&*\xfe\xadF\u0379\xdde\xce\xfbqsx\xb7\xed&\xe88\x97{k\xe1\xef:'\u007f\xad\xbf\xd6y\xe4'y\xbf\xf34\xc9n\x86ak\xff\xc0\xc2\xd9\x05\xd0P\x8a\xfb'nyl\xce\xf5\xfe\x87\xb5\xab\u007fok\x9b\xfb\xf9\x8f\xef\xfe\xcf\v>5o\xa9\xd4O\xddK\xab\xcf\x1aٶ\xcb\xcc~\xb3\xfaK\xa3\xfb\xeb\xea\xc2\xe3O\x9d\xba\xa0\xf7d\xaf~\u007f^\x1d\x8d\x9a\x1b\xbc6\xe7v*Ekܜ\xf7msx\x8f\x9a\xbf{T\xdbq\xf3\x87\xa7\x0es\x9d\xcfc\x8e\xf1\xce\xef\xf7\v\x8d\x03\x17\xfb\x17\x19\x9a\xa3\xcb|\xf0\x0f\u007f\xb8m\xad~\xea\u05ec\xedâ\x9b\xea\xb5L\a\x18\xc8\xf09\x9e\xc7lҟİ]\x06{\xbb7\xbc\xfdS\x83ߋ\xf5\x17\x9d58\xa8\xaf\xcb\ue2f2\v\xe0\xcaw\xddP\xed\xfb/\xdaf\x02L\x13\xee\x02\xa8\xfb\xe8c\xf7\xf6\xe6[\xfc{\xbd'\xc7\xceS\xde<\x88mܜ\xf7]\xda4\xe7\xef\x9eo\u007f\xf3Y\xf6\xb8\xfe&}\xbf\xc3\xe6|\x1f6\x1fy\x97\xf9\xe0\xf3\xeb6\xde{\xe3>\xf37\x02\xfb:[\x00Z\xe4b}\xc6\xeb\xee\xeb\xe5 \xb0\x90\xc2]\x0e\xfeۗ\x8a\u007f\xea\x17\xeb\\\xc8\xf3\xed\x8f\\\U000d9a98\u007f\xe4\x9a\xee\x97\x02.\xed˿\xfcq\xc1a\x01S\xf3N]\xb0\xc0i}\xbbι\xdde\xce\xfbI\xe6\xc5_\xca\xf9\xc3\xe73\xc7\xf8|\xe6\xf8\x1fv\x15\xc0\xfas\xe3\xde\a\xb0tl\x01pz\xd0H%\x04d\xfd\xcb\xfc\x8e\xfd\xbc\xb6=\x98z\xf5\xb0\xb0Ѓ\x1e\xf7$\a\x92\x01Q\t\x00\x02@h\x02\x00\x10U\xf8]\x00G\xed\xbe\xe4i\tBUEx\xa2y\x8etW\v\x98\xf7<)H\x00,\x91\xe9\xe5\x1e\xc0\xde\xe2\xe4ןS}-\xc8\x04\x97\x82\x05\x80\xe5\x14~\v@_\xef\xa4\xc3gN4~ \xdd6\xbf\xa9B\xbb\x9c?\x9e\x16v\xee4\x00,\x96\xf0\x01\xa0\xac\xf5_\x95fNC{\xb0\xebV\x80R\xf0\xcb\xe6\xfc!\xc5~\xd6\xf9Եǆ\xcd\x19\x0f\x00K)\xfc.\x80\an\xbd-\x95\xb5\xff,\xdfΏ\x8d\x95\v~mm>\x17\xf6\xaa\xe0\x97\xc7\xebk\xfa\xc3\x1e\x03\x80e\x14~\v@˥\x90{C\xaff6̈9\xd0\a\xe7I\xd7ڌ\x9a\xf8\x04\x00\x96\xca\x01\xfd\xfd\xddQ\x0f^\xab\xde{\xd9\xfc\x9f\xfa\xbb\x02\xfe\xa0\xdc\x19v\t\xd4\x16Ê\xfd,m\x97H\x05\x80e\x10~\v\xc0\xb0#\xff\xf3c\x9b~e\xf4\xdc\xe6e\xee\xf4\xdd\xf3\x99\xb7\xef\xd7/\xf3\xa4\xcf\\\"\xd5\xfe\u007f\x00\x96_\xf8\x000J\xf3ҥ\x9d.U\xda2W\xfa\x9cK\xa4\x8e\x9a:\x15\x00\xf60\x01\xa0Ű}\xf5\x1bj\xb77\xfd\xfc\x85\xbd9횧\x02\xe6\xfb\xe7_5U\xce\x02\xd8ж\xb0ٯ\x13\x06\x00\xd8\xe3\xcaY\x00\x11\x8b\xce\xf2\xbd\xe7z\xc1wf\x00\x00ˡ\xd7땯h\xaa\xf7}\xe4\xf5\xbf<\xe7\x89\xfeci⯝[gw4s\u007f\xf7\xe3\xe5~\xb9\xfd\xb1w\xf56\x9ezA\xaf\xfe\xfcr\xff>\x00\x10C}\x17@\xa4\xb3\x01\xeak\xffSk\x9f\xfa\x83\xde-\x87\xff\xbb\xea\xceڧ\xfe\xa0\xf9|g\xe5`\xbf\xa2\x1c\xf4\xd7<\bp\xd3\xcf_\x98ʼ\xe9\xa9q\x90 \x00,\x85\xf0\x17\x02\xea\xcb!`Aſ8\xed\xe0\xbf\x1d|5\x1f\x1fv\xbf\x1cC0n\xaet\x00XLS\x8dـ\xc3m\x010\xfb\x1e\x00\x115\xb7\x00D\xa8\x86\x11\xde#\x00\x8c4l\x17\xc0\xfe\\ \xf7\xe7\xf7\x06\x00\x9d9\x06\x00\x00\x02j\v\x00\xfb\xe3\x9a\xf2\xfe\xf8\x9e\x00`^Fm\x01؟\n\xe6\xfe\xf4^\x00`\xc1\xec\x02\x00\x80\x80\xc6\x05\x80\xfda\xcdy\u007fx\x0f\x00\xb0\xa8\xbal\x01ؗ\v\xe8\xbe<v\x00\xd8c\xba\xee\x02\xd8\x17\v\xe9\xbe8f\x00X\x12\x93\x1c\x03\xb0/\x15\xd4}i\xac\x00\xb0\xe4\x0e\x98\xb0})\xac{\xeb%\x83\x15~\x00\xe8`\xbeg\x01썅vo\x1c\x13\x00\xec\x95&\xdd\x02P\xb7\xb7l\rP\xf8\x01`B\x8bq\x1d\x80\xe5,\xc0\x8a?\x00\xcc\xc3B\xb6\x00\xd4\xd5\v\xf1\x9e\xde\"\xa0\xe8\x03\xc0\x02-V\x00\xa8\xdbS\xbb\x06\x14~\x00X${\"\x00\x14͂=i P\xf0\x01`O9&\xa5\xe7_8\x95\x0e\\\xeeq\x00\x00K\xe8\x8a\xe9\xf4\x92'\x8f?\xfc\x8c\xe5\x1e\a\x00\xb0t\xa6\u007f\xf1\xc0\xf4\xd2\xc3\xcf\xf8\x89\xb7/\xf7@\x00\x80\xa53}\xe2\xff\x9fNHo^\xfd\x8b\xcb=\x10\x00`\xe9L\x1f\xf3©\x13\xfefſz\xc1r\x0f\x04\x00X:\xd3\a\xbe(\xbd\xec\x87?r\xd2r_\xcd\x0f\x00XB\xd3\xe9\xf0\xdeQG\xfe\xe81\xdf_\xee\x81\x00\x00Kg:\xfdDzqJ\xe9G\x97{ \x00\xc0ҙN?\x95\x0eJ)=o\xb9\a\x02\x00,\x9d\xe9\x1f\x1eW\xad\xfd\xbb\xea\x1e\x00\x042\xfd\xf5\x1f\xb7\xf6\x0f\x00\xd1Lo?H\x00\x00\x80h\xa6\xbf\xf8O\xcb=\x04\x00`\xa9M\u007f\xf6\xef\x9c\x00\x00\x00\xd1L?\xf8\x1d\x13\x01\x02@4\xd3釮\x01\x04\x00\xd1L\xa7\xe4 \x00\x00\x88f:\x1dp\xd0r\x8f\x01\x00Xb\xd3\xe9\x05/Y\xee1\x00\x00Kl:\x1d\xb4j\xb9\xc7\x00\x00,\xb1\xe9\xd4[\x9d\xbf\x9b\x0e\x18\x00\x02\x99NO\x9c\x94\x8b\xbf#\x01\x01 \x90\xe9\xe9\x87^\x96\x8b\xff?.\xf7@\x00\x80\xa53\xfd#_|\xe1\x0fSJ\xcf.\xf7@\x00\x80\xa53}\xf0\x8e\xde\xf7SJ_]\xee\x81\x00\x00K\xe8\xb8\x03\xfe\xe6/\xdf\xff\xf5\xf7\xbb\x1c \x00\x042}\xf8\x0f\xbf\xf7\xe4]Ɍ@\x00\x10\xc9\xf4!陯\xffٽ\x17|o\xb9\a\x02\x00,\x9d\xe9\xe7\xa5o<v\xe0\u007f:r\xe7r\x0f\x04\x00X:\a\xfcC\xfa\xca\xc3+\xffꈯ\xefZ\xee\x91\x00\x00K性\xd2\xf6/\xff }\xe1\x1b\xcb=\x10\x00`\t\x1d\x9c\x0e?\xb8\xd7s%`\x00\x00\x00دM/\xf7\x00\x00\x80\xa5'\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04$\x00\x00@@\x02\x00\x00\x04\xf4\xff\x02\x00\x00\xff\xff\xc8dWh\xd4\xd4@G\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -0,0 +1,25 @@
+package main
+
+// how much each part of the game adds to the intensity the music follows
+const (
+	intensityEnemy  = 0.02 // per live enemy
+	intensityBullet = 0.05 // per enemy bullet on screen
+	intensityLife   = 0.15 // per life lost from the starting three
+)
+
+// intensity rates how frantic the game is right now, from 0 calm to 1
+func (g *Game) intensity() float64 {
+	v := 0.0
+	for _, a := range g.actors.actors {
+		switch a.group {
+		case groupEnemy:
+			v += intensityEnemy
+		case groupEnemyBullet:
+			v += intensityBullet
+		}
+	}
+	if g.lives < 3 {
+		v += float64(3-g.lives) * intensityLife
+	}
+	return clamp01(v)
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/hajimehoshi/ebiten"
+	"github.com/leenattress/goshootygame/src/atlas"
+	"image"
+	"log"
+	"os"
+	"path"
+	"strings"
+)
+
+// SoundFile is a WAV sound effect and how it is played, read from assets/data/sfx.json
+type SoundFile struct {
+	File   string  `json:"file"`
+	Voices int     `json:"voices"` // how many can play at once
+	Gain   float64 `json:"gain"`   // base volume, before the SFX bus
+}
+
+// loadAtlas reads the atlas pages listed in the manifest, their images and
+// everything that refers to sprites by ID
+func (g *Game) loadAtlas() error {
+	data, err := g.assets.ReadFile(assetManifest)
+	if err != nil {
+		return err
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return fmt.Errorf("%s: %v", assetManifest, err)
+	}
+	var parts []*atlas.Atlas
+	for _, file := range manifest.Atlases {
+		data, err := g.assets.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		part, err := atlas.Parse(file, data)
+		if err != nil {
+			return err
+		}
+		// page images are found next to their atlas file
+		for i := range part.Pages {
+			part.Pages[i].Image = path.Join(path.Dir(file), part.Pages[i].Image)
+		}
+		parts = append(parts, part)
+	}
+	a, err := atlas.Merge(parts...)
+	if err != nil {
+		return err
+	}
+
+	emitterData, err := g.assets.ReadFile(assetEmitters)
+	if err != nil {
+		return err
+	}
+	animationData, err := g.assets.ReadFile(assetAnimations)
+	if err != nil {
+		return err
+	}
+	configs, err := loadAnimationConfigs(animationData)
+	if err != nil {
+		return err
+	}
+
+	var (
+		files  = append([]string(nil), manifest.Atlases...)
+		images []image.Image
+		pages  []*ebiten.Image
+	)
+	for _, p := range a.Pages {
+		file := p.Image
+		png, err := g.assets.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		img, _, err := image.Decode(bytes.NewReader(png))
+		if err != nil {
+			return fmt.Errorf("%s: %v", file, err)
+		}
+		page, _ := ebiten.NewImageFromImage(img, ebiten.FilterDefault)
+		files = append(files, file)
+		images = append(images, img)
+		pages = append(pages, page)
+	}
+
+	m := make(map[string]Sprite, len(a.Frames))
+	for _, f := range a.Frames {
+		anim, frame, ok := splitFrameName(f.Name)
+		if !ok {
+			anim = ""
+		}
+		m[f.Name] = Sprite{
+			name:       f.Name,
+			x:          f.X,
+			y:          f.Y,
+			width:      f.SourceW,
+			height:     f.SourceH,
+			anim:       anim,
+			frame:      frame,
+			page:       f.Page,
+			rotated:    f.Rotated,
+			offsetX:    f.OffsetX,
+			offsetY:    f.OffsetY,
+			trimWidth:  f.W,
+			trimHeight: f.H,
+		}
+	}
+
+	table := newSpriteTable(pages, m)
+	table.BuildMasks(images)
+	emitters, err := loadEmitters(emitterData, table)
+	if err != nil {
+		return err
+	}
+	animations, err := buildAnimations(m, configs)
+	if err != nil {
+		return err
+	}
+	for _, anim := range animations {
+		for i := range anim.frames {
+			anim.frames[i].mask = table.Mask(table.ID(anim.frames[i].sprite))
+		}
+	}
+
+	// nothing is replaced until everything has loaded, so a bad file leaves the old atlas working
+	g.atlasFiles = files
+	g.spriteTable = table
+	g.render.sprites = table
+	g.animations = animations
+	g.emitters = emitters
+	return nil
+}
+
+// loadSounds decodes the sound effects, generates the synth sounds and reads which events play them
+func (g *Game) loadSounds() error {
+	data, err := g.assets.ReadFile(assetSFX)
+	if err != nil {
+		return err
+	}
+	var sounds map[SoundName]SoundFile
+	if err := json.Unmarshal(data, &sounds); err != nil {
+		return fmt.Errorf("%s: %v", assetSFX, err)
+	}
+	for name, s := range sounds {
+		wav, err := g.assets.ReadFile(s.File)
+		if err != nil {
+			return err
+		}
+		if err := g.sfx.LoadWAV(name, wav, s.Voices, s.Gain); err != nil {
+			return fmt.Errorf("%s: %v", s.File, err)
+		}
+	}
+	synth, err := g.assets.ReadFile(assetSynth)
+	if err != nil {
+		return err
+	}
+	if err := loadSynthSounds(synth, g.sfx); err != nil {
+		return err
+	}
+	events, err := g.assets.ReadFile(assetSoundEvents)
+	if err != nil {
+		return err
+	}
+	soundEvents, err := loadSoundEvents(events, g.sfx)
+	if err != nil {
+		return err
+	}
+	g.soundEvents = soundEvents
+	return nil
+}
+
+// loadTracks reads the music for every track. Missing music is returned rather
+// than failing, the game plays on without it.
+func (g *Game) loadTracks() (missing []string) {
+	read := func(file string) []byte {
+		data, err := g.assets.ReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				missing = append(missing, file)
+			} else {
+				log.Printf("music: %v", err)
+			}
+			return nil
+		}
+		return data
+	}
+	for _, track := range tracks {
+		if track.file != "" {
+			track.data = read(track.file)
+		}
+		for i := range track.stems {
+			track.stems[i].data = read(track.stems[i].file)
+		}
+	}
+	return missing
+}
+
+// reloadAssets picks up files saved while the game is running, from a directory source.
+// A file that fails to load is logged and the old one kept, so a half saved file does no harm.
+func (g *Game) reloadAssets() {
+	changed := g.assets.Changed()
+	if len(changed) == 0 {
+		return
+	}
+	reload, sounds, music := false, false, false
+	for _, name := range changed {
+		log.Printf("assets: %s changed", name)
+		switch {
+		case name == assetManifest || name == assetEmitters || name == assetAnimations || g.isAtlasFile(name):
+			reload = true
+		case name == assetSFX || name == assetSynth || name == assetSoundEvents:
+			sounds = true
+		case isMusicFile(name):
+			music = true
+		case strings.HasPrefix(name, "audio/"):
+			sounds = true
+		}
+	}
+
+	if reload {
+		if err := g.loadAtlas(); err != nil {
+			log.Printf("assets: %v", err)
+		} else {
+			g.particles.Reload(g.emitters)
+			if !g.particles.Has("stars") {
+				g.Emit("stars", 0, 0)
+			}
+		}
+	}
+	if sounds {
+		if err := g.loadSounds(); err != nil {
+			log.Printf("assets: %v", err)
+		}
+	}
+	if music {
+		g.loadTracks()
+		if err := g.music.Restart(); err != nil {
+			log.Printf("assets: %v", err)
+		}
+	}
+}
+
+// isMusicFile reports whether a file belongs to one of the music tracks
+func isMusicFile(name string) bool {
+	for _, track := range tracks {
+		if track.file == name {
+			return true
+		}
+		for _, stem := range track.stems {
+			if stem.file == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isAtlasFile reports whether a file is one of the atlas files or page images
+func (g *Game) isAtlasFile(name string) bool {
+	for _, file := range g.atlasFiles {
+		if file == name {
+			return true
+		}
+	}
+	return false
+}
@@ -1,22 +1,19 @@
 package main
 
 import (
-	"bytes"
-	"encoding/xml"
+	"flag"
 	"fmt"
 	"github.com/hajimehoshi/ebiten"
 	"github.com/hajimehoshi/ebiten/audio"
-	"github.com/hajimehoshi/ebiten/audio/mp3"
-	"github.com/hajimehoshi/ebiten/audio/wav"
 	"github.com/hajimehoshi/ebiten/ebitenutil"
 	"github.com/hajimehoshi/ebiten/inpututil"
-	"image"
-	"image/color"
 	_ "image/png"
 	"log"
 	"math"
 	"math/rand"
 	"strconv"
+	"strings"
+	"time"
 )
 
 /*
@@ -41,15 +38,15 @@ Tasks - Galaga Clone
 [x] - scoring
 [ ] - fabulous ui
 [ ] - title screen
-[ ] - game over screen
+[x] - game over screen
 [ ] - high scores storage
 [ ] - high scores name joystick entry
 [ ] - high scores screen
 [ ] - high score
 [ ] - sound effects for movement
-[ ] - sound effects for shoot
-[ ] - sound effects for enemy die
-[ ] - sound effects for player die
+[x] - sound effects for shoot
+[x] - sound effects for enemy die
+[x] - sound effects for player die
 [ ] - screen shake
 [x] - particles
 
@@ -60,20 +57,18 @@ const (
 	screenHeight = 320
 	maxAngle     = 256
 	sampleRate   = 44100
+	pauseKey     = ebiten.KeyP
+	pauseButton  = ebiten.GamepadButton9 // start on most pads
 )
 
+// enemySprites are the ships a wave can be made of
+var enemySprites = []SpriteName{spriteEnemy1, spriteEnemy2, spriteEnemy3}
+
 var (
-	debug              bool = false
-	spriteAtlas        *ebiten.Image
-	t                  int
-	sfxVolume          float64 = 0.4
-	bgmVolume          float64 = 0.3
-	audioContext       *audio.Context
-	audioShooty        *audio.Player
-	audioDeath         *audio.Player
-	audioPlayerExplode *audio.Player
-	audioExploded      *audio.Player
-	audioMusic         *audio.Player
+	debug        bool = false
+	t            int
+	audioContext *audio.Context
+	assetDir     string // set by -assets
 )
 
 func init() {
@@ -93,13 +88,22 @@ type Controls struct {
 	fire  bool
 }
 
-// Sprite is used in the construction of the sprite atlas object
+// Sprite is used in the construction of the sprite atlas object. width and
+// height are the whole sprite, trimmed sprites store fewer pixels in the atlas.
 type Sprite struct {
-	name   string
-	x      int
-	y      int
-	width  int
-	height int
+	name       string
+	x          int // top left of the stored pixels in the atlas page
+	y          int
+	width      int
+	height     int
+	anim       string // animation this sprite is a frame of, empty if it is not part of one
+	frame      int    // position in the animation, from the "#N" suffix of the name
+	page       int    // atlas page image the sprite is on
+	rotated    bool   // stored turned 90 degrees clockwise
+	offsetX    int    // where the stored pixels start within the sprite
+	offsetY    int
+	trimWidth  int // size of the stored pixels, upright
+	trimHeight int
 }
 
 // Game is the state of our game
@@ -109,7 +113,7 @@ type Game struct {
 	axes           map[int][]string
 	pressedButtons map[int][]string
 	actors         Actors
-	op             ebiten.DrawImageOptions
+	render         RenderQueue
 	inited         bool
 	player         Player
 	bullets        Bullets
@@ -117,10 +121,28 @@ type Game struct {
 	particles      Particles
 	difficulty     int
 	controls       Controls
-	sprites        map[string]Sprite
+	spriteTable    *SpriteTable
+	animations     map[string]*Animation
+	emitters       map[string]*Emitter
+	noEmitter      map[string]bool // emitter names already logged as missing
+	collisions     Collisions
+	assets         AssetSource
+	atlasFiles     []string // atlas files and page images, to reload the atlas when one changes
+	sfx            *AudioManager
+	soundEvents    map[GameEvent]*EventSound
+	music          *Music
+	settings       Settings
+	mixer          *Mixer
+	options        OptionsMenu
+	paused         bool
+	scene          Scene
+	sceneT         int // ticks since the scene started
+	revive         int // ticks until the player flies again after losing a life
 	enemyShoot     int
 	lives          int
 	debug          bool
+	updateTime     time.Duration // how long the last Update took, for the debug overlay
+	drawTime       time.Duration // how long the last Draw took before the overlay text
 }
 
 func (g *Game) init() {
@@ -129,108 +151,40 @@ func (g *Game) init() {
 		g.inited = true
 	}()
 
-	// get the sprite atlas from code
-	img, _, err := image.Decode(bytes.NewReader(packagepng))
-	spriteAtlas, _ = ebiten.NewImageFromImage(img, ebiten.FilterDefault)
-	if err != nil {
-		log.Fatal(err)
+	// assets come from the executable, or from a directory with -assets while developing
+	g.assets = newEmbeddedSource()
+	if assetDir != "" {
+		g.assets = newDirSource(assetDir)
 	}
 
-	// get a shooty sound
-	audioShootDecoded, err := wav.Decode(audioContext, audio.BytesReadSeekCloser(shootSample))
-	audioShooty, err = audio.NewPlayer(audioContext, audioShootDecoded)
-	audioShooty.SetVolume(sfxVolume - 0.2)
-	if err != nil {
+	if err := g.loadAtlas(); err != nil {
 		log.Fatal(err)
 	}
 
-	// get a player death sound
-	audioDeathDecoded, err := wav.Decode(audioContext, audio.BytesReadSeekCloser(deathSample))
-	audioDeath, err = audio.NewPlayer(audioContext, audioDeathDecoded)
-	audioDeath.SetVolume(sfxVolume + 0.3)
-	if err != nil {
-		log.Fatal(err)
-	}
+	// volumes come from the settings file, through the mixer buses
+	g.settings = loadSettings()
+	g.mixer = newMixer(&g.settings)
 
-	// get a small explosion sound
-	audioExplodeDecoded, err := wav.Decode(audioContext, audio.BytesReadSeekCloser(explodeSample))
-	audioExploded, err = audio.NewPlayer(audioContext, audioExplodeDecoded)
-	audioExploded.SetVolume(sfxVolume - 0.2)
-	if err != nil {
+	// sound effects are decoded once and shared by a few voices each
+	g.sfx = newAudioManager(audioContext, g.mixer)
+	if err := g.loadSounds(); err != nil {
 		log.Fatal(err)
 	}
 
-	// get background music
-	audioMusicDecoded, err := mp3.Decode(audioContext, audio.BytesReadSeekCloser(bgmSample))
-	audioMusic, err = audio.NewPlayer(audioContext, audioMusicDecoded)
-	audioMusic.SetVolume(bgmVolume)
-	if err != nil {
-		log.Fatal(err)
+	// background music loops the playlist for the scene
+	g.music = newMusic(audioContext, g.mixer)
+	if missing := g.loadTracks(); len(missing) > 0 {
+		log.Printf("music: %s missing, build with -tags large or run with -assets", strings.Join(missing, ", "))
 	}
-	audioMusic.Rewind()
-	audioMusic.Play()
 
-	// prepare the sprite atlas in memory
-	buf := bytes.NewBuffer(packagexml)
-	dec := xml.NewDecoder(buf)
+	g.setupCollisions()
 
-	var n Node
-	errXML := dec.Decode(&n)
-	if errXML != nil {
-		panic(errXML)
-	}
+	g.newGame()
 
-	m := make(map[string]Sprite)
-
-	walk([]Node{n}, func(n Node) bool {
-		if n.XMLName.Local == "SubTexture" {
-			// fmt.Println(string(n.Content))
-
-			x, err := strconv.Atoi(n.Attrs[1].Value)
-			if err != nil {
-				panic(err)
-			}
-			y, err := strconv.Atoi(n.Attrs[2].Value)
-			if err != nil {
-				panic(err)
-			}
-			width, err := strconv.Atoi(n.Attrs[3].Value)
-			if err != nil {
-				panic(err)
-			}
-			height, err := strconv.Atoi(n.Attrs[4].Value)
-			if err != nil {
-				panic(err)
-			}
-
-			m[n.Attrs[0].Value] = Sprite{
-				name:   n.Attrs[0].Value,
-				x:      x,
-				y:      y,
-				width:  width,
-				height: height,
-			}
-		}
-		return true
-	})
-
-	g.sprites = m
-
-	g.enemyShoot = 120 // start our enemies shooting
-
-	g.player = newPlayer()
-	g.lives = 3
-	g.player.safety = 60 * 4
+	g.particles = newParticles(g.settings.MaxParticles())
 
 	// create some star particles
-	for i := 0; i < 50; i++ {
-		g.particles.particles = append(g.particles.particles, &Particle{
-			x:       float64(rand.Intn(screenWidth)),
-			y:       float64(rand.Intn(screenHeight)),
-			vy:      float64(rand.Intn(10) + 1),
-			forever: true,
-		})
-	}
+	g.Emit("stars", 0, 0)
 
 }
 
@@ -239,30 +193,55 @@ func (g *Game) Update(screen *ebiten.Image) error {
 	if !g.inited {
 		g.init()
 	}
+	start := time.Now()
+	defer func() {
+		g.updateTime = time.Since(start)
+	}()
+	g.updateDebug()
+	g.reloadAssets()
+	g.updateGamepads()
+	g.updatePause()
+	if g.music.Adaptive() || g.debug {
+		g.music.SetIntensity(g.intensity()) // only worth rating the game when the music listens or the overlay shows it
+	}
+	if err := g.music.Update(); err != nil {
+		return err
+	}
+	if g.paused {
+		g.updateOptions()
+		return nil
+	}
 	t++
+	g.updateRevive()
+	g.updateGameOver()
 
 	if g.enemyShoot == 0 && len(g.actors.actors) > 0 {
 
 		var enemyToShoot = rand.Intn(len(g.actors.actors))
-		var actorSprite string = "enemyBullet"
+		var actorSprite SpriteName = spriteEnemyBullet
+		w, h := g.spriteTable.Size(g.spriteTable.ID(actorSprite))
 		g.actors.Create(Actor{
-			group:       "enemyBullet",
-			imageWidth:  g.sprites[actorSprite].width,
-			imageHeight: g.sprites[actorSprite].height,
+			group:       groupEnemyBullet,
+			imageWidth:  w,
+			imageHeight: h,
 			x:           g.actors.actors[enemyToShoot].x, // all these squares make a circle
 			y:           g.actors.actors[enemyToShoot].y,
 			vx:          0,
 			vy:          3,
-			actorType:   "bullet",
+			actorType:   actorBullet,
 			sprite:      actorSprite,
-			hitbox: Hitbox{
+			faceTravel:  true,
+			collision:   collisionEnemyBullet,
+			hitbox: g.shapedHitbox(actorSprite, Hitbox{
 				x: 0,
 				y: 0,
-				w: float64(g.sprites[actorSprite].width),
-				h: float64(g.sprites[actorSprite].height),
-			},
+				w: float64(w),
+				h: float64(h),
+			}),
 		})
 
+		g.EventAt(eventEnemyFired, g.actors.actors[enemyToShoot].x+float64(g.actors.actors[enemyToShoot].imageWidth)/2)
+
 		g.enemyShoot = 60 //rand.Intn(30) + 30 - (g.difficulty * 2)
 		if g.enemyShoot < 10 {
 			g.enemyShoot = 10
@@ -303,22 +282,6 @@ func (g *Game) Update(screen *ebiten.Image) error {
 		g.controls.fire = true
 	}
 
-	if g.gamepadIDs == nil {
-		g.gamepadIDs = map[int]struct{}{}
-	}
-
-	// Log the gamepad connection eventa.
-	for _, id := range inpututil.JustConnectedGamepadIDs() {
-		log.Printf("gamepad connected: id: %d", id)
-		g.gamepadIDs[id] = struct{}{}
-	}
-	for id := range g.gamepadIDs {
-		if inpututil.IsGamepadJustDisconnected(id) {
-			log.Printf("gamepad disconnected: id: %d", id)
-			delete(g.gamepadIDs, id)
-		}
-	}
-
 	g.axes = map[int][]string{}
 	g.pressedButtons = map[int][]string{}
 	for id := range g.gamepadIDs {
@@ -386,16 +349,16 @@ func (g *Game) Update(screen *ebiten.Image) error {
 				vx:          0,
 				vy:          -6,
 				angle:       0,
-				hitbox: Hitbox{
+				pierce:      g.player.pierce,
+				hitbox: g.shapedHitbox(spriteBullet, Hitbox{
 					x: 0,
 					y: 0,
 					w: 8,
 					h: 8,
-				},
+				}),
 			})
 			g.bullets.num = len(g.bullets.bullets)
-			audioShooty.Rewind()
-			audioShooty.Play()
+			g.EventAt(eventPlayerShot, g.player.x+16)
 		}
 	}
 
@@ -425,34 +388,28 @@ func (g *Game) Update(screen *ebiten.Image) error {
 			g.player.fireRate--
 		}
 
+		// spawn safety wears off
+		if g.player.safety > 0 {
+			g.player.safety--
+		}
+
 		// engine trail
-		g.particles.particles = append(g.particles.particles, &Particle{
-			x:            g.player.x,
-			y:            g.player.y,
-			vx:           0,
-			vy:           0,
-			size:         10,
-			sizev:        -1,
-			particleType: 99,
-			life:         6,
-		})
+		g.Emit("engine_trail", g.player.x+16, g.player.y+28) // exhaust at the back of the ship
 
 	}
 
 	// Update the vectors
 	for i := len(g.actors.actors) - 1; i >= 0; i-- {
 		var a = g.actors.actors[i]
-		if a.group == "enemy" {
+		if a.group == groupEnemy {
 			var vx = float64(math.Sin(float64(a.t / 10)))
 			var vy = float64(math.Sin(float64(a.t/20) + 80))
-			if a.actorType == "enemy1" || a.actorType == "enemy2" || a.actorType == "enemy3" {
-				a.SetVectors(vx, vy)
-			}
-
+			a.SetVectors(vx, vy)
 		}
-		if a.group == "enemyBullet" {
+		if a.group == groupEnemyBullet {
 			if a.y > screenHeight {
 				a.Kill()
+				g.EventAt(eventEnemyMissed, a.x+float64(a.imageWidth)/2)
 			}
 		}
 	}
@@ -460,27 +417,7 @@ func (g *Game) Update(screen *ebiten.Image) error {
 
 	for i := len(g.bullets.bullets) - 1; i >= 0; i-- {
 		var b = g.bullets.bullets[i]
-		for j := len(g.actors.actors) - 1; j >= 0; j-- {
-			var a = g.actors.actors[j]
-
-			if a.actorType != "5" {
-				if collide(
-					a.x+a.hitbox.x,
-					a.y+a.hitbox.y,
-					a.hitbox.w,
-					a.hitbox.h,
-					b.x+b.hitbox.x,
-					b.y+b.hitbox.y,
-					b.hitbox.w,
-					b.hitbox.h,
-				) {
-					g.bullets.bullets[i].toDelete = true
-					g.actors.actors[j].Kill()
-					g.score++
-					explodeSmall(g, b.x, b.y)
-				}
-			}
-		}
+		g.collideBullet(b)
 
 		g.bullets.bullets[i].x += g.bullets.bullets[i].vx
 		g.bullets.bullets[i].y += g.bullets.bullets[i].vy
@@ -490,25 +427,33 @@ func (g *Game) Update(screen *ebiten.Image) error {
 		}
 
 		if len(g.actors.actors) == 0 {
+			if g.difficulty > 0 {
+				g.Event(eventWaveCleared)
+			}
+			g.Event(eventWaveStart)
+
 			// create some baddies
-			var thisWave int = rand.Intn(3)
+			var thisWave SpriteName = enemySprites[rand.Intn(len(enemySprites))]
 			for i := 0; i < 5; i++ {
 				for j := 0; j < 4; j++ {
 					g.actors.Create(Actor{
-						group:       "enemy",
-						actorType:   "enemy" + strconv.Itoa(thisWave),
-						sprite:      "enemy" + strconv.Itoa(thisWave),
+						group:       groupEnemy,
+						collision:   collisionEnemy,
+						faceTravel:  true, // point the way they are flying
+						actorType:   string(thisWave),
+						sprite:      thisWave,
+						anim:        g.animations[string(thisWave)], // nil unless the atlas has enemyN#0, enemyN#1...
 						imageWidth:  32,
 						imageHeight: 32,
 						x:           float64(12 + (i * 40)), // all these squares make a circle
 						y:           float64(48 + (j * 32)),
 						t:           (i + j) * 3, // by starting the timer offset like this we get a pleasant wiggly formation
-						hitbox: Hitbox{
+						hitbox: g.shapedHitbox(thisWave, Hitbox{
 							x: 4,
 							y: 4,
 							w: 24,
 							h: 24,
-						},
+						}),
 					})
 				}
 			}
@@ -516,37 +461,15 @@ func (g *Game) Update(screen *ebiten.Image) error {
 		}
 	}
 
-	var tempBullets = make([]*Bullet, 0)
-	for _, x := range g.bullets.bullets {
-		if !x.toDelete {
-			tempBullets = append(tempBullets, x)
-		}
-	}
-	g.bullets.bullets = tempBullets
+	g.bullets.Clean()
 
-	var deletedAny = g.actors.Clean()
-	if deletedAny {
-		audioExploded.Rewind()
-		audioExploded.Play()
-	}
+	g.actors.Clean()
 
-	var tempParticles = make([]*Particle, 0)
-	for _, x := range g.particles.particles {
-		if !x.toDelete {
-			tempParticles = append(tempParticles, x)
-		}
-	}
-	g.particles.particles = tempParticles
+	g.particles.Clean()
 	g.particles.Update()
 
-	// Does the player collide with any enemy?
-	if g.actors.CollidesHitbox(g.player.x, g.player.y, g.player.hitbox, "enemy") && g.player.safety <= 0 {
-		g.player.toDelete = true
-	}
-	// Does the player collide with any enemy bullets??
-	if g.actors.CollidesHitbox(g.player.x, g.player.y, g.player.hitbox, "enemyBullet") && g.player.safety <= 0 {
-		g.player.toDelete = true
-	}
+	// Does the player collide with any enemy or enemy bullet?
+	g.collidePlayer()
 
 	if g.player.toDelete {
 		killPlayer(g)
@@ -557,56 +480,36 @@ func (g *Game) Update(screen *ebiten.Image) error {
 
 // Draw is called every frame to draw the game contents
 func (g *Game) Draw(screen *ebiten.Image) {
+	start := time.Now()
 
-	// Draw the current gamepad statua.
-	str := ""
-	if debug {
-		if len(g.gamepadIDs) > 0 {
-
-			// for id := range g.gamepadIDs {
-			// 	str += fmt.Sprintf("Gamepad (ID: %d, SDL ID: %s):\n", id, ebiten.GamepadSDLID(id))
-			// 	str += fmt.Sprintf("  Axes:    %s\n", strings.Join(g.axes[id], ", "))
-			// 	str += fmt.Sprintf("  Buttons: %s\n", strings.Join(g.pressedButtons[id], ", "))
-			// 	str += fmt.Sprintf("Bullets: %d\n", len(g.bullets.bullets))
-			// 	str += fmt.Sprintf("Player: x-%d y-%d\n", int(g.player.x), int(g.player.y))
-			// 	str += "\n"
-			// }
-
-		} else {
-			str = "Please connect your gamepad."
-		}
-		ebitenutil.DebugPrint(screen, str)
-	}
+	var noColor ebiten.ColorM
 
 	if g.player.alive {
 		// draw player sprite
-		g.op.GeoM.Reset()
-		//g.op.GeoM.Translate(-float64(w)/2, -float64(h)/2)
-		//g.op.GeoM.Rotate(2 * math.Pi * float64(s.angle) / maxAngle)
-		//g.op.GeoM.Translate(float64(w)/2, float64(h)/2)
-		g.op.GeoM.Translate(float64(g.player.x), float64(g.player.y))
-		//screen.DrawImage(playerImg, &g.op)
+		var geoM ebiten.GeoM
+		geoM.Translate(float64(g.player.x), float64(g.player.y))
 
 		if g.player.safety > 0 {
 			if t%2 == 0 {
 				// flicker is safe
-				spriteDraw(screen, g, "player")
+				g.render.Submit(spritePlayer, geoM, noColor, layerPlayer)
 			}
 		} else {
-			spriteDraw(screen, g, "player")
+			g.render.Submit(spritePlayer, geoM, noColor, layerPlayer)
 		}
 		// some rotating stars
-		wp, hp := g.sprites["player"].width, g.sprites["player"].height       // player width/height
-		ws, hs := g.sprites["starSmall"].width, g.sprites["starSmall"].height // small star
-		wst, hst := g.sprites["starTiny"].width, g.sprites["starTiny"].height // tiny star
+		starSmall, starTiny := g.spriteTable.ID(spriteStarSmall), g.spriteTable.ID(spriteStarTiny)
+		wp, hp := g.spriteTable.Size(g.spriteTable.ID(spritePlayer)) // player width/height
+		ws, hs := g.spriteTable.Size(starSmall)                      // small star
+		wst, hst := g.spriteTable.Size(starTiny)                     // tiny star
 
 		if g.player.safety > 0 {
 
 			for i := 0; i < 6; i++ {
-				g.op.GeoM.Reset()
-				g.op.GeoM.Translate(-float64(ws)/2, -float64(hs)/2) // center this sprite
-				g.op.GeoM.Translate(float64(wp)/2, float64(hp)/2)   // center on player
-				g.op.GeoM.Translate(
+				geoM.Reset()
+				geoM.Translate(-float64(ws)/2, -float64(hs)/2) // center this sprite
+				geoM.Translate(float64(wp)/2, float64(hp)/2)   // center on player
+				geoM.Translate(
 					float64(
 						g.player.x+ldX(
 							24, float64(t+(i*10))/10,
@@ -618,12 +521,13 @@ func (g *Game) Draw(screen *ebiten.Image) {
 						),
 					),
 				)
-				spriteDraw(screen, g, "starSmall")
+				// the lower half of the orbit passes in front of the ship, the upper half behind it
+				g.render.SubmitZ(spriteStarSmall, geoM, noColor, layerPlayer, ldY(1, float64(t+(i*10))/10))
 
-				g.op.GeoM.Reset()
-				g.op.GeoM.Translate(-float64(wst)/2, -float64(hst)/2)
-				g.op.GeoM.Translate(float64(wp)/2, float64(hp)/2)
-				g.op.GeoM.Translate(
+				geoM.Reset()
+				geoM.Translate(-float64(wst)/2, -float64(hst)/2)
+				geoM.Translate(float64(wp)/2, float64(hp)/2)
+				geoM.Translate(
 					float64(
 						g.player.x+ldX(
 							32, -float64(t+(i*18))/18,
@@ -635,94 +539,86 @@ func (g *Game) Draw(screen *ebiten.Image) {
 						),
 					),
 				)
-				spriteDraw(screen, g, "starTiny")
+				g.render.SubmitZ(spriteStarTiny, geoM, noColor, layerPlayer, ldY(1, -float64(t+(i*18))/18))
 			}
-			g.player.safety--
 		}
 
 	}
 
-	g.actors.DrawGroup(g, screen, "enemy")
-	g.actors.DrawGroup(g, screen, "enemyBullet")
+	g.actors.DrawGroup(g, groupEnemy, layerEnemy)
+	g.actors.DrawGroup(g, groupEnemyBullet, layerEnemyBullet)
 
-	w, h := g.sprites["bullet"].width, g.sprites["bullet"].height
+	bullet := g.spriteTable.ID(spriteBullet)
+	w, h := g.spriteTable.Size(bullet)
 	for i := 0; i < len(g.bullets.bullets); i++ {
 		if !g.bullets.bullets[i].toDelete {
 			s := g.bullets.bullets[i]
-			g.op.GeoM.Reset()
-			g.op.GeoM.Translate(-float64(w)/2, -float64(h)/2)
-			g.op.GeoM.Rotate(2 * math.Pi * float64(s.angle) / maxAngle)
-			g.op.GeoM.Translate(float64(w)/2, float64(h)/2)
-			g.op.GeoM.Translate(float64(s.x), float64(s.y))
-			//screen.DrawImage(bulletImg, &g.op)
-			spriteDraw(screen, g, "bullet")
-			if debug {
-				ebitenutil.DrawRect(
-					screen,
-					float64(s.x+s.hitbox.x),
-					float64(s.y+s.hitbox.y),
-					float64(s.hitbox.w),
-					float64(s.hitbox.h),
-					color.NRGBA{0xff, 0x00, 0x00, 0x77},
-				)
-			}
+			geoM := s.transform.Rotated(stepsToRadians(s.angle)).GeoM(w, h, s.x, s.y)
+			g.render.SubmitID(bullet, geoM, noColor, layerBullet)
 		}
 	}
 
-	for i := 0; i < len(g.particles.particles); i++ {
-		s := g.particles.particles[i]
-
-		// stars
-		if s.particleType == 0 {
-			var scale float64 = s.vy / 9 // magic nine?
-			g.op.GeoM.Reset()
-			g.op.GeoM.Scale(1, float64(scale))
-			g.op.GeoM.Translate(float64(s.x), float64(s.y))
-			g.op.ColorM.Translate(0, 0, 0, -(0.5 + (-scale + 1)))
-			if s.vy > 5 {
-				spriteDraw(screen, g, "starFast")
-			} else {
-				spriteDraw(screen, g, "starSlow")
-			}
-			g.op.ColorM.Reset()
-		}
+	g.particles.Draw(g)
+
+	lives := g.spriteTable.ID(spriteLives)
+	for i := 0; i < g.lives; i++ {
+		var geoM ebiten.GeoM
+		geoM.Translate(float64(16+(i*18)), float64(screenHeight-20))
+		g.render.SubmitID(lives, geoM, noColor, layerUI)
+	}
 
-		// fireballs
-		if s.particleType == 1 {
-			var scale float64 = s.size / 100 // between 0 and 1
-			w, h := g.sprites["circleWhite"].width, g.sprites["circleWhite"].height
-			var nudgex float64 = float64(w) * scale
-			var nudgey float64 = float64(h) * scale
+	if g.debug {
+		g.submitHitboxes()
+	}
 
-			g.op.GeoM.Reset()
-			g.op.GeoM.Scale(s.size/100, s.size/100)
-			g.op.GeoM.Translate(float64(s.x-(nudgex/2)), float64(s.y-(nudgey/2)))
+	g.render.Flush(screen)
+	g.drawTime = time.Since(start)
 
-			g.op.ColorM.Translate(2, -scale*2, -1, 0)
-			spriteDraw(screen, g, "circleWhite")
-			g.op.ColorM.Reset()
-		}
-		// big white circle
-		if s.particleType == 2 {
-			var scale float64 = s.size / 100 // between 0 and 1
-			w, h := g.sprites["circleWhite"].width, g.sprites["circleWhite"].height
-			var nudgex float64 = float64(w) * scale
-			var nudgey float64 = float64(h) * scale
-
-			g.op.GeoM.Reset()
-			g.op.GeoM.Scale(scale, scale)
-			g.op.GeoM.Translate(float64(s.x-(nudgex/2)), float64(s.y-(nudgey/2)))
-			spriteDraw(screen, g, "circleWhite")
-			g.op.ColorM.Reset()
+	if g.debug {
+		g.drawDebugText(screen)
+	}
+	ebitenutil.DebugPrint(screen, fmt.Sprintf("SCORE: %d  -  WAVE: %d ", g.score*1000, g.difficulty))
+	if g.scene == sceneGameOver {
+		g.drawGameOver(screen)
+	}
+	if g.paused {
+		g.drawOptions(screen)
+	}
+}
+
+// updateGamepads adds and removes gamepads as they are plugged in and out. It
+// runs every tick, paused or not, as each only reports on the tick it happens.
+func (g *Game) updateGamepads() {
+	if g.gamepadIDs == nil {
+		g.gamepadIDs = map[int]struct{}{}
+	}
+
+	// Log the gamepad connection eventa.
+	for _, id := range inpututil.JustConnectedGamepadIDs() {
+		log.Printf("gamepad connected: id: %d", id)
+		g.gamepadIDs[id] = struct{}{}
+	}
+	for id := range g.gamepadIDs {
+		if inpututil.IsGamepadJustDisconnected(id) {
+			log.Printf("gamepad disconnected: id: %d", id)
+			delete(g.gamepadIDs, id)
 		}
 	}
+}
 
-	for i := 0; i < g.lives; i++ {
-		g.op.GeoM.Reset()
-		g.op.GeoM.Translate(float64(16+(i*18)), float64(screenHeight-20))
-		spriteDraw(screen, g, "lives")
+// updatePause stops the game and ducks the music with the pause key or start button
+func (g *Game) updatePause() {
+	pressed := inpututil.IsKeyJustPressed(pauseKey)
+	for id := range g.gamepadIDs {
+		if inpututil.IsGamepadButtonJustPressed(id, pauseButton) {
+			pressed = true
+		}
+	}
+	if pressed {
+		g.paused = !g.paused
+		g.music.SetPaused(g.paused)
+		g.Event(eventMenuSelect)
 	}
-	ebitenutil.DebugPrint(screen, fmt.Sprintf("SCORE: %d  -  WAVE: %d ", g.score*1000, g.difficulty))
 }
 
 // Layout is part of the ebiten framework
@@ -731,6 +627,9 @@ func (g *Game) Layout(outsideWidth, outsideHeight int) (int, int) {
 }
 
 func main() {
+	flag.StringVar(&assetDir, "assets", "", "read assets from this directory and reload them when they change")
+	flag.Parse()
+
 	ebiten.SetWindowSize(screenWidth*2, screenHeight*2)
 	ebiten.SetWindowTitle("Game Window")
 	if err := ebiten.RunGame(&Game{}); err != nil {
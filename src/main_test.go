@@ -0,0 +1,17 @@
+package main
+
+import (
+	"testing"
+)
+
+// newTestGame loads the embedded atlas and emitters, enough to spawn, collide and draw without running the game
+func newTestGame(tb testing.TB) *Game {
+	tb.Helper()
+	g := &Game{assets: newEmbeddedSource()}
+	if err := g.loadAtlas(); err != nil {
+		tb.Fatal(err)
+	}
+	g.particles = newParticles(defaultMaxParticles)
+	g.setupCollisions()
+	return g
+}
@@ -0,0 +1,54 @@
+package main
+
+// Bus is a volume control that a group of sounds plays through
+type Bus int
+
+const (
+	busMaster Bus = iota // scales every other bus
+	busMusic
+	busSFX
+	numBuses
+)
+
+// busNames are the keys for each bus in the settings file
+var busNames = [numBuses]string{"master", "music", "sfx"}
+
+// Mixer reads and changes bus volumes, which live in the settings so they are saved
+type Mixer struct {
+	settings *Settings
+}
+
+func newMixer(settings *Settings) *Mixer {
+	return &Mixer{settings: settings}
+}
+
+// Level is the volume of one bus on its own
+func (m *Mixer) Level(b Bus) float64 {
+	if v, ok := m.settings.Volume[busNames[b]]; ok {
+		return v
+	}
+	return 1
+}
+
+// SetLevel changes the volume of a bus, kept between silent and full
+func (m *Mixer) SetLevel(b Bus, v float64) {
+	m.settings.Volume[busNames[b]] = clamp01(v)
+}
+
+// Gain is the volume a sound on the bus actually plays at, after the master bus
+func (m *Mixer) Gain(b Bus) float64 {
+	if b == busMaster {
+		return m.Level(busMaster)
+	}
+	return m.Level(busMaster) * m.Level(b)
+}
+
+// Mono reports whether sounds should ignore their pan
+func (m *Mixer) Mono() bool {
+	return m.settings.Mono
+}
+
+// SetMono turns panning off for single speaker setups, or back on
+func (m *Mixer) SetMono(mono bool) {
+	m.settings.Mono = mono
+}
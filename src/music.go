@@ -0,0 +1,302 @@
+package main
+
+import (
+	"fmt"
+	"github.com/hajimehoshi/ebiten/audio"
+	"github.com/hajimehoshi/ebiten/audio/mp3"
+	"github.com/hajimehoshi/ebiten/audio/wav"
+	"math"
+	"time"
+)
+
+// Scene is a part of the game with its own music
+type Scene int
+
+const (
+	sceneGame     Scene = iota
+	sceneGameOver       // no playlist, so the music fades out
+)
+
+const (
+	musicFade  = 90   // ticks to crossfade between tracks
+	pauseDuck  = 0.25 // music volume while paused, zero stops it instead
+	pauseFade  = 15   // ticks to duck or restore the music on pause
+	tickLength = time.Second / 60
+)
+
+// Track is a piece of music and where it loops. loopStart and loopEnd are in
+// seconds, a zero loopEnd loops at the end of the track. loops is how many
+// times the loop plays before the playlist moves on, zero loops forever.
+// An adaptive track is made of stems instead of data, see Stem.
+type Track struct {
+	name      string
+	file      string // under assets/, read into data by loadTracks
+	data      []byte
+	mp3       bool
+	gain      float64 // base volume of the track, before the music bus
+	loopStart float64
+	loopEnd   float64
+	loops     int
+	stems     []Stem
+}
+
+// tracks is all the music the game knows about
+var tracks = map[string]*Track{
+	"focus": {name: "focus", file: "audio/chipzel-focus.mp3", mp3: true, gain: 0.3, loops: 1},
+	// small enough to always be bundled, the drums and lead come in as the game heats up
+	"pulse": {name: "pulse", gain: 0.3, loops: 16, stems: []Stem{
+		{name: "bass", file: "audio/pulse-bass.wav"},
+		{name: "drums", file: "audio/pulse-drums.wav", from: 0.2, to: 0.4},
+		{name: "lead", file: "audio/pulse-lead.wav", from: 0.6, to: 0.8},
+	}},
+}
+
+// playlists is the music for each scene, played in order and then from the top.
+// A scene without a playlist fades the music out.
+var playlists = map[Scene][]string{
+	sceneGame: {"pulse", "focus"},
+}
+
+// musicVoice is a track being played, fading towards its target gain
+type musicVoice struct {
+	track  *Track
+	player *audio.Player
+	stems  *stemStream   // mixes the stems of an adaptive track, nil for a plain one
+	length time.Duration // how long until the playlist moves on, zero for never
+	gain   float64
+	target float64
+}
+
+// Music plays the playlist for the current scene, crossfading between tracks
+type Music struct {
+	context   *audio.Context
+	mixer     *Mixer
+	scene     Scene
+	index     int
+	current   *musicVoice
+	fading    []*musicVoice
+	duck      float64 // 1 normally, pauseDuck while paused
+	paused    bool
+	intensity float64 // passed on to adaptive tracks as they start
+}
+
+func newMusic(context *audio.Context, mixer *Mixer) *Music {
+	return &Music{context: context, mixer: mixer, scene: -1, duck: 1}
+}
+
+// SetScene crossfades to the start of a scene's playlist, unless already playing it
+func (m *Music) SetScene(scene Scene) error {
+	if scene == m.scene {
+		return nil
+	}
+	m.scene = scene
+	m.index = 0
+	return m.crossfade()
+}
+
+// crossfade fades out what is playing and fades in the current playlist entry
+func (m *Music) crossfade() error {
+	if m.current != nil {
+		m.current.target = 0
+		m.fading = append(m.fading, m.current)
+		m.current = nil
+	}
+	list := playlists[m.scene]
+	if len(list) == 0 {
+		return nil
+	}
+	// skip tracks whose music is missing, a build without the large files still plays the rest
+	for range list {
+		track, ok := tracks[list[m.index%len(list)]]
+		if ok && track.loaded() {
+			v, err := m.start(track)
+			if err != nil {
+				return err
+			}
+			m.current = v
+			return nil
+		}
+		m.index++
+	}
+	return nil
+}
+
+// loaded reports whether the music for a track, or every one of its stems, has been read
+func (t *Track) loaded() bool {
+	if len(t.stems) == 0 {
+		return t.data != nil
+	}
+	for _, stem := range t.stems {
+		if stem.data == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Restart crossfades into the start of the playlist again, to pick up music that has been reloaded
+func (m *Music) Restart() error {
+	m.index = 0
+	return m.crossfade()
+}
+
+// decodeMusic decodes an mp3 or WAV for streaming, with its length in bytes
+func decodeMusic(context *audio.Context, data []byte, isMP3 bool) (audio.ReadSeekCloser, int64, error) {
+	if isMP3 {
+		s, err := mp3.Decode(context, audio.BytesReadSeekCloser(data))
+		if err != nil {
+			return nil, 0, err
+		}
+		return s, s.Length(), nil
+	}
+	s, err := wav.Decode(context, audio.BytesReadSeekCloser(data))
+	if err != nil {
+		return nil, 0, err
+	}
+	return s, s.Length(), nil
+}
+
+// start decodes a track and plays it silently from the top, looping between
+// its loop points. The stems of an adaptive track are mixed into one stream.
+func (m *Music) start(track *Track) (*musicVoice, error) {
+	var (
+		sources []audio.ReadSeekCloser
+		length  int64
+	)
+	if len(track.stems) == 0 {
+		src, l, err := decodeMusic(m.context, track.data, track.mp3)
+		if err != nil {
+			return nil, err
+		}
+		sources, length = append(sources, src), l
+	}
+	for _, stem := range track.stems {
+		src, l, err := decodeMusic(m.context, stem.data, stem.mp3)
+		if err != nil {
+			return nil, fmt.Errorf("track %s stem %s: %v", track.name, stem.name, err)
+		}
+		// stems should be the same length, loop at the shortest so they stay together
+		if len(sources) == 0 || l < length {
+			length = l
+		}
+		sources = append(sources, src)
+	}
+
+	// loop points are whole frames, in bytes of the decoded stream
+	bytesPerSecond := float64(m.context.SampleRate() * bytesPerFrame)
+	toBytes := func(seconds float64) int64 {
+		return int64(seconds*bytesPerSecond) / bytesPerFrame * bytesPerFrame
+	}
+	intro, end := toBytes(track.loopStart), length
+	if track.loopEnd > 0 && toBytes(track.loopEnd) < length {
+		end = toBytes(track.loopEnd)
+	}
+	if intro >= end {
+		intro = 0
+	}
+
+	v := &musicVoice{track: track, target: 1}
+	var src audio.ReadSeekCloser
+	if len(track.stems) == 0 {
+		src = audio.NewInfiniteLoopWithIntro(sources[0], intro, end-intro)
+	} else {
+		loops := make([]audio.ReadSeekCloser, len(sources))
+		for i, s := range sources {
+			loops[i] = audio.NewInfiniteLoopWithIntro(s, intro, end-intro)
+		}
+		v.stems = newStemStream(track.stems, loops, m.context.SampleRate())
+		v.stems.SetIntensity(m.intensity)
+		src = v.stems
+	}
+
+	p, err := audio.NewPlayer(m.context, src)
+	if err != nil {
+		return nil, err
+	}
+	v.player = p
+	if track.loops > 0 {
+		v.length = time.Duration(float64(intro+(end-intro)*int64(track.loops)) / bytesPerSecond * float64(time.Second))
+	}
+	p.SetVolume(0)
+	if !m.paused || pauseDuck > 0 {
+		p.Play()
+	}
+	return v, nil
+}
+
+// Adaptive reports whether the track playing now is made of stems that follow the intensity
+func (m *Music) Adaptive() bool {
+	return m.current != nil && m.current.stems != nil
+}
+
+// SetIntensity tells adaptive tracks how intense the game is, from 0 calm to 1 frantic
+func (m *Music) SetIntensity(intensity float64) {
+	m.intensity = intensity
+	if m.Adaptive() {
+		m.current.stems.SetIntensity(intensity)
+	}
+}
+
+// Update moves fades along and advances the playlist, call it every tick
+func (m *Music) Update() error {
+	step := 1.0 / musicFade
+	if m.current != nil {
+		m.current.gain = math.Min(m.current.target, m.current.gain+step)
+		m.apply(m.current)
+
+		// move on shortly before the end, so the crossfade finishes as the loops do
+		if m.current.length > 0 && m.current.player.Current() >= m.current.length-musicFade*tickLength {
+			m.index++
+			if err := m.crossfade(); err != nil {
+				return err
+			}
+		}
+	}
+
+	playing := m.fading[:0]
+	for _, v := range m.fading {
+		v.gain = math.Max(0, v.gain-step)
+		if v.gain == 0 {
+			v.player.Close()
+			continue
+		}
+		m.apply(v)
+		playing = append(playing, v)
+	}
+	m.fading = playing
+
+	target := 1.0
+	if m.paused {
+		target = pauseDuck
+	}
+	if m.duck < target {
+		m.duck = math.Min(target, m.duck+1.0/pauseFade)
+	} else {
+		m.duck = math.Max(target, m.duck-1.0/pauseFade)
+	}
+	return nil
+}
+
+// apply sets the player volume from the track gain, the voice fade, the duck and the music bus
+func (m *Music) apply(v *musicVoice) {
+	v.player.SetVolume(clamp01(v.track.gain * v.gain * m.duck * m.mixer.Gain(busMusic)))
+}
+
+// SetPaused ducks the music while the game is paused, or stops it if pauseDuck is zero
+func (m *Music) SetPaused(paused bool) {
+	m.paused = paused
+	if pauseDuck > 0 {
+		return
+	}
+	voices := m.fading
+	if m.current != nil {
+		voices = append([]*musicVoice{m.current}, m.fading...)
+	}
+	for _, v := range voices {
+		if paused {
+			v.player.Pause()
+		} else {
+			v.player.Play()
+		}
+	}
+}
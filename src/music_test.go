@@ -0,0 +1,39 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestGamePlaylistAdaptive checks the game always has an adaptive track to
+// play, bundled without -tags large, with stems that loop together
+func TestGamePlaylistAdaptive(t *testing.T) {
+	g := &Game{assets: newEmbeddedSource()}
+	g.loadTracks()
+
+	adaptive := false
+	for _, name := range playlists[sceneGame] {
+		track, ok := tracks[name]
+		if !ok {
+			t.Fatalf("playlist has %q, which is not a track", name)
+		}
+		if len(track.stems) == 0 || !track.loaded() {
+			continue
+		}
+		adaptive = true
+		size := -1
+		for _, stem := range track.stems {
+			// the data chunk of these WAVs follows the 36 byte header
+			if !bytes.HasPrefix(stem.data[36:], []byte("data")) {
+				t.Fatalf("%s stem %s is not a plain WAV", name, stem.name)
+			}
+			if size >= 0 && len(stem.data) != size {
+				t.Errorf("%s stem %s is %d bytes, want %d like the others", name, stem.name, len(stem.data), size)
+			}
+			size = len(stem.data)
+		}
+	}
+	if !adaptive {
+		t.Error("no bundled adaptive track in the game playlist, the intensity would never be heard")
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+	"github.com/hajimehoshi/ebiten/inpututil"
+	"log"
+	"math"
+	"strings"
+)
+
+const volumeStep = 0.1 // how much left and right change a volume in the options menu
+
+// OptionsMenu is shown while the game is paused, to change the sound settings
+type OptionsMenu struct {
+	cursor int
+	padX   int // gamepad stick direction last tick, -1, 0 or 1, so holding it moves once
+	padY   int
+}
+
+// option is one line of the options menu, change is called with -1 for left and 1 for right
+type option struct {
+	label  string
+	value  func(g *Game) string
+	change func(g *Game, dir int)
+}
+
+// optionEntries are the options menu lines, top to bottom
+var optionEntries = []option{
+	volumeOption(busMaster),
+	volumeOption(busMusic),
+	volumeOption(busSFX),
+	{
+		label: "OUTPUT",
+		value: func(g *Game) string {
+			if g.mixer.Mono() {
+				return "MONO"
+			}
+			return "STEREO"
+		},
+		change: func(g *Game, dir int) {
+			g.mixer.SetMono(!g.mixer.Mono())
+			g.PlayAt(soundShoot, screenWidth) // off to the right, unless mono
+		},
+	},
+}
+
+// volumeOption is the menu line for a mixer bus
+func volumeOption(bus Bus) option {
+	return option{
+		label: strings.ToUpper(busNames[bus]),
+		value: func(g *Game) string {
+			return fmt.Sprintf("%3.0f%%", g.mixer.Level(bus)*100)
+		},
+		change: func(g *Game, dir int) {
+			level := g.mixer.Level(bus) + float64(dir)*volumeStep
+			g.mixer.SetLevel(bus, math.Round(level/volumeStep)*volumeStep)
+			g.sfx.Refresh()
+			g.Event(eventMenuSelect) // so a new SFX volume can be heard
+		},
+	}
+}
+
+// updateOptions moves the cursor with up and down and changes the line under it
+// with left and right, from the arrow keys or a gamepad stick
+func (g *Game) updateOptions() {
+	o := &g.options
+	padX, padY := g.gamepadDirection()
+	up := inpututil.IsKeyJustPressed(ebiten.KeyUp) || (padY == -1 && o.padY != -1)
+	down := inpututil.IsKeyJustPressed(ebiten.KeyDown) || (padY == 1 && o.padY != 1)
+	left := inpututil.IsKeyJustPressed(ebiten.KeyLeft) || (padX == -1 && o.padX != -1)
+	right := inpututil.IsKeyJustPressed(ebiten.KeyRight) || (padX == 1 && o.padX != 1)
+	o.padX, o.padY = padX, padY
+
+	if up {
+		o.cursor = (o.cursor + len(optionEntries) - 1) % len(optionEntries)
+		g.Event(eventMenuMove)
+	}
+	if down {
+		o.cursor = (o.cursor + 1) % len(optionEntries)
+		g.Event(eventMenuMove)
+	}
+
+	dir := 0
+	if left {
+		dir = -1
+	}
+	if right {
+		dir = 1
+	}
+	if dir == 0 {
+		return
+	}
+
+	optionEntries[o.cursor].change(g, dir)
+	if err := g.settings.Save(); err != nil {
+		log.Printf("settings: %v", err)
+	}
+}
+
+// gamepadDirection is where any gamepad's stick is pushed all the way, -1, 0 or 1 on each axis
+func (g *Game) gamepadDirection() (int, int) {
+	x, y := 0, 0
+	for id := range g.gamepadIDs {
+		switch ebiten.GamepadAxis(id, 0) {
+		case 1:
+			x = 1
+		case -1:
+			x = -1
+		}
+		switch ebiten.GamepadAxis(id, 1) {
+		case 1:
+			y = 1
+		case -1:
+			y = -1
+		}
+	}
+	return x, y
+}
+
+// drawOptions prints the pause heading and the value of each option
+func (g *Game) drawOptions(screen *ebiten.Image) {
+	x, y := screenWidth/2-48, screenHeight/2-32
+	ebitenutil.DebugPrintAt(screen, "PAUSED", screenWidth/2-18, y)
+	for i, o := range optionEntries {
+		cursor := " "
+		if i == g.options.cursor {
+			cursor = ">"
+		}
+		line := fmt.Sprintf("%s %-7s %s", cursor, o.label, o.value(g))
+		ebitenutil.DebugPrintAt(screen, line, x, y+24+i*16)
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"github.com/hajimehoshi/ebiten/audio"
+	"math"
+	"sync"
+)
+
+// panStream wraps a decoded stream and places it between the speakers as it
+// is read. It is read on the audio goroutine, so changes take the lock.
+type panStream struct {
+	mu    sync.Mutex
+	src   audio.ReadSeekCloser
+	left  float64
+	right float64
+	mono  bool
+}
+
+func newPanStream(src audio.ReadSeekCloser) *panStream {
+	return &panStream{src: src, left: 1, right: 1}
+}
+
+// Set moves the stream to pan, -1 left to 1 right. Each side is full volume
+// until the pan passes the centre, so centred sounds are as loud as before.
+// mono mixes both channels together and ignores the pan.
+func (s *panStream) Set(pan float64, mono bool) {
+	pan = math.Max(-1, math.Min(1, pan))
+	angle := (pan + 1) * math.Pi / 4
+	s.mu.Lock()
+	s.left = math.Min(1, math.Sqrt2*math.Cos(angle))
+	s.right = math.Min(1, math.Sqrt2*math.Sin(angle))
+	s.mono = mono
+	s.mu.Unlock()
+}
+
+func (s *panStream) Read(buf []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(buf) >= bytesPerFrame {
+		buf = buf[:len(buf)/bytesPerFrame*bytesPerFrame] // whole frames only, so both channels are changed together
+	}
+	n, err := s.src.Read(buf)
+	// samples are little endian int16, left then right
+	for i := 0; i+bytesPerFrame <= n; i += bytesPerFrame {
+		l, r := readSample(buf[i:]), readSample(buf[i+2:])
+		if s.mono {
+			l = (l + r) / 2
+			r = l
+		} else {
+			l, r = l*s.left, r*s.right
+		}
+		writeSample(buf[i:], l)
+		writeSample(buf[i+2:], r)
+	}
+	return n, err
+}
+
+func (s *panStream) Seek(offset int64, whence int) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.src.Seek(offset, whence)
+}
+
+func (s *panStream) Close() error {
+	return s.src.Close()
+}
+
+// readSample reads the little endian int16 sample at the start of b
+func readSample(b []byte) float64 {
+	return float64(int16(uint16(b[0]) | uint16(b[1])<<8))
+}
+
+// writeSample writes a sample back as a little endian int16
+func writeSample(b []byte, v float64) {
+	out := int16(math.Max(math.MinInt16, math.Min(math.MaxInt16, v)))
+	b[0], b[1] = byte(out), byte(uint16(out)>>8)
+}
@@ -2,38 +2,101 @@ package main
 
 import (
 	"github.com/hajimehoshi/ebiten"
-	"math/rand"
 )
 
 // Particle is a simple object that can move long a velocity, grow and shrink, etc. Used in visual effects.
 type Particle struct {
-	x            float64
-	y            float64
-	vx           float64
-	vy           float64
-	size         float64
-	sizev        float64
-	speed        float64
-	speedv       float64
-	particleType int
-	col1         ebiten.ColorM
-	col2         ebiten.ColorM
-	col3         ebiten.ColorM
-	life         int
-	toDelete     bool
-	t            int
-	forever      bool
-}
-
-// Particles are multiple Particle
+	x         float64
+	y         float64
+	vx        float64
+	vy        float64
+	size      float64
+	sizev     float64
+	speed     float64
+	speedv    float64
+	def       *ParticleDef // the emitter burst this particle came from, its colours are blended through over the lifetime
+	life      int
+	maxLife   int
+	toDelete  bool
+	t         int
+	forever   bool
+	transform Transform     // rotation, scale and flip, scale is multiplied with size
+	spin      float64       // radians added to the rotation every tick
+	gravity   float64       // added to vy every tick
+	image     *ebiten.Image // drawn instead of the emitter sprite, for shards of a ship
+	tint      ebiten.ColorM // fade of a stretched particle, worked out again only when vy changes
+	tintVY    float64       // vy the tint was worked out for, NaN until the first draw
+}
+
+const colorSteps = 64 // points over a particle's life that its colour is worked out for at load time
+
+// defaultMaxParticles is how many particles can be alive at once unless the
+// settings say otherwise, spawns past this are dropped
+const defaultMaxParticles = 2048
+
+// Particles is a fixed size pool of Particle, the live ones are particles[:num]
 type Particles struct {
-	particles []*Particle
+	particles []Particle
 	num       int
 }
 
+// newParticles makes a pool that holds up to max particles, allocated once
+func newParticles(max int) Particles {
+	return Particles{particles: make([]Particle, max)}
+}
+
+// Spawn hands out a cleared particle from the pool, nil when the pool is full
+func (s *Particles) Spawn() *Particle {
+	if s.num >= len(s.particles) {
+		return nil
+	}
+	p := &s.particles[s.num]
+	*p = Particle{}
+	s.num++
+	return p
+}
+
+// Clean removes dead particles by moving the last live particle into their
+// slot. This never copies more than one particle per death, but it changes the
+// order particles are drawn in within a layer.
+func (s *Particles) Clean() {
+	for i := 0; i < s.num; {
+		if s.particles[i].toDelete {
+			s.num--
+			s.particles[i] = s.particles[s.num]
+		} else {
+			i++
+		}
+	}
+}
+
+// Reload points live particles at a freshly loaded set of emitters, the old
+// definitions hold sprite IDs from the old atlas. Particles whose part of the
+// emitter changed are dropped.
+func (s *Particles) Reload(emitters map[string]*Emitter) {
+	for i := 0; i < s.num; i++ {
+		p := &s.particles[i]
+		if p.def != nil {
+			p.def = p.def.reloaded(emitters)
+			p.toDelete = p.toDelete || p.def == nil
+		}
+	}
+	s.Clean()
+}
+
+// Has reports whether any live particle came from the named emitter
+func (s *Particles) Has(emitter string) bool {
+	for i := 0; i < s.num; i++ {
+		if def := s.particles[i].def; def != nil && def.emitter == emitter {
+			return true
+		}
+	}
+	return false
+}
+
 // Update for every particle
 func (s *Particles) Update() {
-	for i := 0; i < len(s.particles); i++ {
+	for i := 0; i < s.num; i++ {
 		s.particles[i].Update()
 	}
 }
@@ -41,10 +104,12 @@ func (s *Particles) Update() {
 // Update runs once for every particle
 func (s *Particle) Update() {
 
+	s.vy += s.gravity
 	s.x += s.vx
 	s.y += s.vy
 	s.speed += s.speedv
 	s.size += s.sizev
+	s.transform.angle += s.spin
 	if !s.forever {
 		s.life--
 		if s.life < 0 {
@@ -52,8 +117,8 @@ func (s *Particle) Update() {
 		}
 	}
 
-	// special behaviour for falling stars, they wrap back to the top once they reach the bottom
-	if s.particleType == 0 {
+	// falling stars wrap back to the top once they reach the bottom
+	if s.def != nil && s.def.Wrap {
 		if s.y > screenHeight {
 			s.y = -64
 		} // wrap around to top
@@ -62,56 +127,130 @@ func (s *Particle) Update() {
 	s.t++ // time ticks on
 }
 
-func explodeSmall(g *Game, x float64, y float64) {
-	// big white flash
-	g.particles.particles = append(g.particles.particles, &Particle{
-		x:            x,
-		y:            y,
-		vx:           0,
-		vy:           0,
-		size:         100,
-		sizev:        -10,
-		particleType: 2,
-		life:         6,
-	})
-	// smaller fireballs
-	for i := 0; i < 8; i++ {
-		g.particles.particles = append(g.particles.particles, &Particle{
-			x:            x,
-			y:            y,
-			vx:           float64(4 - rand.Intn(8)),
-			vy:           float64(4 - rand.Intn(8)),
-			size:         float64(rand.Intn(30) + 20),
-			sizev:        -3,
-			particleType: 1,
-			life:         10,
-		})
-	}
-}
-
-func explodeBig(g *Game, x float64, y float64) {
-	// big white flash
-	g.particles.particles = append(g.particles.particles, &Particle{
-		x:            x,
-		y:            y,
-		vx:           0,
-		vy:           0,
-		size:         250,
-		sizev:        -10,
-		particleType: 2,
-		life:         8,
-	})
-	// smaller fireballs
-	for i := 0; i < 20; i++ {
-		g.particles.particles = append(g.particles.particles, &Particle{
-			x:            x,
-			y:            y,
-			vx:           float64(4 - rand.Intn(8)),
-			vy:           float64(4 - rand.Intn(8)),
-			size:         float64(rand.Intn(40) + 30),
-			sizev:        -2,
-			particleType: 1,
-			life:         15,
-		})
+// Draw submits every particle that has a sprite to the render queue
+func (s *Particles) Draw(g *Game) {
+	for i := 0; i < s.num; i++ {
+		p := &s.particles[i]
+		def := p.def
+		if (def.sprite == noSprite && p.image == nil) || p.toDelete {
+			continue
+		}
+		w, h := g.spriteTable.Size(def.sprite)
+		if p.image != nil {
+			w, h = p.image.Size()
+		}
+
+		var geoM ebiten.GeoM
+		colorM := p.Color()
+		if def.Stretch > 0 {
+			// stretched by speed, so faster stars streak and slower ones fade into the distance
+			var scale float64 = p.vy / def.Stretch
+			tr := p.transform
+			tr.scaleY = scale
+			tr.pivotY = -float64(h) / 2 // stretch down from the top
+			geoM = tr.GeoM(w, h, p.x, p.y)
+			if p.tintVY != p.vy {
+				// building a colour matrix allocates, so only do it when the speed changes
+				p.tint = ebiten.ColorM{}
+				p.tint.Translate(0, 0, 0, -(0.5 + (-scale + 1)))
+				p.tintVY = p.vy
+			}
+			if def.colorRamp == nil {
+				colorM = p.tint
+			} else {
+				colorM.Concat(p.tint)
+			}
+		} else {
+			geoM = particleGeoM(p, p.Size()/100, w, h)
+		}
+
+		if p.image != nil {
+			g.render.SubmitImage(p.image, geoM, colorM, def.layer, def.blend)
+		} else {
+			g.render.SubmitBlendID(def.sprite, geoM, colorM, def.layer, def.blend)
+		}
+	}
+}
+
+// Size is how big the particle is drawn, its size scaled by the emitter's size curve
+func (s *Particle) Size() float64 {
+	if s.def == nil {
+		return s.size
+	}
+	return s.size * s.def.sizeScale(s.Age())
+}
+
+// Age is how far through its life the particle is, from 0 to 1
+func (s *Particle) Age() float64 {
+	if s.forever || s.maxLife <= 0 {
+		return 0
+	}
+	age := 1 - float64(s.life)/float64(s.maxLife)
+	if age < 0 {
+		return 0
+	}
+	if age > 1 {
+		return 1
+	}
+	return age
+}
+
+// Color is the colour matrix for the particle's age, from the emitter's colour
+// ramp. Looking it up rather than blending it keeps drawing free of allocations.
+func (s *Particle) Color() ebiten.ColorM {
+	if s.def == nil || s.def.colorRamp == nil {
+		return ebiten.ColorM{}
+	}
+	return s.def.colorRamp[int(s.Age()*colorSteps)]
+}
+
+// blendColor is the colour matrix at an age from 0 to 1. It starts as the
+// sprite's own colour and blends evenly through the stops, then the fade takes
+// the alpha down to nothing by the end of the life.
+func blendColor(stops []ebiten.ColorM, fade float64, age float64) ebiten.ColorM {
+	var c ebiten.ColorM
+	if n := len(stops); n > 0 {
+		pos := age * float64(n)
+		i := int(pos)
+		switch {
+		case i >= n:
+			c = stops[n-1]
+		case i == 0:
+			var start ebiten.ColorM // the zero ColorM is the identity
+			c = lerpColorM(&start, &stops[0], pos)
+		default:
+			c = lerpColorM(&stops[i-1], &stops[i], pos-float64(i))
+		}
+	}
+	if fade > 0 && fade < 1 && age > fade {
+		c.Scale(1, 1, 1, 1-(age-fade)/(1-fade))
+	}
+	return c
+}
+
+// lerpColorM blends the per channel scale and offset of two colour matrices,
+// anything off the diagonal like hue rotation is not carried over
+func lerpColorM(a *ebiten.ColorM, b *ebiten.ColorM, f float64) ebiten.ColorM {
+	var scale, offset [4]float64
+	for i := 0; i < 4; i++ {
+		scale[i] = a.Element(i, i) + (b.Element(i, i)-a.Element(i, i))*f
+		offset[i] = a.Element(i, 4) + (b.Element(i, 4)-a.Element(i, 4))*f
+	}
+	c := ebiten.ScaleColor(scale[0], scale[1], scale[2], scale[3])
+	c.Translate(offset[0], offset[1], offset[2], offset[3])
+	return c
+}
+
+// particleGeoM centres a w by h sprite on the particle, scaled by size and its own transform
+func particleGeoM(s *Particle, scale float64, w int, h int) ebiten.GeoM {
+	tr := s.transform
+	if tr.scaleX == 0 {
+		tr.scaleX = 1
+	}
+	if tr.scaleY == 0 {
+		tr.scaleY = 1
 	}
+	tr.scaleX *= scale
+	tr.scaleY *= scale
+	return tr.GeoM(w, h, s.x-float64(w)/2, s.y-float64(h)/2)
 }
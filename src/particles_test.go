@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+)
+
+// explode is one tick of a heavy fight, several explosions and ships breaking
+// up. The particles are queued for drawing but the queue is not flushed.
+func explode(g *Game) {
+	for i := 0; i < 4; i++ {
+		g.Emit("explosion_small", float64(i*40), 100)
+		g.EmitShards("debris", spriteEnemy2, float64(i*40), 100)
+	}
+	g.Emit("explosion_big", 120, 200)
+	g.EmitShards("debris_big", spritePlayer, 120, 200)
+	g.Emit("engine_trail", 120, 280)
+	g.particles.Update()
+	g.particles.Clean()
+	g.particles.Draw(g)
+	g.render.commands = g.render.commands[:0]
+}
+
+func TestParticlesDoNotAllocate(t *testing.T) {
+	g := newTestGame(t)
+	g.Emit("stars", 0, 0)
+	// fill the pool and cut every shard once, so only the steady state is measured
+	for i := 0; i < 200; i++ {
+		explode(g)
+	}
+	if allocs := testing.AllocsPerRun(100, func() { explode(g) }); allocs != 0 {
+		t.Errorf("explosions allocated %v times a tick, want 0", allocs)
+	}
+}
+
+func TestParticlesSpawnPastCap(t *testing.T) {
+	p := newParticles(4)
+	for i := 0; i < 4; i++ {
+		if p.Spawn() == nil {
+			t.Fatalf("spawn %d: pool full early", i)
+		}
+	}
+	if p.Spawn() != nil {
+		t.Errorf("spawn past the cap should be dropped")
+	}
+	p.particles[1].toDelete = true
+	p.Clean()
+	if p.num != 3 {
+		t.Errorf("num after clean = %d, want 3", p.num)
+	}
+	if p.Spawn() == nil {
+		t.Errorf("spawn after clean should reuse the freed slot")
+	}
+}
+
+func TestParticlesReload(t *testing.T) {
+	g := newTestGame(t)
+	g.Emit("stars", 0, 0)
+	g.Emit("explosion_small", 100, 100)
+	count := func() map[string]int {
+		n := map[string]int{}
+		for i := 0; i < g.particles.num; i++ {
+			def := g.particles.particles[i].def
+			n[fmt.Sprintf("%s/%d", def.emitter, def.index)]++
+		}
+		return n
+	}
+	before := count()
+
+	// the flash of the small explosion lives longer, nothing else changes
+	data, err := g.assets.ReadFile(assetEmitters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := strings.Index(string(data), `"explosion_small"`)
+	if at < 0 {
+		t.Fatal("no explosion_small in emitters.json any more, update the test")
+	}
+	edited := string(data[:at]) + strings.Replace(string(data[at:]), `"life": [6, 6]`, `"life": [9, 9]`, 1)
+	if edited == string(data) {
+		t.Fatal("the flash life is not in emitters.json any more, update the test")
+	}
+	emitters, err := loadEmitters([]byte(edited), g.spriteTable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.particles.Reload(emitters)
+	after := count()
+
+	for part, want := range before {
+		if part == "explosion_small/0" {
+			want = 0
+		}
+		if after[part] != want {
+			t.Errorf("%s: %d particles after reload, want %d", part, after[part], want)
+		}
+	}
+	for i := 0; i < g.particles.num; i++ {
+		def := g.particles.particles[i].def
+		if def != emitters[def.emitter].parts[def.index] {
+			t.Errorf("%s part %d still points at the old definition", def.emitter, def.index)
+		}
+	}
+}
+
+func TestMissingEmitterLoggedOnce(t *testing.T) {
+	g := newTestGame(t)
+	var out bytes.Buffer
+	defer log.SetOutput(log.Writer())
+	log.SetOutput(&out)
+	for i := 0; i < 60; i++ {
+		g.Emit("no_such_effect", 0, 0)
+		g.EmitShards("no_such_effect", spritePlayer, 0, 0)
+	}
+	if n := strings.Count(out.String(), "no_such_effect"); n != 1 {
+		t.Errorf("missing emitter logged %d times, want 1", n)
+	}
+}
+
+func BenchmarkParticles(b *testing.B) {
+	g := newTestGame(b)
+	g.Emit("stars", 0, 0)
+	for i := 0; i < 200; i++ {
+		explode(g)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		explode(g)
+	}
+}
@@ -1,8 +1,6 @@
 package main
 
-import (
-	"time"
-)
+const reviveDelay = 60 * 3 // ticks from losing a life to flying again
 
 // Player is the player state object
 type Player struct {
@@ -14,6 +12,7 @@ type Player struct {
 	maxSpeed    float64
 	fireRate    int16
 	maxFireRate int16
+	pierce      int // enemies each shot passes through, 0 stops at the first like the default weapon
 	hitbox      Hitbox
 	lives       int
 	toDelete    bool
@@ -45,17 +44,19 @@ func newPlayer() Player {
 func killPlayer(g *Game) {
 	if g.player.alive {
 		g.player.alive = false
-		explodeBig(g, g.player.x, g.player.y)
+		g.Emit("explosion_big", g.player.x, g.player.y)
+		g.EmitShards("debris_big", spritePlayer, g.player.x, g.player.y)
 		g.lives--
 
-		audioDeath.Rewind()
-		audioDeath.Play()
+		g.EventAt(eventPlayerDied, g.player.x+16)
+		if g.lives == 1 {
+			g.Event(eventLowLives)
+		}
 
 		if g.lives > 0 {
-			f := newFunc(g)
-			_ = time.AfterFunc(3*time.Second, f)
+			g.revive = reviveDelay
 		} else {
-			//goGameOver()
+			g.setScene(sceneGameOver)
 		}
 	}
 
@@ -65,8 +66,12 @@ func revivePlayer(g *Game) {
 	g.player = newPlayer()
 }
 
-func newFunc(g *Game) func() {
-	return func() {
-		revivePlayer(g)
+// updateRevive counts down after losing a life and brings the player back
+func (g *Game) updateRevive() {
+	if g.revive > 0 {
+		g.revive--
+		if g.revive == 0 {
+			revivePlayer(g)
+		}
 	}
 }
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+	"image/color"
+	"sort"
+)
+
+// Layers for the render queue, lower layers are drawn first
+const (
+	layerBackground  = 0   // falling stars
+	layerTrail       = 10  // engine exhaust, under the ship that makes it
+	layerEnemy       = 20  // enemy ships
+	layerPlayer      = 30  // player ship and the safety stars
+	layerEffect      = 40  // explosions, under bullets so they never hide one
+	layerBullet      = 50  // player bullets
+	layerEnemyBullet = 60  // enemy bullets, above everything they can hit
+	layerUI          = 100 // lives and score
+	layerDebug       = 200 // hitboxes
+)
+
+// DrawCommand is one sprite, or a plain rectangle, waiting to be drawn
+type DrawCommand struct {
+	sprite SpriteID
+	geoM   ebiten.GeoM
+	colorM ebiten.ColorM
+	layer  int
+	z      float64              // sorts commands inside a layer, lowest first
+	blend  ebiten.CompositeMode // how the sprite mixes with what is under it, the zero value is normal alpha
+	image  *ebiten.Image        // drawn instead of sprite when set, for pieces cut from a sprite
+
+	rect  bool // draw a filled rectangle instead of a sprite
+	x     float64
+	y     float64
+	w     float64
+	h     float64
+	color color.Color
+}
+
+// RenderQueue collects everything to draw this frame and draws it in layer order
+type RenderQueue struct {
+	commands []DrawCommand
+	op       ebiten.DrawImageOptions
+	sprites  *SpriteTable
+}
+
+// Submit queues a sprite by name with its transform and colour matrix
+func (q *RenderQueue) Submit(sprite SpriteName, geoM ebiten.GeoM, colorM ebiten.ColorM, layer int) {
+	q.SubmitID(q.sprites.ID(sprite), geoM, colorM, layer)
+}
+
+// SubmitID queues a sprite by handle, for hot paths like particles that skip the name lookup
+func (q *RenderQueue) SubmitID(sprite SpriteID, geoM ebiten.GeoM, colorM ebiten.ColorM, layer int) {
+	q.commands = append(q.commands, DrawCommand{
+		sprite: sprite,
+		geoM:   geoM,
+		colorM: colorM,
+		layer:  layer,
+	})
+}
+
+// SubmitBlendID queues a sprite by handle with a composite mode, like ebiten.CompositeModeLighter for glows
+func (q *RenderQueue) SubmitBlendID(sprite SpriteID, geoM ebiten.GeoM, colorM ebiten.ColorM, layer int, blend ebiten.CompositeMode) {
+	q.SubmitID(sprite, geoM, colorM, layer)
+	q.commands[len(q.commands)-1].blend = blend
+}
+
+// SubmitImage queues an image that is not a whole atlas sprite, like a shard of one
+func (q *RenderQueue) SubmitImage(img *ebiten.Image, geoM ebiten.GeoM, colorM ebiten.ColorM, layer int, blend ebiten.CompositeMode) {
+	q.SubmitBlendID(noSprite, geoM, colorM, layer, blend)
+	q.commands[len(q.commands)-1].image = img
+}
+
+// SubmitZ queues a sprite with a z value to order it within its layer
+func (q *RenderQueue) SubmitZ(sprite SpriteName, geoM ebiten.GeoM, colorM ebiten.ColorM, layer int, z float64) {
+	q.Submit(sprite, geoM, colorM, layer)
+	q.commands[len(q.commands)-1].z = z
+}
+
+// SubmitRect queues a filled rectangle, used for debug hitboxes
+func (q *RenderQueue) SubmitRect(x float64, y float64, w float64, h float64, clr color.Color, layer int) {
+	q.commands = append(q.commands, DrawCommand{
+		rect:  true,
+		x:     x,
+		y:     y,
+		w:     w,
+		h:     h,
+		color: clr,
+		layer: layer,
+	})
+}
+
+// Flush sorts the queue once, draws it to the screen and empties it for the next frame
+func (q *RenderQueue) Flush(screen *ebiten.Image) {
+	// stable, so commands with equal layer and z keep the order they were submitted in
+	sort.SliceStable(q.commands, func(i, j int) bool {
+		if q.commands[i].layer != q.commands[j].layer {
+			return q.commands[i].layer < q.commands[j].layer
+		}
+		return q.commands[i].z < q.commands[j].z
+	})
+
+	for i := range q.commands {
+		c := &q.commands[i]
+		if c.rect {
+			ebitenutil.DrawRect(screen, c.x, c.y, c.w, c.h, c.color)
+			continue
+		}
+		q.op.GeoM = c.geoM
+		q.op.ColorM = c.colorM
+		q.op.CompositeMode = c.blend
+		if c.image != nil {
+			screen.DrawImage(c.image, &q.op)
+			continue
+		}
+		s := q.sprites.Get(c.sprite)
+		if s == nil {
+			continue // not in the atlas
+		}
+		spriteDraw(screen, s, &q.op)
+	}
+	q.commands = q.commands[:0]
+}
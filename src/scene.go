@@ -0,0 +1,66 @@
+package main
+
+import (
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+	"github.com/hajimehoshi/ebiten/inpututil"
+	"log"
+)
+
+const gameOverWait = 60 * 2 // ticks before fire starts a new game, so shots held while dying don't skip it
+
+// setScene moves the game on to a scene and crossfades to its music
+func (g *Game) setScene(scene Scene) {
+	g.scene = scene
+	g.sceneT = 0
+	if err := g.music.SetScene(scene); err != nil {
+		log.Printf("music: %v", err)
+	}
+}
+
+// newGame starts again from the first wave with full lives
+func (g *Game) newGame() {
+	for _, a := range g.actors.actors {
+		a.Kill()
+	}
+	for _, b := range g.bullets.bullets {
+		b.toDelete = true
+	}
+	g.score = 0
+	g.difficulty = 0
+	g.enemyShoot = 120 // start our enemies shooting
+	g.lives = 3
+	g.revive = 0
+	g.player = newPlayer()
+	g.player.safety = 60 * 4
+	g.setScene(sceneGame)
+}
+
+// updateGameOver waits for fire on the game over screen, then starts a new game
+func (g *Game) updateGameOver() {
+	g.sceneT++
+	if g.scene != sceneGameOver || g.sceneT < gameOverWait {
+		return
+	}
+	pressed := inpututil.IsKeyJustPressed(ebiten.KeySpace)
+	for id := range g.gamepadIDs {
+		for b := ebiten.GamepadButton(0); b < ebiten.GamepadButton(ebiten.GamepadButtonNum(id)); b++ {
+			if b != pauseButton && inpututil.IsGamepadButtonJustPressed(id, b) {
+				pressed = true
+			}
+		}
+	}
+	if pressed {
+		g.Event(eventMenuSelect)
+		g.newGame()
+	}
+}
+
+// drawGameOver prints the game over heading, and how to play again once it can be
+func (g *Game) drawGameOver(screen *ebiten.Image) {
+	y := screenHeight/2 - 32
+	ebitenutil.DebugPrintAt(screen, "GAME OVER", screenWidth/2-27, y)
+	if g.sceneT >= gameOverWait && (g.sceneT/30)%2 == 0 {
+		ebitenutil.DebugPrintAt(screen, "PRESS FIRE", screenWidth/2-30, y+24)
+	}
+}
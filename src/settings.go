@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+const settingsDir = "goshootygame" // under the user config directory
+
+// Settings are the player's choices, kept between runs in the user settings file
+type Settings struct {
+	Volume map[string]float64 `json:"volume"` // by bus name, missing buses are at full volume
+	Mono   bool               `json:"mono"`   // play every sound centred, for a single speaker
+	// Particles is the most particles alive at once, lower it on slow machines.
+	// Zero uses defaultMaxParticles.
+	Particles int `json:"particles,omitempty"`
+}
+
+// MaxParticles is the size of the particle pool
+func (s *Settings) MaxParticles() int {
+	if s.Particles > 0 {
+		return s.Particles
+	}
+	return defaultMaxParticles
+}
+
+func defaultSettings() Settings {
+	return Settings{Volume: map[string]float64{}}
+}
+
+// settingsPath is where the settings file lives for this user
+func settingsPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, settingsDir, "settings.json"), nil
+}
+
+// loadSettings reads the settings file, falling back to the defaults if there
+// is none yet or it cannot be read
+func loadSettings() Settings {
+	s := defaultSettings()
+	path, err := settingsPath()
+	if err != nil {
+		log.Printf("settings: %v", err)
+		return s
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s
+	}
+	if err == nil {
+		err = json.Unmarshal(data, &s)
+	}
+	if err != nil {
+		log.Printf("settings: %s: %v", path, err)
+		return defaultSettings()
+	}
+	if s.Volume == nil {
+		s.Volume = map[string]float64{}
+	}
+	return s
+}
+
+// Save writes the settings file, making its directory if needed
+func (s *Settings) Save() error {
+	path, err := settingsPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,106 @@
+package sfxr
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// presets make a random variation of a kind of sound, like the buttons in sfxr
+var presets = map[string]func(r *rand.Rand) Params{
+	"laser": func(r *rand.Rand) Params {
+		p := Params{
+			Wave:    []Wave{Square, Sawtooth, Sine}[r.Intn(3)],
+			Freq:    between(r, 500, 1800),
+			MinFreq: 80,
+			Slide:   -between(r, 8, 24),
+			Duty:    between(r, 0.1, 0.5),
+			Sustain: between(r, 0.05, 0.15),
+			Decay:   between(r, 0.05, 0.2),
+			Volume:  0.5,
+		}
+		if r.Intn(2) == 0 {
+			p.DutySweep = between(r, -1, 1)
+		}
+		return p
+	},
+	"explosion": func(r *rand.Rand) Params {
+		return Params{
+			Wave:    Noise,
+			Freq:    between(r, 400, 2000),
+			Slide:   -between(r, 0, 3),
+			Sustain: between(r, 0.05, 0.2),
+			Punch:   between(r, 0.3, 0.8),
+			Decay:   between(r, 0.3, 0.8),
+			LowPass: between(r, 2000, 8000),
+			Volume:  0.6,
+		}
+	},
+	"pickup": func(r *rand.Rand) Params {
+		p := Params{
+			Wave:    Square,
+			Freq:    between(r, 800, 1600),
+			Duty:    between(r, 0.25, 0.5),
+			Sustain: between(r, 0.02, 0.08),
+			Punch:   between(r, 0.3, 0.6),
+			Decay:   between(r, 0.1, 0.25),
+			Volume:  0.4,
+		}
+		if r.Intn(2) == 0 {
+			p.ArpMult = between(r, 1.3, 1.6)
+			p.ArpTime = p.Sustain * between(r, 0.4, 0.9)
+		}
+		return p
+	},
+	"hit": func(r *rand.Rand) Params {
+		return Params{
+			Wave:    []Wave{Square, Sawtooth, Noise}[r.Intn(3)],
+			Freq:    between(r, 200, 900),
+			Slide:   -between(r, 6, 14),
+			Duty:    between(r, 0.2, 0.5),
+			Sustain: between(r, 0.01, 0.05),
+			Decay:   between(r, 0.05, 0.2),
+			Volume:  0.5,
+		}
+	},
+	"powerup": func(r *rand.Rand) Params {
+		p := Params{
+			Wave:    []Wave{Square, Sine}[r.Intn(2)],
+			Freq:    between(r, 200, 600),
+			Slide:   between(r, 1, 4),
+			Duty:    between(r, 0.2, 0.5),
+			Sustain: between(r, 0.1, 0.3),
+			Decay:   between(r, 0.1, 0.3),
+			Volume:  0.4,
+		}
+		if r.Intn(2) == 0 {
+			p.VibratoDepth = between(r, 0.05, 0.2)
+			p.VibratoSpeed = between(r, 8, 20)
+		}
+		return p
+	},
+}
+
+// Preset makes the variation seed of a kind of sound, ok is false for an unknown kind
+func Preset(kind string, seed int64) (Params, bool) {
+	preset, ok := presets[kind]
+	if !ok {
+		return Params{}, false
+	}
+	return preset(rand.New(rand.NewSource(seed))), true
+}
+
+// Presets lists the kinds of sound Preset knows
+func Presets() []string {
+	kinds := make([]string, 0, len(presets))
+	for kind := range presets {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// between is a random value from min to max, rounded to keep the JSON readable
+func between(r *rand.Rand, min float64, max float64) float64 {
+	return math.Round((min+r.Float64()*(max-min))*100) / 100
+}
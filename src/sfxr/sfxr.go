@@ -0,0 +1,160 @@
+// Package sfxr synthesises retro sound effects from a handful of parameters,
+// in the spirit of DrPetter's sfxr. Sounds come out as 16 bit stereo PCM,
+// ready for an ebiten audio player or a WAV file.
+package sfxr
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"math/rand"
+)
+
+// Wave is the shape of the oscillator
+type Wave string
+
+const (
+	Square   Wave = "square"
+	Sawtooth Wave = "sawtooth"
+	Sine     Wave = "sine"
+	Noise    Wave = "noise"
+)
+
+const maxLength = 5.0 // seconds, no sound is generated past this
+
+// Params describe one sound. Times are in seconds and frequencies in Hz.
+type Params struct {
+	Wave         Wave    `json:"wave"`
+	Freq         float64 `json:"freq"`                   // starting pitch
+	MinFreq      float64 `json:"minFreq,omitempty"`      // the sound stops if a slide takes the pitch below this
+	Slide        float64 `json:"slide,omitempty"`        // pitch change in octaves per second
+	DeltaSlide   float64 `json:"deltaSlide,omitempty"`   // change of slide per second
+	VibratoDepth float64 `json:"vibratoDepth,omitempty"` // fraction of the pitch the vibrato swings by
+	VibratoSpeed float64 `json:"vibratoSpeed,omitempty"` // vibrato cycles per second
+	ArpMult      float64 `json:"arpMult,omitempty"`      // pitch is multiplied by this once, at ArpTime
+	ArpTime      float64 `json:"arpTime,omitempty"`      // when the arpeggio jump happens, zero for never
+	Duty         float64 `json:"duty,omitempty"`         // square wave duty cycle, 0 to 0.5
+	DutySweep    float64 `json:"dutySweep,omitempty"`    // duty change per second
+	Attack       float64 `json:"attack,omitempty"`       // time to rise to full volume
+	Sustain      float64 `json:"sustain,omitempty"`      // time held at full volume
+	Punch        float64 `json:"punch,omitempty"`        // extra volume at the start of the sustain, fading over it
+	Decay        float64 `json:"decay,omitempty"`        // time to fall back to silence
+	LowPass      float64 `json:"lowPass,omitempty"`      // cutoff of a one pole low pass filter, zero for none
+	Volume       float64 `json:"volume"`                 // overall volume, 0 to 1
+}
+
+// Length is how long the envelope lasts, a slide can end the sound sooner
+func (p Params) Length() float64 {
+	return math.Min(p.Attack+p.Sustain+p.Decay, maxLength)
+}
+
+// envelope is the volume at time t
+func (p Params) envelope(t float64) float64 {
+	switch {
+	case t < p.Attack:
+		return t / p.Attack
+	case t < p.Attack+p.Sustain:
+		return 1 + p.Punch*(1-(t-p.Attack)/p.Sustain)
+	case t < p.Attack+p.Sustain+p.Decay:
+		return 1 - (t-p.Attack-p.Sustain)/p.Decay
+	}
+	return 0
+}
+
+// Generate renders the sound at sampleRate as 16 bit little endian stereo
+// PCM. The same params always give the same sound.
+func Generate(p Params, sampleRate int) []byte {
+	rate := float64(sampleRate)
+	frames := int(p.Length() * rate)
+	pcm := make([]byte, 0, frames*4)
+	rng := rand.New(rand.NewSource(1))
+
+	var (
+		phase  float64 // position within the current cycle, 0 to 1
+		noise  float64
+		filter float64
+		slide  = p.Slide
+		freq   = p.Freq
+	)
+	alpha := 1.0
+	if p.LowPass > 0 {
+		rc := 1 / (2 * math.Pi * p.LowPass)
+		alpha = (1 / rate) / (rc + 1/rate)
+	}
+	arped := false
+
+	for i := 0; i < frames; i++ {
+		t := float64(i) / rate
+
+		slide += p.DeltaSlide / rate
+		freq *= math.Pow(2, slide/rate)
+		if p.ArpTime > 0 && !arped && t >= p.ArpTime {
+			freq *= p.ArpMult
+			arped = true
+		}
+		if freq < p.MinFreq || freq <= 0 {
+			break
+		}
+		f := freq
+		if p.VibratoDepth > 0 {
+			f *= 1 + p.VibratoDepth*math.Sin(2*math.Pi*p.VibratoSpeed*t)
+		}
+
+		last := phase
+		phase += f / rate
+		phase -= math.Floor(phase)
+
+		var v float64
+		switch p.Wave {
+		case Square:
+			duty := math.Max(0, math.Min(0.5, p.Duty+p.DutySweep*t))
+			v = 1
+			if phase > duty {
+				v = -1
+			}
+		case Sawtooth:
+			v = 1 - 2*phase
+		case Sine:
+			v = math.Sin(2 * math.Pi * phase)
+		case Noise:
+			// a new random level every half cycle, so the pitch still colours the noise
+			if math.Floor(phase*2) != math.Floor(last*2) || i == 0 {
+				noise = rng.Float64()*2 - 1
+			}
+			v = noise
+		}
+
+		filter += alpha * (v - filter)
+		out := filter * p.envelope(t) * p.Volume
+		s := int16(math.Max(-1, math.Min(1, out)) * math.MaxInt16)
+		pcm = append(pcm, byte(s), byte(uint16(s)>>8), byte(s), byte(uint16(s)>>8))
+	}
+	return pcm
+}
+
+// WriteWAV writes PCM from Generate as a WAV file
+func WriteWAV(w io.Writer, pcm []byte, sampleRate int) error {
+	const channels, bits = 2, 16
+	header := []interface{}{
+		[4]byte{'R', 'I', 'F', 'F'},
+		uint32(36 + len(pcm)),
+		[4]byte{'W', 'A', 'V', 'E'},
+		[4]byte{'f', 'm', 't', ' '},
+		uint32(16),
+		uint16(1), // PCM
+		uint16(channels),
+		uint32(sampleRate),
+		uint32(sampleRate * channels * bits / 8),
+		uint16(channels * bits / 8),
+		uint16(bits),
+		[4]byte{'d', 'a', 't', 'a'},
+		uint32(len(pcm)),
+	}
+	for _, v := range header {
+		if err := binary.Write(w, binary.LittleEndian, v); err != nil {
+			return err
+		}
+	}
+	_, err := w.Write(pcm)
+	return err
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"image"
+	"math"
+)
+
+// ShapeKind picks how a Hitbox is tested against others
+type ShapeKind int
+
+const (
+	shapeBox    ShapeKind = iota // the hitbox rectangle, the default
+	shapeCircle                  // the circle that fits inside the hitbox rectangle
+	shapePixels                  // the opaque pixels of the sprite, from the atlas alpha
+)
+
+const maskAlpha = 0x80 // pixels at least this opaque are solid in a Mask
+
+// hitShapes picks the collision shape for sprites that should not use a plain box
+var hitShapes = map[SpriteName]ShapeKind{
+	spriteBullet: shapeCircle,
+	spriteEnemy1: shapePixels,
+	spriteEnemy2: shapePixels,
+	spriteEnemy3: shapePixels,
+}
+
+// Mask is the solid pixels of a sprite, made from the atlas alpha at load time
+type Mask struct {
+	w     int
+	h     int
+	solid []bool
+	edge  [][2]int // solid pixels next to a clear one, for drawing the outline
+}
+
+// newMask reads the alpha of one sprite out of its decoded atlas page
+func newMask(page image.Image, s Sprite) *Mask {
+	m := &Mask{w: s.width, h: s.height, solid: make([]bool, s.width*s.height)}
+	for y := 0; y < s.height; y++ {
+		for x := 0; x < s.width; x++ {
+			px, py, ok := s.pagePixel(x, y)
+			if !ok {
+				continue
+			}
+			_, _, _, a := page.At(px, py).RGBA()
+			m.solid[y*s.width+x] = a>>8 >= maskAlpha
+		}
+	}
+	for y := 0; y < m.h; y++ {
+		for x := 0; x < m.w; x++ {
+			if m.Solid(x, y) && (!m.Solid(x-1, y) || !m.Solid(x+1, y) || !m.Solid(x, y-1) || !m.Solid(x, y+1)) {
+				m.edge = append(m.edge, [2]int{x, y})
+			}
+		}
+	}
+	return m
+}
+
+// Solid reports whether a pixel of the mask is solid, anything outside it is clear
+func (m *Mask) Solid(x int, y int) bool {
+	if x < 0 || y < 0 || x >= m.w || y >= m.h {
+		return false
+	}
+	return m.solid[y*m.w+x]
+}
+
+// shapedHitbox applies the shape from hitShapes for a sprite. A pixel hitbox
+// keeps its box, which trims the mask to the part of the sprite that can be hit.
+func (g *Game) shapedHitbox(sprite SpriteName, h Hitbox) Hitbox {
+	shape, ok := hitShapes[sprite]
+	if !ok {
+		return h
+	}
+	h.shape = shape
+	if shape == shapePixels {
+		id := g.spriteTable.ID(sprite)
+		h.mask = g.spriteTable.Mask(id)
+		if h.mask == nil {
+			h.shape = shapeBox
+		}
+	}
+	return h
+}
+
+// circle returns the centre and radius of a circle hitbox owned by something at x, y
+func (h Hitbox) circle(x float64, y float64) (float64, float64, float64) {
+	return x + h.x + h.w/2, y + h.y + h.h/2, math.Min(h.w, h.h) / 2
+}
+
+// hitTest tests two hitboxes owned by things at x1, y1 and x2, y2
+func hitTest(x1 float64, y1 float64, h1 Hitbox, x2 float64, y2 float64, h2 Hitbox) bool {
+	// every shape fits inside its box, so the boxes must overlap first
+	if !collide(x1+h1.x, y1+h1.y, h1.w, h1.h, x2+h2.x, y2+h2.y, h2.w, h2.h) {
+		return false
+	}
+	if h1.shape > h2.shape {
+		x1, y1, h1, x2, y2, h2 = x2, y2, h2, x1, y1, h1
+	}
+	// masks are only tested where the boxes overlap
+	ox0, oy0 := math.Max(x1+h1.x, x2+h2.x), math.Max(y1+h1.y, y2+h2.y)
+	ox1, oy1 := math.Min(x1+h1.x+h1.w, x2+h2.x+h2.w), math.Min(y1+h1.y+h1.h, y2+h2.y+h2.h)
+
+	switch h1.shape {
+	case shapeBox:
+		switch h2.shape {
+		case shapeBox:
+			return true
+		case shapeCircle:
+			return boxCircle(x1+h1.x, y1+h1.y, h1.w, h1.h, h2, x2, y2)
+		case shapePixels:
+			return maskTest(h2, x2, y2, ox0, oy0, ox1, oy1, func(px float64, py float64) bool {
+				return collide(x1+h1.x, y1+h1.y, h1.w, h1.h, px, py, 1, 1)
+			})
+		}
+	case shapeCircle:
+		cx, cy, r := h1.circle(x1, y1)
+		switch h2.shape {
+		case shapeCircle:
+			cx2, cy2, r2 := h2.circle(x2, y2)
+			return pointDist(cx, cy, cx2, cy2) <= r+r2
+		case shapePixels:
+			return maskTest(h2, x2, y2, ox0, oy0, ox1, oy1, func(px float64, py float64) bool {
+				return boxCircle(px, py, 1, 1, h1, x1, y1)
+			})
+		}
+	case shapePixels:
+		// both are masks, compare the pixels where the boxes overlap
+		return maskTest(h2, x2, y2, ox0, oy0, ox1, oy1, func(px float64, py float64) bool {
+			return h1.solid(px-x1, py-y1)
+		})
+	}
+	return true
+}
+
+// boxCircle tests a rectangle against a circle hitbox owned by something at x, y
+func boxCircle(bx float64, by float64, bw float64, bh float64, circle Hitbox, x float64, y float64) bool {
+	cx, cy, r := circle.circle(x, y)
+	nx := math.Max(bx, math.Min(cx, bx+bw))
+	ny := math.Max(by, math.Min(cy, by+bh))
+	return pointDist(cx, cy, nx, ny) <= r
+}
+
+// maskTest calls hit with the top left of each solid pixel of a pixel hitbox
+// owned by something at x, y that touches the area from x0, y0 to x1, y1, until
+// one hits. Only the pixels in the area are visited, so small overlaps are cheap.
+func maskTest(h Hitbox, x float64, y float64, x0 float64, y0 float64, x1 float64, y1 float64, hit func(px float64, py float64) bool) bool {
+	m := h.mask
+	if m == nil {
+		return false
+	}
+	mx0, my0 := int(math.Floor(x0-x)), int(math.Floor(y0-y))
+	mx1, my1 := int(math.Ceil(x1-x)), int(math.Ceil(y1-y))
+	if h.angle == 0 {
+		// upright, nothing outside the mask can be solid
+		mx0, my0 = intMax(mx0, 0), intMax(my0, 0)
+		mx1, my1 = intMin(mx1, m.w), intMin(my1, m.h)
+	}
+	for my := my0; my < my1; my++ {
+		for mx := mx0; mx < mx1; mx++ {
+			if h.solid(float64(mx), float64(my)) && hit(x+float64(mx), y+float64(my)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// solid reports whether the pixel with its top left at x, y from the owner's
+// position is solid in a turned pixel hitbox. The middle of the pixel is turned
+// back by the hitbox angle to find where it is in the upright mask.
+func (h Hitbox) solid(x float64, y float64) bool {
+	x, y = x+0.5, y+0.5
+	if h.angle != 0 {
+		cx, cy := float64(h.mask.w)/2+h.pivotX, float64(h.mask.h)/2+h.pivotY
+		sin, cos := math.Sincos(-h.angle)
+		dx, dy := x-cx, y-cy
+		x, y = cx+dx*cos-dy*sin, cy+dx*sin+dy*cos
+	}
+	return h.mask.Solid(int(math.Floor(x)), int(math.Floor(y)))
+}
+
+// turn moves a point of an upright pixel hitbox to where it is drawn, by the
+// hitbox angle around its pivot
+func (h Hitbox) turn(x float64, y float64) (float64, float64) {
+	if h.angle == 0 || h.mask == nil {
+		return x, y
+	}
+	cx, cy := float64(h.mask.w)/2+h.pivotX, float64(h.mask.h)/2+h.pivotY
+	sin, cos := math.Sincos(h.angle)
+	dx, dy := x-cx, y-cy
+	return cx + dx*cos - dy*sin, cy + dx*sin + dy*cos
+}
+
+// intMin is the smaller of two ints
+func intMin(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// intMax is the larger of two ints
+func intMax(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+// columnMask is a 4x4 mask with only its left column solid
+func columnMask() *Mask {
+	m := &Mask{w: 4, h: 4, solid: make([]bool, 16)}
+	for y := 0; y < 4; y++ {
+		m.solid[y*4] = true
+	}
+	return m
+}
+
+func TestPixelHitboxTurnsWithSprite(t *testing.T) {
+	upright := Hitbox{w: 4, h: 4, shape: shapePixels, mask: columnMask()}
+	turned := upright
+	turned.angle = math.Pi / 2 // a quarter turn clockwise, the left column is drawn along the top
+	pixel := Hitbox{w: 1, h: 1}
+
+	tests := []struct {
+		name   string
+		h      Hitbox
+		px, py float64
+		want   bool
+	}{
+		{"upright left column", upright, 0, 2, true},
+		{"upright top row", upright, 2, 0, false},
+		{"turned left column", turned, 0, 2, false},
+		{"turned top row", turned, 2, 0, true},
+		{"turned top right", turned, 3, 0, true},
+		{"turned bottom row", turned, 2, 3, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hitTest(100, 50, tt.h, 100+tt.px, 50+tt.py, pixel); got != tt.want {
+				t.Errorf("hitTest = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestActorHitboxFollowsRotationAndFrame(t *testing.T) {
+	first, second := columnMask(), columnMask()
+	anim := &Animation{
+		frames: []Frame{{sprite: "a", duration: 2, mask: first}, {sprite: "b", duration: 2, mask: second}},
+		length: 4,
+	}
+	a := &Actor{vx: 1, faceTravel: true, anim: anim, hitbox: Hitbox{w: 4, h: 4, shape: shapePixels, mask: first}}
+	a.Update()
+	if a.hitbox.angle != a.transform.angle || a.hitbox.angle == 0 {
+		t.Errorf("hitbox angle %v, want the drawn angle %v", a.hitbox.angle, a.transform.angle)
+	}
+	a.Update()
+	if a.sprite != "b" || a.hitbox.mask != second {
+		t.Errorf("on frame %q the hitbox should use that frame's mask", a.sprite)
+	}
+}
@@ -2,7 +2,6 @@ package main
 
 import (
 	"github.com/hajimehoshi/ebiten"
-	"image"
 	"math"
 )
 
@@ -14,8 +13,17 @@ func collide(x1 float64, y1 float64, w1 float64, h1 float64, x2 float64, y2 floa
 	return true
 }
 
-func spriteDraw(screen *ebiten.Image, g *Game, sprite string) {
-	screen.DrawImage(spriteAtlas.SubImage(image.Rect(g.sprites[sprite].x, g.sprites[sprite].y, g.sprites[sprite].x+g.sprites[sprite].width, g.sprites[sprite].y+g.sprites[sprite].height)).(*ebiten.Image), &g.op)
+func spriteDraw(screen *ebiten.Image, s *ResolvedSprite, op *ebiten.DrawImageOptions) {
+	if !s.placed {
+		screen.DrawImage(s.image, op)
+		return
+	}
+	// trimmed or rotated, put the stored pixels where they belong in the sprite first
+	geoM := op.GeoM
+	op.GeoM = s.local
+	op.GeoM.Concat(geoM)
+	screen.DrawImage(s.image, op)
+	op.GeoM = geoM
 }
 
 // ldX Length Direction x is used to calculate the x given the length and direction
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"github.com/hajimehoshi/ebiten/audio"
+	"github.com/hajimehoshi/ebiten/audio/wav"
+	"io"
+	"io/ioutil"
+	"math"
+)
+
+const bytesPerFrame = 4 // 16 bit stereo PCM, as the audio context plays it
+
+// Sound is one decoded sample and the voices that can play it at once
+type Sound struct {
+	name   SoundName
+	pcm    []byte
+	gain   float64 // base volume of this sound, multiplied into every Play
+	voices []*Voice
+}
+
+// Voice is one player of a Sound, reading from the shared decoded PCM
+type Voice struct {
+	player  *audio.Player
+	stream  *panStream
+	volume  float64 // asked for by Play including the sound's gain, before the mixer
+	started int     // when the voice was last played, the oldest one is stolen
+}
+
+// AudioManager owns every sound effect, gameplay asks it to Play by name
+type AudioManager struct {
+	context *audio.Context
+	mixer   *Mixer
+	sounds  map[SoundName]*Sound
+	played  int // counts Play calls, to find the oldest voice
+}
+
+func newAudioManager(context *audio.Context, mixer *Mixer) *AudioManager {
+	return &AudioManager{
+		context: context,
+		mixer:   mixer,
+		sounds:  make(map[SoundName]*Sound),
+	}
+}
+
+// LoadWAV decodes a WAV sample once and makes maxVoices players for it
+func (m *AudioManager) LoadWAV(name SoundName, data []byte, maxVoices int, gain float64) error {
+	decoded, err := wav.Decode(m.context, audio.BytesReadSeekCloser(data))
+	if err != nil {
+		return err
+	}
+	pcm, err := ioutil.ReadAll(decoded)
+	if err != nil {
+		return err
+	}
+	return m.Add(name, pcm, maxVoices, gain)
+}
+
+// Add registers already decoded PCM as a sound, replacing any sound of the same name
+func (m *AudioManager) Add(name SoundName, pcm []byte, maxVoices int, gain float64) error {
+	if maxVoices < 1 {
+		maxVoices = 1
+	}
+	s := &Sound{name: name, pcm: pcm, gain: gain}
+	for i := 0; i < maxVoices; i++ {
+		stream := newPanStream(&pcmStream{pcm: pcm})
+		p, err := audio.NewPlayer(m.context, stream)
+		if err != nil {
+			return err
+		}
+		s.voices = append(s.voices, &Voice{player: p, stream: stream})
+	}
+	if old, ok := m.sounds[name]; ok {
+		old.Close()
+	}
+	m.sounds[name] = s
+	return nil
+}
+
+// Play starts a sound on a free voice, or steals the one that has played the
+// longest. volume is multiplied by the sound's gain, pan runs from -1 left to
+// 1 right and is ignored in mono. Unknown names are ignored so a missing sound
+// never stops the game.
+func (m *AudioManager) Play(name SoundName, volume float64, pan float64) {
+	s, ok := m.sounds[name]
+	if !ok {
+		return
+	}
+	m.played++
+
+	v := s.voices[0]
+	for _, candidate := range s.voices {
+		if !candidate.player.IsPlaying() {
+			v = candidate
+			break
+		}
+		if candidate.started < v.started {
+			v = candidate
+		}
+	}
+
+	v.player.Pause()
+	v.player.Rewind()
+	v.started = m.played
+	v.volume = volume * s.gain
+	v.stream.Set(pan, m.mixer.Mono())
+	m.apply(v)
+	v.player.Play()
+}
+
+// Refresh applies the mixer to voices already playing, after a bus volume changes
+func (m *AudioManager) Refresh() {
+	for _, s := range m.sounds {
+		for _, v := range s.voices {
+			m.apply(v)
+		}
+	}
+}
+
+// apply sets the player volume of a voice through the SFX bus
+func (m *AudioManager) apply(v *Voice) {
+	v.player.SetVolume(clamp01(v.volume * m.mixer.Gain(busSFX)))
+}
+
+// PlayAt plays a sound panned to where its x is across the screen
+func (g *Game) PlayAt(name SoundName, x float64) {
+	g.sfx.Play(name, 1, screenPan(x))
+}
+
+// screenPan is the pan for an x position, the left edge of the screen is -1 and the right 1
+func screenPan(x float64) float64 {
+	return math.Max(-1, math.Min(1, x/screenWidth*2-1))
+}
+
+// Has reports whether a sound has been loaded
+func (m *AudioManager) Has(name SoundName) bool {
+	_, ok := m.sounds[name]
+	return ok
+}
+
+// Close stops and releases every voice of a sound
+func (s *Sound) Close() {
+	for _, v := range s.voices {
+		v.player.Close()
+	}
+}
+
+// pcmStream reads decoded PCM with its own position, so many voices can share one sample
+type pcmStream struct {
+	pcm []byte
+	pos int64
+}
+
+func (s *pcmStream) Read(buf []byte) (int, error) {
+	if s.pos >= int64(len(s.pcm)) {
+		return 0, io.EOF
+	}
+	n := copy(buf, s.pcm[s.pos:])
+	s.pos += int64(n)
+	return n, nil
+}
+
+func (s *pcmStream) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		s.pos = offset
+	case io.SeekCurrent:
+		s.pos += offset
+	case io.SeekEnd:
+		s.pos = int64(len(s.pcm)) + offset
+	}
+	if s.pos < 0 {
+		s.pos = 0
+	}
+	return s.pos, nil
+}
+
+func (s *pcmStream) Close() error {
+	return nil
+}
+
+// clamp01 keeps a volume inside what an audio player accepts
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
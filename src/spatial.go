@@ -0,0 +1,85 @@
+package main
+
+const cellSize = 32 // width and height of a spatial hash cell, about one ship
+
+// SpatialHash is a uniform grid over the screen that actors are dropped into
+// every tick, so a collision check only looks at actors in nearby cells.
+// Anything off screen is kept in the nearest edge cell.
+type SpatialHash struct {
+	cols   int
+	rows   int
+	cells  [][]*Actor
+	query  int      // bumped every query so an actor in several cells is only returned once
+	result []*Actor // reused by Query
+}
+
+func newSpatialHash(width int, height int) SpatialHash {
+	cols := (width + cellSize - 1) / cellSize
+	rows := (height + cellSize - 1) / cellSize
+	return SpatialHash{
+		cols:  cols,
+		rows:  rows,
+		cells: make([][]*Actor, cols*rows),
+	}
+}
+
+// cellRange is the clamped span of cells covered by a box
+func (h *SpatialHash) cellRange(x float64, y float64, w float64, hgt float64) (int, int, int, int) {
+	clamp := func(v float64, max int) int {
+		c := int(v) / cellSize
+		if c < 0 {
+			return 0
+		}
+		if c >= max {
+			return max - 1
+		}
+		return c
+	}
+	return clamp(x, h.cols), clamp(y, h.rows), clamp(x+w, h.cols), clamp(y+hgt, h.rows)
+}
+
+// Build empties the grid and adds every actor
+func (h *SpatialHash) Build(actors []*Actor) {
+	for i := range h.cells {
+		h.cells[i] = h.cells[i][:0]
+	}
+	for _, a := range actors {
+		h.Insert(a)
+	}
+}
+
+// Insert adds an actor to every cell its hitbox covers. Fast actors are added
+// along the whole path they moved this tick, so swept tests can find them.
+func (h *SpatialHash) Insert(a *Actor) {
+	bx, by, bw, bh := a.x+a.hitbox.x, a.y+a.hitbox.y, a.hitbox.w, a.hitbox.h
+	if isFast(a.vx, a.vy) {
+		bx, by, bw, bh = sweptBounds(a.x-a.vx, a.y-a.vy, a.hitbox, a.vx, a.vy)
+	}
+	x0, y0, x1, y1 := h.cellRange(bx, by, bw, bh)
+	for cy := y0; cy <= y1; cy++ {
+		for cx := x0; cx <= x1; cx++ {
+			i := cy*h.cols + cx
+			h.cells[i] = append(h.cells[i], a)
+		}
+	}
+}
+
+// Query returns the actors sharing a cell with the box, each once. They may
+// not actually overlap it, use collide on the result. The slice is reused by
+// the next call.
+func (h *SpatialHash) Query(x float64, y float64, w float64, hgt float64) []*Actor {
+	h.query++
+	h.result = h.result[:0]
+	x0, y0, x1, y1 := h.cellRange(x, y, w, hgt)
+	for cy := y0; cy <= y1; cy++ {
+		for cx := x0; cx <= x1; cx++ {
+			for _, a := range h.cells[cy*h.cols+cx] {
+				if a.queryMark != h.query {
+					a.queryMark = h.query
+					h.result = append(h.result, a)
+				}
+			}
+		}
+	}
+	return h.result
+}
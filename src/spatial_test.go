@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// crowd is a wave of enemies, some enemy bullets and n player bullets spread over the screen
+func crowd(n int) ([]*Actor, []*Bullet) {
+	r := rand.New(rand.NewSource(1))
+	var actors []*Actor
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 4; j++ {
+			actors = append(actors, &Actor{
+				x: float64(12 + i*40), y: float64(48 + j*32),
+				hitbox: Hitbox{x: 4, y: 4, w: 24, h: 24},
+			})
+		}
+	}
+	for i := 0; i < 30; i++ {
+		actors = append(actors, &Actor{
+			x: r.Float64() * screenWidth, y: r.Float64() * screenHeight, vy: 3,
+			hitbox: Hitbox{w: 8, h: 8},
+		})
+	}
+	bullets := make([]*Bullet, n)
+	for i := range bullets {
+		bullets[i] = &Bullet{
+			x: r.Float64() * screenWidth, y: r.Float64() * screenHeight, vy: -6,
+			hitbox: Hitbox{w: 8, h: 8},
+		}
+	}
+	return actors, bullets
+}
+
+// overlaps tests one bullet box against an actor box
+func overlaps(b *Bullet, a *Actor) bool {
+	return collide(b.x+b.hitbox.x, b.y+b.hitbox.y, b.hitbox.w, b.hitbox.h, a.x+a.hitbox.x, a.y+a.hitbox.y, a.hitbox.w, a.hitbox.h)
+}
+
+func TestSpatialHashFindsEveryOverlap(t *testing.T) {
+	actors, bullets := crowd(500)
+	h := newSpatialHash(screenWidth, screenHeight)
+	h.Build(actors)
+	for _, b := range bullets {
+		found := make(map[*Actor]bool)
+		for _, a := range h.Query(b.x+b.hitbox.x, b.y+b.hitbox.y, b.hitbox.w, b.hitbox.h) {
+			if found[a] {
+				t.Fatalf("query returned an actor twice")
+			}
+			found[a] = true
+		}
+		for _, a := range actors {
+			if overlaps(b, a) && !found[a] {
+				t.Fatalf("bullet at %v,%v overlaps an actor at %v,%v the query missed", b.x, b.y, a.x, a.y)
+			}
+		}
+	}
+}
+
+func TestSpatialHashInsertAfterBuild(t *testing.T) {
+	h := newSpatialHash(screenWidth, screenHeight)
+	h.Build(nil)
+	a := &Actor{x: 100, y: 100, hitbox: Hitbox{w: 32, h: 32}}
+	h.Insert(a)
+	if got := h.Query(110, 110, 4, 4); len(got) != 1 || got[0] != a {
+		t.Errorf("query after insert = %v, want the inserted actor", got)
+	}
+}
+
+func BenchmarkCollide(b *testing.B) {
+	for _, n := range []int{100, 300, 1000} {
+		actors, bullets := crowd(n)
+
+		b.Run(fmt.Sprintf("brute/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				hits := 0
+				for _, bl := range bullets {
+					for _, a := range actors {
+						if overlaps(bl, a) {
+							hits++
+						}
+					}
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("hash/%d", n), func(b *testing.B) {
+			h := newSpatialHash(screenWidth, screenHeight)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				h.Build(actors) // every tick, as Actors.Update does
+				hits := 0
+				for _, bl := range bullets {
+					for _, a := range h.Query(bl.x+bl.hitbox.x, bl.y+bl.hitbox.y, bl.hitbox.w, bl.hitbox.h) {
+						if overlaps(bl, a) {
+							hits++
+						}
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"github.com/hajimehoshi/ebiten"
+	"image"
+	"math"
+	"sort"
+)
+
+// SpriteID is a handle into the SpriteTable, cheaper to draw with than a name
+type SpriteID int
+
+const noSprite SpriteID = -1 // returned for names that are not in the atlas
+
+// ResolvedSprite is an atlas sprite with its sub image already cut out of the atlas
+type ResolvedSprite struct {
+	Sprite
+	image  *ebiten.Image
+	placed bool        // trimmed or rotated, so local is applied when drawing
+	local  ebiten.GeoM // turns the stored pixels upright and moves them to their place in the sprite
+}
+
+// SpriteTable is every sprite in the atlas, resolved once at load time
+type SpriteTable struct {
+	sprites []ResolvedSprite
+	ids     map[SpriteName]SpriteID
+	pages   []*ebiten.Image
+	shards  map[shardKey][]*ebiten.Image
+	masks   []*Mask // by SpriteID, filled by BuildMasks
+}
+
+// shardKey identifies a sprite cut into a grid of pieces
+type shardKey struct {
+	id   SpriteID
+	cols int
+	rows int
+}
+
+// newSpriteTable cuts every sprite out of the atlas pages, IDs follow the sorted sprite names
+func newSpriteTable(pages []*ebiten.Image, sprites map[string]Sprite) *SpriteTable {
+	names := make([]string, 0, len(sprites))
+	for name := range sprites {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	st := &SpriteTable{
+		sprites: make([]ResolvedSprite, 0, len(names)),
+		ids:     make(map[SpriteName]SpriteID, len(names)),
+		pages:   pages,
+		shards:  make(map[shardKey][]*ebiten.Image),
+	}
+	for i, name := range names {
+		s := sprites[name]
+		r := ResolvedSprite{
+			Sprite: s,
+			image:  pages[s.page].SubImage(s.storedRect()).(*ebiten.Image),
+			placed: s.rotated || s.offsetX != 0 || s.offsetY != 0,
+		}
+		if s.rotated {
+			// stored clockwise, so turn it back anticlockwise
+			r.local.Rotate(-math.Pi / 2)
+			r.local.Translate(0, float64(s.trimHeight))
+		}
+		r.local.Translate(float64(s.offsetX), float64(s.offsetY))
+		st.sprites = append(st.sprites, r)
+		st.ids[SpriteName(name)] = SpriteID(i)
+	}
+	return st
+}
+
+// ID looks up the handle for a sprite name, noSprite if there is no such sprite
+func (st *SpriteTable) ID(name SpriteName) SpriteID {
+	if id, ok := st.ids[name]; ok {
+		return id
+	}
+	return noSprite
+}
+
+// Get returns the resolved sprite for a handle, nil for noSprite
+func (st *SpriteTable) Get(id SpriteID) *ResolvedSprite {
+	if id < 0 || int(id) >= len(st.sprites) {
+		return nil
+	}
+	return &st.sprites[id]
+}
+
+// Size returns the width and height of a sprite
+func (st *SpriteTable) Size(id SpriteID) (int, int) {
+	if s := st.Get(id); s != nil {
+		return s.width, s.height
+	}
+	return 0, 0
+}
+
+// Shards cuts a sprite into a cols by rows grid, left to right then top to bottom.
+// The pieces are cached so breaking the same sprite again costs nothing.
+// Trimmed and rotated sprites are drawn upright at their full size first, so the
+// grid is the same as for a sprite stored as it is drawn.
+func (st *SpriteTable) Shards(id SpriteID, cols int, rows int) []*ebiten.Image {
+	key := shardKey{id: id, cols: cols, rows: rows}
+	if shards, ok := st.shards[key]; ok {
+		return shards
+	}
+	s := st.Get(id)
+	if s == nil || cols < 1 || rows < 1 {
+		return nil
+	}
+	w, h := s.width, s.height
+	upright := s.image
+	if s.placed || s.trimWidth != w || s.trimHeight != h {
+		upright, _ = ebiten.NewImage(w, h, ebiten.FilterDefault)
+		spriteDraw(upright, s, &ebiten.DrawImageOptions{})
+	}
+	min := upright.Bounds().Min
+	shards := make([]*ebiten.Image, 0, cols*rows)
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			rect := image.Rect(
+				min.X+c*w/cols,
+				min.Y+r*h/rows,
+				min.X+(c+1)*w/cols,
+				min.Y+(r+1)*h/rows,
+			)
+			shards = append(shards, upright.SubImage(rect).(*ebiten.Image))
+		}
+	}
+	st.shards[key] = shards
+	return shards
+}
+
+// BuildMasks reads the alpha of every sprite from the decoded atlas pages for pixel collision
+func (st *SpriteTable) BuildMasks(pages []image.Image) {
+	st.masks = make([]*Mask, len(st.sprites))
+	for i := range st.sprites {
+		st.masks[i] = newMask(pages[st.sprites[i].page], st.sprites[i].Sprite)
+	}
+}
+
+// Mask returns the collision mask for a sprite, nil before BuildMasks or for noSprite
+func (st *SpriteTable) Mask(id SpriteID) *Mask {
+	if id < 0 || int(id) >= len(st.masks) {
+		return nil
+	}
+	return st.masks[id]
+}
+
+// storedRect is where the sprite's pixels are in its atlas page
+func (s Sprite) storedRect() image.Rectangle {
+	w, h := s.trimWidth, s.trimHeight
+	if s.rotated {
+		w, h = h, w
+	}
+	return image.Rect(s.x, s.y, s.x+w, s.y+h)
+}
+
+// pagePixel finds the pixel of the atlas page that shows a pixel of the
+// sprite. ok is false for pixels trimmed away, which are clear.
+func (s Sprite) pagePixel(x int, y int) (int, int, bool) {
+	x, y = x-s.offsetX, y-s.offsetY
+	if x < 0 || y < 0 || x >= s.trimWidth || y >= s.trimHeight {
+		return 0, 0, false
+	}
+	if s.rotated {
+		return s.x + s.trimHeight - 1 - y, s.y + x, true
+	}
+	return s.x + x, s.y + y, true
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"github.com/hajimehoshi/ebiten"
+	"testing"
+)
+
+// benchSprites are looked up and drawn by the sprite benchmarks, about what a busy frame draws
+var benchSprites = []SpriteName{spritePlayer, spriteEnemy1, spriteEnemy2, spriteEnemy3, spriteBullet, spriteEnemyBullet, spriteStarSmall, spriteLives}
+
+// nameSprites is the name keyed sprite map the game drew from before SpriteTable
+func nameSprites(st *SpriteTable) map[SpriteName]Sprite {
+	m := make(map[SpriteName]Sprite, len(st.sprites))
+	for _, s := range st.sprites {
+		m[SpriteName(s.name)] = s.Sprite
+	}
+	return m
+}
+
+func TestShardsAreCutUpright(t *testing.T) {
+	page, _ := ebiten.NewImage(32, 32, ebiten.FilterDefault)
+	st := newSpriteTable([]*ebiten.Image{page}, map[string]Sprite{
+		"plain":   {name: "plain", width: 8, height: 12, trimWidth: 8, trimHeight: 12},
+		"trimmed": {name: "trimmed", x: 8, width: 12, height: 8, offsetX: 2, offsetY: 1, trimWidth: 6, trimHeight: 4},
+		"rotated": {name: "rotated", x: 16, width: 12, height: 8, rotated: true, offsetX: 1, offsetY: 2, trimWidth: 10, trimHeight: 5},
+	})
+	for _, name := range []SpriteName{"plain", "trimmed", "rotated"} {
+		id := st.ID(name)
+		w, h := st.Size(id)
+		shards := st.Shards(id, 2, 2)
+		if len(shards) != 4 {
+			t.Fatalf("%s: %d shards, want 4", name, len(shards))
+		}
+		for i, shard := range shards {
+			if sw, sh := shard.Size(); sw != w/2 || sh != h/2 {
+				t.Errorf("%s shard %d is %dx%d, want %dx%d", name, i, sw, sh, w/2, h/2)
+			}
+		}
+	}
+}
+
+func BenchmarkSpriteLookup(b *testing.B) {
+	g := newTestGame(b)
+	st := g.spriteTable
+
+	b.Run("map", func(b *testing.B) {
+		m := nameSprites(st)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			s := m[benchSprites[i%len(benchSprites)]]
+			_ = st.pages[s.page].SubImage(s.storedRect()).(*ebiten.Image)
+		}
+	})
+
+	b.Run("id", func(b *testing.B) {
+		ids := make([]SpriteID, len(benchSprites))
+		for i, name := range benchSprites {
+			ids[i] = st.ID(name)
+		}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = st.Get(ids[i%len(ids)]).image
+		}
+	})
+}
+
+func BenchmarkSpriteDraw(b *testing.B) {
+	g := newTestGame(b)
+	st := g.spriteTable
+	screen, _ := ebiten.NewImage(screenWidth, screenHeight, ebiten.FilterDefault)
+	var op ebiten.DrawImageOptions
+
+	b.Run("map", func(b *testing.B) {
+		m := nameSprites(st)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			s := m[benchSprites[i%len(benchSprites)]]
+			screen.DrawImage(st.pages[s.page].SubImage(s.storedRect()).(*ebiten.Image), &op)
+		}
+	})
+
+	b.Run("id", func(b *testing.B) {
+		ids := make([]SpriteID, len(benchSprites))
+		for i, name := range benchSprites {
+			ids[i] = st.ID(name)
+		}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			spriteDraw(screen, st.Get(ids[i%len(ids)]), &op)
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"github.com/hajimehoshi/ebiten/audio"
+	"io"
+	"math"
+	"sync"
+)
+
+const stemFade = 2.0 // seconds for a stem to fade fully in or out as the intensity changes
+
+// Stem is one layer of an adaptive track, like the drums or the lead. Every
+// stem of a track plays in sync and is faded in as the intensity rises from
+// `from` to `to`. A stem with both at zero always plays.
+//
+//	"battle": {name: "battle", gain: 0.3, stems: []Stem{
+//		{name: "bass", file: "audio/battle-bass.mp3", mp3: true},
+//		{name: "drums", file: "audio/battle-drums.mp3", mp3: true, from: 0.2, to: 0.4},
+//		{name: "lead", file: "audio/battle-lead.mp3", mp3: true, from: 0.6, to: 0.8},
+//	}},
+type Stem struct {
+	name string
+	file string // under assets/, read into data by loadTracks
+	data []byte
+	mp3  bool
+	from float64
+	to   float64
+}
+
+// level is how loud the stem should be at an intensity
+func (s Stem) level(intensity float64) float64 {
+	if s.to <= s.from {
+		if intensity >= s.from {
+			return 1
+		}
+		return 0
+	}
+	return clamp01((intensity - s.from) / (s.to - s.from))
+}
+
+// stemStream mixes the stems of a track into one stream. Mixing here rather
+// than giving each stem its own player keeps them sample accurate, they can
+// never drift apart. Stem volumes glide towards their targets a frame at a time.
+type stemStream struct {
+	mu      sync.Mutex
+	stems   []Stem
+	sources []audio.ReadSeekCloser
+	gains   []float64
+	targets []float64
+	step    float64 // gain change per frame
+	scratch []byte
+	mix     []float64
+}
+
+func newStemStream(stems []Stem, sources []audio.ReadSeekCloser, sampleRate int) *stemStream {
+	return &stemStream{
+		stems:   stems,
+		sources: sources,
+		gains:   make([]float64, len(stems)),
+		targets: make([]float64, len(stems)),
+		step:    1 / (stemFade * float64(sampleRate)),
+	}
+}
+
+// SetIntensity sets the level each stem glides to
+func (s *stemStream) SetIntensity(intensity float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, stem := range s.stems {
+		s.targets[i] = stem.level(intensity)
+	}
+}
+
+func (s *stemStream) Read(buf []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := len(buf) / bytesPerFrame * bytesPerFrame
+	if n == 0 {
+		return 0, nil
+	}
+	if cap(s.scratch) < n {
+		s.scratch = make([]byte, n)
+	}
+	scratch := s.scratch[:n]
+	if cap(s.mix) < n/2 {
+		s.mix = make([]float64, n/2)
+	}
+	mix := s.mix[:n/2]
+	for j := range mix {
+		mix[j] = 0
+	}
+
+	for i, src := range s.sources {
+		// the stems loop forever, so each always fills the buffer
+		if _, err := io.ReadFull(src, scratch); err != nil {
+			return 0, err
+		}
+		gain := s.gains[i]
+		for f := 0; f < n/bytesPerFrame; f++ {
+			if gain < s.targets[i] {
+				gain = math.Min(s.targets[i], gain+s.step)
+			} else if gain > s.targets[i] {
+				gain = math.Max(s.targets[i], gain-s.step)
+			}
+			mix[f*2] += readSample(scratch[f*bytesPerFrame:]) * gain
+			mix[f*2+1] += readSample(scratch[f*bytesPerFrame+2:]) * gain
+		}
+		s.gains[i] = gain
+	}
+
+	for j, v := range mix {
+		writeSample(buf[j*2:], v)
+	}
+	return n, nil
+}
+
+func (s *stemStream) Seek(offset int64, whence int) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var pos int64
+	for _, src := range s.sources {
+		p, err := src.Seek(offset, whence)
+		if err != nil {
+			return 0, err
+		}
+		pos = p
+	}
+	return pos, nil
+}
+
+func (s *stemStream) Close() error {
+	var err error
+	for _, src := range s.sources {
+		if e := src.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
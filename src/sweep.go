@@ -0,0 +1,71 @@
+package main
+
+import (
+	"math"
+)
+
+// sweepSpeed is the speed in pixels per tick above which projectiles are swept
+// along their path, rather than only tested where they end up
+const sweepSpeed = 4.0
+
+// isFast reports whether something moving by vx, vy each tick should be swept
+func isFast(vx float64, vy float64) bool {
+	return vx*vx+vy*vy >= sweepSpeed*sweepSpeed
+}
+
+// sweptBounds is the box covering a hitbox at x, y and at x+dx, y+dy, for broadphase queries
+func sweptBounds(x float64, y float64, h Hitbox, dx float64, dy float64) (float64, float64, float64, float64) {
+	return x + h.x + math.Min(dx, 0), y + h.y + math.Min(dy, 0), h.w + math.Abs(dx), h.h + math.Abs(dy)
+}
+
+// sweepAABB finds when a box at x, y moving by dx, dy over one tick touches a
+// still box, as fractions of the tick. ok is false if they never touch in it.
+func sweepAABB(x float64, y float64, w float64, h float64, dx float64, dy float64, tx float64, ty float64, tw float64, th float64) (float64, float64, bool) {
+	enter, exit := math.Inf(-1), math.Inf(1)
+
+	// one slab per axis, the target grown by the moving box so the moving box is a point
+	axis := func(p float64, d float64, lo float64, hi float64) bool {
+		if d == 0 {
+			return p >= lo && p <= hi
+		}
+		t1, t2 := (lo-p)/d, (hi-p)/d
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		enter = math.Max(enter, t1)
+		exit = math.Min(exit, t2)
+		return true
+	}
+	if !axis(x, dx, tx-w, tx+tw) || !axis(y, dy, ty-h, ty+th) {
+		return 0, 0, false
+	}
+	if enter > exit || exit < 0 || enter > 1 {
+		return 0, 0, false
+	}
+	return math.Max(enter, 0), math.Min(exit, 1), true
+}
+
+// sweptHitTest is hitTest for a hitbox that moves from x, y by dx, dy this
+// tick against one that stays still. Boxes are solved exactly, other shapes
+// are tested every pixel along the part of the path where the boxes overlap.
+func sweptHitTest(x float64, y float64, h Hitbox, dx float64, dy float64, tx float64, ty float64, th Hitbox) bool {
+	enter, exit, ok := sweepAABB(
+		x+h.x, y+h.y, h.w, h.h, dx, dy,
+		tx+th.x, ty+th.y, th.w, th.h,
+	)
+	if !ok {
+		return false
+	}
+	if h.shape == shapeBox && th.shape == shapeBox {
+		return true
+	}
+
+	steps := int(math.Ceil(math.Hypot(dx, dy)*(exit-enter))) + 1
+	for i := 0; i <= steps; i++ {
+		f := enter + (exit-enter)*float64(i)/float64(steps)
+		if hitTest(x+dx*f, y+dy*f, h, tx, ty, th) {
+			return true
+		}
+	}
+	return false
+}
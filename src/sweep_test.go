@@ -0,0 +1,130 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestSweptHitTest(t *testing.T) {
+	bullet := Hitbox{w: 8, h: 8}
+	tests := []struct {
+		name           string
+		x, y, dx, dy   float64 // bullet start and movement this tick
+		tx, ty         float64 // target position
+		target         Hitbox
+		wantOld, wantS bool // tested only where it ends up, and swept
+	}{
+		{
+			// moves 20 in a tick through a target 4 thick, it is never overlapping at either end
+			name: "tunnels through thin target", x: 100, y: 60, dy: -20,
+			tx: 96, ty: 50, target: Hitbox{w: 16, h: 4},
+			wantOld: false, wantS: true,
+		},
+		{
+			name: "passes a corner", x: 0, y: 0, dx: 20, dy: 20,
+			tx: 14, ty: 0, target: Hitbox{w: 4, h: 4},
+			wantOld: false, wantS: false,
+		},
+		{
+			name: "passes alongside", x: 100, y: 100, dy: -40,
+			tx: 109, ty: 70, target: Hitbox{w: 10, h: 10},
+			wantOld: false, wantS: false,
+		},
+		{
+			name: "touches at the end of the tick", x: 100, y: 100, dy: -20,
+			tx: 100, ty: 70, target: Hitbox{w: 8, h: 10},
+			wantOld: true, wantS: true,
+		},
+		{
+			name: "stops one pixel short", x: 100, y: 101, dy: -20,
+			tx: 100, ty: 70, target: Hitbox{w: 8, h: 10},
+			wantOld: false, wantS: false,
+		},
+		{
+			name: "circle through thin target", x: 100, y: 60, dy: -20,
+			tx: 96, ty: 50, target: Hitbox{w: 16, h: 4, shape: shapeCircle},
+			wantOld: false, wantS: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hitTest(tt.x+tt.dx, tt.y+tt.dy, bullet, tt.tx, tt.ty, tt.target); got != tt.wantOld {
+				t.Errorf("hitTest at the end = %v, want %v", got, tt.wantOld)
+			}
+			if got := sweptHitTest(tt.x, tt.y, bullet, tt.dx, tt.dy, tt.tx, tt.ty, tt.target); got != tt.wantS {
+				t.Errorf("sweptHitTest = %v, want %v", got, tt.wantS)
+			}
+		})
+	}
+}
+
+func TestSweepAABBBoundaries(t *testing.T) {
+	tests := []struct {
+		name             string
+		y, dy            float64 // 8x8 box at x 0, target 8x10 at 0, 70
+		wantEnter, wantX float64
+		wantOK           bool
+	}{
+		{name: "enters on the last instant", y: 100, dy: -20, wantEnter: 1, wantX: 1, wantOK: true},
+		{name: "touching and leaving", y: 80, dy: 20, wantEnter: 0, wantX: 0, wantOK: true},
+		{name: "leaves on the last instant", y: 70, dy: 10, wantEnter: 0, wantX: 1, wantOK: true},
+		{name: "through and out the far side", y: 100, dy: -60, wantEnter: 20.0 / 60, wantX: 38.0 / 60, wantOK: true},
+		{name: "moving away", y: 81, dy: 20, wantOK: false},
+		{name: "not far enough", y: 101, dy: -20, wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enter, exit, ok := sweepAABB(0, tt.y, 8, 8, 0, tt.dy, 0, 70, 8, 10)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && (enter != tt.wantEnter || exit != tt.wantX) {
+				t.Errorf("enter, exit = %v, %v, want %v, %v", enter, exit, tt.wantEnter, tt.wantX)
+			}
+		})
+	}
+}
+
+func TestIsFast(t *testing.T) {
+	tests := []struct {
+		vx, vy float64
+		want   bool
+	}{
+		{0, sweepSpeed, true},
+		{0, -sweepSpeed, true},
+		{0, sweepSpeed - 0.01, false},
+		{sweepSpeed + 0.01, 0, true},
+		{3, 3, true},      // 4.24 along the diagonal
+		{2.8, 2.8, false}, // 3.96
+		{0, 0, false},
+	}
+	for _, tt := range tests {
+		if got := isFast(tt.vx, tt.vy); got != tt.want {
+			t.Errorf("isFast(%v, %v) = %v, want %v", tt.vx, tt.vy, got, tt.want)
+		}
+	}
+}
+
+func TestSpatialHashSweptCells(t *testing.T) {
+	h := newSpatialHash(screenWidth, screenHeight)
+	// has moved 100 down this tick, from y 100 to 200
+	fast := &Actor{x: 40, y: 200, vy: 100, hitbox: Hitbox{w: 8, h: 8}}
+	slow := &Actor{x: 140, y: 200, vy: sweepSpeed - 1, hitbox: Hitbox{w: 8, h: 8}}
+	h.Build([]*Actor{fast, slow})
+
+	// one probe in the middle of every cell row along the path, and one either side
+	for row := 0; row < h.rows; row++ {
+		found := false
+		for _, a := range h.Query(44, float64(row*cellSize+cellSize/2), 1, 1) {
+			found = found || a == fast
+		}
+		want := row >= 100/cellSize && row <= 208/cellSize
+		if found != want {
+			t.Errorf("row %d: found the fast actor %v, want %v", row, found, want)
+		}
+	}
+	for _, a := range h.Query(144, 100, 1, 1) {
+		if a == slow {
+			t.Errorf("a slow actor is only added where it is, not along its path")
+		}
+	}
+}
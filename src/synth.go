@@ -0,0 +1,24 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/leenattress/goshootygame/src/sfxr"
+)
+
+const synthVoices = 4 // voices for each synthesised sound
+
+// loadSynthSounds generates every sound in assets/data/synth.json and adds it
+// to the audio manager under its name. Use tools/sfxr to try out parameters.
+func loadSynthSounds(data []byte, m *AudioManager) error {
+	var defs map[SoundName]sfxr.Params
+	if err := json.Unmarshal(data, &defs); err != nil {
+		return fmt.Errorf("synth: %v", err)
+	}
+	for name, p := range defs {
+		if err := m.Add(name, sfxr.Generate(p, m.context.SampleRate()), synthVoices, 1); err != nil {
+			return fmt.Errorf("synth %s: %v", name, err)
+		}
+	}
+	return nil
+}
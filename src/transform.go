@@ -0,0 +1,65 @@
+package main
+
+import (
+	"github.com/hajimehoshi/ebiten"
+	"math"
+)
+
+// Transform is how a sprite is rotated, scaled and flipped when it is drawn.
+// The zero value draws the sprite as it is in the atlas.
+type Transform struct {
+	angle  float64 // rotation in radians, clockwise
+	scaleX float64 // 0 is treated as 1
+	scaleY float64 // 0 is treated as 1
+	pivotX float64 // rotation and scale origin in pixels from the centre of the sprite
+	pivotY float64
+	flipX  bool // mirror left to right
+	flipY  bool // mirror top to bottom
+}
+
+// stepsToRadians converts the 256 step angles used by actors and bullets to radians
+func stepsToRadians(steps int) float64 {
+	return 2 * math.Pi * float64(steps) / maxAngle
+}
+
+// Rotated returns a copy of the transform turned by another angle in radians
+func (tr Transform) Rotated(angle float64) Transform {
+	tr.angle += angle
+	return tr
+}
+
+// GeoM builds the matrix for a w by h sprite whose untransformed top left corner is at x, y
+func (tr Transform) GeoM(w int, h int, x float64, y float64) ebiten.GeoM {
+	var geoM ebiten.GeoM
+
+	sx, sy := tr.scaleX, tr.scaleY
+	if sx == 0 {
+		sx = 1
+	}
+	if sy == 0 {
+		sy = 1
+	}
+	if tr.flipX {
+		sx = -sx
+	}
+	if tr.flipY {
+		sy = -sy
+	}
+
+	// move the pivot to 0,0, transform around it, then put it back
+	ox := float64(w)/2 + tr.pivotX
+	oy := float64(h)/2 + tr.pivotY
+	geoM.Translate(-ox, -oy)
+	geoM.Scale(sx, sy)
+	if tr.angle != 0 {
+		geoM.Rotate(tr.angle)
+	}
+	geoM.Translate(ox, oy)
+	geoM.Translate(x, y)
+	return geoM
+}
+
+// faceAngle is the rotation that points a downward facing sprite along vx, vy
+func faceAngle(vx float64, vy float64) float64 {
+	return math.Atan2(vy, vx) - math.Pi/2
+}
@@ -1,25 +1,56 @@
+// Command tools is the asset pipeline. It packs assets/texture into atlas
+// pages, bundles the atlas, audio and data files into Go source so they are
+// embedded in the game, and writes assets/manifest.json with Go constants
+// for every sprite and sound name. Run it from the repository root:
+//
+//	go run ./tools
+//
+// Files bigger than -large are only embedded when building with -tags large,
+// and are not committed. Without them the game reads them with -assets.
 package main
 
 import (
-	"context"
-	"github.com/RaniSputnik/lovepac/packer"
-	"github.com/RaniSputnik/lovepac/target"
+	"flag"
 	"log"
 )
 
 func main() {
-	params := packer.Params{
-		Name:   "atlas",
-		Format: target.Starling,
-		Input:  packer.NewFileStream("./assets/texture"),
-		Output: packer.NewFileOutputter("./assets"),
-		Width:  512,
-		Height: 512,
-	}
-	var err error
+	assets := flag.String("assets", "assets", "assets directory")
+	src := flag.String("src", "src", "directory of the game source, where Go files are written")
+	size := flag.Int("size", 512, "width and height of each atlas page")
+	large := flag.Int("large", 1<<20, "files of at least this many bytes are only embedded with -tags large")
+	noPack := flag.Bool("nopack", false, "keep the current atlas instead of packing the textures again")
+	flag.Parse()
 
-	err = packer.Run(context.Background(), &params)
+	if !*noPack {
+		if err := pack(*assets+"/texture", *assets, *size); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	files, err := collect(*assets, int64(*large))
+	if err != nil {
+		log.Fatal(err)
+	}
+	names, err := readNames(*assets, files)
 	if err != nil {
 		log.Fatal(err)
 	}
+	if err := checkNames(*assets, names); err != nil {
+		log.Fatal(err)
+	}
+	if err := writeManifest(*assets, files, names); err != nil {
+		log.Fatal(err)
+	}
+	// the manifest is bundled too, so collect again to pick it up
+	if files, err = collect(*assets, int64(*large)); err != nil {
+		log.Fatal(err)
+	}
+	if err := writeBundle(*src, files); err != nil {
+		log.Fatal(err)
+	}
+	if err := writeNames(*src, names); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("bundled %d files, %d sprites, %d sounds", len(files), len(names.Sprites), len(names.Sounds))
 }
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	manifestName  = "manifest.json"
+	generated     = "// Code generated by go run ./tools. DO NOT EDIT.\n\n"
+	largeTag      = "large"
+	bundlePrefix  = "bundle_"      // small files, committed
+	largePrefix   = "bundlelarge_" // large files, built with -tags large and not committed
+	bundleMapFile = "bundle.go"
+)
+
+// bundledDirs are the folders under assets/ whose files are embedded, the atlas and manifest sit at the top
+var bundledDirs = []string{"audio", "data"}
+
+// bundleFile is one file embedded in the game
+type bundleFile struct {
+	Name   string `json:"name"` // path under assets/ with forward slashes, as the game asks for it
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+	Large  bool   `json:"large"`
+	path   string // on disk
+}
+
+// manifest is written to assets/manifest.json, the game reads the atlas list from it
+type manifest struct {
+	Atlases []string     `json:"atlases"`
+	Sprites []string     `json:"sprites"`
+	Sounds  []string     `json:"sounds"`
+	Files   []bundleFile `json:"files"`
+}
+
+// collect finds every file to embed, sorted by name
+func collect(assets string, large int64) ([]bundleFile, error) {
+	var paths []string
+	top, err := filepath.Glob(filepath.Join(assets, atlasName+"-*.*"))
+	if err != nil {
+		return nil, err
+	}
+	paths = append(paths, top...)
+	if _, err := os.Stat(filepath.Join(assets, manifestName)); err == nil {
+		paths = append(paths, filepath.Join(assets, manifestName))
+	}
+	for _, dir := range bundledDirs {
+		err := filepath.Walk(filepath.Join(assets, dir), func(p string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			paths = append(paths, p)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	files := make([]bundleFile, 0, len(paths))
+	for _, p := range paths {
+		rel, err := filepath.Rel(assets, p)
+		if err != nil {
+			return nil, err
+		}
+		data, err := ioutil.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(data)
+		files = append(files, bundleFile{
+			Name:   filepath.ToSlash(rel),
+			Size:   int64(len(data)),
+			SHA256: hex.EncodeToString(sum[:]),
+			Large:  int64(len(data)) >= large,
+			path:   p,
+		})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	return files, nil
+}
+
+// writeManifest writes assets/manifest.json, leaving itself out of the file list
+func writeManifest(assets string, files []bundleFile, n names) error {
+	m := manifest{Atlases: n.Atlases, Sprites: n.Sprites, Sounds: n.Sounds}
+	for _, f := range files {
+		if f.Name != manifestName {
+			m.Files = append(m.Files, f)
+		}
+	}
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(assets, manifestName), append(data, '\n'), 0644)
+}
+
+// writeBundle writes a Go file holding each file's bytes, and bundle.go
+// mapping names to them. Bundle files from an earlier run are removed first.
+func writeBundle(src string, files []bundleFile) error {
+	for _, prefix := range []string{bundlePrefix, largePrefix} {
+		old, err := filepath.Glob(filepath.Join(src, prefix+"*.go"))
+		if err != nil {
+			return err
+		}
+		for _, file := range old {
+			if err := os.Remove(file); err != nil {
+				return err
+			}
+		}
+	}
+
+	var small strings.Builder
+	for _, f := range files {
+		data, err := ioutil.ReadFile(f.path)
+		if err != nil {
+			return err
+		}
+		v := "bundle" + ident(f.Name)
+		var b strings.Builder
+		b.WriteString(generated)
+		prefix := bundlePrefix
+		if f.Large {
+			prefix = largePrefix
+			fmt.Fprintf(&b, "// +build %s\n\n", largeTag)
+		}
+		fmt.Fprintf(&b, "package main\n\nvar %s = []byte(%s)\n", v, strconv.Quote(string(data)))
+		if f.Large {
+			fmt.Fprintf(&b, "\nfunc init() {\n\tembeddedAssets[%q] = %s\n}\n", f.Name, v)
+		} else {
+			fmt.Fprintf(&small, "\t%q: %s,\n", f.Name, v)
+		}
+		// the name always ends in the file extension, never a GOOS or _test that would change the build
+		file := filepath.Join(src, prefix+strings.ToLower(snake(f.Name))+".go")
+		if f.Large {
+			// go/format would add a //go:build line, which the Go 1.14 this builds with does not know
+			err = ioutil.WriteFile(file, []byte(b.String()), 0644)
+		} else {
+			err = writeGo(file, b.String())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	out := generated + "package main\n\n" +
+		"// embeddedAssets are the files bundled from assets/ by name, large ones are added by -tags " + largeTag + "\n" +
+		"var embeddedAssets = map[string][]byte{\n" + small.String() + "}\n"
+	return writeGo(filepath.Join(src, bundleMapFile), out)
+}
+
+// writeGo formats Go source and writes it
+func writeGo(file string, src string) error {
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		return fmt.Errorf("%s: %v", file, err)
+	}
+	return ioutil.WriteFile(file, formatted, 0644)
+}
+
+// snake joins the words of a file name with underscores, data/sfx.json becomes data_sfx_json
+func snake(name string) string {
+	return strings.Join(words(name), "_")
+}
+
+// words splits a name on anything that is not a letter or digit
+func words(name string) []string {
+	return strings.FieldsFunc(name, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+}
+
+// pageNumber is N in atlas-N.xml, for sorting pages in order
+func pageNumber(name string) int {
+	n, _ := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, atlasName+"-"), path.Ext(name)))
+	return n
+}
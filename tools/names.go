@@ -0,0 +1,178 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/leenattress/goshootygame/src/atlas"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const namesFile = "assetNames.go"
+
+// soundLists are the data files whose keys are sound names in the game's audio manager
+var soundLists = []string{"data/sfx.json", "data/synth.json"}
+
+const (
+	emittersFile    = "data/emitters.json" // particle bursts name the sprite they draw
+	soundEventsFile = "data/sounds.json"   // game events name the sound they play
+)
+
+// names are every atlas, sprite and sound the game can ask for
+type names struct {
+	Atlases []string
+	Sprites []string
+	Sounds  []string
+}
+
+// readNames reads the sprite names from the atlas pages and the sound names from the sound lists
+func readNames(assets string, files []bundleFile) (names, error) {
+	var n names
+	for _, f := range files {
+		if path.Dir(f.Name) == "." && strings.HasPrefix(f.Name, atlasName+"-") && path.Ext(f.Name) == ".xml" {
+			n.Atlases = append(n.Atlases, f.Name)
+		}
+	}
+	sort.Slice(n.Atlases, func(i, j int) bool { return pageNumber(n.Atlases[i]) < pageNumber(n.Atlases[j]) })
+	if len(n.Atlases) == 0 {
+		return n, fmt.Errorf("no %s-N.xml atlas in %s", atlasName, assets)
+	}
+
+	var pages []*atlas.Atlas
+	for _, name := range n.Atlases {
+		data, err := ioutil.ReadFile(filepath.Join(assets, filepath.FromSlash(name)))
+		if err != nil {
+			return n, err
+		}
+		a, err := atlas.Parse(name, data)
+		if err != nil {
+			return n, err
+		}
+		pages = append(pages, a)
+	}
+	all, err := atlas.Merge(pages...)
+	if err != nil {
+		return n, err
+	}
+	for _, f := range all.Frames {
+		n.Sprites = append(n.Sprites, f.Name)
+	}
+	sort.Strings(n.Sprites)
+
+	seen := make(map[string]string)
+	for _, list := range soundLists {
+		data, err := ioutil.ReadFile(filepath.Join(assets, filepath.FromSlash(list)))
+		if err != nil {
+			return n, err
+		}
+		var sounds map[string]json.RawMessage
+		if err := json.Unmarshal(data, &sounds); err != nil {
+			return n, fmt.Errorf("%s: %v", list, err)
+		}
+		for name := range sounds {
+			if other, ok := seen[name]; ok {
+				return n, fmt.Errorf("sound %q is in both %s and %s", name, other, list)
+			}
+			seen[name] = list
+			n.Sounds = append(n.Sounds, name)
+		}
+	}
+	sort.Strings(n.Sounds)
+	return n, nil
+}
+
+// checkNames makes sure the data files only name sprites and sounds that exist,
+// so a typo is found here rather than when the game loads
+func checkNames(assets string, n names) error {
+	read := func(file string, into interface{}) error {
+		data, err := ioutil.ReadFile(filepath.Join(assets, filepath.FromSlash(file)))
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, into); err != nil {
+			return fmt.Errorf("%s: %v", file, err)
+		}
+		return nil
+	}
+	has := func(list []string, name string) bool {
+		i := sort.SearchStrings(list, name)
+		return i < len(list) && list[i] == name
+	}
+
+	var emitters map[string][]struct {
+		Sprite string `json:"sprite"`
+	}
+	if err := read(emittersFile, &emitters); err != nil {
+		return err
+	}
+	for name, parts := range emitters {
+		for _, p := range parts {
+			if p.Sprite != "" && !has(n.Sprites, p.Sprite) {
+				return fmt.Errorf("%s: emitter %s: no sprite named %q", emittersFile, name, p.Sprite)
+			}
+		}
+	}
+
+	var events map[string]struct {
+		Sound string `json:"sound"`
+	}
+	if err := read(soundEventsFile, &events); err != nil {
+		return err
+	}
+	for name, e := range events {
+		if !has(n.Sounds, e.Sound) {
+			return fmt.Errorf("%s: event %s: no sound named %q", soundEventsFile, name, e.Sound)
+		}
+	}
+	return nil
+}
+
+// writeNames writes a typed constant for every sprite and sound, so a misspelt name does not compile
+func writeNames(src string, n names) error {
+	var b strings.Builder
+	b.WriteString(generated + "package main\n")
+	for _, group := range []struct {
+		prefix   string
+		typeName string
+		typeDoc  string
+		doc      string
+		names    []string
+	}{
+		{"sprite", "SpriteName", "the name of a sprite in the atlas", "sprites in the atlas", n.Sprites},
+		{"sound", "SoundName", "the name of a sound in the audio manager", "sounds in the audio manager, from " + strings.Join(soundLists, " and "), n.Sounds},
+	} {
+		fmt.Fprintf(&b, "\n// %s is %s\ntype %s string\n", group.typeName, group.typeDoc, group.typeName)
+		fmt.Fprintf(&b, "\n// %s\nconst (\n", group.doc)
+		used := make(map[string]string)
+		for _, name := range group.names {
+			id := group.prefix + ident(name)
+			if other, ok := used[id]; ok {
+				return fmt.Errorf("%s names %q and %q both make the constant %s", group.prefix, other, name, id)
+			}
+			used[id] = name
+			fmt.Fprintf(&b, "\t%s %s = %q\n", id, group.typeName, name)
+		}
+		b.WriteString(")\n")
+	}
+	return writeGo(filepath.Join(src, namesFile), b.String())
+}
+
+// ident makes a Go identifier from a name, font\m becomes FontM and enemy1_0 becomes Enemy1_0
+func ident(name string) string {
+	var b strings.Builder
+	for _, w := range words(name) {
+		last := b.String()
+		if last != "" && isDigit(last[len(last)-1]) && isDigit(w[0]) {
+			b.WriteByte('_') // keep enemy1_0 and enemy10 apart
+		}
+		b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+	}
+	return b.String()
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
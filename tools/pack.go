@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"github.com/RaniSputnik/lovepac/packer"
+	"github.com/RaniSputnik/lovepac/target"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	atlasName = "atlas"  // pages are written as atlas-1.png and atlas-1.xml, then atlas-2...
+	framesDir = "frames" // texture folder holding a folder of numbered frames for each animation
+	frameSep  = "#"      // between the animation name and frame number, the game splits on it too
+)
+
+// framedAsset renames an asset so nested folders survive into the atlas name
+type framedAsset struct {
+	asset packer.Asset
+	name  string
+}
+
+// Reader opens the original asset
+func (a *framedAsset) Reader() (io.ReadCloser, error) {
+	return a.asset.Reader()
+}
+
+// Asset returns the flattened name of the asset
+func (a *framedAsset) Asset() string {
+	return a.name
+}
+
+// frameName flattens a path inside the texture folder. Animation frames live
+// in frames/, so frames/enemy1/0.png becomes enemy1#0.png, and any other
+// folder is joined with an underscore, so font/a.png becomes font_a.png.
+func frameName(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '/' || r == '\\'
+	})
+	if len(parts) == 3 && parts[0] == framesDir {
+		return parts[1] + frameSep + parts[2]
+	}
+	return strings.Join(parts, "_")
+}
+
+// frameStream renames every asset with frameName and remembers the sprite names it saw
+func frameStream(input packer.AssetStreamer, names *[]string, mu *sync.Mutex) packer.AssetStreamer {
+	return packer.AssetStreamerFunc(func(ctx context.Context) (<-chan packer.Asset, <-chan error) {
+		in, errc := input.AssetStream(ctx)
+		out := make(chan packer.Asset)
+		go func() {
+			defer close(out)
+			for asset := range in {
+				name := frameName(asset.Asset())
+				mu.Lock()
+				*names = append(*names, strings.TrimSuffix(name, path.Ext(name)))
+				mu.Unlock()
+				select {
+				case out <- &framedAsset{asset: asset, name: name}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return out, errc
+	})
+}
+
+// checkFrames warns about animations with missing frames, eg. enemy1#0 and
+// enemy1#2 without enemy1#1. Frames not starting at 0 are not an animation,
+// the game ignores them the same way.
+func checkFrames(names []string) {
+	frames := make(map[string][]int)
+	for _, name := range names {
+		i := strings.LastIndex(name, frameSep)
+		if i <= 0 {
+			continue
+		}
+		frame, err := strconv.Atoi(name[i+1:])
+		if err != nil || frame < 0 {
+			continue
+		}
+		frames[name[:i]] = append(frames[name[:i]], frame)
+	}
+	for anim, f := range frames {
+		sort.Ints(f)
+		if f[0] != 0 {
+			log.Printf("animation %s: has no frame 0, so it will not animate", anim)
+			continue
+		}
+		for i, frame := range f {
+			if frame != i {
+				log.Printf("animation %s: expected frame %d but found %d", anim, i, frame)
+				break
+			}
+		}
+	}
+}
+
+// pack packs every texture into atlas pages of size by size pixels, in Starling format
+func pack(textures string, out string, size int) error {
+	// old pages would be picked up as extra pages if the new atlas needs fewer
+	old, err := filepath.Glob(filepath.Join(out, atlasName+"-*.*"))
+	if err != nil {
+		return err
+	}
+	for _, file := range old {
+		if err := os.Remove(file); err != nil {
+			return err
+		}
+	}
+
+	var names []string
+	var mu sync.Mutex
+
+	params := packer.Params{
+		Name:   atlasName,
+		Format: target.Starling,
+		Input:  frameStream(packer.NewFileStream(textures), &names, &mu),
+		Output: packer.NewFileOutputter(out),
+		Width:  size,
+		Height: size,
+	}
+	if err := packer.Run(context.Background(), &params); err != nil {
+		return err
+	}
+
+	checkFrames(names)
+	return nil
+}
@@ -0,0 +1,97 @@
+// Command sfxr auditions and exports the game's synthesised sound effects.
+//
+//	go run ./tools/sfxr -preset laser -seed 7 -play -json
+//	go run ./tools/sfxr -sounds assets/data/synth.json -name laser -wav laser.wav
+//
+// Start from a preset and seed, or from a sound in the game's synth file,
+// then listen to it, print its parameters as JSON to paste into the synth
+// file, or write it out as a WAV.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/hajimehoshi/ebiten/audio"
+	"github.com/leenattress/goshootygame/src/sfxr"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+const sampleRate = 44100
+
+func main() {
+	preset := flag.String("preset", "", "start from a preset: "+strings.Join(sfxr.Presets(), ", "))
+	seed := flag.Int64("seed", 1, "variation of the preset")
+	sounds := flag.String("sounds", "", "synth JSON file to read a sound from")
+	name := flag.String("name", "", "sound to read from the synth file")
+	wav := flag.String("wav", "", "write the sound to this WAV file")
+	printJSON := flag.Bool("json", false, "print the sound's parameters as JSON")
+	play := flag.Bool("play", false, "play the sound")
+	flag.Parse()
+
+	var p sfxr.Params
+	switch {
+	case *preset != "":
+		var ok bool
+		p, ok = sfxr.Preset(*preset, *seed)
+		if !ok {
+			log.Fatalf("no preset named %q, try one of %s", *preset, strings.Join(sfxr.Presets(), ", "))
+		}
+	case *sounds != "" && *name != "":
+		data, err := ioutil.ReadFile(*sounds)
+		if err != nil {
+			log.Fatal(err)
+		}
+		var all map[string]sfxr.Params
+		if err := json.Unmarshal(data, &all); err != nil {
+			log.Fatalf("%s: %v", *sounds, err)
+		}
+		var ok bool
+		p, ok = all[*name]
+		if !ok {
+			log.Fatalf("%s: no sound named %q", *sounds, *name)
+		}
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *printJSON {
+		out, err := json.MarshalIndent(p, "", "  ")
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(out))
+	}
+
+	pcm := sfxr.Generate(p, sampleRate)
+
+	if *wav != "" {
+		var buf bytes.Buffer
+		if err := sfxr.WriteWAV(&buf, pcm, sampleRate); err != nil {
+			log.Fatal(err)
+		}
+		if err := ioutil.WriteFile(*wav, buf.Bytes(), 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if *play {
+		context, err := audio.NewContext(sampleRate)
+		if err != nil {
+			log.Fatal(err)
+		}
+		player, err := audio.NewPlayerFromBytes(context, pcm)
+		if err != nil {
+			log.Fatal(err)
+		}
+		player.Play()
+		// leave a little longer than the sound for the audio buffer to drain
+		time.Sleep(time.Duration(len(pcm)/4)*time.Second/sampleRate + 200*time.Millisecond)
+	}
+}